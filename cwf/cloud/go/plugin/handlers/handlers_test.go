@@ -229,7 +229,7 @@ func TestCwfNetworks(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// setup fixtures in backend
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.UpgradeTierEntityType, Key: "t1"},
@@ -312,7 +312,7 @@ func TestCwfGateways(t *testing.T) {
 	seedCwfNetworks(t)
 
 	// setup fixtures in backend
-	_, err := configurator.CreateEntities(
+	_, err := configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.UpgradeTierEntityType, Key: "t1"},
@@ -563,7 +563,7 @@ func TestCwfGateways(t *testing.T) {
 // n1, n3 are cwf networks, n2, n5 are not
 func seedCwfNetworks(t *testing.T) {
 	fegNetworkID := "n5"
-	_, err := configurator.CreateNetworks(
+	_, err := configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          fegNetworkID,
@@ -579,7 +579,7 @@ func seedCwfNetworks(t *testing.T) {
 		},
 	)
 	assert.NoError(t, err)
-	_, err = configurator.CreateNetworks(
+	_, err = configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          "n1",
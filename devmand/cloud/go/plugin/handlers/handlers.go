@@ -147,7 +147,7 @@ func createNetwork(c echo.Context) error {
 	if err := payload.Validate(strfmt.Default); err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
-	err := configurator.CreateNetwork(payload.ToConfiguratorNetwork())
+	err := configurator.CreateNetwork(c.Request().Context(), payload.ToConfiguratorNetwork())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -202,7 +202,7 @@ func updateNetwork(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a Symphony network", nid))
 	}
 
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
+	err = configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -227,7 +227,7 @@ func deleteNetwork(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a Symphony network", nid))
 	}
 
-	err = configurator.DeleteNetwork(nid)
+	err = configurator.DeleteNetwork(c.Request().Context(), nid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -320,7 +320,7 @@ func deleteAgent(c echo.Context) error {
 			Type: orc8r.MagmadGatewayType, Key: aid, DeleteEntity: true,
 		},
 	}
-	err := configurator.WriteEntities(nid, updates...)
+	err := configurator.WriteEntities(c.Request().Context(), nid, updates...)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -372,7 +372,7 @@ func createDevice(c echo.Context) error {
 	for _, update := range symphonymodels.GetAgentUpdates(string(payload.ID), "", string(payload.ManagingAgent)) {
 		writes = append(writes, update)
 	}
-	err := configurator.WriteEntities(nid, writes...)
+	err := configurator.WriteEntities(c.Request().Context(), nid, writes...)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -419,7 +419,7 @@ func updateDevice(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntities(nid, deviceUpdates)
+	_, err = configurator.UpdateEntities(c.Request().Context(), nid, deviceUpdates)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -440,7 +440,7 @@ func deleteDevice(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	err = configurator.DeleteEntity(nid, devmand.SymphonyDeviceType, did)
+	err = configurator.DeleteEntity(c.Request().Context(), nid, devmand.SymphonyDeviceType, did)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -1989,7 +1989,7 @@ func seedNetworks(t *testing.T) {
 	err := device.RegisterDevice("n1", orc8r.AccessGatewayRecordType, "hw1", gatewayRecord)
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateNetworks(
+	_, err = configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			models2.NewDefaultSymphonyNetwork().ToConfiguratorNetwork(),
 			{
@@ -2006,7 +2006,7 @@ func seedNetworks(t *testing.T) {
 
 func seedPreAgent(t *testing.T) {
 	// Create Tier necessary for the Agent's gateway to be in
-	_, err := configurator.CreateEntities(
+	_, err := configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -2019,7 +2019,7 @@ func seedPreAgent(t *testing.T) {
 
 func seedAgents(t *testing.T) {
 	nID := "n1"
-	_, err := configurator.CreateEntities(
+	_, err := configurator.CreateEntities(context.Background(),
 		nID,
 		[]configurator.NetworkEntity{
 			{
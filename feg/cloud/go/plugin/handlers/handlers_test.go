@@ -199,7 +199,7 @@ func TestFederationNetworks(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// setup fixtures in backend
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.UpgradeTierEntityType, Key: "t1"},
@@ -277,7 +277,7 @@ func TestFederationGateways(t *testing.T) {
 	seedFederationNetworks(t)
 
 	// setup fixtures in backend
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.UpgradeTierEntityType, Key: "t1"},
@@ -651,7 +651,7 @@ func TestFederatedLteNetworks(t *testing.T) {
 
 // n1, n3 are feg networks, n2 is not
 func seedFederationNetworks(t *testing.T) {
-	_, err := configurator.CreateNetworks(
+	_, err := configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          "n1",
@@ -685,7 +685,7 @@ func seedFederationNetworks(t *testing.T) {
 
 // n1, n3 are feg networks, n2 is not
 func seedFederatedLteNetworks(t *testing.T) {
-	_, err := configurator.CreateNetworks(
+	_, err := configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          "n1",
@@ -97,6 +97,9 @@ func (*Builder) Build(
 	if swxc != nil {
 		mc := &mconfig.SwxConfig{LogLevel: protos.LogLevel_INFO}
 		protos.FillIn(swxc, mc)
+		if swxc.Server != nil {
+			mc.Server = swxc.Server.ToMconfig()
+		}
 		mconfigOut["swx_proxy"] = mc
 	}
 
@@ -68,6 +68,27 @@ func TestBuilder_Build(t *testing.T) {
 				ProductName:      "magma",
 				Realm:            "magma.com",
 				Host:             "magma-fedgw.magma.com",
+				Peers: []*mconfig.DiamPeerConfig{
+					{
+						Protocol:  "sctp",
+						Address:   "hss2.magma.com:3868",
+						DestHost:  "hss2.magma.com",
+						DestRealm: "magma.com",
+						Priority:  1,
+						Weight:    2,
+						Realms:    []string{"*"},
+						Tls: &mconfig.DiamTLSConfig{
+							Security: "tls",
+							CaFile:   "/var/opt/magma/certs/hss_ca.pem",
+						},
+					},
+				},
+				Tls: &mconfig.DiamTLSConfig{
+					Security:   "tls",
+					CaFile:     "/var/opt/magma/certs/hss_ca.pem",
+					ServerName: "hss.magma.com",
+					MinVersion: "1.2",
+				},
 			},
 			RequestFailureThreshold: 0.50,
 			MinimumRequestThreshold: 1,
@@ -77,6 +98,11 @@ func TestBuilder_Build(t *testing.T) {
 				Protocol:  "tcp",
 				DestHost:  "magma.com",
 				DestRealm: "magma.com",
+				Tls: &mconfig.DiamTLSConfig{
+					Security: "inband",
+					CertFile: "/var/opt/magma/certs/hss.pem",
+					KeyFile:  "/var/opt/magma/certs/hss.key",
+				},
 			},
 			LteAuthOp:  []byte("EREREREREREREREREREREQ=="),
 			LteAuthAmf: []byte("gA"),
@@ -145,6 +171,7 @@ func TestBuilder_Build(t *testing.T) {
 			IdleSessionTimeoutMs: 21600000,
 			AccountingEnabled:    false,
 			CreateSessionOnAuth:  false,
+			PersistSessions:      true,
 		},
 		"health": &mconfig.GatewayHealthConfig{
 			RequiredServices:          []string{"SWX_PROXY", "SESSION_PROXY"},
@@ -180,6 +207,27 @@ var defaultConfig = &models.NetworkFederationConfigs{
 			ProductName:      "magma",
 			Host:             "magma-fedgw.magma.com",
 			Realm:            "magma.com",
+			Peers: []*models.DiameterPeerConfigs{
+				{
+					Protocol:  "sctp",
+					Address:   "hss2.magma.com:3868",
+					DestHost:  "hss2.magma.com",
+					DestRealm: "magma.com",
+					Priority:  1,
+					Weight:    2,
+					Realms:    []string{"*"},
+					TLS: &models.DiameterTLSConfigs{
+						Security: "tls",
+						CaFile:   "/var/opt/magma/certs/hss_ca.pem",
+					},
+				},
+			},
+			TLS: &models.DiameterTLSConfigs{
+				Security:   "tls",
+				CaFile:     "/var/opt/magma/certs/hss_ca.pem",
+				ServerName: "hss.magma.com",
+				MinVersion: "1.2",
+			},
 		},
 	},
 	Gx: &models.Gx{
@@ -210,6 +258,11 @@ var defaultConfig = &models.NetworkFederationConfigs{
 			Protocol:  "tcp",
 			DestHost:  "magma.com",
 			DestRealm: "magma.com",
+			TLS: &models.DiameterTLSConfigs{
+				Security: "inband",
+				CertFile: "/var/opt/magma/certs/hss.pem",
+				KeyFile:  "/var/opt/magma/certs/hss.key",
+			},
 		},
 		LteAuthOp:  []byte("EREREREREREREREREREREQ=="),
 		LteAuthAmf: []byte("gA"),
@@ -246,6 +299,7 @@ var defaultConfig = &models.NetworkFederationConfigs{
 		IDLESessionTimeoutMs: 21600000,
 		AccountingEnabled:    false,
 		CreateSessionOnAuth:  false,
+		PersistSessions:      true,
 	},
 	ServedNetworkIds: []string{},
 	Health: &models.Health{
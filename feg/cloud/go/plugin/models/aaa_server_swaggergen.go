@@ -23,6 +23,9 @@ type AaaServer struct {
 
 	// idle session timeout ms
 	IDLESessionTimeoutMs uint32 `json:"idle_session_timeout_ms,omitempty" magma_alt_name:"IdleSessionTimeoutMs"`
+
+	// Persist sessions in Redis, so they survive AAA server restarts
+	PersistSessions bool `json:"persist_sessions,omitempty"`
 }
 
 // Validate validates this aaa server
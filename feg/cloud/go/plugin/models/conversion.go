@@ -249,6 +249,18 @@ func (m *GatewayFederationConfigs) ToUpdateCriteria(networkID string, gatewayID
 func (m *DiameterClientConfigs) ToMconfig() *mconfig.DiamClientConfig {
 	res := &mconfig.DiamClientConfig{}
 	protos.FillIn(m, res)
+	if m == nil {
+		return res
+	}
+	// FillIn doesn't copy slices of structs
+	for _, peer := range m.Peers {
+		if peer == nil {
+			continue
+		}
+		peerRes := &mconfig.DiamPeerConfig{}
+		protos.FillIn(peer, peerRes)
+		res.Peers = append(res.Peers, peerRes)
+	}
 	return res
 }
 
@@ -7,6 +7,7 @@ package models
 
 import (
 	"encoding/json"
+	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
@@ -40,6 +41,9 @@ type DiameterClientConfigs struct {
 	// Pattern: [0-9a-f\:\.]*(:[0-9]{1,5})?
 	LocalAddress string `json:"local_address,omitempty"`
 
+	// Alternate peers of the server, such as secondary servers or DRAs
+	Peers []*DiameterPeerConfigs `json:"peers,omitempty"`
+
 	// product name
 	// Min Length: 1
 	ProductName string `json:"product_name,omitempty"`
@@ -58,6 +62,9 @@ type DiameterClientConfigs struct {
 	// retry count
 	RetryCount uint32 `json:"retry_count,omitempty"`
 
+	// tls
+	TLS *DiameterTLSConfigs `json:"tls,omitempty"`
+
 	// watchdog interval
 	WatchdogInterval uint32 `json:"watchdog_interval,omitempty"`
 }
@@ -78,6 +85,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePeers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProductName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,6 +101,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTLS(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -135,6 +150,31 @@ func (m *DiameterClientConfigs) validateLocalAddress(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *DiameterClientConfigs) validatePeers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Peers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Peers); i++ {
+		if swag.IsZero(m.Peers[i]) { // not required
+			continue
+		}
+
+		if m.Peers[i] != nil {
+			if err := m.Peers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("peers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateProductName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ProductName) { // not required
@@ -216,6 +256,24 @@ func (m *DiameterClientConfigs) validateRealm(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DiameterClientConfigs) validateTLS(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TLS) { // not required
+		return nil
+	}
+
+	if m.TLS != nil {
+		if err := m.TLS.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tls")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DiameterClientConfigs) MarshalBinary() ([]byte, error) {
 	if m == nil {
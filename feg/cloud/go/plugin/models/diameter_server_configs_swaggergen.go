@@ -36,6 +36,9 @@ type DiameterServerConfigs struct {
 	// protocol
 	// Enum: [tcp tcp4 tcp6 sctp sctp4 sctp6]
 	Protocol string `json:"protocol,omitempty"`
+
+	// tls
+	TLS *DiameterTLSConfigs `json:"tls,omitempty"`
 }
 
 // Validate validates this diameter server configs
@@ -54,6 +57,10 @@ func (m *DiameterServerConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTLS(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -141,6 +148,24 @@ func (m *DiameterServerConfigs) validateProtocol(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *DiameterServerConfigs) validateTLS(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TLS) { // not required
+		return nil
+	}
+
+	if m.TLS != nil {
+		if err := m.TLS.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tls")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DiameterServerConfigs) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -29,23 +29,25 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("feg/protos/hss_service.proto", fileDescriptor_6adda26d69f7818f) }
 
 var fileDescriptor_6adda26d69f7818f = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x41, 0x4b, 0x03, 0x31,
-	0x10, 0x85, 0x8b, 0x07, 0xc1, 0x80, 0xd8, 0x06, 0x41, 0x76, 0xd5, 0x4b, 0x7f, 0x40, 0x16, 0xf4,
-	0xe2, 0x4d, 0xad, 0x01, 0xed, 0x79, 0xd1, 0x83, 0x17, 0xc9, 0x26, 0xb3, 0x31, 0xb0, 0xe9, 0x94,
-	0xc9, 0xac, 0xff, 0xd9, 0x7f, 0x21, 0x66, 0x5b, 0xb5, 0xd0, 0x1e, 0xec, 0x29, 0xf0, 0xde, 0xe3,
-	0xcb, 0x1b, 0x9e, 0xb8, 0x68, 0xc1, 0x57, 0x4b, 0x42, 0xc6, 0x54, 0xbd, 0xa7, 0xf4, 0x96, 0x80,
-	0x3e, 0x82, 0x05, 0x95, 0x25, 0x79, 0x14, 0x8d, 0x8f, 0x46, 0xb5, 0xe0, 0xcb, 0x02, 0xc9, 0xde,
-	0xd0, 0x3a, 0x6a, 0x31, 0x46, 0x5c, 0x0c, 0xa9, 0xf2, 0xb2, 0x63, 0x58, 0x1b, 0xa9, 0x6f, 0x92,
-	0xa5, 0xd0, 0x00, 0xb9, 0x66, 0xb0, 0xaf, 0x3e, 0x0f, 0xc4, 0xc9, 0x53, 0x5d, 0x3f, 0xe0, 0xa2,
-	0x0d, 0xbe, 0x27, 0xc3, 0x48, 0xf2, 0x56, 0x1c, 0xdf, 0x3b, 0x57, 0xff, 0x84, 0x65, 0xa1, 0x86,
-	0xaf, 0x3a, 0x06, 0xf5, 0x2b, 0x6b, 0xc3, 0xa6, 0x9c, 0xac, 0xac, 0x5c, 0x40, 0xbd, 0x60, 0x70,
-	0xd3, 0x91, 0xbc, 0x13, 0x63, 0x0d, 0x1d, 0x30, 0xfc, 0x61, 0x9c, 0x6d, 0x65, 0xcc, 0xf5, 0x76,
-	0xc2, 0x4c, 0x8c, 0x9f, 0x97, 0xce, 0x6c, 0x10, 0xfe, 0xdb, 0x62, 0x2e, 0x26, 0x8f, 0xc0, 0x9b,
-	0xc9, 0xdd, 0x35, 0x76, 0xd3, 0xa7, 0x23, 0xa9, 0xc5, 0xa9, 0x06, 0x02, 0x1f, 0x12, 0x03, 0xed,
-	0x7b, 0xd4, 0xec, 0xfc, 0xb5, 0xc8, 0x6a, 0xf5, 0x3d, 0xab, 0xed, 0xb0, 0x77, 0x95, 0xc7, 0xd5,
-	0x36, 0xcd, 0x61, 0x7e, 0xaf, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xaf, 0xf4, 0x1b, 0x20, 0xf4,
-	0x01, 0x00, 0x00,
+	// 283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4b, 0xc3, 0x30,
+	0x18, 0xc5, 0x77, 0x10, 0xc1, 0x80, 0xb8, 0x95, 0x81, 0xb4, 0xea, 0x65, 0x07, 0x8f, 0x29, 0x28,
+	0x88, 0x37, 0x75, 0x06, 0xb4, 0x57, 0x8b, 0x1e, 0xbc, 0x8c, 0xb4, 0xf9, 0x1a, 0x0b, 0x6d, 0xbf,
+	0xfa, 0xe5, 0xab, 0xe8, 0xdd, 0x3f, 0x5c, 0x6c, 0xbb, 0x39, 0xa5, 0x03, 0xd1, 0x53, 0xe0, 0xbd,
+	0xc7, 0x2f, 0x2f, 0xe1, 0x89, 0xc3, 0x0c, 0x6c, 0x58, 0x13, 0x32, 0xba, 0xf0, 0xc9, 0xb9, 0x85,
+	0x03, 0x7a, 0xc9, 0x53, 0x90, 0xad, 0xe4, 0xed, 0x94, 0xda, 0x96, 0x5a, 0x66, 0x60, 0x03, 0x1f,
+	0x29, 0x3d, 0xa7, 0x65, 0x34, 0xc5, 0xb2, 0xc4, 0xaa, 0x4b, 0x05, 0x47, 0x05, 0xc3, 0xd2, 0x70,
+	0x4d, 0xe2, 0x52, 0xca, 0x13, 0x20, 0x93, 0xf4, 0x76, 0xb0, 0x76, 0x85, 0x3b, 0xd3, 0x8b, 0x9a,
+	0xf0, 0xf5, 0xad, 0xf3, 0x4e, 0xde, 0xb7, 0xc4, 0xde, 0x6d, 0x1c, 0x5f, 0x63, 0x95, 0xe5, 0xb6,
+	0x21, 0xcd, 0x48, 0xde, 0x85, 0xd8, 0xbd, 0x32, 0x26, 0x5e, 0x81, 0x3c, 0x5f, 0x76, 0x35, 0x0a,
+	0x06, 0xf9, 0x25, 0x2b, 0xcd, 0x3a, 0x98, 0xf4, 0x56, 0x5b, 0x4e, 0x3e, 0x60, 0x6e, 0x66, 0x23,
+	0xef, 0x52, 0x8c, 0x15, 0x14, 0xc0, 0xb0, 0xc6, 0xd8, 0x1f, 0x64, 0x44, 0x6a, 0x98, 0x30, 0x17,
+	0xe3, 0xfb, 0xda, 0x68, 0x86, 0x7f, 0xb4, 0x88, 0xc4, 0xe4, 0x06, 0xf8, 0x7b, 0x72, 0x73, 0x8d,
+	0xcd, 0xf4, 0xd9, 0xc8, 0x53, 0x62, 0xaa, 0x80, 0xc0, 0xe6, 0x8e, 0x81, 0xfe, 0xfc, 0x28, 0x25,
+	0xa6, 0x51, 0xe5, 0x80, 0x7e, 0xdd, 0x69, 0x90, 0x12, 0x8b, 0xe9, 0xcf, 0xcf, 0x6d, 0x29, 0xc7,
+	0x72, 0xb5, 0x15, 0x39, 0x14, 0xb8, 0x83, 0xe7, 0x06, 0x1c, 0x0f, 0x42, 0xe7, 0x07, 0x8f, 0x7e,
+	0xab, 0x86, 0x9f, 0x53, 0x49, 0x0b, 0x6c, 0x4c, 0x68, 0xb1, 0xdf, 0x4c, 0xb2, 0xdd, 0x9e, 0xa7,
+	0x1f, 0x03, 0x00, 0xe0, 0xf3, 0x0f, 0x98, 0xab, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -78,6 +80,16 @@ type HSSConfiguratorClient interface {
 	GetSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos.SubscriberData, error)
 	// De-register an authenticated subscriber
 	DeregisterSubscriber(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Sends the subscriber's current subscription data to the MME serving it
+	// with an Insert-Subscriber-Data request.
+	// Throws FAILED_PRECONDITION if no MME is serving the subscriber.
+	//
+	InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Deletes some of the subscriber's data at the MME serving it with a
+	// Delete-Subscriber-Data request.
+	// Throws FAILED_PRECONDITION if no MME is serving the subscriber.
+	//
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error)
 }
 
 type hSSConfiguratorClient struct {
@@ -133,6 +145,24 @@ func (c *hSSConfiguratorClient) DeregisterSubscriber(ctx context.Context, in *pr
 	return out, nil
 }
 
+func (c *hSSConfiguratorClient) InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hSSConfiguratorClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HSSConfiguratorServer is the server API for HSSConfigurator service.
 type HSSConfiguratorServer interface {
 	// Adds a new subscriber to the store.
@@ -153,6 +183,16 @@ type HSSConfiguratorServer interface {
 	GetSubscriberData(context.Context, *protos.SubscriberID) (*protos.SubscriberData, error)
 	// De-register an authenticated subscriber
 	DeregisterSubscriber(context.Context, *protos.SubscriberID) (*protos1.Void, error)
+	// Sends the subscriber's current subscription data to the MME serving it
+	// with an Insert-Subscriber-Data request.
+	// Throws FAILED_PRECONDITION if no MME is serving the subscriber.
+	//
+	InsertSubscriberData(context.Context, *protos.SubscriberID) (*protos1.Void, error)
+	// Deletes some of the subscriber's data at the MME serving it with a
+	// Delete-Subscriber-Data request.
+	// Throws FAILED_PRECONDITION if no MME is serving the subscriber.
+	//
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*protos1.Void, error)
 }
 
 // UnimplementedHSSConfiguratorServer can be embedded to have forward compatible implementations.
@@ -174,6 +214,12 @@ func (*UnimplementedHSSConfiguratorServer) GetSubscriberData(ctx context.Context
 func (*UnimplementedHSSConfiguratorServer) DeregisterSubscriber(ctx context.Context, req *protos.SubscriberID) (*protos1.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeregisterSubscriber not implemented")
 }
+func (*UnimplementedHSSConfiguratorServer) InsertSubscriberData(ctx context.Context, req *protos.SubscriberID) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSubscriberData not implemented")
+}
+func (*UnimplementedHSSConfiguratorServer) DeleteSubscriberData(ctx context.Context, req *DeleteSubscriberDataRequest) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriberData not implemented")
+}
 
 func RegisterHSSConfiguratorServer(s *grpc.Server, srv HSSConfiguratorServer) {
 	s.RegisterService(&_HSSConfigurator_serviceDesc, srv)
@@ -269,6 +315,42 @@ func _HSSConfigurator_DeregisterSubscriber_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HSSConfigurator_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.SubscriberID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, req.(*protos.SubscriberID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HSSConfigurator_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.HSSConfigurator",
 	HandlerType: (*HSSConfiguratorServer)(nil),
@@ -293,6 +375,14 @@ var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeregisterSubscriber",
 			Handler:    _HSSConfigurator_DeregisterSubscriber_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _HSSConfigurator_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _HSSConfigurator_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/hss_service.proto",
@@ -53,21 +53,23 @@ func (GyInitMethod) EnumDescriptor() ([]byte, []int) {
 // FeG configs
 //------------------------------------------------------------------------------
 type DiamClientConfig struct {
-	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Retransmits          uint32   `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
-	WatchdogInterval     uint32   `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
-	RetryCount           uint32   `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LocalAddress         string   `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	ProductName          string   `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Realm                string   `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
-	Host                 string   `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
-	DestRealm            string   `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
-	DestHost             string   `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
-	DisableDestHost      bool     `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Protocol             string            `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address              string            `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Retransmits          uint32            `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	WatchdogInterval     uint32            `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
+	RetryCount           uint32            `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LocalAddress         string            `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	ProductName          string            `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Realm                string            `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
+	Host                 string            `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+	DestRealm            string            `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost             string            `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	DisableDestHost      bool              `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
+	Peers                []*DiamPeerConfig `protobuf:"bytes,13,rep,name=peers,proto3" json:"peers,omitempty"`
+	Tls                  *DiamTLSConfig    `protobuf:"bytes,14,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DiamClientConfig) Reset()         { *m = DiamClientConfig{} }
@@ -179,22 +181,227 @@ func (m *DiamClientConfig) GetDisableDestHost() bool {
 	return false
 }
 
-type DiamServerConfig struct {
-	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	LocalAddress         string   `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	DestHost             string   `protobuf:"bytes,4,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
-	DestRealm            string   `protobuf:"bytes,5,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+func (m *DiamClientConfig) GetPeers() []*DiamPeerConfig {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetTls() *DiamTLSConfig {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+type DiamTLSConfig struct {
+	Security             string   `protobuf:"bytes,1,opt,name=security,proto3" json:"security,omitempty"`
+	CaFile               string   `protobuf:"bytes,2,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	CertFile             string   `protobuf:"bytes,3,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile              string   `protobuf:"bytes,4,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	ServerName           string   `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	MinVersion           string   `protobuf:"bytes,6,opt,name=min_version,json=minVersion,proto3" json:"min_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *DiamTLSConfig) Reset()         { *m = DiamTLSConfig{} }
+func (m *DiamTLSConfig) String() string { return proto.CompactTextString(m) }
+func (*DiamTLSConfig) ProtoMessage()    {}
+func (*DiamTLSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac1e34e12c6f455d, []int{1}
+}
+
+func (m *DiamTLSConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiamTLSConfig.Unmarshal(m, b)
+}
+func (m *DiamTLSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiamTLSConfig.Marshal(b, m, deterministic)
+}
+func (m *DiamTLSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiamTLSConfig.Merge(m, src)
+}
+func (m *DiamTLSConfig) XXX_Size() int {
+	return xxx_messageInfo_DiamTLSConfig.Size(m)
+}
+func (m *DiamTLSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiamTLSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiamTLSConfig proto.InternalMessageInfo
+
+func (m *DiamTLSConfig) GetSecurity() string {
+	if m != nil {
+		return m.Security
+	}
+	return ""
+}
+
+func (m *DiamTLSConfig) GetCaFile() string {
+	if m != nil {
+		return m.CaFile
+	}
+	return ""
+}
+
+func (m *DiamTLSConfig) GetCertFile() string {
+	if m != nil {
+		return m.CertFile
+	}
+	return ""
+}
+
+func (m *DiamTLSConfig) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
+func (m *DiamTLSConfig) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *DiamTLSConfig) GetMinVersion() string {
+	if m != nil {
+		return m.MinVersion
+	}
+	return ""
+}
+
+type DiamPeerConfig struct {
+	Protocol             string         `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address              string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAddress         string         `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	DestRealm            string         `protobuf:"bytes,4,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost             string         `protobuf:"bytes,5,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	DisableDestHost      bool           `protobuf:"varint,6,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
+	Priority             uint32         `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	Weight               uint32         `protobuf:"varint,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Realms               []string       `protobuf:"bytes,9,rep,name=realms,proto3" json:"realms,omitempty"`
+	Tls                  *DiamTLSConfig `protobuf:"bytes,10,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DiamPeerConfig) Reset()         { *m = DiamPeerConfig{} }
+func (m *DiamPeerConfig) String() string { return proto.CompactTextString(m) }
+func (*DiamPeerConfig) ProtoMessage()    {}
+func (*DiamPeerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac1e34e12c6f455d, []int{2}
+}
+
+func (m *DiamPeerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiamPeerConfig.Unmarshal(m, b)
+}
+func (m *DiamPeerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiamPeerConfig.Marshal(b, m, deterministic)
+}
+func (m *DiamPeerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiamPeerConfig.Merge(m, src)
+}
+func (m *DiamPeerConfig) XXX_Size() int {
+	return xxx_messageInfo_DiamPeerConfig.Size(m)
+}
+func (m *DiamPeerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiamPeerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiamPeerConfig proto.InternalMessageInfo
+
+func (m *DiamPeerConfig) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetLocalAddress() string {
+	if m != nil {
+		return m.LocalAddress
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDestRealm() string {
+	if m != nil {
+		return m.DestRealm
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDestHost() string {
+	if m != nil {
+		return m.DestHost
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDisableDestHost() bool {
+	if m != nil {
+		return m.DisableDestHost
+	}
+	return false
+}
+
+func (m *DiamPeerConfig) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *DiamPeerConfig) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *DiamPeerConfig) GetRealms() []string {
+	if m != nil {
+		return m.Realms
+	}
+	return nil
+}
+
+func (m *DiamPeerConfig) GetTls() *DiamTLSConfig {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+type DiamServerConfig struct {
+	Protocol             string         `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address              string         `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAddress         string         `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	DestHost             string         `protobuf:"bytes,4,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	DestRealm            string         `protobuf:"bytes,5,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	Tls                  *DiamTLSConfig `protobuf:"bytes,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
 func (m *DiamServerConfig) Reset()         { *m = DiamServerConfig{} }
 func (m *DiamServerConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamServerConfig) ProtoMessage()    {}
 func (*DiamServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{1}
+	return fileDescriptor_ac1e34e12c6f455d, []int{3}
 }
 
 func (m *DiamServerConfig) XXX_Unmarshal(b []byte) error {
@@ -250,6 +457,13 @@ func (m *DiamServerConfig) GetDestRealm() string {
 	return ""
 }
 
+func (m *DiamServerConfig) GetTls() *DiamTLSConfig {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
 type S6AConfig struct {
 	LogLevel protos.LogLevel   `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
 	Server   *DiamClientConfig `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
@@ -266,7 +480,7 @@ func (m *S6AConfig) Reset()         { *m = S6AConfig{} }
 func (m *S6AConfig) String() string { return proto.CompactTextString(m) }
 func (*S6AConfig) ProtoMessage()    {}
 func (*S6AConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{2}
+	return fileDescriptor_ac1e34e12c6f455d, []int{4}
 }
 
 func (m *S6AConfig) XXX_Unmarshal(b []byte) error {
@@ -326,7 +540,7 @@ func (m *GxConfig) Reset()         { *m = GxConfig{} }
 func (m *GxConfig) String() string { return proto.CompactTextString(m) }
 func (*GxConfig) ProtoMessage()    {}
 func (*GxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{3}
+	return fileDescriptor_ac1e34e12c6f455d, []int{5}
 }
 
 func (m *GxConfig) XXX_Unmarshal(b []byte) error {
@@ -366,7 +580,7 @@ func (m *GyConfig) Reset()         { *m = GyConfig{} }
 func (m *GyConfig) String() string { return proto.CompactTextString(m) }
 func (*GyConfig) ProtoMessage()    {}
 func (*GyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{4}
+	return fileDescriptor_ac1e34e12c6f455d, []int{6}
 }
 
 func (m *GyConfig) XXX_Unmarshal(b []byte) error {
@@ -418,7 +632,7 @@ func (m *SessionProxyConfig) Reset()         { *m = SessionProxyConfig{} }
 func (m *SessionProxyConfig) String() string { return proto.CompactTextString(m) }
 func (*SessionProxyConfig) ProtoMessage()    {}
 func (*SessionProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{5}
+	return fileDescriptor_ac1e34e12c6f455d, []int{7}
 }
 
 func (m *SessionProxyConfig) XXX_Unmarshal(b []byte) error {
@@ -499,7 +713,7 @@ func (m *SwxConfig) Reset()         { *m = SwxConfig{} }
 func (m *SwxConfig) String() string { return proto.CompactTextString(m) }
 func (*SwxConfig) ProtoMessage()    {}
 func (*SwxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{6}
+	return fileDescriptor_ac1e34e12c6f455d, []int{8}
 }
 
 func (m *SwxConfig) XXX_Unmarshal(b []byte) error {
@@ -596,7 +810,7 @@ func (m *EapAkaConfig) Reset()         { *m = EapAkaConfig{} }
 func (m *EapAkaConfig) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig) ProtoMessage()    {}
 func (*EapAkaConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{7}
+	return fileDescriptor_ac1e34e12c6f455d, []int{9}
 }
 
 func (m *EapAkaConfig) XXX_Unmarshal(b []byte) error {
@@ -652,7 +866,7 @@ func (m *EapAkaConfig_Timeouts) Reset()         { *m = EapAkaConfig_Timeouts{} }
 func (m *EapAkaConfig_Timeouts) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig_Timeouts) ProtoMessage()    {}
 func (*EapAkaConfig_Timeouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{7, 0}
+	return fileDescriptor_ac1e34e12c6f455d, []int{9, 0}
 }
 
 func (m *EapAkaConfig_Timeouts) XXX_Unmarshal(b []byte) error {
@@ -708,7 +922,9 @@ type AAAConfig struct {
 	// enable accounting & maintain long term user sessions
 	AccountingEnabled bool `protobuf:"varint,3,opt,name=AccountingEnabled,proto3" json:"AccountingEnabled,omitempty"`
 	// Postpone Auth success until successful accounting CreateSession completion
-	CreateSessionOnAuth  bool     `protobuf:"varint,4,opt,name=CreateSessionOnAuth,proto3" json:"CreateSessionOnAuth,omitempty"`
+	CreateSessionOnAuth bool `protobuf:"varint,4,opt,name=CreateSessionOnAuth,proto3" json:"CreateSessionOnAuth,omitempty"`
+	// Persist sessions in Redis, the store can be shared by active & standby AAA servers
+	PersistSessions      bool     `protobuf:"varint,5,opt,name=PersistSessions,proto3" json:"PersistSessions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -718,7 +934,7 @@ func (m *AAAConfig) Reset()         { *m = AAAConfig{} }
 func (m *AAAConfig) String() string { return proto.CompactTextString(m) }
 func (*AAAConfig) ProtoMessage()    {}
 func (*AAAConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{8}
+	return fileDescriptor_ac1e34e12c6f455d, []int{10}
 }
 
 func (m *AAAConfig) XXX_Unmarshal(b []byte) error {
@@ -767,6 +983,13 @@ func (m *AAAConfig) GetCreateSessionOnAuth() bool {
 	return false
 }
 
+func (m *AAAConfig) GetPersistSessions() bool {
+	if m != nil {
+		return m.PersistSessions
+	}
+	return false
+}
+
 type GatewayHealthConfig struct {
 	RequiredServices          []string `protobuf:"bytes,1,rep,name=required_services,json=requiredServices,proto3" json:"required_services,omitempty"`
 	UpdateIntervalSecs        uint32   `protobuf:"varint,2,opt,name=update_interval_secs,json=updateIntervalSecs,proto3" json:"update_interval_secs,omitempty"`
@@ -782,7 +1005,7 @@ func (m *GatewayHealthConfig) Reset()         { *m = GatewayHealthConfig{} }
 func (m *GatewayHealthConfig) String() string { return proto.CompactTextString(m) }
 func (*GatewayHealthConfig) ProtoMessage()    {}
 func (*GatewayHealthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{9}
+	return fileDescriptor_ac1e34e12c6f455d, []int{11}
 }
 
 func (m *GatewayHealthConfig) XXX_Unmarshal(b []byte) error {
@@ -859,7 +1082,7 @@ func (m *HSSConfig) Reset()         { *m = HSSConfig{} }
 func (m *HSSConfig) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig) ProtoMessage()    {}
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{10}
+	return fileDescriptor_ac1e34e12c6f455d, []int{12}
 }
 
 func (m *HSSConfig) XXX_Unmarshal(b []byte) error {
@@ -936,7 +1159,7 @@ func (m *HSSConfig_SubscriptionProfile) Reset()         { *m = HSSConfig_Subscri
 func (m *HSSConfig_SubscriptionProfile) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig_SubscriptionProfile) ProtoMessage()    {}
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{10, 0}
+	return fileDescriptor_ac1e34e12c6f455d, []int{12, 0}
 }
 
 func (m *HSSConfig_SubscriptionProfile) XXX_Unmarshal(b []byte) error {
@@ -989,7 +1212,7 @@ func (m *RadiusdConfig) Reset()         { *m = RadiusdConfig{} }
 func (m *RadiusdConfig) String() string { return proto.CompactTextString(m) }
 func (*RadiusdConfig) ProtoMessage()    {}
 func (*RadiusdConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{11}
+	return fileDescriptor_ac1e34e12c6f455d, []int{13}
 }
 
 func (m *RadiusdConfig) XXX_Unmarshal(b []byte) error {
@@ -1041,6 +1264,8 @@ func (m *RadiusdConfig) GetRadiusMetricsHost() string {
 func init() {
 	proto.RegisterEnum("magma.mconfig.GyInitMethod", GyInitMethod_name, GyInitMethod_value)
 	proto.RegisterType((*DiamClientConfig)(nil), "magma.mconfig.DiamClientConfig")
+	proto.RegisterType((*DiamTLSConfig)(nil), "magma.mconfig.DiamTLSConfig")
+	proto.RegisterType((*DiamPeerConfig)(nil), "magma.mconfig.DiamPeerConfig")
 	proto.RegisterType((*DiamServerConfig)(nil), "magma.mconfig.DiamServerConfig")
 	proto.RegisterType((*S6AConfig)(nil), "magma.mconfig.S6aConfig")
 	proto.RegisterType((*GxConfig)(nil), "magma.mconfig.GxConfig")
@@ -1060,91 +1285,104 @@ func init() {
 func init() { proto.RegisterFile("feg/protos/mconfig/mconfigs.proto", fileDescriptor_ac1e34e12c6f455d) }
 
 var fileDescriptor_ac1e34e12c6f455d = []byte{
-	// 1365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x4e, 0x1b, 0xc7,
-	0x17, 0xff, 0xdb, 0xe6, 0xc3, 0x3e, 0x36, 0xc1, 0x0c, 0x24, 0x18, 0x92, 0x7f, 0x43, 0x9c, 0x56,
-	0xa5, 0x49, 0x6a, 0x52, 0x2a, 0xa5, 0x51, 0x54, 0x35, 0x72, 0xc0, 0x25, 0xa8, 0x90, 0xa0, 0x59,
-	0x52, 0xa9, 0x55, 0xa5, 0xd5, 0xb0, 0x3b, 0xb6, 0x47, 0x99, 0xdd, 0x71, 0x67, 0x67, 0x09, 0xee,
-	0x5d, 0x5f, 0x21, 0x6f, 0xd1, 0xbb, 0x5e, 0xe4, 0x45, 0xa2, 0xbc, 0x48, 0x1f, 0xa1, 0x9a, 0x8f,
-	0xb5, 0x8d, 0x31, 0x48, 0xc8, 0xbd, 0xf2, 0xce, 0xf9, 0xfd, 0xce, 0x99, 0xf3, 0x35, 0x33, 0xc7,
-	0x70, 0xaf, 0x4d, 0x3b, 0x5b, 0x3d, 0x29, 0x94, 0x48, 0xb6, 0xa2, 0x40, 0xc4, 0x6d, 0xd6, 0xc9,
-	0x7e, 0x93, 0x86, 0x91, 0xa3, 0x85, 0x88, 0x74, 0x22, 0xd2, 0x70, 0xd2, 0xf5, 0x35, 0x21, 0x83,
-	0xa7, 0x32, 0xd3, 0x09, 0x44, 0x14, 0x89, 0xd8, 0x32, 0xeb, 0xef, 0x0b, 0x50, 0xdd, 0x65, 0x24,
-	0xda, 0xe1, 0x8c, 0xc6, 0x6a, 0xc7, 0xf0, 0xd1, 0x3a, 0x14, 0x0d, 0x1a, 0x08, 0x5e, 0xcb, 0x6d,
-	0xe4, 0x36, 0x4b, 0x78, 0xb0, 0x46, 0x35, 0x98, 0x27, 0x61, 0x28, 0x69, 0x92, 0xd4, 0xf2, 0x06,
-	0xca, 0x96, 0x68, 0x03, 0xca, 0x92, 0x2a, 0x49, 0xe2, 0x24, 0x62, 0x2a, 0xa9, 0x15, 0x36, 0x72,
-	0x9b, 0x0b, 0x78, 0x54, 0x84, 0x1e, 0xc2, 0xd2, 0x3b, 0xa2, 0x82, 0x6e, 0x28, 0x3a, 0x3e, 0x8b,
-	0x15, 0x95, 0xa7, 0x84, 0xd7, 0x66, 0x0c, 0xaf, 0x9a, 0x01, 0xfb, 0x4e, 0x8e, 0xee, 0x5a, 0x73,
-	0x7d, 0x3f, 0x10, 0x69, 0xac, 0x6a, 0xb3, 0x86, 0x06, 0x46, 0xb4, 0xa3, 0x25, 0xe8, 0x3e, 0x2c,
-	0x70, 0x11, 0x10, 0xee, 0x67, 0xfe, 0xcc, 0x19, 0x7f, 0x2a, 0x46, 0xd8, 0x74, 0x4e, 0xdd, 0x83,
-	0x4a, 0x4f, 0x8a, 0x30, 0x0d, 0x94, 0x1f, 0x93, 0x88, 0xd6, 0xe6, 0x0d, 0xa7, 0xec, 0x64, 0xaf,
-	0x48, 0x44, 0xd1, 0x0a, 0xcc, 0x4a, 0x4a, 0x78, 0x54, 0x2b, 0x1a, 0xcc, 0x2e, 0x10, 0x82, 0x99,
-	0xae, 0x48, 0x54, 0xad, 0x64, 0x84, 0xe6, 0x1b, 0xfd, 0x1f, 0x20, 0xa4, 0x89, 0xf2, 0x2d, 0x1d,
-	0x0c, 0x52, 0xd2, 0x12, 0x6c, 0x54, 0x6e, 0x83, 0x59, 0xf8, 0x46, 0xaf, 0x6c, 0xf3, 0xa6, 0x05,
-	0x2f, 0xb5, 0xee, 0x03, 0x58, 0x0a, 0x59, 0x42, 0x4e, 0x38, 0xf5, 0x87, 0xa4, 0xca, 0x46, 0x6e,
-	0xb3, 0x88, 0x17, 0x1d, 0xb0, 0xeb, 0xb8, 0xf5, 0xbf, 0x72, 0xb6, 0x28, 0x1e, 0x95, 0xa7, 0x54,
-	0x4e, 0x55, 0x94, 0x0b, 0x49, 0x2a, 0x4c, 0x48, 0xd2, 0x39, 0xc7, 0x67, 0xc6, 0x1c, 0x3f, 0x1f,
-	0xf4, 0xec, 0x58, 0xd0, 0xf5, 0x7f, 0x72, 0x50, 0xf2, 0x9e, 0x10, 0xe7, 0xe4, 0x36, 0x94, 0xb8,
-	0xe8, 0xf8, 0x9c, 0x9e, 0x52, 0xeb, 0xe5, 0x8d, 0xed, 0x9b, 0x0d, 0xdb, 0x8c, 0xa6, 0x07, 0x1b,
-	0x07, 0xa2, 0x73, 0xa0, 0x41, 0x5c, 0xe4, 0xee, 0x0b, 0x7d, 0x07, 0x73, 0x89, 0x09, 0xd4, 0x18,
-	0x2f, 0x6f, 0xdf, 0x6d, 0x9c, 0xeb, 0xde, 0xc6, 0x78, 0x7b, 0x62, 0x47, 0x47, 0xcf, 0x60, 0x4d,
-	0xd2, 0xdf, 0x53, 0xed, 0x5c, 0x9b, 0x30, 0x9e, 0x4a, 0xea, 0xab, 0xae, 0xa4, 0x49, 0x57, 0xf0,
-	0xd0, 0x34, 0x43, 0x1e, 0xaf, 0x3a, 0xc2, 0x8f, 0x16, 0x3f, 0xce, 0x60, 0xad, 0x1b, 0xb1, 0x98,
-	0x45, 0x69, 0xe4, 0x67, 0x36, 0x86, 0xba, 0xf3, 0xa6, 0xd7, 0x56, 0x1d, 0x01, 0x5b, 0x7c, 0xa0,
-	0x5b, 0xdf, 0x81, 0xe2, 0xde, 0x99, 0x0b, 0x78, 0xe8, 0x7c, 0xee, 0x5a, 0xce, 0xd7, 0xff, 0xcc,
-	0x41, 0x71, 0xaf, 0x3f, 0xa5, 0x15, 0xf4, 0x3d, 0x94, 0x59, 0xcc, 0x94, 0x1f, 0x51, 0xd5, 0x15,
-	0xa1, 0x29, 0xfe, 0x8d, 0xed, 0xdb, 0x63, 0xda, 0x7b, 0xfd, 0xfd, 0x98, 0xa9, 0x43, 0x43, 0xc1,
-	0xc0, 0x06, 0xdf, 0xf5, 0xf7, 0x79, 0x40, 0x1e, 0x4d, 0x12, 0x26, 0xe2, 0x23, 0x29, 0xce, 0xfa,
-	0x53, 0x14, 0xf1, 0x4b, 0xc8, 0x77, 0xce, 0x5c, 0x01, 0x57, 0xc7, 0xf7, 0x77, 0xc9, 0xc2, 0xf9,
-	0xce, 0x99, 0x21, 0xf6, 0x4d, 0x75, 0x26, 0x10, 0xfb, 0x03, 0x62, 0xff, 0xea, 0xea, 0xce, 0x4f,
-	0x51, 0xdd, 0xe2, 0xd5, 0xd5, 0xfd, 0x58, 0x80, 0x92, 0xf7, 0xee, 0xec, 0x3f, 0x69, 0xe8, 0xfc,
-	0xf5, 0xaa, 0xf9, 0x0d, 0xac, 0x9c, 0x52, 0xc9, 0xda, 0x7d, 0x9f, 0xa4, 0xaa, 0x2b, 0x24, 0xfb,
-	0x83, 0x28, 0x26, 0x62, 0x73, 0x66, 0x8b, 0x78, 0xd9, 0x62, 0xcd, 0x51, 0x08, 0x6d, 0xc2, 0xe2,
-	0x0e, 0x09, 0xba, 0xf4, 0xf8, 0xf8, 0xc0, 0xa3, 0x81, 0x88, 0xc3, 0xc4, 0x5d, 0xa8, 0xe3, 0xe2,
-	0xab, 0xf3, 0x39, 0x3b, 0x45, 0x3e, 0xe7, 0xae, 0xcc, 0x27, 0xda, 0x84, 0xaa, 0xa4, 0x1d, 0x96,
-	0x28, 0x2a, 0x7d, 0x11, 0x9b, 0xc8, 0x4c, 0xf9, 0x8a, 0xf8, 0x46, 0x26, 0x7f, 0x1d, 0xeb, 0xa0,
-	0xd0, 0x13, 0x58, 0x0d, 0xa9, 0x64, 0xa7, 0xd4, 0x4f, 0xe3, 0x81, 0xca, 0xf0, 0x6a, 0x2e, 0xe2,
-	0x9b, 0x16, 0x7e, 0x33, 0x40, 0xed, 0xbd, 0xbb, 0x01, 0x95, 0x2e, 0x97, 0x7e, 0x8f, 0x47, 0xb1,
-	0xcf, 0xc2, 0xa4, 0x56, 0xda, 0x28, 0x6c, 0x96, 0x30, 0x74, 0xb9, 0x3c, 0xe2, 0x51, 0xbc, 0x1f,
-	0x26, 0xf5, 0x4f, 0x79, 0xa8, 0xb4, 0x48, 0xaf, 0xf9, 0x76, 0x9a, 0x7b, 0xea, 0x07, 0x98, 0x57,
-	0x2c, 0xa2, 0x22, 0x55, 0xae, 0xae, 0x9f, 0x8f, 0xd5, 0x75, 0x74, 0x87, 0xc6, 0xb1, 0xa5, 0x26,
-	0x38, 0x53, 0xd2, 0x97, 0xb4, 0xf3, 0xa7, 0x56, 0x30, 0x1e, 0x66, 0xcb, 0xf5, 0x0f, 0x39, 0x28,
-	0x66, 0x7c, 0xfd, 0x8c, 0xee, 0x74, 0x09, 0xe7, 0x34, 0xee, 0xd0, 0xc3, 0xc4, 0x38, 0xb7, 0x80,
-	0x47, 0x45, 0xe8, 0x31, 0x2c, 0xb7, 0xa4, 0x14, 0xf2, 0x95, 0x50, 0xac, 0xcd, 0x02, 0xd3, 0x08,
-	0x87, 0xf6, 0xe6, 0x5f, 0xc0, 0x93, 0x20, 0x74, 0x07, 0x4a, 0xee, 0x9c, 0x1f, 0x66, 0x0f, 0xf3,
-	0x50, 0x80, 0x9e, 0xc0, 0x2d, 0xb7, 0xd0, 0x65, 0xa0, 0xb1, 0xd2, 0x8a, 0x34, 0x3c, 0xcc, 0x5a,
-	0xe9, 0x12, 0xb4, 0xfe, 0x29, 0x07, 0xa5, 0x66, 0xb3, 0x39, 0x45, 0x4a, 0xb7, 0x61, 0x65, 0x3f,
-	0xe4, 0xd4, 0xd9, 0x77, 0x29, 0x18, 0x84, 0x32, 0x11, 0x43, 0x8f, 0x60, 0xa9, 0x19, 0x98, 0x99,
-	0x80, 0xc5, 0x9d, 0x56, 0xac, 0x1f, 0xce, 0xd0, 0x9d, 0x90, 0x8b, 0x80, 0xce, 0xd5, 0x8e, 0xa4,
-	0x44, 0x65, 0x76, 0x6c, 0xab, 0x99, 0xc0, 0x8a, 0x78, 0x12, 0x54, 0xff, 0x3b, 0x0f, 0xcb, 0x7b,
-	0x44, 0xd1, 0x77, 0xa4, 0xff, 0x92, 0x12, 0xae, 0xba, 0x2e, 0xbe, 0x87, 0xb0, 0xa4, 0x7b, 0x9f,
-	0x49, 0x1a, 0xfa, 0xfa, 0xbc, 0xb2, 0x80, 0xea, 0xea, 0xe8, 0x42, 0x56, 0x33, 0xc0, 0x73, 0x72,
-	0xf4, 0x18, 0x56, 0xd2, 0x5e, 0x48, 0x14, 0x1d, 0xcc, 0x39, 0x7e, 0x42, 0x83, 0x2c, 0x30, 0x64,
-	0xb1, 0x6c, 0xd4, 0xf1, 0x68, 0x90, 0xa0, 0xa7, 0x50, 0x73, 0x1a, 0x17, 0x4f, 0xa7, 0xad, 0xd8,
-	0x2d, 0x8b, 0x5f, 0x38, 0x9c, 0xcf, 0xe1, 0x4e, 0xc0, 0x45, 0x1a, 0xfa, 0x21, 0x4b, 0x02, 0x11,
-	0xc7, 0x34, 0x50, 0x7e, 0x8f, 0x4a, 0x26, 0x42, 0xbb, 0xa7, 0x2d, 0xe2, 0x9a, 0xe1, 0xec, 0x0e,
-	0x28, 0x47, 0x86, 0x61, 0xb6, 0x7e, 0x0e, 0x77, 0xec, 0x8c, 0x70, 0x89, 0x01, 0x3b, 0x7a, 0xad,
-	0x19, 0xce, 0x24, 0x03, 0xf5, 0x0f, 0x33, 0x50, 0x7a, 0xe9, 0x79, 0xd7, 0x78, 0xcc, 0x46, 0x27,
-	0x9b, 0xc1, 0xf5, 0xf7, 0x19, 0x94, 0xb9, 0xa2, 0xe6, 0x86, 0xf0, 0x45, 0xcf, 0xe4, 0xaa, 0x82,
-	0x4b, 0x5c, 0x51, 0x5d, 0x97, 0xd7, 0x3d, 0x7d, 0xce, 0x07, 0x38, 0x89, 0xda, 0x26, 0x2d, 0x15,
-	0x0c, 0x8e, 0xd0, 0x8c, 0xda, 0xe8, 0x00, 0x2a, 0x49, 0x7a, 0xe2, 0xf7, 0xa4, 0x68, 0x33, 0x4e,
-	0x75, 0xe8, 0x85, 0xcd, 0xf2, 0xf6, 0x57, 0x63, 0x0e, 0x0c, 0x5c, 0x6d, 0x78, 0xe9, 0xc9, 0x91,
-	0xe3, 0xb6, 0x62, 0x25, 0xfb, 0xb8, 0x9c, 0x0c, 0x25, 0xe8, 0x37, 0x58, 0x0e, 0x69, 0x9b, 0xa4,
-	0x5c, 0xf9, 0x23, 0x56, 0xdd, 0x23, 0xf7, 0xe8, 0x2a, 0xa3, 0x49, 0x20, 0x59, 0x4f, 0xd9, 0x67,
-	0x55, 0xeb, 0xe0, 0x25, 0x67, 0x68, 0xb8, 0x21, 0xfa, 0x1a, 0x50, 0xa2, 0x24, 0x25, 0x91, 0x36,
-	0xae, 0x15, 0x4e, 0xa8, 0xb4, 0x33, 0x6c, 0x11, 0x2f, 0x59, 0xc4, 0x1b, 0x02, 0xeb, 0x01, 0x2c,
-	0x4f, 0x30, 0x8c, 0xbe, 0x80, 0xc5, 0x88, 0x9c, 0xf9, 0x29, 0xf7, 0x4f, 0x98, 0xf2, 0x25, 0x51,
-	0xd4, 0x64, 0x7d, 0x06, 0x57, 0x22, 0x72, 0xf6, 0x86, 0xbf, 0x60, 0x0a, 0x13, 0x35, 0xa0, 0x85,
-	0x23, 0xb4, 0xfc, 0x80, 0xb6, 0x9b, 0xd1, 0xd6, 0x39, 0x54, 0xc7, 0x53, 0x82, 0xaa, 0x50, 0x78,
-	0x4b, 0xfb, 0x6e, 0xe4, 0xd4, 0x9f, 0xe8, 0x05, 0xcc, 0x9e, 0x12, 0x9e, 0x52, 0x77, 0x0d, 0x5e,
-	0x2f, 0x13, 0x56, 0xf5, 0x59, 0xfe, 0x69, 0xae, 0xfe, 0x31, 0x07, 0x0b, 0x98, 0x84, 0x2c, 0x4d,
-	0x42, 0xd7, 0x3a, 0x0d, 0x58, 0x96, 0x46, 0xa0, 0x07, 0x1a, 0xc9, 0x82, 0xc4, 0xef, 0x09, 0xa9,
-	0xdc, 0x1d, 0xb8, 0x64, 0xa1, 0x43, 0x8b, 0x1c, 0x09, 0xa9, 0x26, 0xf1, 0x89, 0xea, 0xba, 0x19,
-	0x78, 0x8c, 0x4f, 0x54, 0xf7, 0xd2, 0x63, 0x59, 0xb8, 0xf4, 0x58, 0x5e, 0xdc, 0x61, 0x64, 0x48,
-	0x3e, 0xbf, 0x83, 0x9e, 0x96, 0x1f, 0x3c, 0x83, 0xca, 0xe8, 0xb8, 0x85, 0x2a, 0x50, 0xc4, 0x2d,
-	0xaf, 0x85, 0x7f, 0x6e, 0xed, 0x56, 0xff, 0x87, 0x16, 0xa1, 0x7c, 0xd4, 0xc2, 0xbe, 0xd7, 0xf2,
-	0xbc, 0xfd, 0xd7, 0xaf, 0xaa, 0x39, 0x54, 0x86, 0x79, 0x2d, 0xf8, 0xa9, 0xf5, 0x4b, 0x35, 0xff,
-	0xe2, 0xfe, 0xaf, 0xf7, 0x4c, 0x26, 0xb7, 0xf4, 0x1f, 0x3c, 0x73, 0x5c, 0xb7, 0x3a, 0x62, 0xec,
-	0x9f, 0xde, 0xc9, 0x9c, 0x59, 0x7f, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0x5d, 0xfa,
-	0x52, 0x06, 0x0e, 0x00, 0x00,
+	// 1581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x4e, 0x23, 0xc9,
+	0x15, 0x8e, 0x6d, 0x6c, 0xec, 0x63, 0x1b, 0x4c, 0xc1, 0x0e, 0x0d, 0xcb, 0x06, 0x8f, 0x37, 0x51,
+	0xc8, 0xee, 0xc6, 0x6c, 0x58, 0x69, 0x32, 0x1a, 0x45, 0x59, 0x79, 0xc0, 0xcb, 0xa0, 0xc0, 0x8c,
+	0xd5, 0xcd, 0xae, 0x94, 0x28, 0x52, 0xab, 0xe8, 0x2e, 0xdb, 0x25, 0xba, 0xbb, 0x9c, 0xaa, 0x6a,
+	0xc0, 0xb9, 0xcb, 0x2b, 0xe4, 0x49, 0x72, 0x31, 0xd7, 0x79, 0x85, 0x68, 0x94, 0xab, 0xbc, 0x45,
+	0xee, 0x72, 0x15, 0x29, 0xaa, 0x9f, 0xb6, 0x8d, 0x31, 0x68, 0x66, 0x48, 0xae, 0xdc, 0x75, 0xbe,
+	0xef, 0x54, 0xd5, 0x39, 0xdf, 0xa9, 0xea, 0xd3, 0x86, 0xa7, 0x7d, 0x32, 0xd8, 0x1f, 0x71, 0x26,
+	0x99, 0xd8, 0x8f, 0x03, 0x96, 0xf4, 0xe9, 0x20, 0xfb, 0x15, 0x6d, 0x6d, 0x47, 0xf5, 0x18, 0x0f,
+	0x62, 0xdc, 0xb6, 0xd6, 0xed, 0x2d, 0xc6, 0x83, 0xe7, 0x3c, 0xf3, 0x09, 0x58, 0x1c, 0xb3, 0xc4,
+	0x30, 0x5b, 0xff, 0x2e, 0x40, 0xe3, 0x88, 0xe2, 0xf8, 0x30, 0xa2, 0x24, 0x91, 0x87, 0x9a, 0x8f,
+	0xb6, 0xa1, 0xac, 0xd1, 0x80, 0x45, 0x4e, 0xae, 0x99, 0xdb, 0xab, 0xb8, 0x93, 0x31, 0x72, 0x60,
+	0x19, 0x87, 0x21, 0x27, 0x42, 0x38, 0x79, 0x0d, 0x65, 0x43, 0xd4, 0x84, 0x2a, 0x27, 0x92, 0xe3,
+	0x44, 0xc4, 0x54, 0x0a, 0xa7, 0xd0, 0xcc, 0xed, 0xd5, 0xdd, 0x59, 0x13, 0xfa, 0x12, 0xd6, 0xae,
+	0xb1, 0x0c, 0x86, 0x21, 0x1b, 0xf8, 0x34, 0x91, 0x84, 0x5f, 0xe1, 0xc8, 0x59, 0xd2, 0xbc, 0x46,
+	0x06, 0x9c, 0x58, 0x3b, 0xda, 0x35, 0xd3, 0x8d, 0xfd, 0x80, 0xa5, 0x89, 0x74, 0x8a, 0x9a, 0x06,
+	0xda, 0x74, 0xa8, 0x2c, 0xe8, 0x73, 0xa8, 0x47, 0x2c, 0xc0, 0x91, 0x9f, 0xed, 0xa7, 0xa4, 0xf7,
+	0x53, 0xd3, 0xc6, 0x8e, 0xdd, 0xd4, 0x53, 0xa8, 0x8d, 0x38, 0x0b, 0xd3, 0x40, 0xfa, 0x09, 0x8e,
+	0x89, 0xb3, 0xac, 0x39, 0x55, 0x6b, 0x7b, 0x8d, 0x63, 0x82, 0x36, 0xa0, 0xc8, 0x09, 0x8e, 0x62,
+	0xa7, 0xac, 0x31, 0x33, 0x40, 0x08, 0x96, 0x86, 0x4c, 0x48, 0xa7, 0xa2, 0x8d, 0xfa, 0x19, 0x7d,
+	0x06, 0x10, 0x12, 0x21, 0x7d, 0x43, 0x07, 0x8d, 0x54, 0x94, 0xc5, 0xd5, 0x2e, 0x9f, 0x82, 0x1e,
+	0xf8, 0xda, 0xaf, 0x6a, 0xf2, 0xa6, 0x0c, 0xaf, 0x94, 0xef, 0x17, 0xb0, 0x16, 0x52, 0x81, 0x2f,
+	0x22, 0xe2, 0x4f, 0x49, 0xb5, 0x66, 0x6e, 0xaf, 0xec, 0xae, 0x5a, 0xe0, 0x28, 0xe3, 0x7e, 0x03,
+	0xc5, 0x11, 0x21, 0x5c, 0x38, 0xf5, 0x66, 0x61, 0xaf, 0x7a, 0xf0, 0x59, 0xfb, 0x96, 0x9c, 0x6d,
+	0xa5, 0x57, 0x8f, 0x10, 0x6e, 0xd4, 0x72, 0x0d, 0x17, 0xb5, 0xa1, 0x20, 0x23, 0xe1, 0xac, 0x34,
+	0x73, 0x7b, 0xd5, 0x83, 0x9d, 0x05, 0x2e, 0xe7, 0xa7, 0x9e, 0xf5, 0x50, 0xc4, 0xd6, 0xdf, 0x72,
+	0x50, 0xbf, 0x65, 0x56, 0xb2, 0x0b, 0x12, 0xa4, 0x9c, 0xca, 0x71, 0x26, 0x7b, 0x36, 0x46, 0x9b,
+	0xb0, 0x1c, 0x60, 0xbf, 0x4f, 0x23, 0x62, 0x65, 0x2f, 0x05, 0xf8, 0x3b, 0x1a, 0x11, 0x15, 0x74,
+	0x40, 0xb8, 0x34, 0x50, 0xc1, 0x78, 0x29, 0x83, 0x06, 0xb7, 0xa0, 0x7c, 0x49, 0xc6, 0x06, 0x5b,
+	0x32, 0xd5, 0x72, 0x49, 0xc6, 0x1a, 0xda, 0x85, 0xaa, 0x20, 0xfc, 0x8a, 0x70, 0xa3, 0x4b, 0x51,
+	0xa3, 0x60, 0x4c, 0x5a, 0x96, 0x5d, 0xa8, 0xc6, 0x34, 0xf1, 0xaf, 0x08, 0x17, 0x94, 0x25, 0x56,
+	0x5c, 0x88, 0x69, 0xf2, 0x83, 0xb1, 0xb4, 0xfe, 0x9e, 0x87, 0x95, 0xdb, 0xa9, 0xf8, 0xc8, 0xc2,
+	0xbd, 0x53, 0x48, 0x85, 0x05, 0x85, 0x74, 0x5b, 0xfb, 0xa5, 0x07, 0xb5, 0x2f, 0xbe, 0x8f, 0xf6,
+	0xa5, 0xc5, 0xda, 0xeb, 0x10, 0x28, 0xd3, 0x22, 0x2c, 0xeb, 0x9a, 0x9f, 0x8c, 0xd1, 0x13, 0x28,
+	0x5d, 0x13, 0x3a, 0x18, 0x4a, 0x5d, 0xaa, 0x75, 0xd7, 0x8e, 0x94, 0x5d, 0x6f, 0x4b, 0x38, 0x95,
+	0x66, 0x41, 0x69, 0x63, 0x46, 0x59, 0x49, 0xc0, 0xfb, 0x96, 0xc4, 0x3f, 0x73, 0xe6, 0x32, 0xf0,
+	0xb4, 0x0a, 0xff, 0xff, 0x9c, 0xde, 0x4a, 0xda, 0xd2, 0x5c, 0xd2, 0x6e, 0x27, 0xbc, 0x38, 0x9f,
+	0x70, 0x1b, 0x5b, 0xe9, 0x7d, 0x63, 0xfb, 0x57, 0x0e, 0x2a, 0xde, 0x33, 0x6c, 0x83, 0x3a, 0x80,
+	0x4a, 0xc4, 0x06, 0x7e, 0x44, 0xae, 0x88, 0x89, 0x6a, 0xe5, 0xe0, 0x13, 0x3b, 0x87, 0xbe, 0x2b,
+	0xdb, 0xa7, 0x6c, 0x70, 0xaa, 0x40, 0xb7, 0x1c, 0xd9, 0x27, 0xf4, 0x2b, 0x28, 0x99, 0xf2, 0xd4,
+	0x9b, 0xa9, 0x1e, 0xec, 0x2e, 0x58, 0x74, 0xf6, 0x1a, 0x75, 0x2d, 0x1d, 0xbd, 0x80, 0x2d, 0x4e,
+	0xfe, 0x98, 0xaa, 0x60, 0xfa, 0x98, 0x46, 0x29, 0x27, 0xbe, 0x1c, 0x72, 0x22, 0x86, 0x2c, 0x0a,
+	0x75, 0x00, 0x79, 0x77, 0xd3, 0x12, 0xbe, 0x33, 0xf8, 0x79, 0x06, 0x2b, 0xdf, 0x98, 0x26, 0x34,
+	0x4e, 0x63, 0x3f, 0x9b, 0x63, 0xea, 0x6b, 0xea, 0x63, 0xd3, 0x12, 0x5c, 0x83, 0x4f, 0x7c, 0x5b,
+	0x87, 0x50, 0x3e, 0xbe, 0xb1, 0x01, 0x4f, 0x37, 0x9f, 0xfb, 0xa0, 0xcd, 0xb7, 0xfe, 0x9c, 0x83,
+	0xf2, 0xf1, 0xf8, 0x91, 0xb3, 0xa0, 0x5f, 0x43, 0x95, 0x26, 0x54, 0xfa, 0x31, 0x91, 0x43, 0x16,
+	0xea, 0x62, 0x59, 0x39, 0xf8, 0x74, 0xce, 0xfb, 0x78, 0x7c, 0x92, 0x50, 0x79, 0xa6, 0x29, 0x2e,
+	0xd0, 0xc9, 0x73, 0xeb, 0x2f, 0x79, 0x40, 0x1e, 0x11, 0xea, 0xd4, 0xf7, 0x38, 0xbb, 0x19, 0x3f,
+	0x42, 0xc4, 0x9f, 0x41, 0x7e, 0x70, 0x63, 0x05, 0xdc, 0x9c, 0x5f, 0xdf, 0x26, 0xcb, 0xcd, 0x0f,
+	0x6e, 0x34, 0x71, 0xec, 0x94, 0x16, 0x13, 0xc7, 0x13, 0xe2, 0xf8, 0x61, 0x75, 0x97, 0x1f, 0xa1,
+	0x6e, 0xf9, 0x61, 0x75, 0xdf, 0x15, 0xa0, 0xe2, 0x5d, 0xdf, 0xfc, 0x4f, 0x0a, 0x3a, 0xff, 0x61,
+	0x6a, 0xfe, 0x12, 0x36, 0xae, 0x08, 0xa7, 0xfd, 0xb1, 0x8f, 0x53, 0x39, 0x64, 0x9c, 0xfe, 0x09,
+	0x4b, 0x75, 0x47, 0x17, 0xf4, 0x95, 0xb6, 0x6e, 0xb0, 0xce, 0x2c, 0x84, 0xf6, 0x60, 0xf5, 0x10,
+	0x07, 0x43, 0x72, 0x7e, 0x7e, 0xea, 0x91, 0x80, 0x25, 0xa1, 0xb0, 0x2f, 0xfe, 0x79, 0xf3, 0xc3,
+	0xf9, 0x2c, 0x3e, 0x22, 0x9f, 0xa5, 0x07, 0xf3, 0x89, 0xf6, 0xa0, 0xc1, 0xc9, 0x80, 0x0a, 0x49,
+	0xb8, 0xcf, 0x12, 0x1d, 0x99, 0x96, 0xaf, 0xec, 0xae, 0x64, 0xf6, 0x37, 0x89, 0x0a, 0x0a, 0x3d,
+	0x83, 0xcd, 0x90, 0x70, 0x7a, 0x45, 0xfc, 0x34, 0x99, 0xb8, 0x4c, 0x5b, 0x88, 0xb2, 0xfb, 0x89,
+	0x81, 0xbf, 0x9f, 0xa0, 0xe6, 0xca, 0x6a, 0x42, 0x6d, 0x18, 0x71, 0x7f, 0x14, 0xc5, 0x89, 0x4f,
+	0xc3, 0xec, 0xb2, 0x86, 0x61, 0xc4, 0x7b, 0x51, 0x9c, 0x9c, 0x84, 0xa2, 0xf5, 0x8f, 0x3c, 0xd4,
+	0xba, 0x78, 0xd4, 0xb9, 0x7c, 0xcc, 0x3d, 0xf5, 0x1b, 0x58, 0x96, 0x34, 0x26, 0x2c, 0x95, 0x56,
+	0xd7, 0x9f, 0xcc, 0xe9, 0x3a, 0xbb, 0x42, 0xfb, 0xdc, 0x50, 0x85, 0x9b, 0x39, 0xa9, 0x4b, 0xdd,
+	0xee, 0xc7, 0x29, 0xe8, 0x1d, 0x66, 0xc3, 0xed, 0xb7, 0x39, 0x28, 0x67, 0x7c, 0xd5, 0xee, 0x1d,
+	0x0e, 0x71, 0x14, 0x91, 0x64, 0x40, 0xce, 0x84, 0xde, 0x5c, 0xdd, 0x9d, 0x35, 0xa1, 0xaf, 0x61,
+	0xbd, 0xcb, 0x39, 0xe3, 0xaf, 0x99, 0xa4, 0x7d, 0x1a, 0xe8, 0x42, 0x38, 0x33, 0x6f, 0x8a, 0xba,
+	0xbb, 0x08, 0x42, 0x3b, 0x50, 0xb1, 0xe7, 0xfc, 0x2c, 0x6b, 0x20, 0xa7, 0x06, 0xf4, 0x0c, 0x9e,
+	0xd8, 0x81, 0x92, 0x81, 0x24, 0x52, 0x39, 0x92, 0xf0, 0x2c, 0x2b, 0xa5, 0x7b, 0xd0, 0xd6, 0x7f,
+	0x72, 0x50, 0xe9, 0x74, 0x3a, 0x8f, 0x48, 0xe9, 0x01, 0x6c, 0x9c, 0x84, 0x11, 0xb1, 0xf3, 0xdb,
+	0x14, 0x4c, 0x42, 0x59, 0x88, 0xa1, 0xaf, 0x60, 0xad, 0x13, 0xe8, 0xde, 0x95, 0x26, 0x83, 0x6e,
+	0xa2, 0x5e, 0xf2, 0xa1, 0x3d, 0x21, 0x77, 0x01, 0x95, 0xab, 0x43, 0x4e, 0xb0, 0xcc, 0xe6, 0x31,
+	0xa5, 0xa6, 0x03, 0x2b, 0xbb, 0x8b, 0x20, 0x75, 0xa2, 0x7a, 0x84, 0x0b, 0x2a, 0xa4, 0xb5, 0x0b,
+	0x7d, 0x3a, 0xca, 0xee, 0xbc, 0xb9, 0xf5, 0xd7, 0x3c, 0xac, 0x1f, 0x63, 0x49, 0xae, 0xf1, 0xf8,
+	0x15, 0xc1, 0x91, 0x1c, 0xda, 0x4c, 0x7c, 0x09, 0x6b, 0xea, 0x94, 0x50, 0x4e, 0x42, 0x5f, 0x9d,
+	0x6c, 0x1a, 0x10, 0xa5, 0xa3, 0x92, 0xbc, 0x91, 0x01, 0x9e, 0xb5, 0xa3, 0xaf, 0x61, 0x23, 0x1d,
+	0x85, 0x58, 0x92, 0x49, 0xe7, 0xee, 0x0b, 0x12, 0x64, 0x29, 0x40, 0x06, 0xcb, 0x9a, 0x77, 0x8f,
+	0x04, 0x02, 0x3d, 0x07, 0xc7, 0x7a, 0xdc, 0x3d, 0xc7, 0x46, 0xdb, 0x27, 0x06, 0xbf, 0x73, 0x8c,
+	0xbf, 0x85, 0x9d, 0x20, 0x62, 0x69, 0xe8, 0x87, 0x54, 0x04, 0x2c, 0x49, 0x48, 0x20, 0xfd, 0x11,
+	0xe1, 0x94, 0x85, 0x66, 0x4d, 0x23, 0xf7, 0x96, 0xe6, 0x1c, 0x4d, 0x28, 0x3d, 0xcd, 0xd0, 0x4b,
+	0x7f, 0x0b, 0x3b, 0xa6, 0xfb, 0xb8, 0x67, 0x02, 0xf3, 0x31, 0xb1, 0xa5, 0x39, 0x8b, 0x26, 0x68,
+	0xbd, 0x5d, 0x82, 0xca, 0x2b, 0xcf, 0xfb, 0x80, 0xd7, 0xde, 0x6c, 0xcf, 0x34, 0xb9, 0x28, 0x7f,
+	0x0c, 0xd5, 0x48, 0x12, 0x7d, 0x97, 0xf8, 0x6c, 0xa4, 0x73, 0x55, 0x73, 0x2b, 0x91, 0x24, 0x4a,
+	0xc1, 0x37, 0x23, 0x75, 0x23, 0x4c, 0x70, 0x1c, 0xf7, 0x75, 0x5a, 0x6a, 0x2e, 0x58, 0x42, 0x27,
+	0xee, 0xa3, 0x53, 0xa8, 0x89, 0xf4, 0xc2, 0x1f, 0x71, 0xa6, 0x9a, 0x68, 0x15, 0xba, 0xfa, 0x22,
+	0xf8, 0xf9, 0xdc, 0x06, 0x26, 0x5b, 0x6d, 0x7b, 0xe9, 0x45, 0xcf, 0x72, 0xbb, 0x89, 0xe4, 0x63,
+	0xb7, 0x2a, 0xa6, 0x16, 0xf4, 0x07, 0x58, 0x0f, 0x49, 0x1f, 0xa7, 0x91, 0xf4, 0x67, 0x66, 0xb5,
+	0xaf, 0xc3, 0xaf, 0x1e, 0x9a, 0x54, 0x04, 0x9c, 0x8e, 0xa4, 0x79, 0x01, 0x2b, 0x1f, 0x77, 0xcd,
+	0x4e, 0x34, 0x5d, 0x10, 0xfd, 0x02, 0x90, 0x90, 0x9c, 0xe0, 0xd8, 0x17, 0xc6, 0xe1, 0x42, 0x7d,
+	0xc3, 0x98, 0x3e, 0x77, 0xcd, 0x20, 0xde, 0x14, 0xd8, 0x0e, 0x60, 0x7d, 0xc1, 0xc4, 0xe8, 0xa7,
+	0xb0, 0x1a, 0xe3, 0x1b, 0x3f, 0x8d, 0xfc, 0x0b, 0x2a, 0x7d, 0x8e, 0x25, 0xd1, 0x59, 0x5f, 0x72,
+	0x6b, 0x31, 0xbe, 0xf9, 0x3e, 0x7a, 0x49, 0xa5, 0x8b, 0xe5, 0x84, 0x16, 0xce, 0xd0, 0xf2, 0x13,
+	0xda, 0x51, 0x46, 0xdb, 0x8e, 0xa0, 0x31, 0x9f, 0x12, 0xd4, 0x80, 0xc2, 0x25, 0xc9, 0x3e, 0x71,
+	0xd4, 0x23, 0x7a, 0x09, 0xc5, 0x2b, 0x1c, 0xa5, 0xc4, 0xc9, 0x7f, 0x44, 0x26, 0x8c, 0xeb, 0x8b,
+	0xfc, 0xf3, 0x5c, 0xeb, 0x5d, 0x0e, 0xea, 0x2e, 0x0e, 0x69, 0x2a, 0x42, 0x5b, 0x3a, 0x6d, 0x58,
+	0xe7, 0xda, 0xa0, 0x5a, 0x1f, 0x4e, 0x03, 0xe1, 0x8f, 0x18, 0x97, 0xf6, 0xb6, 0x5c, 0x33, 0xd0,
+	0x99, 0x41, 0x7a, 0x8c, 0xcb, 0x45, 0x7c, 0x2c, 0x87, 0xb6, 0xbb, 0x9e, 0xe3, 0x63, 0x39, 0xbc,
+	0xf7, 0x58, 0x16, 0xee, 0x3d, 0x96, 0x77, 0x57, 0x98, 0x69, 0xbf, 0x6f, 0xaf, 0xa0, 0xfa, 0xf0,
+	0x2f, 0x5e, 0x40, 0x6d, 0xb6, 0x31, 0x43, 0x35, 0x28, 0xbb, 0x5d, 0xaf, 0xeb, 0xfe, 0xd0, 0x3d,
+	0x6a, 0xfc, 0x08, 0xad, 0x42, 0xb5, 0xd7, 0x75, 0x7d, 0xaf, 0xeb, 0x79, 0x27, 0x6f, 0x5e, 0x37,
+	0x72, 0xa8, 0x0a, 0xcb, 0xca, 0xf0, 0xdb, 0xee, 0xef, 0x1a, 0xf9, 0x97, 0x9f, 0xff, 0xfe, 0xa9,
+	0xce, 0xe4, 0xbe, 0xfa, 0xcb, 0x42, 0x1f, 0xd7, 0xfd, 0x01, 0x9b, 0xfb, 0xef, 0xe2, 0xa2, 0xa4,
+	0xc7, 0xdf, 0xfc, 0x77, 0x00, 0x8e, 0x14, 0x39, 0x7d, 0xd8, 0x10, 0x00, 0x00,
 }
@@ -1028,6 +1028,403 @@ func (m *ResetAnswer) GetErrorCode() ErrorCode {
 	return ErrorCode_UNDEFINED
 }
 
+// Insert Subscriber Data Request (Section 7.2.9)
+type InsertSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Subscription data to add to or replace at the MME, see UpdateLocationAnswer
+	Msisdn            []byte                                 `protobuf:"bytes,2,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	NetworkAccessMode UpdateLocationAnswer_NetworkAccessMode `protobuf:"varint,3,opt,name=network_access_mode,json=networkAccessMode,proto3,enum=magma.feg.UpdateLocationAnswer_NetworkAccessMode" json:"network_access_mode,omitempty"`
+	// Identifier of the default APN
+	DefaultContextId uint32 `protobuf:"varint,4,opt,name=default_context_id,json=defaultContextId,proto3" json:"default_context_id,omitempty"`
+	// Subscriber authorized aggregate bitrate, unset if AMBR isn't in the request
+	TotalAmbr *UpdateLocationAnswer_AggregatedMaximumBitrate `protobuf:"bytes,5,opt,name=total_ambr,json=totalAmbr,proto3" json:"total_ambr,omitempty"`
+	// Indicates to wipe other stored APNs
+	AllApnsIncluded bool `protobuf:"varint,6,opt,name=all_apns_included,json=allApnsIncluded,proto3" json:"all_apns_included,omitempty"`
+	// APN configurations
+	Apn []*UpdateLocationAnswer_APNConfiguration `protobuf:"bytes,7,rep,name=apn,proto3" json:"apn,omitempty"`
+	// IDR-Flags 7.3.103
+	IdrFlags uint32 `protobuf:"varint,8,opt,name=idr_flags,json=idrFlags,proto3" json:"idr_flags,omitempty"`
+	// Network-Access-Mode is set in the request
+	NetworkAccessModePresent bool `protobuf:"varint,9,opt,name=network_access_mode_present,json=networkAccessModePresent,proto3" json:"network_access_mode_present,omitempty"`
+	// APN-Configuration-Profile is set in the request, default_context_id,
+	// all_apns_included and apn are to be ignored otherwise
+	ApnConfigurationPresent bool     `protobuf:"varint,10,opt,name=apn_configuration_present,json=apnConfigurationPresent,proto3" json:"apn_configuration_present,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *InsertSubscriberDataRequest) Reset()         { *m = InsertSubscriberDataRequest{} }
+func (m *InsertSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataRequest) ProtoMessage()    {}
+func (*InsertSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{10}
+}
+
+func (m *InsertSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataRequest.Merge(m, src)
+}
+func (m *InsertSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Size(m)
+}
+func (m *InsertSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *InsertSubscriberDataRequest) GetMsisdn() []byte {
+	if m != nil {
+		return m.Msisdn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetNetworkAccessMode() UpdateLocationAnswer_NetworkAccessMode {
+	if m != nil {
+		return m.NetworkAccessMode
+	}
+	return UpdateLocationAnswer_PACKET_AND_CIRCUIT
+}
+
+func (m *InsertSubscriberDataRequest) GetDefaultContextId() uint32 {
+	if m != nil {
+		return m.DefaultContextId
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetTotalAmbr() *UpdateLocationAnswer_AggregatedMaximumBitrate {
+	if m != nil {
+		return m.TotalAmbr
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetAllApnsIncluded() bool {
+	if m != nil {
+		return m.AllApnsIncluded
+	}
+	return false
+}
+
+func (m *InsertSubscriberDataRequest) GetApn() []*UpdateLocationAnswer_APNConfiguration {
+	if m != nil {
+		return m.Apn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetIdrFlags() uint32 {
+	if m != nil {
+		return m.IdrFlags
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetNetworkAccessModePresent() bool {
+	if m != nil {
+		return m.NetworkAccessModePresent
+	}
+	return false
+}
+
+func (m *InsertSubscriberDataRequest) GetApnConfigurationPresent() bool {
+	if m != nil {
+		return m.ApnConfigurationPresent
+	}
+	return false
+}
+
+// Insert Subscriber Data Answer (Section 7.2.10)
+type InsertSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *InsertSubscriberDataAnswer) Reset()         { *m = InsertSubscriberDataAnswer{} }
+func (m *InsertSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataAnswer) ProtoMessage()    {}
+func (*InsertSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{11}
+}
+
+func (m *InsertSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataAnswer.Merge(m, src)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Size(m)
+}
+func (m *InsertSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+// Delete Subscriber Data Request (Section 7.2.11)
+type DeleteSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// DSR-Flags 7.3.25
+	DsrFlags uint32 `protobuf:"varint,2,opt,name=dsr_flags,json=dsrFlags,proto3" json:"dsr_flags,omitempty"`
+	// Identifiers of the APNs to delete when the PDN subscription contexts
+	// withdrawal flag (bit 3) is set
+	ContextIds           []uint32 `protobuf:"varint,3,rep,packed,name=context_ids,json=contextIds,proto3" json:"context_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscriberDataRequest) Reset()         { *m = DeleteSubscriberDataRequest{} }
+func (m *DeleteSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataRequest) ProtoMessage()    {}
+func (*DeleteSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{12}
+}
+
+func (m *DeleteSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataRequest.Merge(m, src)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Size(m)
+}
+func (m *DeleteSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeleteSubscriberDataRequest) GetDsrFlags() uint32 {
+	if m != nil {
+		return m.DsrFlags
+	}
+	return 0
+}
+
+func (m *DeleteSubscriberDataRequest) GetContextIds() []uint32 {
+	if m != nil {
+		return m.ContextIds
+	}
+	return nil
+}
+
+// Delete Subscriber Data Answer (Section 7.2.12)
+type DeleteSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DeleteSubscriberDataAnswer) Reset()         { *m = DeleteSubscriberDataAnswer{} }
+func (m *DeleteSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataAnswer) ProtoMessage()    {}
+func (*DeleteSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{13}
+}
+
+func (m *DeleteSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.Merge(m, src)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Size(m)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+// Notify Request (Section 7.2.17)
+type NotifyRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Identifier and name of the APN whose PDN GW changed
+	ContextId        uint32 `protobuf:"varint,2,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	ServiceSelection string `protobuf:"bytes,3,opt,name=service_selection,json=serviceSelection,proto3" json:"service_selection,omitempty"`
+	// Host of the PDN GW now serving the APN
+	PgwHost string `protobuf:"bytes,4,opt,name=pgw_host,json=pgwHost,proto3" json:"pgw_host,omitempty"`
+	// Selective unrolling of NOR-Flags 29.272 Table 7.3.49/1
+	SingleRegistrationIndication bool     `protobuf:"varint,5,opt,name=single_registration_indication,json=singleRegistrationIndication,proto3" json:"single_registration_indication,omitempty"`
+	UeReachableFromMme           bool     `protobuf:"varint,6,opt,name=ue_reachable_from_mme,json=ueReachableFromMme,proto3" json:"ue_reachable_from_mme,omitempty"`
+	ReadyForSmFromMme            bool     `protobuf:"varint,7,opt,name=ready_for_sm_from_mme,json=readyForSmFromMme,proto3" json:"ready_for_sm_from_mme,omitempty"`
+	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
+	XXX_unrecognized             []byte   `json:"-"`
+	XXX_sizecache                int32    `json:"-"`
+}
+
+func (m *NotifyRequest) Reset()         { *m = NotifyRequest{} }
+func (m *NotifyRequest) String() string { return proto.CompactTextString(m) }
+func (*NotifyRequest) ProtoMessage()    {}
+func (*NotifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{14}
+}
+
+func (m *NotifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotifyRequest.Unmarshal(m, b)
+}
+func (m *NotifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotifyRequest.Marshal(b, m, deterministic)
+}
+func (m *NotifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyRequest.Merge(m, src)
+}
+func (m *NotifyRequest) XXX_Size() int {
+	return xxx_messageInfo_NotifyRequest.Size(m)
+}
+func (m *NotifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyRequest proto.InternalMessageInfo
+
+func (m *NotifyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *NotifyRequest) GetContextId() uint32 {
+	if m != nil {
+		return m.ContextId
+	}
+	return 0
+}
+
+func (m *NotifyRequest) GetServiceSelection() string {
+	if m != nil {
+		return m.ServiceSelection
+	}
+	return ""
+}
+
+func (m *NotifyRequest) GetPgwHost() string {
+	if m != nil {
+		return m.PgwHost
+	}
+	return ""
+}
+
+func (m *NotifyRequest) GetSingleRegistrationIndication() bool {
+	if m != nil {
+		return m.SingleRegistrationIndication
+	}
+	return false
+}
+
+func (m *NotifyRequest) GetUeReachableFromMme() bool {
+	if m != nil {
+		return m.UeReachableFromMme
+	}
+	return false
+}
+
+func (m *NotifyRequest) GetReadyForSmFromMme() bool {
+	if m != nil {
+		return m.ReadyForSmFromMme
+	}
+	return false
+}
+
+// Notify Answer (Section 7.2.18)
+type NotifyAnswer struct {
+	// EPC error code on failure
+	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *NotifyAnswer) Reset()         { *m = NotifyAnswer{} }
+func (m *NotifyAnswer) String() string { return proto.CompactTextString(m) }
+func (*NotifyAnswer) ProtoMessage()    {}
+func (*NotifyAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f32b2af5087a8858, []int{15}
+}
+
+func (m *NotifyAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotifyAnswer.Unmarshal(m, b)
+}
+func (m *NotifyAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotifyAnswer.Marshal(b, m, deterministic)
+}
+func (m *NotifyAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotifyAnswer.Merge(m, src)
+}
+func (m *NotifyAnswer) XXX_Size() int {
+	return xxx_messageInfo_NotifyAnswer.Size(m)
+}
+func (m *NotifyAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotifyAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotifyAnswer proto.InternalMessageInfo
+
+func (m *NotifyAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
 func init() {
 	proto.RegisterEnum("magma.feg.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_NetworkAccessMode", UpdateLocationAnswer_NetworkAccessMode_name, UpdateLocationAnswer_NetworkAccessMode_value)
@@ -1047,118 +1444,146 @@ func init() {
 	proto.RegisterType((*PurgeUEAnswer)(nil), "magma.feg.PurgeUEAnswer")
 	proto.RegisterType((*ResetRequest)(nil), "magma.feg.ResetRequest")
 	proto.RegisterType((*ResetAnswer)(nil), "magma.feg.ResetAnswer")
+	proto.RegisterType((*InsertSubscriberDataRequest)(nil), "magma.feg.InsertSubscriberDataRequest")
+	proto.RegisterType((*InsertSubscriberDataAnswer)(nil), "magma.feg.InsertSubscriberDataAnswer")
+	proto.RegisterType((*DeleteSubscriberDataRequest)(nil), "magma.feg.DeleteSubscriberDataRequest")
+	proto.RegisterType((*DeleteSubscriberDataAnswer)(nil), "magma.feg.DeleteSubscriberDataAnswer")
+	proto.RegisterType((*NotifyRequest)(nil), "magma.feg.NotifyRequest")
+	proto.RegisterType((*NotifyAnswer)(nil), "magma.feg.NotifyAnswer")
 }
 
 func init() { proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_f32b2af5087a8858) }
 
 var fileDescriptor_f32b2af5087a8858 = []byte{
-	// 1687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x6e, 0xdb, 0xc8,
-	0x19, 0xb6, 0x7c, 0x88, 0xad, 0xdf, 0xb2, 0x4d, 0xcf, 0xda, 0x96, 0xec, 0x64, 0x61, 0x57, 0x68,
-	0x61, 0xc3, 0xdb, 0x3a, 0x5b, 0xa7, 0x70, 0x0f, 0x7b, 0xd1, 0xa5, 0xc9, 0x49, 0xcc, 0x84, 0x22,
-	0xb9, 0x43, 0x52, 0xc6, 0x2e, 0x8a, 0x9d, 0x8e, 0xc9, 0xb1, 0x42, 0x84, 0x07, 0x85, 0xa4, 0x1c,
-	0xfb, 0x05, 0x0a, 0xf4, 0xf0, 0x00, 0x05, 0xda, 0x9b, 0xb6, 0x40, 0xaf, 0x7a, 0x02, 0x7a, 0xd5,
-	0xd3, 0xf6, 0xf0, 0x04, 0x6d, 0xd1, 0x3e, 0x44, 0x6f, 0xfa, 0x0c, 0x05, 0x87, 0x94, 0x23, 0x29,
-	0x09, 0x92, 0xc0, 0xbd, 0xd2, 0xf0, 0xfb, 0xfe, 0xd3, 0x7c, 0xff, 0x3f, 0x43, 0x0a, 0xb6, 0xce,
-	0x79, 0xef, 0x6e, 0x3f, 0x4d, 0xf2, 0x24, 0xbb, 0x9b, 0x1d, 0x31, 0xda, 0x4f, 0x93, 0xcb, 0xab,
-	0x03, 0x01, 0xa0, 0x7a, 0xc4, 0x7a, 0x11, 0x3b, 0x38, 0xe7, 0xbd, 0xf6, 0x77, 0xa6, 0x61, 0x47,
-	0x1e, 0xe4, 0x8f, 0x79, 0x9c, 0x07, 0x1e, 0xcb, 0x83, 0x24, 0xd6, 0xe2, 0xf3, 0x24, 0x8d, 0xc4,
-	0x92, 0xf0, 0xa7, 0x03, 0x9e, 0xe5, 0xe8, 0x36, 0xd4, 0x07, 0x19, 0x4f, 0x69, 0xcc, 0x22, 0xde,
-	0xaa, 0xed, 0xd4, 0xf6, 0xea, 0x64, 0xa1, 0x00, 0x0c, 0x16, 0x71, 0xf4, 0x39, 0x68, 0x5c, 0x04,
-	0x59, 0x90, 0x73, 0x9f, 0xf6, 0xc3, 0x28, 0x6e, 0x4d, 0xef, 0xd4, 0xf6, 0x1a, 0x64, 0xb1, 0xc2,
-	0xac, 0x30, 0x8a, 0xd1, 0x37, 0xe1, 0x4e, 0x3c, 0x88, 0x68, 0x5a, 0x86, 0xe3, 0x3e, 0xe5, 0x83,
-	0x3c, 0x65, 0x31, 0xbd, 0xe0, 0x5e, 0x9e, 0xa4, 0x59, 0x6b, 0x66, 0xa7, 0xb6, 0xb7, 0x44, 0x36,
-	0xe3, 0x41, 0x44, 0x86, 0x26, 0x58, 0x58, 0x74, 0x4b, 0x03, 0xf4, 0x21, 0xdc, 0x09, 0xa2, 0x88,
-	0xfb, 0x01, 0xcb, 0x39, 0x4d, 0x79, 0xd6, 0x4f, 0xe2, 0x8c, 0xd3, 0x7e, 0xca, 0xcf, 0x79, 0x9a,
-	0x72, 0xbf, 0x35, 0xbb, 0x53, 0xdb, 0x5b, 0x20, 0x5b, 0xd7, 0x36, 0xa4, 0x32, 0xb1, 0x86, 0x16,
-	0x68, 0x1b, 0x16, 0x53, 0x9e, 0x5d, 0xc5, 0x1e, 0x0d, 0xe2, 0xf3, 0xa4, 0x35, 0x27, 0x8a, 0x84,
-	0x12, 0x2a, 0x76, 0xdc, 0xfe, 0xe1, 0x34, 0x6c, 0xbf, 0x52, 0x08, 0x39, 0xce, 0x9e, 0xf1, 0x14,
-	0xdd, 0x03, 0xe0, 0x69, 0x9a, 0xa4, 0xd4, 0x4b, 0xfc, 0x52, 0x88, 0xe5, 0xc3, 0xb5, 0x83, 0x6b,
-	0x31, 0x0f, 0x70, 0x41, 0x2a, 0x89, 0xcf, 0x49, 0x9d, 0x0f, 0x97, 0xe8, 0x53, 0x58, 0x9e, 0xd8,
-	0xee, 0xf4, 0xce, 0xcc, 0xde, 0xe2, 0xe1, 0x57, 0x47, 0x1c, 0x5f, 0x93, 0xf8, 0x00, 0xbb, 0x0e,
-	0x91, 0x8d, 0x52, 0x0d, 0xb2, 0xc4, 0x47, 0xb5, 0xd9, 0xfa, 0x36, 0x34, 0x46, 0x69, 0x84, 0x60,
-	0x36, 0x65, 0xb1, 0x2f, 0xca, 0x6b, 0x10, 0xb1, 0x2e, 0xb0, 0xcb, 0x94, 0x67, 0x55, 0x6f, 0xc4,
-	0xba, 0xc0, 0xd8, 0x20, 0x8f, 0x85, 0xf8, 0x0d, 0x22, 0xd6, 0x68, 0x0d, 0xe6, 0x9e, 0xb0, 0x2c,
-	0xe2, 0x42, 0xd0, 0x06, 0x29, 0x1f, 0xda, 0xbf, 0xa9, 0xc1, 0xba, 0xdb, 0xf7, 0x59, 0xce, 0xf5,
-	0xc4, 0xfb, 0xbf, 0x0e, 0xc6, 0xfb, 0xb0, 0x96, 0x3d, 0x09, 0xfa, 0x34, 0x1b, 0x9c, 0x65, 0x5e,
-	0x1a, 0x9c, 0xf1, 0x94, 0xfa, 0x2c, 0x67, 0xa2, 0xa6, 0x05, 0x82, 0x0a, 0xce, 0xbe, 0xa6, 0x54,
-	0x96, 0x33, 0xf4, 0x05, 0x58, 0x0e, 0xe2, 0x20, 0x0f, 0x58, 0x48, 0x59, 0x9e, 0x33, 0xef, 0x71,
-	0xd5, 0xfb, 0xa5, 0x0a, 0x95, 0x05, 0xd8, 0xfe, 0x7b, 0x1d, 0xd6, 0xc6, 0x4b, 0xbe, 0x49, 0x0b,
-	0xbf, 0x08, 0xc8, 0xe7, 0xe7, 0x6c, 0x10, 0xe6, 0xd4, 0x4b, 0xe2, 0x9c, 0x5f, 0xe6, 0x34, 0xf0,
-	0xc5, 0x7e, 0x96, 0x88, 0x54, 0x31, 0x4a, 0x49, 0x68, 0x3e, 0x3a, 0x05, 0xc8, 0x93, 0xbc, 0x28,
-	0x30, 0x3a, 0x4b, 0xc5, 0x56, 0x16, 0x0f, 0xbf, 0x36, 0x92, 0xe2, 0x65, 0x75, 0x1d, 0xc8, 0xbd,
-	0x5e, 0xca, 0x7b, 0x2c, 0xe7, 0x7e, 0x87, 0x5d, 0x06, 0xd1, 0x20, 0x3a, 0x0e, 0xf2, 0xb4, 0x98,
-	0xe4, 0xba, 0x88, 0x25, 0x47, 0x67, 0x29, 0xda, 0x87, 0x55, 0x16, 0x86, 0x94, 0xf5, 0xe3, 0x8c,
-	0x06, 0xb1, 0x17, 0x0e, 0xfc, 0xeb, 0xd1, 0x5f, 0x61, 0x61, 0x28, 0xf7, 0xe3, 0x4c, 0xab, 0x60,
-	0x74, 0x0c, 0x33, 0xac, 0x1f, 0xb7, 0xe6, 0xc4, 0xa8, 0xbd, 0xff, 0xda, 0xec, 0x96, 0xa1, 0x24,
-	0xf1, 0x79, 0xd0, 0x1b, 0xa4, 0x65, 0x7f, 0x0b, 0x67, 0xb4, 0x01, 0xb7, 0xa2, 0x2c, 0xc8, 0xfc,
-	0xb8, 0x35, 0x2f, 0x5a, 0x57, 0x3d, 0x21, 0x06, 0xef, 0xc4, 0x3c, 0x7f, 0x96, 0xa4, 0x4f, 0x28,
-	0xf3, 0x3c, 0x9e, 0x65, 0x34, 0x2a, 0xc4, 0x5c, 0x10, 0x62, 0x7e, 0xf9, 0x75, 0xb9, 0x8c, 0xd2,
-	0x55, 0x16, 0x9e, 0x9d, 0x42, 0xe9, 0xd5, 0x78, 0x12, 0xda, 0xfa, 0xd7, 0x2c, 0x48, 0x93, 0x45,
-	0xa1, 0x77, 0x01, 0x46, 0xe4, 0xaf, 0x09, 0xf9, 0xeb, 0xde, 0xb5, 0xee, 0xef, 0xc1, 0x6a, 0xc6,
-	0xd3, 0x8b, 0xc0, 0xe3, 0x34, 0xe3, 0x21, 0xf7, 0x0a, 0x1f, 0xd1, 0xa4, 0x3a, 0x91, 0x2a, 0xc2,
-	0x1e, 0xe2, 0xe8, 0x5b, 0xb0, 0xf8, 0x34, 0xc9, 0x8a, 0x5b, 0xf1, 0x3c, 0x08, 0x79, 0xd5, 0xa5,
-	0x0f, 0xde, 0x56, 0xa7, 0x83, 0x8f, 0x12, 0xdb, 0x2a, 0x43, 0x10, 0x78, 0x9a, 0x64, 0xd5, 0x1a,
-	0xe9, 0x30, 0x2b, 0x9a, 0x3f, 0x7b, 0xc3, 0xe6, 0x8b, 0x28, 0xe8, 0x21, 0xcc, 0xf4, 0xfd, 0x58,
-	0xdc, 0x59, 0xcb, 0x6f, 0x10, 0x6c, 0xb2, 0x46, 0x4b, 0x35, 0x9c, 0xab, 0x3e, 0x27, 0x45, 0x90,
-	0xad, 0xcf, 0x6a, 0x00, 0xcf, 0x8b, 0x46, 0x9b, 0xb0, 0xe0, 0x85, 0x2c, 0xcb, 0x86, 0x82, 0xce,
-	0x91, 0x79, 0xf1, 0xac, 0xf9, 0xc5, 0x49, 0xeb, 0xa7, 0x41, 0x92, 0x06, 0xf9, 0x15, 0x0d, 0xf9,
-	0x05, 0x0f, 0xab, 0x81, 0x5f, 0x1a, 0xa2, 0x7a, 0x01, 0xa2, 0x7b, 0xb0, 0xde, 0x4f, 0x39, 0x8f,
-	0xfa, 0x45, 0x2e, 0xea, 0xb1, 0x3e, 0x3b, 0x0b, 0xc2, 0x20, 0xbf, 0xaa, 0xce, 0xf0, 0xda, 0x73,
-	0x52, 0xb9, 0xe6, 0xd0, 0xd7, 0xa1, 0x35, 0xe2, 0x74, 0x31, 0x08, 0x63, 0x9e, 0x0e, 0xfd, 0xca,
-	0x81, 0x6e, 0x3e, 0xe7, 0xbb, 0xa3, 0x74, 0xfb, 0x03, 0x98, 0xaf, 0x36, 0x84, 0x16, 0x60, 0x56,
-	0xb3, 0xba, 0x5f, 0x91, 0xa6, 0xaa, 0xd5, 0x91, 0x54, 0x43, 0x00, 0xb7, 0x0a, 0xac, 0x7b, 0x24,
-	0x4d, 0x23, 0x09, 0x1a, 0xc5, 0x9a, 0x9a, 0x84, 0x0a, 0x76, 0x66, 0x2b, 0x86, 0xd6, 0xab, 0xb4,
-	0x46, 0x7b, 0x20, 0x45, 0xec, 0x92, 0x9e, 0xb1, 0xd8, 0x7f, 0x16, 0xf8, 0xf9, 0x63, 0x3a, 0x08,
-	0xab, 0x19, 0x5b, 0x8e, 0xd8, 0xe5, 0xf1, 0x10, 0x76, 0xc3, 0x17, 0x2d, 0xfd, 0xa1, 0x36, 0x63,
-	0x96, 0x6a, 0xd8, 0x7e, 0x08, 0xab, 0x2f, 0x8c, 0x3b, 0xda, 0x00, 0x64, 0xc9, 0xca, 0x23, 0xec,
-	0x50, 0xd9, 0x50, 0xa9, 0xa2, 0x11, 0xc5, 0xd5, 0x1c, 0x69, 0x0a, 0x35, 0x60, 0x81, 0x60, 0x1b,
-	0x93, 0x2e, 0x56, 0xa5, 0x1a, 0x5a, 0x81, 0x45, 0xd3, 0xd0, 0x3f, 0xa6, 0xa5, 0xa9, 0x34, 0xdd,
-	0xfe, 0xed, 0x34, 0xac, 0x2b, 0x2c, 0xf6, 0x78, 0xf8, 0x56, 0xb7, 0xf0, 0xa7, 0xb0, 0xea, 0x09,
-	0xaf, 0x50, 0xf8, 0xd0, 0xfc, 0xaa, 0xcf, 0x45, 0xb5, 0xe3, 0x47, 0xf5, 0xa5, 0x91, 0x2b, 0xb4,
-	0xf4, 0x14, 0x33, 0x24, 0x79, 0x13, 0x48, 0xfb, 0xc7, 0x35, 0x90, 0x26, 0xcd, 0x50, 0x0b, 0xd6,
-	0x3a, 0x1d, 0x4c, 0x5d, 0x4b, 0x95, 0x1d, 0x4c, 0x2d, 0x62, 0x2a, 0x58, 0x75, 0x09, 0x96, 0xa6,
-	0xd0, 0x26, 0xac, 0xdb, 0x0f, 0x6c, 0xe3, 0x45, 0xaa, 0x86, 0x6e, 0x43, 0xd3, 0x76, 0x8f, 0x6d,
-	0x85, 0x68, 0x96, 0xa3, 0x99, 0x06, 0x3d, 0xd5, 0x9c, 0x13, 0x95, 0xc8, 0xa7, 0xb2, 0x2e, 0x4d,
-	0x17, 0x11, 0x27, 0x5d, 0xa8, 0x76, 0x7a, 0x5f, 0x9a, 0x41, 0x77, 0xa0, 0xa5, 0x19, 0x9a, 0xa3,
-	0xc9, 0x3a, 0x95, 0x1d, 0x47, 0x56, 0x4e, 0x46, 0x82, 0xce, 0xb6, 0x1f, 0xc1, 0xda, 0xf8, 0xd6,
-	0x6e, 0xf0, 0x1e, 0x68, 0x7f, 0x09, 0x96, 0xad, 0x41, 0xda, 0xe3, 0x2e, 0x7e, 0x13, 0xe9, 0xdb,
-	0x2a, 0x2c, 0x55, 0xe6, 0x37, 0x49, 0xba, 0x0b, 0x0d, 0xc2, 0x33, 0x9e, 0x0f, 0x53, 0x36, 0x61,
-	0x5e, 0xa4, 0x14, 0x27, 0x76, 0x66, 0xaf, 0x4e, 0x6e, 0x15, 0x8f, 0x9a, 0xdf, 0x3e, 0x86, 0x45,
-	0x61, 0x78, 0x83, 0x64, 0xfb, 0xff, 0x9d, 0x85, 0xfa, 0x35, 0x81, 0x96, 0xa0, 0xee, 0x1a, 0x2a,
-	0xbe, 0xaf, 0x19, 0x58, 0x95, 0xa6, 0xd0, 0x3a, 0x48, 0x1d, 0x57, 0x77, 0x34, 0x4a, 0x4c, 0xd7,
-	0x50, 0xa9, 0xec, 0x3a, 0x27, 0xd2, 0x7f, 0xe6, 0x51, 0x03, 0xe6, 0x6d, 0x57, 0x51, 0xb0, 0x6d,
-	0x4b, 0xff, 0x58, 0x41, 0x6b, 0xb0, 0xa2, 0x6b, 0x1d, 0xcd, 0xc1, 0x2a, 0x1d, 0xa2, 0xff, 0x5c,
-	0x41, 0x4d, 0x40, 0x8a, 0xd9, 0xe9, 0x14, 0x03, 0xef, 0x1a, 0xb6, 0x6b, 0x99, 0xc4, 0xc1, 0xaa,
-	0xf4, 0xbb, 0x26, 0xda, 0x80, 0x55, 0xd7, 0x90, 0x8f, 0x75, 0x4c, 0x1d, 0x93, 0xaa, 0x58, 0xd7,
-	0xba, 0x98, 0x48, 0xbf, 0x6f, 0x16, 0xb9, 0x08, 0x96, 0xf5, 0x0e, 0x35, 0x4c, 0x87, 0x56, 0x87,
-	0xe2, 0x0f, 0x4d, 0xb4, 0x04, 0x0b, 0x8e, 0x69, 0xd2, 0x63, 0xd7, 0xfe, 0x58, 0xfa, 0x63, 0x13,
-	0x21, 0x58, 0xd2, 0x4d, 0xd3, 0xa2, 0x2a, 0x76, 0xb0, 0x52, 0x44, 0xfc, 0x53, 0x13, 0xb5, 0xe0,
-	0x1d, 0x82, 0x55, 0x8d, 0x60, 0xc5, 0xa1, 0x9a, 0xa1, 0x6a, 0x8a, 0x5c, 0x4c, 0x93, 0xf4, 0x59,
-	0x13, 0xdd, 0x81, 0xa6, 0x6c, 0x59, 0x7a, 0x85, 0x94, 0x85, 0x54, 0x95, 0xfc, 0x59, 0x64, 0xd4,
-	0x8c, 0xae, 0xac, 0x6b, 0xea, 0x09, 0x55, 0x09, 0x3d, 0xd6, 0x1c, 0x5b, 0xfa, 0xcb, 0x28, 0x4c,
-	0xe5, 0xae, 0x55, 0xc2, 0x7f, 0x6d, 0xa2, 0x55, 0x68, 0xb8, 0xc6, 0x23, 0xc3, 0x3c, 0x35, 0xa8,
-	0x85, 0x31, 0x91, 0xfe, 0x56, 0x86, 0x77, 0x9d, 0x13, 0x6c, 0x38, 0xc3, 0x0c, 0x04, 0x3f, 0x2c,
-	0xcb, 0xfa, 0xc9, 0x76, 0xe1, 0x60, 0xba, 0x0e, 0x35, 0xef, 0x53, 0xdb, 0x92, 0x15, 0x2c, 0xfd,
-	0x74, 0xbb, 0xa8, 0x1e, 0xeb, 0x58, 0x11, 0xa6, 0xba, 0x69, 0x3b, 0xd2, 0xcf, 0xb6, 0xd1, 0x6d,
-	0xd8, 0x28, 0x82, 0x98, 0x44, 0xfb, 0x64, 0x22, 0xc6, 0xf7, 0x77, 0x45, 0x52, 0x1b, 0x13, 0x5a,
-	0x65, 0x96, 0xbe, 0xbb, 0x5b, 0x08, 0x3b, 0xac, 0xc3, 0xc6, 0xb6, 0x5d, 0x78, 0x68, 0xaa, 0xf4,
-	0xbd, 0x5d, 0xf4, 0x2e, 0xb4, 0x86, 0x04, 0xb6, 0x6c, 0x3a, 0x7a, 0xb2, 0xa4, 0x5f, 0xec, 0x17,
-	0x6d, 0x22, 0xb2, 0x23, 0xd4, 0x95, 0x75, 0xdd, 0x3c, 0xc5, 0xaa, 0xf4, 0xcb, 0x7d, 0xa1, 0x9d,
-	0x29, 0x77, 0x34, 0xe3, 0xc1, 0x18, 0xf3, 0x83, 0xdd, 0xa2, 0x4f, 0xf8, 0x23, 0x57, 0xb3, 0x3a,
-	0xd8, 0x70, 0xae, 0xf3, 0xff, 0x4a, 0x78, 0xb8, 0xc6, 0xa3, 0x32, 0x3d, 0xe9, 0x96, 0x8e, 0x2a,
-	0x96, 0x7e, 0xbd, 0x8f, 0x3e, 0x0f, 0xdb, 0x13, 0x72, 0xa8, 0xb2, 0x23, 0x53, 0xd7, 0x90, 0xbb,
-	0xb2, 0xa6, 0x17, 0x2d, 0x97, 0xfe, 0xbd, 0x73, 0xf8, 0xa3, 0x69, 0x58, 0xb0, 0x8f, 0x98, 0x55,
-	0xfc, 0x3b, 0x41, 0x17, 0xb0, 0xf9, 0xca, 0x2f, 0x61, 0xf4, 0xde, 0x9b, 0x7c, 0x2f, 0x57, 0x87,
-	0x64, 0x6b, 0xff, 0xcd, 0x3f, 0xae, 0xdb, 0x53, 0xc8, 0x85, 0xe5, 0xf1, 0x57, 0x29, 0xda, 0x79,
-	0xe5, 0x5b, 0x76, 0x98, 0x61, 0xfb, 0x35, 0xef, 0xe1, 0xf6, 0x14, 0xfa, 0x10, 0xe6, 0xab, 0xf3,
-	0x8f, 0x36, 0x47, 0xac, 0xc7, 0xaf, 0x90, 0xad, 0xd6, 0x8b, 0xd4, 0x30, 0xc2, 0xe1, 0xcf, 0x6b,
-	0xb0, 0x6a, 0x1f, 0xb1, 0x07, 0x2c, 0xe7, 0xcf, 0xd8, 0x95, 0x5d, 0x7e, 0xc4, 0x14, 0xe5, 0x8e,
-	0xdf, 0x69, 0x63, 0xe5, 0xbe, 0xf4, 0x26, 0x1f, 0x2b, 0xf7, 0x65, 0x17, 0x62, 0x7b, 0x0a, 0x7d,
-	0x03, 0xe6, 0xc4, 0xfd, 0x81, 0x9a, 0x23, 0xb6, 0xa3, 0x57, 0xcf, 0xd6, 0xc6, 0x24, 0x31, 0xf4,
-	0x3d, 0xbe, 0xfd, 0xc9, 0xa6, 0xa0, 0xee, 0x16, 0xff, 0x3a, 0xbd, 0x30, 0x19, 0xf8, 0x77, 0x7b,
-	0x49, 0xf5, 0xf7, 0xf3, 0xec, 0x96, 0xf8, 0xbd, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac,
-	0x7e, 0xc8, 0x80, 0x93, 0x0e, 0x00, 0x00,
+	// 2036 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x49, 0x73, 0xdb, 0xc8,
+	0x15, 0x16, 0xa9, 0x8d, 0x7c, 0x22, 0x65, 0xa8, 0x47, 0x32, 0x29, 0xca, 0x13, 0x29, 0xac, 0x38,
+	0x56, 0x69, 0x12, 0xd9, 0x23, 0xa7, 0x9c, 0xc9, 0x4c, 0x4d, 0x65, 0x20, 0x02, 0xb2, 0x60, 0x93,
+	0x20, 0xdc, 0x00, 0xa4, 0x9a, 0xa9, 0xd4, 0x74, 0x5a, 0x40, 0x93, 0x46, 0x19, 0x0b, 0x0d, 0x80,
+	0x5a, 0xfe, 0x40, 0xaa, 0xb2, 0xfc, 0x80, 0x1c, 0x72, 0x49, 0x72, 0xcd, 0x56, 0x95, 0x53, 0x36,
+	0x67, 0xa9, 0xfc, 0x80, 0x24, 0x95, 0x5c, 0xf2, 0x0f, 0x72, 0xc9, 0x39, 0xc7, 0x14, 0x1a, 0x20,
+	0x45, 0x6a, 0xb1, 0xe4, 0x68, 0x72, 0x62, 0xe3, 0xed, 0xfd, 0xbd, 0xd7, 0xfd, 0x1e, 0x1b, 0x6a,
+	0x1d, 0xd6, 0xbd, 0xdf, 0x0b, 0x83, 0x38, 0x88, 0xee, 0x47, 0x8f, 0x28, 0xe9, 0x85, 0xc1, 0xf1,
+	0xc9, 0x26, 0x27, 0xa0, 0xa2, 0x47, 0xbb, 0x1e, 0xdd, 0xec, 0xb0, 0x6e, 0xfd, 0x5b, 0x79, 0x58,
+	0x13, 0xfb, 0xf1, 0x73, 0xe6, 0xc7, 0x8e, 0x45, 0x63, 0x27, 0xf0, 0x15, 0xbf, 0x13, 0x84, 0x1e,
+	0x5f, 0x62, 0xf6, 0xb2, 0xcf, 0xa2, 0x18, 0xad, 0x40, 0xb1, 0x1f, 0xb1, 0x90, 0xf8, 0xd4, 0x63,
+	0xd5, 0xdc, 0x5a, 0x6e, 0xbd, 0x88, 0x0b, 0x09, 0x41, 0xa5, 0x1e, 0x43, 0x9f, 0x87, 0xd2, 0xa1,
+	0x13, 0x39, 0x31, 0xb3, 0x49, 0xcf, 0xf5, 0xfc, 0x6a, 0x7e, 0x2d, 0xb7, 0x5e, 0xc2, 0x73, 0x19,
+	0x4d, 0x73, 0x3d, 0x1f, 0x7d, 0x1d, 0xee, 0xf8, 0x7d, 0x8f, 0x84, 0xa9, 0x39, 0x66, 0x13, 0xd6,
+	0x8f, 0x43, 0xea, 0x93, 0x43, 0x66, 0xc5, 0x41, 0x18, 0x55, 0x27, 0xd7, 0x72, 0xeb, 0x65, 0xbc,
+	0xec, 0xf7, 0x3d, 0x3c, 0x10, 0x91, 0xb9, 0xc4, 0x5e, 0x2a, 0x80, 0x3e, 0x82, 0x3b, 0x8e, 0xe7,
+	0x31, 0xdb, 0xa1, 0x31, 0x23, 0x21, 0x8b, 0x7a, 0x81, 0x1f, 0x31, 0xd2, 0x0b, 0x59, 0x87, 0x85,
+	0x21, 0xb3, 0xab, 0x53, 0x6b, 0xb9, 0xf5, 0x02, 0xae, 0x0d, 0x65, 0x70, 0x26, 0xa2, 0x0d, 0x24,
+	0xd0, 0x2a, 0xcc, 0x85, 0x2c, 0x3a, 0xf1, 0x2d, 0xe2, 0xf8, 0x9d, 0xa0, 0x3a, 0xcd, 0x83, 0x84,
+	0x94, 0x94, 0xec, 0xb8, 0xfe, 0xfd, 0x3c, 0xac, 0x5e, 0x0a, 0x84, 0xe8, 0x47, 0x47, 0x2c, 0x44,
+	0x0f, 0x01, 0x58, 0x18, 0x06, 0x21, 0xb1, 0x02, 0x3b, 0x05, 0x62, 0x7e, 0x6b, 0x71, 0x73, 0x08,
+	0xe6, 0xa6, 0x9c, 0x30, 0x1b, 0x81, 0xcd, 0x70, 0x91, 0x0d, 0x96, 0xe8, 0x53, 0x98, 0x3f, 0xb3,
+	0xdd, 0xfc, 0xda, 0xe4, 0xfa, 0xdc, 0xd6, 0x57, 0x47, 0x14, 0xaf, 0x70, 0xbc, 0x29, 0x9b, 0x06,
+	0x16, 0xd5, 0x14, 0x0d, 0x5c, 0x66, 0xa3, 0xd8, 0xd4, 0xbe, 0x09, 0xa5, 0x51, 0x36, 0x42, 0x30,
+	0x15, 0x52, 0xdf, 0xe6, 0xe1, 0x95, 0x30, 0x5f, 0x27, 0xb4, 0xe3, 0x90, 0x45, 0x59, 0x6e, 0xf8,
+	0x3a, 0xa1, 0xd1, 0x7e, 0xec, 0x73, 0xf0, 0x4b, 0x98, 0xaf, 0xd1, 0x22, 0x4c, 0xbf, 0xa0, 0x91,
+	0xc7, 0x38, 0xa0, 0x25, 0x9c, 0x7e, 0xd4, 0x7f, 0x91, 0x83, 0x25, 0xb3, 0x67, 0xd3, 0x98, 0x35,
+	0x03, 0xeb, 0x33, 0x2d, 0x8c, 0x07, 0xb0, 0x18, 0xbd, 0x70, 0x7a, 0x24, 0xea, 0x1f, 0x44, 0x56,
+	0xe8, 0x1c, 0xb0, 0x90, 0xd8, 0x34, 0xa6, 0x3c, 0xa6, 0x02, 0x46, 0x09, 0x4f, 0x1f, 0xb2, 0x24,
+	0x1a, 0x53, 0x74, 0x17, 0xe6, 0x1d, 0xdf, 0x89, 0x1d, 0xea, 0x12, 0x1a, 0xc7, 0xd4, 0x7a, 0x9e,
+	0xe5, 0xbe, 0x9c, 0x51, 0x45, 0x4e, 0xac, 0xff, 0xa5, 0x08, 0x8b, 0xe3, 0x21, 0xdf, 0x24, 0x85,
+	0x5f, 0x02, 0x64, 0xb3, 0x0e, 0xed, 0xbb, 0x31, 0xb1, 0x02, 0x3f, 0x66, 0xc7, 0x31, 0x71, 0x6c,
+	0xbe, 0x9f, 0x32, 0x16, 0x32, 0x4e, 0x23, 0x65, 0x28, 0x36, 0xda, 0x07, 0x88, 0x83, 0x38, 0x09,
+	0xd0, 0x3b, 0x08, 0xf9, 0x56, 0xe6, 0xb6, 0xde, 0x1b, 0x71, 0x71, 0x51, 0x5c, 0x9b, 0x62, 0xb7,
+	0x1b, 0xb2, 0x2e, 0x8d, 0x99, 0xdd, 0xa2, 0xc7, 0x8e, 0xd7, 0xf7, 0xb6, 0x9d, 0x38, 0x4c, 0x2a,
+	0xb9, 0xc8, 0x6d, 0x89, 0xde, 0x41, 0x88, 0x36, 0x60, 0x81, 0xba, 0x2e, 0xa1, 0x3d, 0x3f, 0x22,
+	0x8e, 0x6f, 0xb9, 0x7d, 0x7b, 0x58, 0xfa, 0xb7, 0xa8, 0xeb, 0x8a, 0x3d, 0x3f, 0x52, 0x32, 0x32,
+	0xda, 0x86, 0x49, 0xda, 0xf3, 0xab, 0xd3, 0xbc, 0xd4, 0x1e, 0x5c, 0xe9, 0x5d, 0x53, 0x1b, 0x81,
+	0xdf, 0x71, 0xba, 0xfd, 0x30, 0xcd, 0x6f, 0xa2, 0x8c, 0x6e, 0xc3, 0x8c, 0x17, 0x39, 0x91, 0xed,
+	0x57, 0x67, 0x79, 0xea, 0xb2, 0x2f, 0x44, 0xe1, 0x2d, 0x9f, 0xc5, 0x47, 0x41, 0xf8, 0x82, 0x50,
+	0xcb, 0x62, 0x51, 0x44, 0xbc, 0x04, 0xcc, 0x02, 0x07, 0xf3, 0xdd, 0xab, 0x7c, 0xa9, 0xa9, 0xaa,
+	0xc8, 0x35, 0x5b, 0x09, 0xd2, 0x0b, 0xfe, 0x59, 0x52, 0xed, 0xef, 0x53, 0x20, 0x9c, 0x0d, 0x0a,
+	0xbd, 0x0d, 0x30, 0x02, 0x7f, 0x8e, 0xc3, 0x5f, 0xb4, 0x86, 0xb8, 0xbf, 0x03, 0x0b, 0x11, 0x0b,
+	0x0f, 0x1d, 0x8b, 0x91, 0x88, 0xb9, 0xcc, 0x4a, 0x74, 0x78, 0x92, 0x8a, 0x58, 0xc8, 0x18, 0xfa,
+	0x80, 0x8e, 0xbe, 0x01, 0x73, 0x2f, 0x83, 0x28, 0xb9, 0x15, 0x3b, 0x8e, 0xcb, 0xb2, 0x2c, 0x7d,
+	0xf0, 0xa6, 0x38, 0x6d, 0x3e, 0x0b, 0x74, 0x2d, 0x35, 0x81, 0xe1, 0x65, 0x10, 0x65, 0x6b, 0xd4,
+	0x84, 0x29, 0x9e, 0xfc, 0xa9, 0x1b, 0x26, 0x9f, 0x5b, 0x41, 0x4f, 0x60, 0xb2, 0x67, 0xfb, 0xfc,
+	0xce, 0x9a, 0xdf, 0x7a, 0xef, 0x8d, 0x63, 0xd4, 0x24, 0xd5, 0x38, 0xe9, 0x31, 0x9c, 0x18, 0xa9,
+	0xbd, 0xca, 0x01, 0x9c, 0x06, 0x8d, 0x96, 0xa1, 0x60, 0xb9, 0x34, 0x8a, 0x06, 0x80, 0x4e, 0xe3,
+	0x59, 0xfe, 0xad, 0xd8, 0xc9, 0x49, 0xeb, 0x85, 0x4e, 0x10, 0x3a, 0xf1, 0x09, 0x71, 0xd9, 0x21,
+	0x73, 0xb3, 0x82, 0x2f, 0x0f, 0xa8, 0xcd, 0x84, 0x88, 0x1e, 0xc2, 0x52, 0x2f, 0x64, 0xcc, 0xeb,
+	0x25, 0xbe, 0x88, 0x45, 0x7b, 0xf4, 0xc0, 0x71, 0x9d, 0xf8, 0x24, 0x3b, 0xc3, 0x8b, 0xa7, 0xcc,
+	0xc6, 0x90, 0x87, 0xbe, 0x06, 0xd5, 0x11, 0xa5, 0xc3, 0xbe, 0xeb, 0xb3, 0x70, 0xa0, 0x97, 0x16,
+	0x74, 0xe5, 0x94, 0xbf, 0x37, 0xca, 0xae, 0x7f, 0x00, 0xb3, 0xd9, 0x86, 0x50, 0x01, 0xa6, 0x14,
+	0x6d, 0xef, 0x2b, 0xc2, 0x44, 0xb6, 0x7a, 0x24, 0xe4, 0x10, 0xc0, 0x4c, 0x42, 0xdb, 0x7b, 0x24,
+	0xe4, 0x91, 0x00, 0xa5, 0x64, 0x4d, 0xda, 0x98, 0x70, 0xee, 0x64, 0xcd, 0x87, 0xea, 0x65, 0x58,
+	0xa3, 0x75, 0x10, 0x3c, 0x7a, 0x4c, 0x0e, 0xa8, 0x6f, 0x1f, 0x39, 0x76, 0xfc, 0x9c, 0xf4, 0xdd,
+	0xac, 0xc6, 0xe6, 0x3d, 0x7a, 0xbc, 0x3d, 0x20, 0x9b, 0xee, 0x79, 0x49, 0x7b, 0x80, 0xcd, 0x98,
+	0xa4, 0xe4, 0xd6, 0x9f, 0xc0, 0xc2, 0xb9, 0x72, 0x47, 0xb7, 0x01, 0x69, 0x62, 0xe3, 0xa9, 0x6c,
+	0x10, 0x51, 0x95, 0x48, 0x43, 0xc1, 0x0d, 0x53, 0x31, 0x84, 0x09, 0x54, 0x82, 0x02, 0x96, 0x75,
+	0x19, 0xef, 0xc9, 0x92, 0x90, 0x43, 0xb7, 0x60, 0xae, 0xad, 0x36, 0x3f, 0x26, 0xa9, 0xa8, 0x90,
+	0xaf, 0xff, 0x32, 0x0f, 0x4b, 0x0d, 0xea, 0x5b, 0xcc, 0x7d, 0xa3, 0x5b, 0xf8, 0x53, 0x58, 0xb0,
+	0xb8, 0x96, 0xcb, 0x75, 0x48, 0x7c, 0xd2, 0x63, 0xd5, 0xfc, 0xb9, 0xa3, 0x7a, 0xa1, 0xe5, 0xcd,
+	0xc6, 0x88, 0x26, 0xaf, 0x21, 0xc1, 0x3a, 0x43, 0xa9, 0xff, 0x20, 0x07, 0xc2, 0x59, 0x31, 0x54,
+	0x85, 0xc5, 0x56, 0x4b, 0x26, 0xa6, 0x26, 0x89, 0x86, 0x4c, 0x34, 0xdc, 0x6e, 0xc8, 0x92, 0x89,
+	0x65, 0x61, 0x02, 0x2d, 0xc3, 0x92, 0xfe, 0x58, 0x57, 0xcf, 0xb3, 0x72, 0x68, 0x05, 0x2a, 0xba,
+	0xb9, 0xad, 0x37, 0xb0, 0xa2, 0x19, 0x4a, 0x5b, 0x25, 0xfb, 0x8a, 0xb1, 0x2b, 0x61, 0x71, 0x5f,
+	0x6c, 0x0a, 0xf9, 0xc4, 0xe2, 0x59, 0x15, 0xa2, 0xec, 0xef, 0x08, 0x93, 0xe8, 0x0e, 0x54, 0x15,
+	0x55, 0x31, 0x14, 0xb1, 0x49, 0x44, 0xc3, 0x10, 0x1b, 0xbb, 0x23, 0x46, 0xa7, 0xea, 0x4f, 0x61,
+	0x71, 0x7c, 0x6b, 0x37, 0xe8, 0x03, 0xf5, 0x2f, 0xc3, 0xbc, 0xd6, 0x0f, 0xbb, 0xcc, 0x94, 0xaf,
+	0x03, 0x7d, 0x5d, 0x82, 0x72, 0x26, 0x7e, 0x13, 0xa7, 0xf7, 0xa0, 0x84, 0x59, 0xc4, 0xe2, 0x81,
+	0xcb, 0x0a, 0xcc, 0x72, 0x97, 0xfc, 0xc4, 0x4e, 0xae, 0x17, 0xf1, 0x4c, 0xf2, 0xa9, 0xd8, 0xf5,
+	0x6d, 0x98, 0xe3, 0x82, 0x37, 0x71, 0xf6, 0xe7, 0x29, 0x58, 0x51, 0xfc, 0x88, 0x85, 0xf1, 0x78,
+	0xdf, 0xbd, 0x56, 0xa9, 0x9d, 0xf6, 0x8b, 0xfc, 0x75, 0xfa, 0xc5, 0xe4, 0x67, 0xd7, 0x2f, 0x2e,
+	0xe9, 0xd0, 0x53, 0xd7, 0xea, 0xd0, 0xd3, 0xff, 0xe7, 0x0e, 0x3d, 0xf3, 0xda, 0x0e, 0x3d, 0x7b,
+	0x93, 0x0e, 0xbd, 0x02, 0x45, 0xc7, 0x0e, 0x49, 0xc7, 0xa5, 0xdd, 0x88, 0xf7, 0xdf, 0x32, 0x2e,
+	0x38, 0x76, 0xb8, 0x93, 0x7c, 0xa3, 0x0f, 0x61, 0xe5, 0x02, 0xd8, 0x93, 0xa9, 0x39, 0x62, 0x7e,
+	0x5c, 0x2d, 0xf2, 0xb0, 0xaa, 0xe7, 0xb0, 0xd4, 0x52, 0x3e, 0x7a, 0x1f, 0x96, 0x69, 0xcf, 0x27,
+	0xd6, 0xa8, 0xd7, 0xa1, 0x32, 0xa4, 0x97, 0x34, 0xed, 0xf9, 0x63, 0x51, 0x65, 0xba, 0xf5, 0x67,
+	0x50, 0xbb, 0xa8, 0x8a, 0x6e, 0x52, 0x99, 0xc7, 0xb0, 0x22, 0x31, 0x97, 0xc5, 0xec, 0x7f, 0x28,
+	0xcc, 0x15, 0x28, 0xda, 0xd1, 0x00, 0xa6, 0xf4, 0xa6, 0x2e, 0xd8, 0x51, 0x06, 0xd3, 0x2a, 0xcc,
+	0x9d, 0x96, 0x4c, 0xf2, 0x5f, 0x64, 0x72, 0xbd, 0x8c, 0x61, 0x38, 0x56, 0x44, 0xc9, 0x66, 0x2e,
+	0xf2, 0x7c, 0x93, 0xcd, 0xbc, 0xca, 0x43, 0x59, 0x0d, 0x62, 0xa7, 0x73, 0x72, 0xad, 0xf8, 0xc7,
+	0x07, 0x9f, 0xfc, 0xb5, 0x06, 0x9f, 0xc9, 0x4b, 0x06, 0x9f, 0x65, 0x28, 0xf4, 0xba, 0x47, 0xe4,
+	0x79, 0x10, 0xc5, 0xfc, 0x7c, 0x14, 0xf1, 0x6c, 0xaf, 0x7b, 0xb4, 0x1b, 0x44, 0x31, 0x92, 0xe0,
+	0x73, 0x91, 0xe3, 0x77, 0x5d, 0x46, 0x42, 0xd6, 0x75, 0xa2, 0x38, 0xcb, 0xb9, 0xe3, 0xdb, 0xd9,
+	0x1f, 0x13, 0x7e, 0x54, 0x0a, 0xf8, 0x4e, 0x2a, 0x85, 0x47, 0x84, 0x94, 0xa1, 0x0c, 0x7a, 0x17,
+	0x96, 0xfa, 0x89, 0x05, 0x6a, 0x3d, 0xa7, 0x07, 0x2e, 0x23, 0x9d, 0x30, 0xf0, 0x88, 0xe7, 0xb1,
+	0xec, 0x1c, 0xa0, 0x3e, 0xc3, 0x03, 0xde, 0x4e, 0x18, 0x78, 0x2d, 0x8f, 0xa1, 0x07, 0xb0, 0x14,
+	0x32, 0x6a, 0x9f, 0x90, 0x4e, 0x10, 0x92, 0xc8, 0x3b, 0x55, 0x99, 0xe5, 0x2a, 0x0b, 0x9c, 0xb9,
+	0x13, 0x84, 0xba, 0x97, 0x69, 0xd4, 0x1b, 0x50, 0x4a, 0xf1, 0xbb, 0x41, 0x16, 0x36, 0xfe, 0x3d,
+	0x05, 0xc5, 0x21, 0x03, 0x95, 0xa1, 0x68, 0xaa, 0x92, 0xbc, 0xa3, 0xa8, 0xb2, 0x24, 0x4c, 0xa0,
+	0x25, 0x10, 0x5a, 0x66, 0xd3, 0x50, 0x08, 0x6e, 0x9b, 0xaa, 0x44, 0x44, 0xd3, 0xd8, 0x15, 0xfe,
+	0x35, 0x8b, 0x4a, 0x30, 0xab, 0x9b, 0x8d, 0x86, 0xac, 0xeb, 0xc2, 0x5f, 0x6f, 0xa1, 0x45, 0xb8,
+	0xd5, 0x54, 0x5a, 0x8a, 0x21, 0x4b, 0x64, 0x40, 0xfd, 0xdb, 0x2d, 0x54, 0x01, 0xd4, 0x68, 0xb7,
+	0x5a, 0x49, 0x77, 0x37, 0x55, 0xdd, 0xd4, 0xda, 0xd8, 0x90, 0x25, 0xe1, 0x57, 0x15, 0x74, 0x1b,
+	0x16, 0x4c, 0x55, 0xdc, 0x6e, 0xca, 0xc4, 0x68, 0x13, 0x49, 0x6e, 0x2a, 0x7b, 0x32, 0x16, 0x7e,
+	0x5d, 0x49, 0x7c, 0x61, 0x59, 0x6c, 0xb6, 0x88, 0xda, 0x36, 0x48, 0x36, 0x01, 0xfc, 0xa6, 0x82,
+	0xca, 0x50, 0x30, 0xda, 0x6d, 0xb2, 0x6d, 0xea, 0x1f, 0x0b, 0xbf, 0xad, 0x20, 0x04, 0xe5, 0x66,
+	0xbb, 0xad, 0x11, 0x49, 0x36, 0xe4, 0x46, 0x62, 0xf1, 0x77, 0x15, 0x54, 0x85, 0xb7, 0xb0, 0x2c,
+	0x29, 0x58, 0x6e, 0x18, 0x44, 0x51, 0x25, 0xa5, 0x21, 0x26, 0xad, 0x53, 0x78, 0x55, 0x41, 0x77,
+	0xa0, 0x22, 0x6a, 0x5a, 0x33, 0xa3, 0xa4, 0x81, 0x64, 0x91, 0xfc, 0x9e, 0x7b, 0x54, 0xd4, 0x3d,
+	0xb1, 0xa9, 0x48, 0xbb, 0x44, 0xc2, 0x64, 0x5b, 0x31, 0x74, 0xe1, 0x0f, 0xa3, 0x64, 0x22, 0xee,
+	0x69, 0x29, 0xf9, 0x8f, 0x15, 0xb4, 0x00, 0x25, 0x53, 0x7d, 0xaa, 0xb6, 0xf7, 0x55, 0xa2, 0xc9,
+	0x32, 0x16, 0xfe, 0x94, 0x9a, 0x37, 0x8d, 0x5d, 0x59, 0x35, 0x06, 0x1e, 0xb0, 0xfc, 0x24, 0x0d,
+	0xeb, 0x87, 0xab, 0x89, 0x42, 0xdb, 0x34, 0x48, 0x7b, 0x87, 0xe8, 0x9a, 0xd8, 0x90, 0x85, 0x1f,
+	0xad, 0x26, 0xd1, 0xcb, 0x4d, 0xb9, 0xc1, 0x45, 0x9b, 0x6d, 0xdd, 0x10, 0x7e, 0xbc, 0x8a, 0x56,
+	0xe0, 0x76, 0x62, 0xa4, 0x8d, 0x95, 0x4f, 0xce, 0xd8, 0xf8, 0xee, 0x3d, 0xee, 0x54, 0x97, 0x31,
+	0xc9, 0x3c, 0x0b, 0xdf, 0xbe, 0x97, 0x00, 0x3b, 0x88, 0x43, 0x97, 0x75, 0x3d, 0xd1, 0x50, 0x24,
+	0xe1, 0x3b, 0xf7, 0xd0, 0xdb, 0x50, 0x1d, 0x30, 0x64, 0x4d, 0x27, 0xa3, 0x63, 0x84, 0xf0, 0x93,
+	0x8d, 0x24, 0x4d, 0x58, 0x34, 0x38, 0xba, 0x62, 0xb3, 0xd9, 0xde, 0x97, 0x25, 0xe1, 0xa7, 0x1b,
+	0x1c, 0xbb, 0xb6, 0xd8, 0x52, 0xd4, 0xc7, 0x63, 0x9c, 0xef, 0xdd, 0x4b, 0xf2, 0x24, 0x3f, 0x33,
+	0x15, 0xad, 0x25, 0xab, 0xc6, 0xd0, 0xff, 0xcf, 0xb8, 0x86, 0xa9, 0x3e, 0x4d, 0xdd, 0xe3, 0xbd,
+	0x54, 0x51, 0x92, 0x85, 0x9f, 0x6f, 0xa0, 0x2f, 0xc0, 0xea, 0x19, 0x38, 0x24, 0xd1, 0x10, 0x89,
+	0xa9, 0x8a, 0x7b, 0xa2, 0xd2, 0x4c, 0x52, 0x2e, 0xfc, 0x63, 0x6d, 0xeb, 0x9f, 0x79, 0x28, 0xe8,
+	0x8f, 0xa8, 0x96, 0x3c, 0xc5, 0xa0, 0x43, 0x58, 0xbe, 0xf4, 0x6f, 0x3f, 0x7a, 0xe7, 0x3a, 0x8f,
+	0x03, 0xd9, 0xdd, 0x51, 0xdb, 0xb8, 0xfe, 0x4b, 0x42, 0x7d, 0x02, 0x99, 0x30, 0x3f, 0xde, 0x61,
+	0xd0, 0xda, 0xa5, 0xcd, 0x67, 0xe0, 0x61, 0xf5, 0x8a, 0xf6, 0x54, 0x9f, 0x40, 0x1f, 0xc1, 0x6c,
+	0x36, 0xec, 0xa0, 0xe5, 0x11, 0xe9, 0xf1, 0x79, 0xa9, 0x56, 0x3d, 0xcf, 0x1a, 0x5a, 0xf8, 0x10,
+	0x66, 0xd2, 0x33, 0x8d, 0x46, 0xa5, 0xc6, 0xae, 0xc9, 0x5a, 0xe5, 0x1c, 0x67, 0xa0, 0xbe, 0xf5,
+	0x9f, 0x3c, 0x2c, 0xe8, 0x8f, 0xe8, 0x63, 0x1a, 0xb3, 0x23, 0x7a, 0xa2, 0xa7, 0xf7, 0x5e, 0xb2,
+	0xdb, 0xf1, 0xf9, 0x6f, 0x6c, 0xb7, 0x17, 0x4e, 0xbd, 0xb5, 0xd5, 0x4b, 0x25, 0x86, 0xb1, 0xbe,
+	0x0f, 0xd3, 0x7c, 0xd6, 0x42, 0xa3, 0x01, 0x8d, 0x8e, 0x69, 0xb5, 0xdb, 0x67, 0x19, 0x43, 0xdd,
+	0x2e, 0x2c, 0x5e, 0xd4, 0x1c, 0xd1, 0x17, 0x47, 0x34, 0x5e, 0x33, 0x83, 0xd5, 0xee, 0x5e, 0x21,
+	0x37, 0xea, 0xe8, 0xa2, 0xc6, 0x35, 0xe6, 0xe8, 0x35, 0x3d, 0xb5, 0x76, 0xf7, 0x0a, 0xb9, 0x81,
+	0xa3, 0xed, 0x95, 0x4f, 0x96, 0xb9, 0xe4, 0xfd, 0xe4, 0xcd, 0xd1, 0x72, 0x83, 0xbe, 0x7d, 0xbf,
+	0x1b, 0x64, 0x8f, 0x8f, 0x07, 0x33, 0xfc, 0xf7, 0xe1, 0x7f, 0x07, 0x00, 0xb6, 0x77, 0x5b, 0xd7,
+	0x91, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1179,6 +1604,8 @@ type S6AProxyClient interface {
 	UpdateLocation(ctx context.Context, in *UpdateLocationRequest, opts ...grpc.CallOption) (*UpdateLocationAnswer, error)
 	// Purge-UE (Code 321)
 	PurgeUE(ctx context.Context, in *PurgeUERequest, opts ...grpc.CallOption) (*PurgeUEAnswer, error)
+	// Notify (Code 323)
+	Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyAnswer, error)
 }
 
 type s6AProxyClient struct {
@@ -1216,6 +1643,15 @@ func (c *s6AProxyClient) PurgeUE(ctx context.Context, in *PurgeUERequest, opts .
 	return out, nil
 }
 
+func (c *s6AProxyClient) Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyAnswer, error) {
+	out := new(NotifyAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aProxy/Notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AProxyServer is the server API for S6AProxy service.
 type S6AProxyServer interface {
 	// Authentication-Information (Code 318)
@@ -1224,6 +1660,8 @@ type S6AProxyServer interface {
 	UpdateLocation(context.Context, *UpdateLocationRequest) (*UpdateLocationAnswer, error)
 	// Purge-UE (Code 321)
 	PurgeUE(context.Context, *PurgeUERequest) (*PurgeUEAnswer, error)
+	// Notify (Code 323)
+	Notify(context.Context, *NotifyRequest) (*NotifyAnswer, error)
 }
 
 // UnimplementedS6AProxyServer can be embedded to have forward compatible implementations.
@@ -1239,6 +1677,9 @@ func (*UnimplementedS6AProxyServer) UpdateLocation(ctx context.Context, req *Upd
 func (*UnimplementedS6AProxyServer) PurgeUE(ctx context.Context, req *PurgeUERequest) (*PurgeUEAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeUE not implemented")
 }
+func (*UnimplementedS6AProxyServer) Notify(ctx context.Context, req *NotifyRequest) (*NotifyAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
 
 func RegisterS6AProxyServer(s *grpc.Server, srv S6AProxyServer) {
 	s.RegisterService(&_S6AProxy_serviceDesc, srv)
@@ -1298,6 +1739,24 @@ func _S6AProxy_PurgeUE_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AProxy_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AProxyServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aProxy/Notify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AProxyServer).Notify(ctx, req.(*NotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AProxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aProxy",
 	HandlerType: (*S6AProxyServer)(nil),
@@ -1314,6 +1773,10 @@ var _S6AProxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeUE",
 			Handler:    _S6AProxy_PurgeUE_Handler,
 		},
+		{
+			MethodName: "Notify",
+			Handler:    _S6AProxy_Notify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
@@ -1327,6 +1790,10 @@ type S6AGatewayServiceClient interface {
 	CancelLocation(ctx context.Context, in *CancelLocationRequest, opts ...grpc.CallOption) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error)
 }
 
 type s6AGatewayServiceClient struct {
@@ -1355,12 +1822,34 @@ func (c *s6AGatewayServiceClient) Reset(ctx context.Context, in *ResetRequest, o
 	return out, nil
 }
 
+func (c *s6AGatewayServiceClient) InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error) {
+	out := new(InsertSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s6AGatewayServiceClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error) {
+	out := new(DeleteSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AGatewayServiceServer is the server API for S6AGatewayService service.
 type S6AGatewayServiceServer interface {
 	// Cancel-Location (Code 317)
 	CancelLocation(context.Context, *CancelLocationRequest) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(context.Context, *ResetRequest) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(context.Context, *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error)
 }
 
 // UnimplementedS6AGatewayServiceServer can be embedded to have forward compatible implementations.
@@ -1373,6 +1862,12 @@ func (*UnimplementedS6AGatewayServiceServer) CancelLocation(ctx context.Context,
 func (*UnimplementedS6AGatewayServiceServer) Reset(ctx context.Context, req *ResetRequest) (*ResetAnswer, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
 }
+func (*UnimplementedS6AGatewayServiceServer) InsertSubscriberData(ctx context.Context, req *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertSubscriberData not implemented")
+}
+func (*UnimplementedS6AGatewayServiceServer) DeleteSubscriberData(ctx context.Context, req *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscriberData not implemented")
+}
 
 func RegisterS6AGatewayServiceServer(s *grpc.Server, srv S6AGatewayServiceServer) {
 	s.RegisterService(&_S6AGatewayService_serviceDesc, srv)
@@ -1414,6 +1909,42 @@ func _S6AGatewayService_Reset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AGatewayService_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, req.(*InsertSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S6AGatewayService_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aGatewayService",
 	HandlerType: (*S6AGatewayServiceServer)(nil),
@@ -1426,6 +1957,14 @@ var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _S6AGatewayService_Reset_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _S6AGatewayService_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _S6AGatewayService_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
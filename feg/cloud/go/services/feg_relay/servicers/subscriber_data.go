@@ -0,0 +1,94 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc"
+)
+
+// InsertSubscriberData relays the InsertSubscriberDataRequest to a
+// corresponding dispatcher service instance, who will in turn relay the
+// request to the corresponding gateway
+func (srv *FegToGwRelayServer) InsertSubscriberData(
+	ctx context.Context,
+	req *fegprotos.InsertSubscriberDataRequest,
+) (*fegprotos.InsertSubscriberDataAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.InsertSubscriberDataUnverified(ctx, req)
+}
+
+// InsertSubscriberDataUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) InsertSubscriberDataUnverified(
+	ctx context.Context,
+	req *fegprotos.InsertSubscriberDataRequest,
+) (*fegprotos.InsertSubscriberDataAnswer, error) {
+	conn, ctx, errCode := getS6aGatewayConnection(ctx, req.UserName)
+	if errCode != fegprotos.ErrorCode_SUCCESS {
+		return &fegprotos.InsertSubscriberDataAnswer{ErrorCode: errCode}, nil
+	}
+	client := fegprotos.NewS6AGatewayServiceClient(conn)
+	return client.InsertSubscriberData(ctx, req)
+}
+
+// DeleteSubscriberData relays the DeleteSubscriberDataRequest to a
+// corresponding dispatcher service instance, who will in turn relay the
+// request to the corresponding gateway
+func (srv *FegToGwRelayServer) DeleteSubscriberData(
+	ctx context.Context,
+	req *fegprotos.DeleteSubscriberDataRequest,
+) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.DeleteSubscriberDataUnverified(ctx, req)
+}
+
+// DeleteSubscriberDataUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) DeleteSubscriberDataUnverified(
+	ctx context.Context,
+	req *fegprotos.DeleteSubscriberDataRequest,
+) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+	conn, ctx, errCode := getS6aGatewayConnection(ctx, req.UserName)
+	if errCode != fegprotos.ErrorCode_SUCCESS {
+		return &fegprotos.DeleteSubscriberDataAnswer{ErrorCode: errCode}, nil
+	}
+	client := fegprotos.NewS6AGatewayServiceClient(conn)
+	return client.DeleteSubscriberData(ctx, req)
+}
+
+// getS6aGatewayConnection returns a connection to the S6a service of the
+// gateway serving the IMSI, or the error code to answer the HSS with if the
+// gateway can't be reached
+func getS6aGatewayConnection(ctx context.Context, imsi string) (*grpc.ClientConn, context.Context, fegprotos.ErrorCode) {
+	hwId, err := getHwIDFromIMSI(ctx, imsi)
+	if err != nil {
+		glog.Errorf("unable to get HwID from IMSI %v. err: %v", imsi, err)
+		if _, ok := err.(errors.ClientInitError); ok {
+			return nil, ctx, fegprotos.ErrorCode_UNABLE_TO_DELIVER
+		}
+		return nil, ctx, fegprotos.ErrorCode_USER_UNKNOWN
+	}
+	conn, ctx, err := gateway_registry.GetGatewayConnection(gateway_registry.GwS6aService, hwId)
+	if err != nil {
+		glog.Errorf("unable to get connection to the gateway ID: %s", hwId)
+		return nil, ctx, fegprotos.ErrorCode_UNABLE_TO_DELIVER
+	}
+	return conn, ctx, fegprotos.ErrorCode_SUCCESS
+}
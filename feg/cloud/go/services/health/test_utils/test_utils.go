@@ -11,6 +11,7 @@ LICENSE file in the root directory of this source tree.
 package test_utils
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -94,7 +95,7 @@ func GetUnhealthyRequest() *protos.HealthRequest {
 }
 
 func RegisterNetwork(t *testing.T, networkID string) {
-	err := configurator.CreateNetwork(configurator.Network{
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{
 		ID:   TestFegNetwork,
 		Type: feg.FegNetworkType,
 	})
@@ -17,6 +17,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+
+	"magma/feg/cloud/go/protos/mconfig"
 )
 
 const (
@@ -55,6 +57,7 @@ type DiameterServerConnConfig struct {
 	Addr      string // host:port
 	Protocol  string // tcp/sctp
 	LocalAddr string // IP:port or :port
+	TLS       DiameterTLSConfig
 }
 
 type DiameterServerConfig struct {
@@ -62,6 +65,8 @@ type DiameterServerConfig struct {
 	DestHost        string
 	DestRealm       string
 	DisableDestHost bool
+	// Peers lists alternate peers of the server, see PeerGroup
+	Peers []*DiameterPeerConfig
 }
 
 // DiameterClientConfig holds information for connecting with a diameter server
@@ -93,7 +98,7 @@ func (cfg *DiameterServerConfig) Validate() error {
 	if err != nil {
 		return fmt.Errorf("Invalid Diameter Address (%s://%s): %v", cfg.Protocol, cfg.Addr, err)
 	}
-	return nil
+	return cfg.TLS.Validate()
 }
 
 func (cfg *DiameterClientConfig) Validate() error {
@@ -129,6 +134,37 @@ func (srcCfg *DiameterClientConfig) FillInDefaults() *DiameterClientConfig {
 	return &cfg
 }
 
+// PeerConfigsFromMconfig converts managed config peers of a server into
+// DiameterPeerConfigs
+func PeerConfigsFromMconfig(peers []*mconfig.DiamPeerConfig) []*DiameterPeerConfig {
+	if len(peers) == 0 {
+		return nil
+	}
+	res := make([]*DiameterPeerConfig, 0, len(peers))
+	for _, p := range peers {
+		if p == nil {
+			continue
+		}
+		res = append(res, &DiameterPeerConfig{
+			DiameterServerConfig: DiameterServerConfig{
+				DiameterServerConnConfig: DiameterServerConnConfig{
+					Addr:      p.GetAddress(),
+					Protocol:  p.GetProtocol(),
+					LocalAddr: p.GetLocalAddress(),
+					TLS:       TLSConfigFromMconfig(p.GetTls()),
+				},
+				DestHost:        p.GetDestHost(),
+				DestRealm:       p.GetDestRealm(),
+				DisableDestHost: p.GetDisableDestHost(),
+			},
+			Priority: p.GetPriority(),
+			Weight:   p.GetWeight(),
+			Realms:   p.GetRealms(),
+		})
+	}
+	return res
+}
+
 // getUint64FlagValue looks up the flag and either returns its uint64 value
 // or an error.
 func getUint64FlagValue(flagName string) (uint64, error) {
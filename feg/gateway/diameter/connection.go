@@ -140,7 +140,12 @@ func (c *Connection) getDiamConnection() (diam.Conn, *smpeer.Metadata, error) {
 				"Invalid " + c.server.Protocol + " local address '" + c.server.LocalAddr + "':" + err.Error())
 		}
 	}
-	conn, err := c.client.DialExt(c.server.Protocol, c.server.Addr, 0, localAddr)
+	var conn diam.Conn
+	if c.server.TLS.Enabled() {
+		conn, err = dialSecure(c.client, &c.server.DiameterServerConnConfig, localAddr)
+	} else {
+		conn, err = c.client.DialExt(c.server.Protocol, c.server.Addr, 0, localAddr)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -208,15 +213,16 @@ func addDestinationToMessage(
 		// apply new realm
 		realmAVP.Data = destRealm
 	}
-	if server.DisableDestHost {
-		return message, nil
-	}
-	hostAVP, err := message.FindAVP(avp.DestinationHost, 0)
-	if err != nil {
-		message.NewAVP(avp.DestinationHost, avp.Mbit, 0, destHost)
-	} else if hostAVP != nil {
-		// apply new host
-		hostAVP.Data = destHost
+	if !server.DisableDestHost {
+		hostAVP, err := message.FindAVP(avp.DestinationHost, 0)
+		if err != nil {
+			message.NewAVP(avp.DestinationHost, avp.Mbit, 0, destHost)
+		} else if hostAVP != nil {
+			// apply new host
+			hostAVP.Data = destHost
+		}
 	}
+	// replaced realm & host may differ in length from the original ones
+	message.Header.MessageLength = uint32(message.Len())
 	return message, nil
 }
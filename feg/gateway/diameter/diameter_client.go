@@ -14,6 +14,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fiorix/go-diameter/v4/diam"
@@ -46,6 +47,9 @@ type Client struct {
 	requestTracker *RequestTracker
 	cfg            *DiameterClientConfig
 	originStateID  uint32
+
+	peerGroupsMutex sync.Mutex
+	peerGroups      map[DiameterServerConnConfig]*PeerGroup
 }
 
 // OriginRealm returns client's config Realm
@@ -136,6 +140,7 @@ func NewClient(clientCfg *DiameterClientConfig) *Client {
 		requestTracker: NewRequestTracker(),
 		cfg:            clientCfg,
 		originStateID:  originStateID,
+		peerGroups:     map[DiameterServerConnConfig]*PeerGroup{},
 	}
 }
 
@@ -153,13 +158,50 @@ func (client *Client) BeginConnection(server *DiameterServerConfig) error {
 		glog.Error(err)
 		return err
 	}
-	_, err := client.connMan.GetConnection(client.smClient, server)
+	var err error
+	if server != nil && len(server.Peers) > 0 {
+		var peers *PeerGroup
+		peers, err = client.getPeerGroup(server)
+		if err == nil {
+			err = peers.Connect()
+		}
+	} else {
+		_, err = client.connMan.GetConnection(client.smClient, server)
+	}
 	if err != nil {
 		glog.Error(err)
 	}
 	return err
 }
 
+// PeerStatus returns the status of the server's peers if the server has
+// alternate peers configured, nil otherwise
+func (client *Client) PeerStatus(server *DiameterServerConfig) []PeerStatus {
+	if server == nil || len(server.Peers) == 0 {
+		return nil
+	}
+	peers, err := client.getPeerGroup(server)
+	if err != nil {
+		return nil
+	}
+	return peers.Status()
+}
+
+// getPeerGroup returns the peer group of the server, creating it on first use
+func (client *Client) getPeerGroup(server *DiameterServerConfig) (*PeerGroup, error) {
+	client.peerGroupsMutex.Lock()
+	defer client.peerGroupsMutex.Unlock()
+	if peers, ok := client.peerGroups[server.DiameterServerConnConfig]; ok {
+		return peers, nil
+	}
+	peers, err := NewPeerGroup(client.smClient, client.connMan, client.requestTracker, server)
+	if err != nil {
+		return nil, err
+	}
+	client.peerGroups[server.DiameterServerConnConfig] = peers
+	return peers, nil
+}
+
 func (client *Client) Retries() uint {
 	if client != nil && client.cfg != nil {
 		return client.cfg.RetryCount
@@ -187,7 +229,8 @@ func (client *Client) DisableConnectionCreation(period time.Duration) {
 }
 
 // SendRequest sends a diameter request message to the given server and sends
-// back the answer on the given channel. A key is required to identify the
+// back the answer on the given channel. If the server has alternate peers, the
+// request is routed through the server's PeerGroup. A key is required to identify the
 // corresponding answer. Additionally, SendRequest will add the OriginHost/Realm
 // AVPs to the message because they are mandatory for all requests
 // Input: server - cfg containing info on what server to send to
@@ -202,6 +245,16 @@ func (client *Client) SendRequest(
 	key interface{},
 ) error {
 	client.requestTracker.RegisterRequest(key, done)
+	if server != nil && len(server.Peers) > 0 {
+		peers, err := client.getPeerGroup(server)
+		if err == nil {
+			err = peers.SendRequest(client.AddOriginAVPsToMessage(message), key, client.cfg.RetryCount)
+		}
+		if err != nil {
+			client.requestTracker.DeregisterRequest(key)
+		}
+		return err
+	}
 	conn, err := client.connMan.GetConnection(client.smClient, server)
 	if err == nil {
 		m := client.AddOriginAVPsToMessage(message)
@@ -0,0 +1,505 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/golang/glog"
+)
+
+const (
+	// AnyRealm can be used in DiameterPeerConfig.Realms to route requests for
+	// all realms which aren't explicitly routed to a peer (default route)
+	AnyRealm = "*"
+
+	// DefaultPeerReconnectInterval is how long a failed peer is kept out of
+	// rotation before a new connection to it is attempted
+	DefaultPeerReconnectInterval = time.Second * 10
+)
+
+// DiameterPeerConfig is an alternate peer of a DiameterServerConfig.
+// The server itself is the primary peer with priority 0 and weight 1
+type DiameterPeerConfig struct {
+	DiameterServerConfig
+	// Priority of the peer, peers with lower values are preferred. Peers with
+	// higher values are only used while all peers with lower values are down
+	Priority uint32
+	// Weight of the peer amongst the peers of the same priority, requests are
+	// distributed using weighted round robin. Weight 0 is treated as 1
+	Weight uint32
+	// Realms lists destination realms which are routed to the peer in addition
+	// to its DestRealm (for example by a DRA). AnyRealm makes the peer a default route
+	Realms []string
+}
+
+// PeerStatus describes the state of a peer of a PeerGroup
+type PeerStatus struct {
+	Addr      string
+	DestHost  string
+	Priority  uint32
+	Weight    uint32
+	Up        bool
+	Failures  uint32    // consecutive failures since the peer was last up
+	LastError string    // last send or connection error
+	Since     time.Time // time of the last Up/Down transition
+}
+
+type peer struct {
+	cfg           *DiameterPeerConfig
+	client        *sm.Client
+	weight        int
+	currentWeight int // smooth weighted round robin state
+	up            bool
+	failures      uint32
+	lastErr       error
+	since         time.Time
+	conn          *Connection
+	watched       diam.Conn // diameter connection watched for closure
+}
+
+type pendingRequest struct {
+	message    *diam.Message
+	peer       *peer
+	realm      string
+	retryCount uint
+}
+
+// PeerGroup sends requests to a group of diameter peers instead of a single
+// server. Peers are selected by the request's Destination-Realm, then by
+// priority (failover) and finally by weighted round robin (load balancing).
+// A peer is marked down when sending to it fails or its connection is closed,
+// which includes DWR failures detected by the sm.Client watchdog. Requests
+// which are still awaiting answers from a lost peer are re-sent to another
+// peer with the T flag set, as long as their key is tracked by the group's
+// RequestTracker
+type PeerGroup struct {
+	connMan           *ConnectionManager
+	requestTracker    *RequestTracker
+	reconnectInterval time.Duration
+
+	mutex   sync.Mutex
+	peers   []*peer
+	routes  map[string][]*peer // Destination-Realm -> peers
+	pending map[interface{}]*pendingRequest
+}
+
+// NewPeerGroup creates a PeerGroup for the server and its alternate peers.
+// Connections are created through the given connection manager and pending
+// requests are re-sent only while their keys are registered with requestTracker
+func NewPeerGroup(
+	client *sm.Client,
+	connMan *ConnectionManager,
+	requestTracker *RequestTracker,
+	server *DiameterServerConfig,
+) (*PeerGroup, error) {
+	if server == nil {
+		return nil, fmt.Errorf("Nil server config")
+	}
+	primary := &DiameterPeerConfig{DiameterServerConfig: *server, Weight: 1}
+	primary.Peers = nil
+	configs := append([]*DiameterPeerConfig{primary}, server.Peers...)
+
+	pg := &PeerGroup{
+		connMan:           connMan,
+		requestTracker:    requestTracker,
+		reconnectInterval: DefaultPeerReconnectInterval,
+		routes:            map[string][]*peer{},
+		pending:           map[interface{}]*pendingRequest{},
+	}
+	now := time.Now()
+	for _, cfg := range configs {
+		if cfg == nil {
+			continue
+		}
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+		weight := int(cfg.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		p := &peer{cfg: cfg, client: client, weight: weight, up: true, since: now}
+		if len(configs) > 1 {
+			p.client = newPeerClient(client)
+		}
+		pg.peers = append(pg.peers, p)
+
+		realms := cfg.Realms
+		if len(cfg.DestRealm) > 0 {
+			realms = append([]string{cfg.DestRealm}, realms...)
+		} else if len(realms) == 0 {
+			// a peer without any realm accepts whatever realm it's sent
+			realms = []string{AnyRealm}
+		}
+		routed := map[string]bool{}
+		for _, realm := range realms {
+			if !routed[realm] {
+				routed[realm] = true
+				pg.routes[realm] = append(pg.routes[realm], p)
+			}
+		}
+	}
+	return pg, nil
+}
+
+// SetReconnectInterval sets how long a failed peer is kept out of rotation
+func (pg *PeerGroup) SetReconnectInterval(interval time.Duration) {
+	pg.mutex.Lock()
+	pg.reconnectInterval = interval
+	pg.mutex.Unlock()
+}
+
+// Connect creates connections to all peers of the group and puts them back in
+// rotation. As with ConnectionManager.GetConnection, the diameter connections
+// are established on first use. The first error encountered is returned
+func (pg *PeerGroup) Connect() error {
+	var firstErr error
+	for _, p := range pg.peers {
+		conn, err := pg.connMan.GetConnection(p.client, &p.cfg.DiameterServerConfig)
+		if err != nil {
+			pg.peerFailed(p, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		pg.mutex.Lock()
+		p.conn = conn
+		pg.mutex.Unlock()
+		pg.peerUp(p)
+	}
+	return firstErr
+}
+
+// SendRequest sends the request to a peer serving the request's
+// Destination-Realm, falling back to the default route (AnyRealm) for unknown
+// realms. Requests without Destination-Realm may be sent to any peer. If
+// sending to the selected peer fails, the remaining peers are tried in order
+// of preference. The key identifies the request in the group's RequestTracker
+func (pg *PeerGroup) SendRequest(message *diam.Message, key interface{}, retryCount uint) error {
+	return pg.send(&pendingRequest{
+		message:    message,
+		realm:      getDestinationRealm(message),
+		retryCount: retryCount,
+	}, key, nil)
+}
+
+// Status returns the current status of all peers of the group
+func (pg *PeerGroup) Status() []PeerStatus {
+	pg.mutex.Lock()
+	defer pg.mutex.Unlock()
+	res := make([]PeerStatus, 0, len(pg.peers))
+	for _, p := range pg.peers {
+		status := PeerStatus{
+			Addr:     p.cfg.Addr,
+			DestHost: p.cfg.DestHost,
+			Priority: p.cfg.Priority,
+			Weight:   uint32(p.weight),
+			Up:       p.up,
+			Failures: p.failures,
+			Since:    p.since,
+		}
+		if p.lastErr != nil {
+			status.LastError = p.lastErr.Error()
+		}
+		res = append(res, status)
+	}
+	return res
+}
+
+// send sends the request to the best available peer, excluding peers in tried
+func (pg *PeerGroup) send(req *pendingRequest, key interface{}, tried map[*peer]bool) error {
+	if tried == nil {
+		tried = map[*peer]bool{}
+	}
+	var lastErr error
+	for {
+		p, err := pg.selectPeer(req.realm, tried)
+		if err != nil {
+			if lastErr != nil {
+				return lastErr
+			}
+			return err
+		}
+		tried[p] = true
+		err = pg.sendToPeer(p, req, key)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		glog.Errorf("Failed to send diameter request to peer %s (%s): %v", p.cfg.Addr, p.cfg.DestHost, err)
+		pg.peerFailed(p, err)
+	}
+}
+
+func (pg *PeerGroup) sendToPeer(p *peer, req *pendingRequest, key interface{}) error {
+	conn, err := pg.connMan.GetConnection(p.client, &p.cfg.DiameterServerConfig)
+	if err != nil {
+		return err
+	}
+	diamConn, _, err := conn.getDiamConnection()
+	if err != nil {
+		return err
+	}
+	pg.watch(p, conn, diamConn)
+
+	// register the request before sending it, the answer may be received
+	// before SendRequestToServer returns. Without a request tracker there is
+	// no way to tell answered requests apart, so nothing is re-sent
+	pg.mutex.Lock()
+	req.peer = p
+	if pg.requestTracker != nil && key != nil {
+		pg.pending[key] = req
+	}
+	pg.mutex.Unlock()
+
+	err = conn.SendRequestToServer(req.message, req.retryCount, p.serverConfigFor(req.realm))
+	if err != nil {
+		pg.mutex.Lock()
+		if pg.pending[key] == req {
+			delete(pg.pending, key)
+		}
+		pg.mutex.Unlock()
+		return err
+	}
+	pg.peerUp(p)
+	return nil
+}
+
+// selectPeer returns the available peer with the lowest priority which serves
+// the realm. Amongst peers of the same priority, smooth weighted round robin
+// is used
+func (pg *PeerGroup) selectPeer(realm string, exclude map[*peer]bool) (*peer, error) {
+	pg.mutex.Lock()
+	defer pg.mutex.Unlock()
+
+	// requests without a realm can be sent to any peer, the realm is added
+	// based on the selected peer's config
+	candidates := pg.peers
+	if len(realm) > 0 {
+		candidates = pg.routes[realm]
+		if len(candidates) == 0 {
+			candidates = pg.routes[AnyRealm]
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("No diameter peer serves realm '%s'", realm)
+	}
+	now := time.Now()
+	var available []*peer
+	var fallback *peer // the peer which is down for the longest time
+	for _, p := range candidates {
+		if exclude[p] {
+			continue
+		}
+		// peers which are down get another chance after the reconnect interval
+		if !p.up && now.Sub(p.since) < pg.reconnectInterval {
+			if fallback == nil || p.since.Before(fallback.since) {
+				fallback = p
+			}
+			continue
+		}
+		if len(available) > 0 && p.cfg.Priority > available[0].cfg.Priority {
+			continue
+		}
+		if len(available) > 0 && p.cfg.Priority < available[0].cfg.Priority {
+			available = available[:0]
+		}
+		available = append(available, p)
+	}
+	if len(available) == 0 {
+		// rather than failing the request, try the peer which had the most
+		// time to recover
+		if fallback != nil {
+			return fallback, nil
+		}
+		return nil, fmt.Errorf("No available diameter peer for realm '%s'", realm)
+	}
+	var (
+		best  *peer
+		total int
+	)
+	for _, p := range available {
+		p.currentWeight += p.weight
+		total += p.weight
+		if best == nil || p.currentWeight > best.currentWeight {
+			best = p
+		}
+	}
+	best.currentWeight -= total
+	return best, nil
+}
+
+// watch starts tracking the peer's diameter connection. Once it is closed
+// (including closures caused by watchdog failures), the peer is marked down
+// and requests pending on it are re-sent to other peers
+func (pg *PeerGroup) watch(p *peer, conn *Connection, diamConn diam.Conn) {
+	pg.mutex.Lock()
+	defer pg.mutex.Unlock()
+	p.conn = conn
+	if p.watched == diamConn {
+		return
+	}
+	p.watched = diamConn
+	notifier, ok := diamConn.(diam.CloseNotifier)
+	if !ok {
+		return
+	}
+	go func() {
+		<-notifier.CloseNotify()
+		pg.connectionLost(p, diamConn)
+	}()
+}
+
+func (pg *PeerGroup) connectionLost(p *peer, diamConn diam.Conn) {
+	pg.mutex.Lock()
+	if p.watched != diamConn {
+		pg.mutex.Unlock()
+		return
+	}
+	p.watched = nil
+	conn := p.conn
+	pg.mutex.Unlock()
+
+	if conn != nil {
+		conn.destroyConnection(diamConn)
+	}
+	pg.peerFailed(p, fmt.Errorf("connection to %s closed", p.cfg.Addr))
+	pg.resendPending(p)
+}
+
+// resendPending re-sends requests which are still awaiting answers from the
+// lost peer to other peers
+func (pg *PeerGroup) resendPending(lost *peer) {
+	pg.mutex.Lock()
+	toResend := map[interface{}]*pendingRequest{}
+	for key, req := range pg.pending {
+		if !pg.requestTracker.IsTracked(key) {
+			delete(pg.pending, key) // already answered or abandoned
+			continue
+		}
+		if req.peer == lost {
+			delete(pg.pending, key)
+			toResend[key] = req
+		}
+	}
+	pg.mutex.Unlock()
+
+	for key, req := range toResend {
+		req.message.Header.CommandFlags |= diam.RetransmittedFlag
+		removeDestinationHost(req.message)
+		err := pg.send(req, key, map[*peer]bool{lost: true})
+		if err != nil {
+			glog.Errorf("Failed to re-send diameter request %v after losing peer %s: %v", key, lost.cfg.Addr, err)
+		} else {
+			glog.Infof("Re-sent diameter request %v after losing peer %s", key, lost.cfg.Addr)
+		}
+	}
+}
+
+func (pg *PeerGroup) peerFailed(p *peer, err error) {
+	pg.mutex.Lock()
+	defer pg.mutex.Unlock()
+	p.failures++
+	p.lastErr = err
+	if p.up {
+		glog.Warningf("Diameter peer %s (%s) is down: %v", p.cfg.Addr, p.cfg.DestHost, err)
+		p.up = false
+	}
+	// restart the reconnect interval after every failure, including retries
+	p.since = time.Now()
+}
+
+func (pg *PeerGroup) peerUp(p *peer) {
+	pg.mutex.Lock()
+	defer pg.mutex.Unlock()
+	if !p.up {
+		glog.Infof("Diameter peer %s (%s) is up", p.cfg.Addr, p.cfg.DestHost)
+		p.up = true
+		p.since = time.Now()
+	}
+	p.failures = 0
+	// prune requests which were answered since they were sent
+	if pg.requestTracker != nil {
+		for key := range pg.pending {
+			if !pg.requestTracker.IsTracked(key) {
+				delete(pg.pending, key)
+			}
+		}
+	}
+}
+
+// newPeerClient returns a copy of the client with its own state machine.
+// sm.Client registers its CEA & DWA handlers with its state machine on every
+// dial, so connections to several peers can't share one without mixing up
+// handshakes and watchdog answers. Messages other than the base protocol ones
+// are passed on to the client's state machine
+func newPeerClient(client *sm.Client) *sm.Client {
+	settings := *client.Handler.Settings()
+	mux := sm.New(&settings)
+	mux.HandleFunc("ALL", client.Handler.ServeDIAM)
+	go logErrors(mux.ErrorReports())
+
+	peerClient := *client
+	peerClient.Handler = mux
+	return &peerClient
+}
+
+// serverConfigFor returns the server config to use for a request to the
+// realm. Requests for realms which the peer relays (such as through a DRA)
+// keep their realm and are sent without Destination-Host
+func (p *peer) serverConfigFor(realm string) *DiameterServerConfig {
+	cfg := p.cfg.DiameterServerConfig
+	if len(realm) == 0 || realm == cfg.DestRealm {
+		return &cfg
+	}
+	for _, r := range p.cfg.Realms {
+		if r == realm || r == AnyRealm {
+			cfg.DestRealm = realm
+			cfg.DisableDestHost = true
+			break
+		}
+	}
+	return &cfg
+}
+
+// getDestinationRealm returns the Destination-Realm of the message or an
+// empty string if the message doesn't have one. Only top level AVPs are
+// considered, grouped AVPs may carry realms of other nodes
+func getDestinationRealm(message *diam.Message) string {
+	for _, a := range message.AVP {
+		if a.Code == avp.DestinationRealm && a.VendorID == 0 {
+			if realm, ok := a.Data.(datatype.DiameterIdentity); ok {
+				return string(realm)
+			}
+		}
+	}
+	return ""
+}
+
+// removeDestinationHost removes the Destination-Host of the previous peer from
+// a message which is about to be re-sent to another peer
+func removeDestinationHost(message *diam.Message) {
+	avps := message.AVP[:0]
+	for _, a := range message.AVP {
+		if a.Code == avp.DestinationHost && a.VendorID == 0 {
+			message.Header.MessageLength -= uint32(a.Len())
+			continue
+		}
+		avps = append(avps, a)
+	}
+	message.AVP = avps
+}
@@ -0,0 +1,291 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPeerRequest struct {
+	peer    string
+	conn    diam.Conn
+	message *diam.Message
+}
+
+// testPeer is a diameter server which reports received CCRs on its requests
+// channel
+type testPeer struct {
+	name     string
+	cfg      *DiameterServerConfig
+	requests chan testPeerRequest
+
+	mutex    sync.Mutex
+	listener net.Listener
+	conns    []net.Conn
+}
+
+// testPeerListener tracks accepted connections so that the peer can drop them
+type testPeerListener struct {
+	net.Listener
+	peer *testPeer
+}
+
+func (l *testPeerListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.peer.mutex.Lock()
+		l.peer.conns = append(l.peer.conns, conn)
+		l.peer.mutex.Unlock()
+	}
+	return conn, err
+}
+
+// start starts serving on the peer's address, or on a new port if the peer
+// has no address yet
+func (tp *testPeer) start(t *testing.T) {
+	mux := sm.New(&sm.Settings{
+		OriginHost:  datatype.DiameterIdentity(tp.name + ".test.com"),
+		OriginRealm: datatype.DiameterIdentity("test.com"),
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: datatype.UTF8String(tp.name),
+	})
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(conn diam.Conn, m *diam.Message) {
+			tp.requests <- testPeerRequest{peer: tp.name, conn: conn, message: m}
+		}))
+	l, err := diam.MultistreamListen("tcp", tp.cfg.Addr)
+	assert.NoError(t, err)
+	tp.mutex.Lock()
+	tp.listener = l
+	tp.mutex.Unlock()
+	tp.cfg.Addr = l.Addr().String()
+	go (&diam.Server{Network: "tcp", Handler: mux}).Serve(&testPeerListener{Listener: l, peer: tp})
+}
+
+// stop closes the listener and all connections of the peer
+func (tp *testPeer) stop() {
+	tp.mutex.Lock()
+	defer tp.mutex.Unlock()
+	tp.listener.Close()
+	for _, conn := range tp.conns {
+		conn.Close()
+	}
+	tp.conns = nil
+}
+
+func startTestPeer(t *testing.T, name string, requests chan testPeerRequest) *testPeer {
+	tp := &testPeer{
+		name: name,
+		cfg: &DiameterServerConfig{
+			DiameterServerConnConfig: DiameterServerConnConfig{Addr: "127.0.0.1:0", Protocol: "tcp"},
+			DestHost:                 name + ".test.com",
+			DestRealm:                "test.com",
+		},
+		requests: requests,
+	}
+	tp.start(t)
+	return tp
+}
+
+func newTestPeerGroupClient() *sm.Client {
+	mux := sm.New(&sm.Settings{
+		OriginHost:  datatype.DiameterIdentity("peers.magma.com"),
+		OriginRealm: datatype.DiameterIdentity("magma.com"),
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: datatype.UTF8String("peer group"),
+	})
+	return &sm.Client{
+		Dict:               dict.Default,
+		Handler:            mux,
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Second,
+		EnableWatchdog:     true,
+		WatchdogInterval:   time.Millisecond * 50,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+		},
+	}
+}
+
+func newTestPeerRequest(realm string) *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, nil)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity("peers.magma.com"))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+	if len(realm) > 0 {
+		m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(realm))
+	}
+	return m
+}
+
+func receiveTestPeerRequest(t *testing.T, requests chan testPeerRequest) testPeerRequest {
+	t.Helper()
+	select {
+	case req := <-requests:
+		return req
+	case <-time.After(time.Second * 2):
+		t.Fatal("Timed out waiting for request")
+	}
+	return testPeerRequest{}
+}
+
+func waitForPeerStatus(t *testing.T, pg *PeerGroup, peer int, up bool) {
+	assert.Eventually(t, func() bool {
+		return pg.Status()[peer].Up == up
+	}, time.Second, time.Millisecond*10)
+}
+
+func TestPeerGroupFailoverAndLoadBalancing(t *testing.T) {
+	requests := make(chan testPeerRequest, 16)
+	primary := startTestPeer(t, "primary", requests)
+	secondary := startTestPeer(t, "secondary", requests)
+	tertiary := startTestPeer(t, "tertiary", requests)
+	serverCfg := *primary.cfg
+	serverCfg.Peers = []*DiameterPeerConfig{
+		{DiameterServerConfig: *secondary.cfg, Priority: 1, Weight: 2},
+		{DiameterServerConfig: *tertiary.cfg, Priority: 1, Weight: 1},
+	}
+	pg, err := NewPeerGroup(newTestPeerGroupClient(), NewConnectionManager(), NewRequestTracker(), &serverCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, pg.Connect())
+
+	// all requests go to the primary while it's up
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, pg.SendRequest(newTestPeerRequest(""), i, 0))
+		req := receiveTestPeerRequest(t, requests)
+		assert.Equal(t, "primary", req.peer)
+		host, err := req.message.FindAVP(avp.DestinationHost, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, datatype.DiameterIdentity("primary.test.com"), host.Data)
+	}
+
+	// once the primary is gone, requests fail over to the secondaries which
+	// share the load 2:1. go-diameter notices closed TCP connections only
+	// after it read a message following the handshake, let a DWA arrive first
+	time.Sleep(time.Millisecond * 100)
+	primary.stop()
+	waitForPeerStatus(t, pg, 0, false)
+	counts := map[string]int{}
+	for i := 0; i < 6; i++ {
+		assert.NoError(t, pg.SendRequest(newTestPeerRequest(""), i, 0))
+		counts[receiveTestPeerRequest(t, requests).peer]++
+	}
+	assert.Equal(t, map[string]int{"secondary": 4, "tertiary": 2}, counts)
+
+	status := pg.Status()
+	assert.Len(t, status, 3)
+	assert.False(t, status[0].Up)
+	assert.Equal(t, uint32(1), status[0].Failures)
+	assert.True(t, status[1].Up)
+	assert.True(t, status[2].Up)
+
+	// while all peers are down, they are still tried rather than failing
+	// requests until the reconnect interval passes
+	secondary.stop()
+	tertiary.stop()
+	waitForPeerStatus(t, pg, 1, false)
+	waitForPeerStatus(t, pg, 2, false)
+	assert.Error(t, pg.SendRequest(newTestPeerRequest(""), 0, 0))
+	primary.start(t)
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest(""), 0, 0))
+	assert.Equal(t, "primary", receiveTestPeerRequest(t, requests).peer)
+	assert.True(t, pg.Status()[0].Up)
+}
+
+func TestPeerGroupRealmRouting(t *testing.T) {
+	requests := make(chan testPeerRequest, 16)
+	serverCfg := startTestPeer(t, "primary", requests).cfg
+	dra := startTestPeer(t, "dra", requests).cfg
+	dra.DestRealm = "dra.com"
+	serverCfg.Peers = []*DiameterPeerConfig{
+		{DiameterServerConfig: *dra, Priority: 1, Realms: []string{"roaming.com"}},
+	}
+	pg, err := NewPeerGroup(newTestPeerGroupClient(), NewConnectionManager(), NewRequestTracker(), serverCfg)
+	assert.NoError(t, err)
+
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest("test.com"), 1, 0))
+	assert.Equal(t, "primary", receiveTestPeerRequest(t, requests).peer)
+
+	// relayed realms keep their realm and are sent without Destination-Host
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest("roaming.com"), 2, 0))
+	req := receiveTestPeerRequest(t, requests)
+	assert.Equal(t, "dra", req.peer)
+	realm, err := req.message.FindAVP(avp.DestinationRealm, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("roaming.com"), realm.Data)
+	_, err = req.message.FindAVP(avp.DestinationHost, 0)
+	assert.Error(t, err)
+
+	// no peer serves the realm and there is no default route
+	assert.Error(t, pg.SendRequest(newTestPeerRequest("unknown.com"), 3, 0))
+
+	serverCfg.Peers[0].Realms = append(serverCfg.Peers[0].Realms, AnyRealm)
+	pg, err = NewPeerGroup(newTestPeerGroupClient(), NewConnectionManager(), NewRequestTracker(), serverCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest("unknown.com"), 3, 0))
+	req = receiveTestPeerRequest(t, requests)
+	assert.Equal(t, "dra", req.peer)
+	realm, err = req.message.FindAVP(avp.DestinationRealm, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("unknown.com"), realm.Data)
+}
+
+func TestPeerGroupResendOnPeerLoss(t *testing.T) {
+	requests := make(chan testPeerRequest, 16)
+	serverCfg := startTestPeer(t, "primary", requests).cfg
+	secondary := startTestPeer(t, "secondary", requests).cfg
+	serverCfg.Peers = []*DiameterPeerConfig{{DiameterServerConfig: *secondary, Priority: 1}}
+	tracker := NewRequestTracker()
+	pg, err := NewPeerGroup(newTestPeerGroupClient(), NewConnectionManager(), tracker, serverCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, pg.Connect())
+
+	// an answered request isn't re-sent
+	tracker.RegisterRequest("answered", make(chan interface{}))
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest(""), "answered", 0))
+	assert.Equal(t, "primary", receiveTestPeerRequest(t, requests).peer)
+	tracker.DeregisterRequest("answered")
+
+	tracker.RegisterRequest("pending", make(chan interface{}))
+	assert.NoError(t, pg.SendRequest(newTestPeerRequest(""), "pending", 0))
+	req := receiveTestPeerRequest(t, requests)
+	assert.Equal(t, "primary", req.peer)
+	assert.Zero(t, req.message.Header.CommandFlags&diam.RetransmittedFlag)
+
+	// the primary drops the connection without answering, the pending
+	// request is re-sent to the secondary with the T flag set. Let a few
+	// watchdog exchanges pass first, the peers must not be affected by
+	// each other's DWAs
+	time.Sleep(time.Millisecond * 200)
+	assert.True(t, pg.Status()[0].Up)
+	req.conn.Close()
+	req = receiveTestPeerRequest(t, requests)
+	assert.Equal(t, "secondary", req.peer)
+	assert.NotZero(t, req.message.Header.CommandFlags&diam.RetransmittedFlag)
+	host, err := req.message.FindAVP(avp.DestinationHost, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("secondary.test.com"), host.Data)
+	assert.False(t, pg.Status()[0].Up)
+
+	select {
+	case req = <-requests:
+		t.Fatalf("Unexpected request to %s", req.peer)
+	case <-time.After(time.Millisecond * 100):
+	}
+}
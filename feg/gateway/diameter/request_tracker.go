@@ -45,3 +45,11 @@ func (rt *RequestTracker) DeregisterRequest(key interface{}) chan interface{} {
 	delete(rt.requestMap, key)
 	return channel
 }
+
+// IsTracked returns true if a request with the key is registered
+func (rt *RequestTracker) IsTracked(key interface{}) bool {
+	rt.mapMutex.Lock()
+	defer rt.mapMutex.Unlock()
+	_, ok := rt.requestMap[key]
+	return ok
+}
@@ -0,0 +1,526 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/feg/cloud/go/protos/mconfig"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/ishidawataru/sctp"
+)
+
+const (
+	// SecurityTLS starts TLS right after the transport connection is
+	// established (RFC 6733 2.1). Over SCTP the TLS session runs on the
+	// association's ordered stream 0 (RFC 3436)
+	SecurityTLS = "tls"
+	// SecurityInband negotiates TLS in the CER/CEA exchange by means of the
+	// Inband-Security-Id AVP and starts it right after the CEA (RFC 6733 6.10)
+	SecurityInband = "inband"
+
+	// securityDTLS is rejected: DTLS/SCTP (RFC 6083) needs a DTLS stack and
+	// SCTP-AUTH (RFC 4895), neither of which the gateway's dependencies provide
+	securityDTLS = "dtls"
+
+	// Inband-Security-Id values, RFC 6733 6.10
+	inbandSecurityNone = 0
+	inbandSecurityTLS  = 1
+
+	tlsHandshakeTimeout = 10 * time.Second
+)
+
+var (
+	// ErrNoCommonSecurity is returned when the peer did not agree to TLS in the
+	// CER/CEA exchange
+	ErrNoCommonSecurity = errors.New("Peer did not agree to TLS Inband-Security-Id")
+
+	errInbandConnClosed = errors.New("Inband security connection is closed")
+)
+
+// DiameterTLSConfig holds TLS settings of a diameter connection, it's a part
+// of DiameterServerConnConfig & must remain comparable
+type DiameterTLSConfig struct {
+	Security   string // "" (none), SecurityTLS or SecurityInband
+	CAFile     string // PEM CA bundle to verify the peer with, system CAs if empty
+	CertFile   string // PEM certificate presented to the peer
+	KeyFile    string // PEM private key of CertFile
+	ServerName string // name to verify the server certificate against, host of Addr if empty
+	MinVersion string // minimum TLS version: 1.0, 1.1, 1.2 (default) or 1.3
+}
+
+// Enabled returns true if the connection is secured by TLS
+func (cfg *DiameterTLSConfig) Enabled() bool {
+	return cfg != nil && len(cfg.Security) > 0
+}
+
+func (cfg *DiameterTLSConfig) Validate() error {
+	if !cfg.Enabled() {
+		return nil
+	}
+	if cfg.Security == securityDTLS {
+		return fmt.Errorf("DTLS/SCTP is not supported, use TLS over SCTP (%s) instead", SecurityTLS)
+	}
+	if cfg.Security != SecurityTLS && cfg.Security != SecurityInband {
+		return fmt.Errorf("Invalid TLS security mode: %s", cfg.Security)
+	}
+	if (len(cfg.CertFile) == 0) != (len(cfg.KeyFile) == 0) {
+		return fmt.Errorf("TLS certificate & key files must be set together")
+	}
+	_, err := parseTLSVersion(cfg.MinVersion)
+	return err
+}
+
+// TLSConfigFromMconfig converts managed TLS config into a DiameterTLSConfig
+func TLSConfigFromMconfig(cfg *mconfig.DiamTLSConfig) DiameterTLSConfig {
+	return DiameterTLSConfig{
+		Security:   cfg.GetSecurity(),
+		CAFile:     cfg.GetCaFile(),
+		CertFile:   cfg.GetCertFile(),
+		KeyFile:    cfg.GetKeyFile(),
+		ServerName: cfg.GetServerName(),
+		MinVersion: cfg.GetMinVersion(),
+	}
+}
+
+// NewSecureListener wraps the listener to secure accepted connections as set in
+// cfg. The listener is returned as is if cfg does not enable TLS
+func NewSecureListener(l net.Listener, cfg *DiameterTLSConfig) (net.Listener, error) {
+	if !cfg.Enabled() {
+		return l, nil
+	}
+	tlsConfig, err := cfg.serverTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Security == SecurityInband {
+		return &inbandListener{Listener: l, config: tlsConfig}, nil
+	}
+	return tls.NewListener(l, tlsConfig), nil
+}
+
+// clientTLSConfig returns TLS config for connecting to the server at addr
+func (cfg *DiameterTLSConfig) clientTLSConfig(addr string) (*tls.Config, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.RootCAs = tlsConfig.ClientCAs
+	tlsConfig.ClientCAs = nil
+	tlsConfig.ServerName = cfg.ServerName
+	if len(tlsConfig.ServerName) == 0 {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid Diameter Address '%s': %v", addr, err)
+		}
+		tlsConfig.ServerName = host
+	}
+	return tlsConfig, nil
+}
+
+// serverTLSConfig returns TLS config for accepting connections, clients are
+// required to present a certificate if CAFile is set
+func (cfg *DiameterTLSConfig) serverTLSConfig() (*tls.Config, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if len(cfg.CertFile) == 0 {
+		return nil, fmt.Errorf("TLS certificate & key files are required for diameter servers")
+	}
+	tlsConfig, err := cfg.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig.ClientCAs != nil {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// tlsConfig returns TLS config with the certificate, CA pool (as ClientCAs) &
+// minimum version of cfg
+func (cfg *DiameterTLSConfig) tlsConfig() (*tls.Config, error) {
+	minVersion, err := parseTLSVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{MinVersion: minVersion}
+	if len(cfg.CertFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load TLS certificate '%s': %v", cfg.CertFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if len(cfg.CAFile) > 0 {
+		pem, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read TLS CA file '%s': %v", cfg.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No certificates found in TLS CA file '%s'", cfg.CAFile)
+		}
+		tlsConfig.ClientCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+func parseTLSVersion(version string) (uint16, error) {
+	switch version {
+	case "1.0":
+		return tls.VersionTLS10, nil
+	case "1.1":
+		return tls.VersionTLS11, nil
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	return 0, fmt.Errorf("Invalid TLS version: %s", version)
+}
+
+// dialSecure dials the server, secures the connection as set in its TLS config
+// and performs the diameter handshake
+func dialSecure(client *sm.Client, server *DiameterServerConnConfig, localAddr net.Addr) (diam.Conn, error) {
+	tlsConfig, err := server.TLS.clientTLSConfig(server.Addr)
+	if err != nil {
+		return nil, err
+	}
+	rw, err := dialTransport(server.Protocol, server.Addr, localAddr)
+	if err != nil {
+		return nil, err
+	}
+	if server.TLS.Security == SecurityInband {
+		inbandConn := newInbandConn(rw, tlsConfig, client.Dict, false)
+		conn, err := client.NewConn(inbandConn, server.Addr)
+		if err != nil {
+			rw.Close()
+			if negotiationErr := inbandConn.negotiationError(); negotiationErr != nil && negotiationErr != errInbandConnClosed {
+				err = negotiationErr
+			}
+			return nil, err
+		}
+		return conn, nil
+	}
+	tlsConn := tls.Client(rw, tlsConfig)
+	if err = handshakeTLS(tlsConn); err != nil {
+		rw.Close()
+		return nil, err
+	}
+	return client.NewConn(tlsConn, server.Addr)
+}
+
+// dialTransport establishes a transport (TCP or SCTP) connection to addr
+func dialTransport(network, addr string, localAddr net.Addr) (net.Conn, error) {
+	if strings.HasPrefix(network, "sctp") {
+		remoteAddr, err := sctp.ResolveSCTPAddr(network, addr)
+		if err != nil {
+			return nil, err
+		}
+		sctpLocalAddr, _ := localAddr.(*sctp.SCTPAddr)
+		return sctp.DialSCTP(network, sctpLocalAddr, remoteAddr)
+	}
+	if len(network) == 0 {
+		network = "tcp"
+	}
+	dialer := &net.Dialer{Timeout: tlsHandshakeTimeout, LocalAddr: localAddr}
+	return dialer.Dial(network, addr)
+}
+
+// handshakeTLS runs the TLS handshake, bounded by tlsHandshakeTimeout
+func handshakeTLS(conn *tls.Conn) error {
+	conn.SetDeadline(time.Now().Add(tlsHandshakeTimeout))
+	err := conn.Handshake()
+	conn.SetDeadline(time.Time{})
+	return err
+}
+
+// inbandListener accepts connections negotiating TLS in the CER/CEA exchange
+type inbandListener struct {
+	net.Listener
+	config *tls.Config
+}
+
+func (l *inbandListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return newInbandConn(conn, l.config, nil, true), nil
+}
+
+// inbandConn implements RFC 6733 inband security negotiation under
+// go-diameter's state machine, which only supports NO_INBAND_SECURITY.
+// The client offers TLS in the CER's Inband-Security-Id and starts the TLS
+// handshake after receiving a successful CEA selecting TLS. The server hides
+// the offered Inband-Security-Id from the state machine, selects TLS in its CEA
+// & waits for the TLS handshake; CERs not offering TLS are answered with
+// DIAMETER_NO_COMMON_SECURITY. All traffic following the CER/CEA exchange is
+// carried over TLS
+type inbandConn struct {
+	net.Conn // transport connection
+	config   *tls.Config
+	dict     *dict.Parser
+	isServer bool
+
+	mutex       sync.Mutex
+	active      net.Conn      // transport connection until negotiated, then TLS connection
+	pending     *bytes.Reader // rewritten message to be read before reading from active
+	offered     bool          // server: peer offered TLS in its CER
+	awaitingCEA bool          // server: CER was read, reads wait for negotiation
+	negotiated  chan struct{}
+	err         error
+}
+
+func newInbandConn(conn net.Conn, config *tls.Config, dictionary *dict.Parser, isServer bool) *inbandConn {
+	if dictionary == nil {
+		dictionary = dict.Default
+	}
+	return &inbandConn{
+		Conn:       conn,
+		config:     config,
+		dict:       dictionary,
+		isServer:   isServer,
+		active:     conn,
+		pending:    bytes.NewReader(nil),
+		negotiated: make(chan struct{}),
+	}
+}
+
+func (c *inbandConn) Read(b []byte) (int, error) {
+	c.mutex.Lock()
+	if c.pending.Len() > 0 {
+		defer c.mutex.Unlock()
+		return c.pending.Read(b)
+	}
+	awaitingCEA := c.awaitingCEA
+	c.mutex.Unlock()
+
+	if awaitingCEA {
+		<-c.negotiated
+	}
+	if active, err, ok := c.negotiatedConn(); ok {
+		if err != nil {
+			return 0, err
+		}
+		return active.Read(b)
+	}
+	// Still negotiating, read the next message whole to inspect it
+	msg, err := diam.ReadMessage(c.Conn, c.dict)
+	if err != nil {
+		return 0, err
+	}
+	if isCapabilitiesExchange(msg) {
+		if c.isServer && msg.Header.CommandFlags&diam.RequestFlag != 0 {
+			c.readCER(msg)
+		} else if !c.isServer && msg.Header.CommandFlags&diam.RequestFlag == 0 {
+			if err = c.readCEA(msg); err != nil {
+				return 0, err
+			}
+		}
+	}
+	serialized, err := msg.Serialize()
+	if err != nil {
+		return 0, err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.pending = bytes.NewReader(serialized)
+	return c.pending.Read(b)
+}
+
+func (c *inbandConn) Write(b []byte) (int, error) {
+	if active, err, ok := c.negotiatedConn(); ok {
+		if err != nil {
+			return 0, err
+		}
+		return active.Write(b)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.isNegotiated() {
+		// negotiation completed while waiting for the lock
+		if c.err != nil {
+			return 0, c.err
+		}
+		return c.active.Write(b)
+	}
+	msg, err := diam.ReadMessage(bytes.NewReader(b), c.dict)
+	if err != nil || !isCapabilitiesExchange(msg) {
+		return c.Conn.Write(b)
+	}
+	if c.isServer && msg.Header.CommandFlags&diam.RequestFlag == 0 {
+		return len(b), c.writeCEA(msg)
+	} else if !c.isServer && msg.Header.CommandFlags&diam.RequestFlag != 0 {
+		setInbandSecurity(msg, inbandSecurityTLS)
+		if _, err = msg.WriteTo(c.Conn); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return c.Conn.Write(b)
+}
+
+func (c *inbandConn) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.setNegotiatedLocked(c.active, errInbandConnClosed)
+	return c.active.Close()
+}
+
+// negotiatedConn returns the connection to use & the negotiation error, ok is
+// false while the negotiation is in progress
+func (c *inbandConn) negotiatedConn() (active net.Conn, err error, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.isNegotiated() {
+		return nil, nil, false
+	}
+	return c.active, c.err, true
+}
+
+func (c *inbandConn) isNegotiated() bool {
+	select {
+	case <-c.negotiated:
+		return true
+	default:
+		return false
+	}
+}
+
+// negotiationError returns the error which failed the negotiation, if any
+func (c *inbandConn) negotiationError() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.err
+}
+
+// readCEA verifies that the server selected TLS in a successful CEA & starts TLS
+func (c *inbandConn) readCEA(cea *diam.Message) error {
+	resultCode, err := cea.FindAVP(avp.ResultCode, 0)
+	if err != nil || resultCode.Data != datatype.Unsigned32(diam.Success) {
+		// failed CEAs are handled by the state machine
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !hasInbandSecurity(cea, inbandSecurityTLS) {
+		c.setNegotiatedLocked(c.Conn, ErrNoCommonSecurity)
+		return ErrNoCommonSecurity
+	}
+	tlsConn := tls.Client(c.Conn, c.config)
+	if err = handshakeTLS(tlsConn); err != nil {
+		err = fmt.Errorf("Inband TLS handshake failure: %v", err)
+		c.setNegotiatedLocked(c.Conn, err)
+		return err
+	}
+	c.setNegotiatedLocked(tlsConn, nil)
+	return nil
+}
+
+// readCER records whether the client offered TLS & hides its offer from the
+// state machine
+func (c *inbandConn) readCER(cer *diam.Message) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.offered = hasInbandSecurity(cer, inbandSecurityTLS)
+	setInbandSecurity(cer, inbandSecurityNone)
+	c.awaitingCEA = true
+}
+
+// writeCEA sends the server's CEA selecting TLS & starts TLS or rejects the
+// client if it did not offer TLS. Must be called with c.mutex held
+func (c *inbandConn) writeCEA(cea *diam.Message) error {
+	resultCode, err := cea.FindAVP(avp.ResultCode, 0)
+	if err != nil || resultCode.Data != datatype.Unsigned32(diam.Success) {
+		_, err = cea.WriteTo(c.Conn)
+		c.setNegotiatedLocked(c.Conn, err)
+		return err
+	}
+	if !c.offered {
+		resultCode.Data = datatype.Unsigned32(diam.NoCommonSecurity)
+		cea.WriteTo(c.Conn)
+		c.Conn.Close()
+		c.setNegotiatedLocked(c.Conn, ErrNoCommonSecurity)
+		return ErrNoCommonSecurity
+	}
+	setInbandSecurity(cea, inbandSecurityTLS)
+	if _, err = cea.WriteTo(c.Conn); err != nil {
+		c.setNegotiatedLocked(c.Conn, err)
+		return err
+	}
+	tlsConn := tls.Server(c.Conn, c.config)
+	if err = handshakeTLS(tlsConn); err != nil {
+		c.Conn.Close()
+		c.setNegotiatedLocked(c.Conn, err)
+		return err
+	}
+	c.setNegotiatedLocked(tlsConn, nil)
+	return nil
+}
+
+// setNegotiatedLocked completes the negotiation, must be called with c.mutex held
+func (c *inbandConn) setNegotiatedLocked(active net.Conn, err error) {
+	if c.isNegotiated() {
+		return
+	}
+	c.active, c.err = active, err
+	close(c.negotiated)
+}
+
+func isCapabilitiesExchange(msg *diam.Message) bool {
+	return msg.Header.CommandCode == diam.CapabilitiesExchange && msg.Header.ApplicationID == 0
+}
+
+// hasInbandSecurity returns true if one of message's Inband-Security-Id AVPs
+// is set to the given value
+func hasInbandSecurity(msg *diam.Message, value uint32) bool {
+	ids, err := msg.FindAVPs(avp.InbandSecurityID, 0)
+	if err != nil {
+		return false
+	}
+	for _, id := range ids {
+		if id.Data == datatype.Unsigned32(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// setInbandSecurity replaces message's Inband-Security-Id AVPs with a single
+// one set to value
+func setInbandSecurity(msg *diam.Message, value uint32) {
+	avps := msg.AVP[:0]
+	for _, a := range msg.AVP {
+		if a.Code != avp.InbandSecurityID {
+			avps = append(avps, a)
+		}
+	}
+	msg.AVP = avps
+	msg.NewAVP(avp.InbandSecurityID, avp.Mbit, 0, datatype.Unsigned32(value))
+	msg.Header.MessageLength = uint32(msg.Len())
+}
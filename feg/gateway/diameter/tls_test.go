@@ -0,0 +1,237 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/feg/gateway/diameter/test"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smparser"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTLSSessionID = "tls-session-id;12345"
+
+// recordingListener records all transport bytes received by the server
+type recordingListener struct {
+	net.Listener
+	mutex    sync.Mutex
+	received bytes.Buffer
+}
+
+type recordingConn struct {
+	net.Conn
+	listener *recordingListener
+}
+
+func (l *recordingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &recordingConn{Conn: conn, listener: l}, nil
+}
+
+func (l *recordingListener) receivedBytes() []byte {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]byte{}, l.received.Bytes()...)
+}
+
+func (c *recordingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.listener.mutex.Lock()
+	c.listener.received.Write(b[:n])
+	c.listener.mutex.Unlock()
+	return n, err
+}
+
+// startTestTLSServer starts a diameter server securing its connections as set
+// in tlsCfg & reporting received CCRs on the returned channel
+func startTestTLSServer(t *testing.T, tlsCfg DiameterTLSConfig) (*DiameterServerConfig, *recordingListener, chan *diam.Message) {
+	l, err := diam.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	return startTestTLSServerOnListener(t, "tcp", l, tlsCfg)
+}
+
+// startTestTLSServerOnListener starts a diameter server on the given listener
+// of network, see startTestTLSServer
+func startTestTLSServerOnListener(
+	t *testing.T, network string, l net.Listener, tlsCfg DiameterTLSConfig) (*DiameterServerConfig, *recordingListener, chan *diam.Message) {
+
+	requests := make(chan *diam.Message, 10)
+	mux := sm.New(&sm.Settings{
+		OriginHost:  datatype.DiameterIdentity("tls.test.com"),
+		OriginRealm: datatype.DiameterIdentity("test.com"),
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: datatype.UTF8String("tls"),
+	})
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(conn diam.Conn, m *diam.Message) { requests <- m }))
+	recorder := &recordingListener{Listener: l}
+	secureListener, err := NewSecureListener(recorder, &tlsCfg)
+	assert.NoError(t, err)
+	go (&diam.Server{Network: network, Handler: mux}).Serve(secureListener)
+
+	return &DiameterServerConfig{
+		DiameterServerConnConfig: DiameterServerConnConfig{Addr: l.Addr().String(), Protocol: network},
+		DestHost:                 "tls.test.com",
+		DestRealm:                "test.com",
+	}, recorder, requests
+}
+
+func generateTestTLSFiles(t *testing.T) (*test.TLSFiles, func()) {
+	dir, err := ioutil.TempDir("", "diameter_tls")
+	assert.NoError(t, err)
+	files, err := test.GenerateTLSFiles(dir)
+	assert.NoError(t, err)
+	return files, func() { os.RemoveAll(dir) }
+}
+
+func TestDiameterTLS(t *testing.T) {
+	files, cleanup := generateTestTLSFiles(t)
+	defer cleanup()
+
+	for _, security := range []string{SecurityTLS, SecurityInband} {
+		tlsCfg := DiameterTLSConfig{
+			Security: security,
+			CAFile:   files.CAFile,
+			CertFile: files.CertFile,
+			KeyFile:  files.KeyFile,
+		}
+		serverCfg, recorder, requests := startTestTLSServer(t, tlsCfg)
+		testSecureRequest(t, serverCfg, tlsCfg, recorder, requests)
+	}
+}
+
+func TestDiameterTLSOverSCTP(t *testing.T) {
+	files, cleanup := generateTestTLSFiles(t)
+	defer cleanup()
+
+	for _, security := range []string{SecurityTLS, SecurityInband} {
+		l, err := diam.Listen("sctp", fmt.Sprintf("127.0.0.1:%d", 29000+rand.Intn(1900)))
+		if err != nil {
+			t.Skipf("SCTP is not available: %v", err)
+		}
+		tlsCfg := DiameterTLSConfig{
+			Security: security,
+			CAFile:   files.CAFile,
+			CertFile: files.CertFile,
+			KeyFile:  files.KeyFile,
+		}
+		serverCfg, recorder, requests := startTestTLSServerOnListener(t, "sctp", l, tlsCfg)
+		testSecureRequest(t, serverCfg, tlsCfg, recorder, requests)
+		l.Close()
+	}
+}
+
+// testSecureRequest sends a CCR to the test server over a connection secured
+// by tlsCfg & verifies that the server received it encrypted
+func testSecureRequest(
+	t *testing.T, serverCfg *DiameterServerConfig, tlsCfg DiameterTLSConfig, recorder *recordingListener, requests chan *diam.Message) {
+
+	security := serverCfg.Protocol + "/" + tlsCfg.Security
+	serverCfg.TLS = tlsCfg
+	assert.NoError(t, serverCfg.Validate())
+
+	conn, err := NewConnectionManager().GetConnection(newTestPeerGroupClient(), serverCfg)
+	assert.NoError(t, err)
+	req := newTestPeerRequest("")
+	req.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(testTLSSessionID))
+	assert.NoError(t, conn.SendRequest(req, 0), security)
+
+	select {
+	case m := <-requests:
+		sid, err := m.FindAVP(avp.SessionID, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, datatype.UTF8String(testTLSSessionID), sid.Data)
+	case <-time.After(time.Second * 3):
+		assert.Fail(t, "CCR not received", security)
+	}
+	// The request must not be readable on the wire
+	received := recorder.receivedBytes()
+	assert.NotEmpty(t, received)
+	assert.False(t, bytes.Contains(received, []byte(testTLSSessionID)), security)
+	// Inband security negotiates TLS in plain text CER/CEA
+	assert.Equal(t, tlsCfg.Security == SecurityInband, bytes.Contains(received, []byte("peers.magma.com")), security)
+	conn.cleanupConnection()
+}
+
+func TestDiameterInbandTLSNoCommonSecurity(t *testing.T) {
+	files, cleanup := generateTestTLSFiles(t)
+	defer cleanup()
+	inbandCfg := DiameterTLSConfig{Security: SecurityInband, CAFile: files.CAFile, CertFile: files.CertFile, KeyFile: files.KeyFile}
+
+	// plain text client is rejected by a server requiring inband TLS
+	serverCfg, _, _ := startTestTLSServer(t, inbandCfg)
+	conn := &Connection{client: newTestPeerGroupClient(), server: serverCfg}
+	_, _, err := conn.getDiamConnection()
+	assert.Error(t, err)
+	if resultErr, ok := err.(*smparser.ErrFailedResultCode); assert.True(t, ok, "%v", err) {
+		assert.Equal(t, uint32(diam.NoCommonSecurity), resultErr.ResultCode)
+	}
+
+	// server without TLS support rejects the client offering inband TLS
+	serverCfg, _, _ = startTestTLSServer(t, DiameterTLSConfig{})
+	serverCfg.TLS = inbandCfg
+	conn = &Connection{client: newTestPeerGroupClient(), server: serverCfg}
+	_, _, err = conn.getDiamConnection()
+	assert.Error(t, err)
+	if resultErr, ok := err.(*smparser.ErrFailedResultCode); assert.True(t, ok, "%v", err) {
+		assert.Equal(t, uint32(diam.NoCommonSecurity), resultErr.ResultCode)
+	}
+}
+
+func TestDiameterTLSUnknownCA(t *testing.T) {
+	files, cleanup := generateTestTLSFiles(t)
+	defer cleanup()
+	otherFiles, otherCleanup := generateTestTLSFiles(t)
+	defer otherCleanup()
+
+	for _, security := range []string{SecurityTLS, SecurityInband} {
+		serverCfg, _, _ := startTestTLSServer(
+			t, DiameterTLSConfig{Security: security, CertFile: files.CertFile, KeyFile: files.KeyFile})
+		serverCfg.TLS = DiameterTLSConfig{Security: security, CAFile: otherFiles.CAFile}
+		conn := &Connection{client: newTestPeerGroupClient(), server: serverCfg}
+		_, _, err := conn.getDiamConnection()
+		assert.Error(t, err, security)
+	}
+}
+
+func TestDiameterTLSConfigValidate(t *testing.T) {
+	assert.NoError(t, (&DiameterTLSConfig{}).Validate())
+	assert.NoError(t, (&DiameterTLSConfig{Security: SecurityTLS, MinVersion: "1.3"}).Validate())
+	assert.EqualError(t, (&DiameterTLSConfig{Security: "dtls"}).Validate(),
+		"DTLS/SCTP is not supported, use TLS over SCTP (tls) instead")
+	assert.Error(t, (&DiameterTLSConfig{Security: "ssl"}).Validate())
+	assert.Error(t, (&DiameterTLSConfig{Security: SecurityInband, MinVersion: "1.4"}).Validate())
+	assert.Error(t, (&DiameterTLSConfig{Security: SecurityTLS, CertFile: "cert.pem"}).Validate())
+
+	_, err := NewSecureListener(nil, &DiameterTLSConfig{Security: SecurityTLS})
+	assert.Error(t, err) // servers need a certificate
+
+	cfg, err := (&DiameterTLSConfig{Security: SecurityTLS, MinVersion: "1.1"}).clientTLSConfig("localhost:3868")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", cfg.ServerName)
+	assert.Equal(t, uint16(0x0302), cfg.MinVersion)
+}
@@ -43,6 +43,12 @@ func (client *mockRedisClient) HDel(hash string, field string) error {
 	return nil
 }
 
+func (client *mockRedisClient) HDelExisting(hash string, field string) (bool, error) {
+	_, ok := client.dataMap[field]
+	delete(client.dataMap, field)
+	return ok, nil
+}
+
 type testObject struct {
 	foo string
 }
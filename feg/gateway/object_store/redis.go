@@ -23,6 +23,7 @@ type RedisClient interface {
 	HGet(hash string, field string) (string, error)
 	HGetAll(hash string) (map[string]string, error)
 	HDel(hash string, field string) error
+	HDelExisting(hash string, field string) (bool, error)
 }
 
 // RedisClientImpl is the implementation of the redis client using an actual connection
@@ -64,3 +65,10 @@ func (client *RedisClientImpl) HGetAll(hash string) (map[string]string, error) {
 func (client *RedisClientImpl) HDel(hash string, field string) error {
 	return client.RawClient.HDel(hash, field).Err()
 }
+
+// HDelExisting deletes a value at a hash,field pair and returns true if the
+// field existed, so that only one of concurrent deleters succeeds
+func (client *RedisClientImpl) HDelExisting(hash string, field string) (bool, error) {
+	deleted, err := client.RawClient.HDel(hash, field).Result()
+	return deleted > 0, err
+}
@@ -31,6 +31,7 @@ const (
 	AAA_SERVER    = "AAA_SERVER"
 	EAP           = "EAP"
 	EAP_AKA       = "EAP_AKA"
+	EAP_AKA_PRIME = "EAP_AKA_PRIME"
 	RADIUSD       = "RADIUSD"
 	RADIUS        = "RADIUS"
 	REDIS         = "REDIS"
@@ -77,6 +78,7 @@ func init() {
 	addLocalService(EAP, 9109)
 	addLocalService(AAA_SERVER, 9109)
 	addLocalService(EAP_AKA, 9123)
+	addLocalService(EAP_AKA_PRIME, 9124)
 	addLocalService(SWX_PROXY, 9110)
 	addLocalService(RADIUSD, 9115)
 	addLocalService(HLR_PROXY, 9116)
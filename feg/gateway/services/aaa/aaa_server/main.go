@@ -16,7 +16,9 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/object_store"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/aaa/servicers"
 	"magma/feg/gateway/services/aaa/store"
@@ -31,9 +33,6 @@ const (
 )
 
 func main() {
-	// Create a shared Session Table
-	sessions := store.NewMemorySessionTable()
-
 	// Create the EAP AKA Provider service
 	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.AAA_SERVER)
 	if err != nil {
@@ -45,11 +44,38 @@ func main() {
 		log.Printf("Error getting AAA Server service configs: %s", err)
 		aaaConfigs = nil
 	}
+	// Create a shared Session Table
+	var sessions aaa.SessionTable
+	var redisSessions *store.RedisSessionTable
+	if aaaConfigs.GetPersistSessions() {
+		redisClient, err := object_store.NewRedisClient()
+		if err != nil {
+			log.Printf("Error creating Redis client: %v, sessions will not be persisted", err)
+		} else {
+			redisSessions = store.NewRedisSessionTable(redisClient)
+			sessions = redisSessions
+		}
+	}
+	if sessions == nil {
+		sessions = store.NewMemorySessionTable()
+	}
 	acct, _ := servicers.NewAccountingService(sessions, proto.Clone(aaaConfigs).(*mconfig.AAAConfig))
 	protos.RegisterAccountingServer(srv.GrpcServer, acct)
 	lteprotos.RegisterAbortSessionResponderServer(srv.GrpcServer, acct)
 	fegprotos.RegisterSwxGatewayServiceServer(srv.GrpcServer, acct)
 
+	if redisSessions != nil {
+		restored, err := redisSessions.RestoreSessions(func(s aaa.Session) error {
+			return acct.EndTimedOutSession(s.GetCtx())
+		})
+		if err != nil {
+			log.Printf("Error restoring persisted AAA sessions: %v", err)
+		} else {
+			log.Printf("Restored %d persisted AAA sessions", restored)
+		}
+		redisSessions.ScanTimeouts(store.DefaultTimeoutScanInterval)
+	}
+
 	auth, _ := servicers.NewEapAuthenticator(sessions, aaaConfigs, acct)
 	protos.RegisterAuthenticatorServer(srv.GrpcServer, auth)
 
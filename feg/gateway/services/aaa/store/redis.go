@@ -0,0 +1,581 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"magma/feg/gateway/object_store"
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/metrics"
+	"magma/feg/gateway/services/aaa/protos"
+	orcprotos "magma/orc8r/cloud/go/protos"
+
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+)
+
+// Redis hashes of the persistent session table
+const (
+	SessionsHash   = "aaa_sessions"         // SID -> session context
+	TimeoutsHash   = "aaa_session_timeouts" // SID -> session timeout deadline, Unix time in milliseconds
+	SessionIDsHash = "aaa_session_ids"      // IMSI -> SID
+)
+
+// DefaultTimeoutScanInterval - frequency of the scans for timeouts of sessions created by other servers
+const DefaultTimeoutScanInterval = time.Second * 30
+
+// redisSession - locally cached session of a redisSessionTable, context changes are persisted on Unlock
+type redisSession struct {
+	sid       string
+	owner     *RedisSessionTable
+	ctx       atomic.Value // *protos.Context
+	mu        sync.Mutex   // session lock
+	stateMu   sync.Mutex   // guards persisted
+	persisted *protos.Context
+	removed   int32
+
+	// guarded by owner.rwl
+	timer    *time.Timer
+	timerGen uint64
+	notifier aaa.TimeoutNotifier
+}
+
+// Lock - locks the Session's mutex
+func (s *redisSession) Lock() {
+	if s != nil {
+		s.mu.Lock()
+	}
+}
+
+// Unlock - persists changes of the session's context & unlocks the Session's mutex
+func (s *redisSession) Unlock() {
+	if s != nil {
+		s.persist()
+		s.mu.Unlock()
+	}
+}
+
+// GetCtx returns AAA Session Context
+func (s *redisSession) GetCtx() *protos.Context {
+	if s != nil {
+		pc, _ := s.ctx.Load().(*protos.Context)
+		return pc
+	}
+	return nil
+}
+
+// SetCtx sets AAA Session Context - must be called on a Locked session
+func (s *redisSession) SetCtx(pc *protos.Context) {
+	if s != nil {
+		s.ctx.Store(pc)
+	}
+}
+
+// StopTimeout - stops the session's timeout if possible, returns if the timeout was successfully stopped
+func (s *redisSession) StopTimeout() bool {
+	if s == nil {
+		return false
+	}
+	st := s.owner
+	st.rwl.Lock()
+	stopped := s.stopTimerLocked()
+	st.rwl.Unlock()
+	if err := st.timeouts.Delete(s.sid); err != nil {
+		log.Printf("Failed to delete timeout of session %s: %v", s.sid, err)
+	}
+	return stopped
+}
+
+// persist stores the session's context if it was changed since it was persisted or loaded
+func (s *redisSession) persist() {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	pc := s.GetCtx()
+	if pc == nil || atomic.LoadInt32(&s.removed) != 0 || proto.Equal(pc, s.persisted) {
+		return
+	}
+	if err := s.owner.sessions.Set(s.sid, pc); err != nil {
+		log.Printf("Failed to persist session %s: %v", s.sid, err)
+		return
+	}
+	s.persisted = proto.Clone(pc).(*protos.Context)
+}
+
+// refresh replaces the session's context if it was changed in the store by another server
+func (s *redisSession) refresh(pc *protos.Context) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+	if !proto.Equal(pc, s.persisted) {
+		s.persisted = proto.Clone(pc).(*protos.Context)
+		s.ctx.Store(pc)
+	}
+}
+
+// stopTimerLocked stops & invalidates the session's timer, must be called with owner.rwl locked
+func (s *redisSession) stopTimerLocked() bool {
+	var stopped bool
+	if s.timer != nil {
+		stopped = s.timer.Stop()
+		s.timer = nil
+	}
+	s.timerGen++
+	return stopped
+}
+
+// RedisSessionTable - session table persisting sessions in Redis. Sessions survive AAA server restarts &
+// the store can be shared by an active/standby pair of AAA servers: every server caches the sessions it
+// serves, refreshes them from the store on access, picks up timeouts of sessions created by other servers
+// (see ScanTimeouts) & only the server removing a session from the store notifies about its timeout.
+type RedisSessionTable struct {
+	client   object_store.RedisClient
+	sessions object_store.ObjectMap // SID -> *protos.Context
+	timeouts object_store.ObjectMap // SID -> timeout deadline (int64)
+	sids     object_store.ObjectMap // IMSI -> SID
+
+	rwl      sync.RWMutex
+	sm       map[string]*redisSession // locally cached sessions
+	notifier aaa.TimeoutNotifier      // notifier of restored sessions & sessions created by other servers
+}
+
+// NewRedisSessionTable - returns a new session table persisting its sessions with the given client
+func NewRedisSessionTable(client object_store.RedisClient) *RedisSessionTable {
+	return &RedisSessionTable{
+		client:   client,
+		sessions: object_store.NewRedisMap(client, SessionsHash, serializeContext, deserializeContext),
+		timeouts: object_store.NewRedisMap(client, TimeoutsHash, serializeDeadline, deserializeDeadline),
+		sids:     object_store.NewRedisMap(client, SessionIDsHash, serializeString, deserializeString),
+		sm:       map[string]*redisSession{},
+	}
+}
+
+// RestoreSessions loads all persisted sessions & restarts their timeouts, notifier is called on timeouts
+// of the restored sessions and of sessions created by other servers. Returns the number of restored sessions.
+func (st *RedisSessionTable) RestoreSessions(notifier aaa.TimeoutNotifier) (int, error) {
+	st.rwl.Lock()
+	st.notifier = notifier
+	st.rwl.Unlock()
+
+	all, err := st.sessions.GetAll()
+	if err != nil {
+		return 0, err
+	}
+	for sid, obj := range all {
+		if pc, ok := obj.(*protos.Context); ok {
+			st.cacheSession(sid, pc)
+		}
+	}
+	return len(all), nil
+}
+
+// ScanTimeouts starts periodic scans of the persisted timeouts with the given interval. Sessions created by
+// other servers after RestoreSessions are cached & their timers started, so they time out even if the server
+// which created them is gone. Returns chan to stop the scans: done := st.ScanTimeouts(interval); ... done <- struct{}{}
+func (st *RedisSessionTable) ScanTimeouts(interval time.Duration) chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := st.scanTimeouts(); err != nil {
+					log.Printf("Failed to scan session timeouts: %v", err)
+				}
+			}
+		}
+	}()
+	return done
+}
+
+// AddSession - adds a new session to the table & returns the newly created session pointer.
+// If a session with the same ID already is in the table - returns "Session with SID: XYZ already exist" as well as the
+// existing session.
+func (st *RedisSessionTable) AddSession(
+	pc *protos.Context, tout time.Duration, notifier aaa.TimeoutNotifier, overwrite ...bool) (aaa.Session, error) {
+
+	if st == nil {
+		return nil, fmt.Errorf("Nil SessionTable")
+	}
+	if pc == nil {
+		return nil, fmt.Errorf("Nil Session Context")
+	}
+	sid := strings.TrimSpace(pc.SessionId)
+	if len(sid) == 0 {
+		return nil, fmt.Errorf("Empty Session Id")
+	}
+	imsi := pc.GetImsi()
+	if oldSession := st.getSession(sid); oldSession != nil {
+		if len(overwrite) == 0 || !overwrite[0] {
+			return oldSession, fmt.Errorf("Session with SID: %s already exist", sid)
+		}
+		oldImsi := oldSession.GetCtx().GetImsi()
+		log.Printf("Session with SID: %s already exist, will overwrite. Old IMSI: %s, New IMSI: %s",
+			sid, oldImsi, imsi)
+
+		oldSession.StopTimeout()
+		st.dropLocal(oldSession)
+		if oldImsi != imsi {
+			st.removeSessionID(oldImsi, sid)
+		}
+	}
+	if err := st.sessions.Set(sid, pc); err != nil {
+		return nil, fmt.Errorf("Failed to persist session %s: %v", sid, err)
+	}
+	if len(imsi) > 0 {
+		if err := st.sids.Set(imsi, sid); err != nil {
+			log.Printf("Failed to persist SID of IMSI %s: %v", imsi, err)
+		}
+	}
+	s := st.newSession(sid, pc)
+	st.rwl.Lock()
+	st.sm[sid] = s
+	st.rwl.Unlock()
+	st.setTimeout(s, tout, notifier)
+
+	apn := pc.GetApn()
+	imsi = metrics.DecorateIMSI(imsi)
+	metrics.Sessions.WithLabelValues(apn, imsi, sid).Inc()
+	metrics.SessionStart.WithLabelValues(apn, imsi, sid).Inc()
+
+	return s, nil
+}
+
+// GetSession returns session corresponding to the given sid or nil if not found
+func (st *RedisSessionTable) GetSession(sid string) aaa.Session {
+	if s := st.getSession(sid); s != nil {
+		return s
+	}
+	return nil
+}
+
+// FindSession returns session ID corresponding to the given IMSI (empty string if not found)
+func (st *RedisSessionTable) FindSession(imsi string) (sid string) {
+	if st == nil {
+		return ""
+	}
+	obj, err := st.sids.Get(imsi)
+	if err == nil {
+		sid, _ = obj.(string)
+		return sid
+	}
+	if err != redis.Nil {
+		log.Printf("Failed to find session of IMSI %s: %v, using cached sessions", imsi, err)
+		st.rwl.RLock()
+		defer st.rwl.RUnlock()
+		for cachedSid, s := range st.sm {
+			if s.GetCtx().GetImsi() == imsi {
+				return cachedSid
+			}
+		}
+	}
+	return ""
+}
+
+// RemoveSession - removes the session with the given SID and returns it, returns nil if not found
+func (st *RedisSessionTable) RemoveSession(sid string) aaa.Session {
+	s := st.getSession(sid)
+	if s == nil {
+		return nil
+	}
+	deleted, err := st.client.HDelExisting(SessionsHash, sid)
+	if err != nil {
+		log.Printf("Failed to delete session %s: %v", sid, err)
+	}
+	st.dropLocal(s)
+	if err == nil && !deleted {
+		return nil // removed by another server
+	}
+	if err = st.timeouts.Delete(sid); err != nil {
+		log.Printf("Failed to delete timeout of session %s: %v", sid, err)
+	}
+	apn, imsi := s.GetCtx().GetApn(), s.GetCtx().GetImsi()
+	st.removeSessionID(imsi, sid)
+
+	imsi = metrics.DecorateIMSI(imsi)
+	metrics.Sessions.WithLabelValues(apn, imsi, sid).Dec()
+	metrics.SessionStop.WithLabelValues(apn, imsi, sid).Inc()
+	return s
+}
+
+// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
+func (st *RedisSessionTable) SetTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	if tout <= 0 || len(sid) == 0 {
+		return false
+	}
+	s := st.getSession(sid)
+	if s == nil {
+		return false
+	}
+	return st.setTimeout(s, tout, notifier)
+}
+
+func (st *RedisSessionTable) newSession(sid string, pc *protos.Context) *redisSession {
+	s := &redisSession{sid: sid, owner: st, persisted: proto.Clone(pc).(*protos.Context)}
+	s.ctx.Store(pc)
+	return s
+}
+
+// getSession returns the cached session refreshed from the store or loads it from the store if it's not cached
+func (st *RedisSessionTable) getSession(sid string) *redisSession {
+	if st == nil || len(sid) == 0 {
+		return nil
+	}
+	st.rwl.RLock()
+	s := st.sm[sid]
+	st.rwl.RUnlock()
+
+	obj, err := st.sessions.Get(sid)
+	if err != nil {
+		if err == redis.Nil {
+			if s != nil {
+				st.dropLocal(s) // removed by another server
+			}
+			return nil
+		}
+		log.Printf("Failed to load session %s: %v, using cached session", sid, err)
+		return s
+	}
+	pc, ok := obj.(*protos.Context)
+	if !ok {
+		return s
+	}
+	if s != nil {
+		s.refresh(pc)
+		return s
+	}
+	return st.cacheSession(sid, pc)
+}
+
+// cacheSession caches a session loaded from the store & starts its timer if the session has a timeout
+func (st *RedisSessionTable) cacheSession(sid string, pc *protos.Context) *redisSession {
+	var deadline time.Time
+	obj, err := st.timeouts.Get(sid)
+	if err == nil {
+		deadline, _ = obj.(time.Time)
+	} else if err != redis.Nil {
+		log.Printf("Failed to load timeout of session %s: %v", sid, err)
+	}
+
+	st.rwl.Lock()
+	defer st.rwl.Unlock()
+	if s, ok := st.sm[sid]; ok {
+		return s // cached concurrently
+	}
+	s := st.newSession(sid, pc)
+	st.sm[sid] = s
+	if !deadline.IsZero() {
+		st.startTimerLocked(s, time.Until(deadline), st.notifier)
+	}
+	return s
+}
+
+// scanTimeouts caches the sessions with a persisted timeout which aren't cached yet
+func (st *RedisSessionTable) scanTimeouts() error {
+	all, err := st.timeouts.GetAll()
+	if err != nil {
+		return err
+	}
+	for sid := range all {
+		st.rwl.RLock()
+		_, cached := st.sm[sid]
+		st.rwl.RUnlock()
+		if cached {
+			continue
+		}
+		obj, err := st.sessions.Get(sid)
+		if err != nil {
+			if err != redis.Nil {
+				log.Printf("Failed to load session %s: %v", sid, err)
+			}
+			continue // removed concurrently
+		}
+		if pc, ok := obj.(*protos.Context); ok {
+			st.cacheSession(sid, pc)
+		}
+	}
+	return nil
+}
+
+// setTimeout persists the session's timeout deadline & [re]starts its timer
+func (st *RedisSessionTable) setTimeout(s *redisSession, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	if tout < aaa.MinimalSessionTimeout {
+		tout = aaa.MinimalSessionTimeout
+	}
+	if err := st.timeouts.Set(s.sid, time.Now().Add(tout)); err != nil {
+		log.Printf("Failed to persist timeout of session %s: %v", s.sid, err)
+	}
+	st.rwl.Lock()
+	defer st.rwl.Unlock()
+	if st.sm[s.sid] != s {
+		return false
+	}
+	st.startTimerLocked(s, tout, notifier)
+	return true
+}
+
+// startTimerLocked [re]starts the session's timer, must be called with st.rwl locked
+func (st *RedisSessionTable) startTimerLocked(s *redisSession, tout time.Duration, notifier aaa.TimeoutNotifier) {
+	if tout < aaa.MinimalSessionTimeout {
+		tout = aaa.MinimalSessionTimeout
+	}
+	s.stopTimerLocked()
+	s.notifier = notifier
+	gen := s.timerGen
+	s.timer = time.AfterFunc(tout, func() { st.timeout(s, gen) })
+}
+
+// timeout handles expiration of the session's timer. The session is removed & its notifier called unless
+// the session's timeout was extended, stopped or the session was removed by another server.
+func (st *RedisSessionTable) timeout(s *redisSession, gen uint64) {
+	st.rwl.RLock()
+	current := s.timerGen == gen && st.sm[s.sid] == s
+	notifier := s.notifier
+	if notifier == nil {
+		notifier = st.notifier
+	}
+	st.rwl.RUnlock()
+	if !current {
+		return
+	}
+	obj, err := st.timeouts.Get(s.sid)
+	switch {
+	case err == nil:
+		if deadline, ok := obj.(time.Time); ok && time.Until(deadline) > 0 {
+			// extended by another server
+			st.rwl.Lock()
+			if s.timerGen == gen {
+				st.startTimerLocked(s, time.Until(deadline), s.notifier)
+			}
+			st.rwl.Unlock()
+			return
+		}
+	case err == redis.Nil:
+		// timeout stopped or session removed by another server
+		if _, err = st.sessions.Get(s.sid); err == redis.Nil {
+			st.dropLocal(s)
+		}
+		return
+	default:
+		log.Printf("Failed to load timeout of session %s: %v, timing out", s.sid, err)
+	}
+	deleted, err := st.client.HDelExisting(SessionsHash, s.sid)
+	st.dropLocal(s)
+	if err == nil && !deleted {
+		return // timed out or removed by another server
+	}
+	if err != nil {
+		log.Printf("Failed to delete session %s: %v", s.sid, err)
+	}
+	if err = st.timeouts.Delete(s.sid); err != nil {
+		log.Printf("Failed to delete timeout of session %s: %v", s.sid, err)
+	}
+	st.removeSessionID(s.GetCtx().GetImsi(), s.sid)
+
+	var notifyResult error
+	if notifier != nil {
+		notifyResult = notifier(s)
+	}
+	log.Printf(
+		"Timed out session '%s' for SessionId: %s; IMSI: %s; Identity: %s; MAC: %s; IP: %s; notify result: %v",
+		s.sid, s.GetCtx().GetSessionId(), s.GetCtx().GetImsi(), s.GetCtx().GetIdentity(), s.GetCtx().GetMacAddr(),
+		s.GetCtx().GetIpAddr(), notifyResult)
+
+	metrics.SessionTimeouts.WithLabelValues(s.GetCtx().GetApn(), metrics.DecorateIMSI(s.GetCtx().GetImsi())).Inc()
+}
+
+// dropLocal removes the session from the local cache & stops its timer
+func (st *RedisSessionTable) dropLocal(s *redisSession) {
+	atomic.StoreInt32(&s.removed, 1)
+	st.rwl.Lock()
+	defer st.rwl.Unlock()
+	if st.sm[s.sid] == s {
+		delete(st.sm, s.sid)
+	}
+	s.stopTimerLocked()
+}
+
+// removeSessionID removes the IMSI -> SID mapping if the IMSI still maps to sid
+func (st *RedisSessionTable) removeSessionID(imsi, sid string) {
+	if len(imsi) == 0 {
+		return
+	}
+	obj, err := st.sids.Get(imsi)
+	if err == nil && obj == sid {
+		err = st.sids.Delete(imsi)
+	}
+	if err != nil && err != redis.Nil {
+		log.Printf("Failed to delete SID of IMSI %s: %v", imsi, err)
+	}
+}
+
+func serializeContext(object interface{}) (string, error) {
+	pc, ok := object.(*protos.Context)
+	if !ok {
+		return "", fmt.Errorf("Could not cast object to session context")
+	}
+	serialized, err := proto.Marshal(pc)
+	if err != nil {
+		return "", fmt.Errorf("Could not marshal session context: %v", err)
+	}
+	serialized, err = proto.Marshal(&orcprotos.RedisState{SerializedMsg: serialized})
+	if err != nil {
+		return "", fmt.Errorf("Could not marshal session context: %v", err)
+	}
+	return string(serialized), nil
+}
+
+func deserializeContext(serialized string) (interface{}, error) {
+	redisState := &orcprotos.RedisState{}
+	if err := proto.Unmarshal([]byte(serialized), redisState); err != nil {
+		return nil, err
+	}
+	pc := &protos.Context{}
+	if err := proto.Unmarshal(redisState.GetSerializedMsg(), pc); err != nil {
+		return nil, err
+	}
+	return pc, nil
+}
+
+func serializeDeadline(object interface{}) (string, error) {
+	deadline, ok := object.(time.Time)
+	if !ok {
+		return "", fmt.Errorf("Could not cast object to time")
+	}
+	return strconv.FormatInt(deadline.UnixNano()/int64(time.Millisecond), 10), nil
+}
+
+func deserializeDeadline(serialized string) (interface{}, error) {
+	ms, err := strconv.ParseInt(serialized, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return time.Unix(0, ms*int64(time.Millisecond)), nil
+}
+
+func serializeString(object interface{}) (string, error) {
+	str, ok := object.(string)
+	if !ok {
+		return "", fmt.Errorf("Could not cast object to string")
+	}
+	return str, nil
+}
+
+func deserializeString(serialized string) (interface{}, error) {
+	return serialized, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/store"
+)
+
+// mockRedisClient is an in memory RedisClient shared by the tables under test
+type mockRedisClient struct {
+	sync.Mutex
+	hashes map[string]map[string]string
+}
+
+func newMockRedisClient() *mockRedisClient {
+	return &mockRedisClient{hashes: map[string]map[string]string{}}
+}
+
+func (client *mockRedisClient) HSet(hash string, field string, value string) error {
+	client.Lock()
+	defer client.Unlock()
+	if _, ok := client.hashes[hash]; !ok {
+		client.hashes[hash] = map[string]string{}
+	}
+	client.hashes[hash][field] = value
+	return nil
+}
+
+func (client *mockRedisClient) HGet(hash string, field string) (string, error) {
+	client.Lock()
+	defer client.Unlock()
+	value, ok := client.hashes[hash][field]
+	if !ok {
+		return "", redis.Nil
+	}
+	return value, nil
+}
+
+func (client *mockRedisClient) HGetAll(hash string) (map[string]string, error) {
+	client.Lock()
+	defer client.Unlock()
+	all := map[string]string{}
+	for field, value := range client.hashes[hash] {
+		all[field] = value
+	}
+	return all, nil
+}
+
+func (client *mockRedisClient) HDel(hash string, field string) error {
+	_, err := client.HDelExisting(hash, field)
+	return err
+}
+
+func (client *mockRedisClient) HDelExisting(hash string, field string) (bool, error) {
+	client.Lock()
+	defer client.Unlock()
+	_, ok := client.hashes[hash][field]
+	delete(client.hashes[hash], field)
+	return ok, nil
+}
+
+func TestRedisSessionTable(t *testing.T) {
+	const routines = 30
+
+	st := store.NewRedisSessionTable(newMockRedisClient())
+	var err error
+	sharedSession, err = st.AddSession(&protos.Context{SessionId: sharedSid, Imsi: sharedImsi}, time.Minute*10, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, sharedSession)
+
+	c := make(chan struct{})
+	i := 0
+	for ; i < routines; i++ {
+		go runTest(t, st, c)
+	}
+	t.Logf("Started %d test routines\n", i)
+	for i = 0; i < routines; i++ {
+		<-c
+	}
+}
+
+func TestRedisSessionTableRestore(t *testing.T) {
+	client := newMockRedisClient()
+	active := store.NewRedisSessionTable(client)
+	var activeTimeouts, standbyTimeouts int32
+	activeNotifier := func(aaa.Session) error { atomic.AddInt32(&activeTimeouts, 1); return nil }
+	standbyNotifier := func(aaa.Session) error { atomic.AddInt32(&standbyTimeouts, 1); return nil }
+
+	sid1, sid2 := aaa.CreateSessionId(), aaa.CreateSessionId()
+	_, err := active.AddSession(&protos.Context{SessionId: sid1, Imsi: "001010000000001"}, time.Minute, activeNotifier)
+	assert.NoError(t, err)
+	s2, err := active.AddSession(
+		&protos.Context{SessionId: sid2, Imsi: "001010000000002"}, time.Millisecond*200, activeNotifier)
+	assert.NoError(t, err)
+	s2.Lock()
+	s2.GetCtx().Identity = "user@magma.com"
+	s2.Unlock()
+
+	// standby restores persisted sessions & their timers
+	standby := store.NewRedisSessionTable(client)
+	restored, err := standby.RestoreSessions(standbyNotifier)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, restored)
+	assert.Equal(t, sid2, standby.FindSession("001010000000002"))
+	if s := standby.GetSession(sid2); assert.NotNil(t, s) {
+		assert.Equal(t, "user@magma.com", s.GetCtx().GetIdentity())
+	}
+
+	// context changes are visible to the peer
+	s1 := standby.GetSession(sid1)
+	s1.Lock()
+	s1.GetCtx().IpAddr = "10.0.0.1"
+	s1.Unlock()
+	assert.Equal(t, "10.0.0.1", active.GetSession(sid1).GetCtx().GetIpAddr())
+
+	// only one of the tables times the session out
+	time.Sleep(time.Millisecond * 400)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&activeTimeouts)+atomic.LoadInt32(&standbyTimeouts))
+	assert.Nil(t, active.GetSession(sid2))
+	assert.Nil(t, standby.GetSession(sid2))
+	assert.Equal(t, "", standby.FindSession("001010000000002"))
+
+	// timeout extended by the active table isn't expired by the standby table
+	assert.True(t, standby.SetTimeout(sid1, time.Millisecond*100, standbyNotifier))
+	assert.True(t, active.SetTimeout(sid1, time.Minute, activeNotifier))
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&activeTimeouts)+atomic.LoadInt32(&standbyTimeouts))
+	assert.NotNil(t, standby.GetSession(sid1))
+
+	// sessions removed by the peer are gone
+	assert.NotNil(t, active.RemoveSession(sid1))
+	assert.Nil(t, standby.GetSession(sid1))
+	assert.Nil(t, standby.RemoveSession(sid1))
+	assert.Equal(t, "", standby.FindSession("001010000000001"))
+
+	restored, err = store.NewRedisSessionTable(client).RestoreSessions(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, restored)
+}
+
+func TestRedisSessionTableScanTimeouts(t *testing.T) {
+	client := newMockRedisClient()
+	active := store.NewRedisSessionTable(client)
+	standby := store.NewRedisSessionTable(client)
+	var standbyTimeouts int32
+	_, err := standby.RestoreSessions(func(aaa.Session) error { atomic.AddInt32(&standbyTimeouts, 1); return nil })
+	assert.NoError(t, err)
+	done := standby.ScanTimeouts(time.Millisecond * 50)
+	defer func() { done <- struct{}{} }()
+
+	// session created by the active table after the standby restored its sessions
+	sid := aaa.CreateSessionId()
+	_, err = active.AddSession(&protos.Context{SessionId: sid, Imsi: "001010000000001"}, time.Millisecond*200, nil)
+	assert.NoError(t, err)
+	time.Sleep(time.Millisecond * 100)
+
+	// stop the active table's timer as if its server was gone & expire the persisted deadline,
+	// the standby times the session out
+	active.GetSession(sid).StopTimeout()
+	assert.NoError(t, client.HSet(store.TimeoutsHash, sid, "0"))
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&standbyTimeouts))
+	assert.Nil(t, standby.GetSession(sid))
+	assert.Equal(t, "", standby.FindSession("001010000000001"))
+}
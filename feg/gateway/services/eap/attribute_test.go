@@ -124,3 +124,39 @@ func TestAttributeScanner(t *testing.T) {
 		t.Fatalf("EAP Mismatch 2\nexpected: %v\n     got: %v", []byte(testEAP), p)
 	}
 }
+
+func TestNewAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		data        []byte
+		expectedLen int
+	}{
+		{nil, 4},
+		{[]byte{1, 2}, 4},
+		{[]byte{1, 2, 3}, 8},
+		{make([]byte, 18), 20},
+		{make([]byte, 1018), 1020},
+	} {
+		a := NewAttribute(11, tc.data)
+		if a.Len() != tc.expectedLen {
+			t.Fatalf("Invalid Attr Len for %d bytes of data: expected %d got %d", len(tc.data), tc.expectedLen, a.Len())
+		}
+		if int(a.AttrLen()) != tc.expectedLen>>2 {
+			t.Fatalf("Invalid Attr Length byte for %d bytes of data: expected %d got %d",
+				len(tc.data), tc.expectedLen>>2, a.AttrLen())
+		}
+	}
+	// Attributes created by NewAttribute must be identical to the ones scanned from a received EAP
+	scanner, err := NewAttributeScanner([]byte(testEAP))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range expectedAttrs {
+		attr, err := scanner.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(attr.Marshaled()) != string(expected.Marshaled()) {
+			t.Fatalf("EAP Attr Mismatch for attr #%d\nexpected: %v\n     got: %v", i, expected.Marshaled(), attr.Marshaled())
+		}
+	}
+}
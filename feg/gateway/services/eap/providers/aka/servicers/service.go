@@ -32,11 +32,14 @@ type UserCtx struct {
 	Profile    *protos.AuthenticationAnswer_UserProfile
 	Identifier uint8
 	Rand,
+	K_encr,
 	K_aut,
+	K_re,
 	MSK,
 	Xres []byte
 	SessionId     string
 	AuthSessionId string
+	ReauthId      string // fast re-authentication identity (AKA' only)
 }
 
 type SessionCtx struct {
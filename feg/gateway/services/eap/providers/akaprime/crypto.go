@@ -0,0 +1,201 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package akaprime
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const (
+	// FC value of CK' & IK' derivation function (3GPP TS 33.402, Annex A.2)
+	ckIkPrimeFC = 0x20
+	sqnXorAkLen = 6
+
+	keysLen       = K_ENCR_LEN + K_AUT_LEN + K_RE_LEN + MSK_LEN + EMSK_LEN
+	reauthKeysLen = MSK_LEN + EMSK_LEN
+)
+
+// MakeCKIKPrime derives CK' & IK' from CK, IK, the access network name & SQN xor AK (the first 6 bytes of AUTN),
+// see RFC 5448, section 3.3 & 3GPP TS 33.402, Annex A.2
+func MakeCKIKPrime(CK, IK []byte, networkName string, autn []byte) (CKPrime, IKPrime []byte) {
+	s := make([]byte, 0, 1+len(networkName)+2+sqnXorAkLen+2)
+	s = append(s, ckIkPrimeFC)
+	s = append(s, networkName...)
+	s = append(s, byte(len(networkName)>>8), byte(len(networkName)))
+	s = append(s, autn[:sqnXorAkLen]...)
+	s = append(s, 0, sqnXorAkLen)
+	h := hmac.New(sha256.New, append(append(make([]byte, 0, len(CK)+len(IK)), CK...), IK...))
+	h.Write(s)
+	k := h.Sum(nil)
+	return k[:16], k[16:32]
+}
+
+// PRFPrime returns n bytes generated by AKA' pseudo-random function PRF'(K,S) (RFC 5448, section 3.4):
+//   PRF'(K,S) = T1 | T2 | T3 | T4 | ...
+//   T1 = HMAC-SHA-256 (K, S | 0x01)
+//   Tn = HMAC-SHA-256 (K, Tn-1 | S | n)
+func PRFPrime(K, S []byte, n int) []byte {
+	res := make([]byte, 0, n+sha256.Size)
+	h := hmac.New(sha256.New, K)
+	var t []byte
+	for i := 1; len(res) < n; i++ {
+		h.Reset()
+		h.Write(t)
+		h.Write(S)
+		h.Write([]byte{byte(i)})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:n]
+}
+
+// MakeAKAPrimeKeys returns K_encr, K_aut, K_re, MSK & EMSK keys for AKA' full authentication (RFC 5448, section 3.3)
+//   MK = PRF'(IK'|CK',"EAP-AKA'"|Identity)
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := append(append(make([]byte, 0, len(IKPrime)+len(CKPrime)), IKPrime...), CKPrime...)
+	s := append([]byte("EAP-AKA'"), identity...)
+	mk := PRFPrime(key, s, keysLen)
+	K_encr, mk = mk[:K_ENCR_LEN], mk[K_ENCR_LEN:]
+	K_aut, mk = mk[:K_AUT_LEN], mk[K_AUT_LEN:]
+	K_re, mk = mk[:K_RE_LEN], mk[K_RE_LEN:]
+	MSK, EMSK = mk[:MSK_LEN], mk[MSK_LEN:]
+	return
+}
+
+// MakeReauthKeys returns MSK & EMSK keys for AKA' fast re-authentication (RFC 5448, section 3.3)
+//   MK = PRF'(K_re,"EAP-AKA' re-auth"|Identity|counter|NONCE_S)
+func MakeReauthKeys(identity, K_re []byte, counter uint16, nonceS []byte) (MSK, EMSK []byte) {
+	s := append([]byte("EAP-AKA' re-auth"), identity...)
+	s = append(s, byte(counter>>8), byte(counter))
+	s = append(s, nonceS...)
+	mk := PRFPrime(K_re, s, reauthKeysLen)
+	return mk[:MSK_LEN], mk[MSK_LEN:]
+}
+
+// GenMac calculates AKA' MAC given data & K_aut, AKA' uses HMAC-SHA-256-128 (RFC 5448, section 3.4)
+func GenMac(data, K_aut []byte) []byte {
+	h := hmac.New(sha256.New, K_aut)
+	h.Write(data)
+	return h.Sum(nil)[:aka.MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// extra, if given, is appended to the packet for MAC calculation only (NONCE_S of re-authentication)
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte, extra ...byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, aka.MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(append(append(make([]byte, 0, len(p)+len(extra)), p...), extra...), K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
+
+// VerifyMac verifies AT_MAC of the packet, the packet is not modified
+func VerifyMac(p eap.Packet, K_aut []byte, extra ...byte) error {
+	p = append(append(make([]byte, 0, len(p)+len(extra)), p...), extra...)
+	scanner, err := eap.NewAttributeScanner(p[:len(p)-len(extra)])
+	if err != nil {
+		return err
+	}
+	var a eap.Attribute
+	offset := eap.EapFirstAttribute
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		if a.Type() == aka.AT_MAC {
+			break
+		}
+		offset += a.Len()
+	}
+	if err != nil {
+		return fmt.Errorf("Missing AT_MAC: %v", err)
+	}
+	if a.Len() < aka.AT_MAC_ATTR_LEN {
+		return fmt.Errorf("Malformed AT_MAC")
+	}
+	macOffset := offset + aka.ATT_HDR_LEN
+	ueMac := append([]byte{}, p[macOffset:macOffset+aka.MAC_LEN]...)
+	copy(p[macOffset:macOffset+aka.MAC_LEN], make([]byte, aka.MAC_LEN))
+	if !hmac.Equal(ueMac, GenMac(p, K_aut)) {
+		return fmt.Errorf("Invalid MAC: %x", ueMac)
+	}
+	return nil
+}
+
+// Encrypt encrypts attributes into AT_ENCR_DATA value using AES-CBC with K_encr & given IV (RFC 4187, 10.12),
+// the attributes are padded by AT_PADDING to a multiple of 16 bytes
+func Encrypt(attrs []byte, K_encr, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, err
+	}
+	plain := append(make([]byte, 0, len(attrs)+aes.BlockSize), attrs...)
+	if pad := (aes.BlockSize - len(plain)%aes.BlockSize) % aes.BlockSize; pad > 0 {
+		padding := make([]byte, pad)
+		padding[0], padding[1] = byte(aka.AT_PADDING), byte(pad/4)
+		plain = append(plain, padding...)
+	}
+	encrypted := make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, plain)
+	return encrypted, nil
+}
+
+// Decrypt decrypts value of AT_ENCR_DATA using AES-CBC with K_encr & given IV & returns the encrypted attributes
+func Decrypt(encrypted []byte, K_encr, iv []byte) ([]byte, error) {
+	if len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("Invalid encrypted data len: %d", len(encrypted))
+	}
+	if len(iv) != aes.BlockSize {
+		return nil, fmt.Errorf("Invalid IV len: %d", len(iv))
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, encrypted)
+	return plain, nil
+}
+
+// ScanAttributes scans raw (not EAP packet encapsulated) attributes, such as decrypted AT_ENCR_DATA attributes &
+// returns them keyed by type
+func ScanAttributes(data []byte) (map[eap.AttrType]eap.Attribute, error) {
+	res := map[eap.AttrType]eap.Attribute{}
+	for len(data) > 0 {
+		if len(data) < 2 {
+			return res, fmt.Errorf("Truncated attribute: %x", data)
+		}
+		l := int(data[1]) << 2
+		if l == 0 || l > len(data) {
+			return res, fmt.Errorf("Invalid attribute %d length: %d, available: %d", data[0], l, len(data))
+		}
+		res[eap.AttrType(data[0])] = eap.NewRawAttribute(data[:l])
+		data = data[l:]
+	}
+	return res, nil
+}
+
+// Uint16 returns the first 2 bytes of an attribute value as uint16 (AT_COUNTER, AT_KDF, etc.)
+func Uint16(a eap.Attribute) uint16 {
+	if a == nil || len(a.Value()) < 2 {
+		return 0
+	}
+	return binary.BigEndian.Uint16(a.Value())
+}
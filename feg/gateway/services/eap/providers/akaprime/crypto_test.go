@@ -0,0 +1,129 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package akaprime_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+)
+
+// RFC 5448, Appendix C test vectors
+type akaPrimeTestVector struct {
+	identity, networkName,
+	rand, autn, ik, ck,
+	ckPrime, ikPrime,
+	kEncr, kAut, kRe, msk, emsk string
+}
+
+var rfc5448Vectors = []akaPrimeTestVector{
+	{ // Test Case 1
+		identity:    "0555444333222111",
+		networkName: "WLAN",
+		rand:        "81e92b6c0ee0e12ebceba8d92a99dfa5",
+		autn:        "bb52e91c747ac3ab2a5c23d15ee351d5",
+		ik:          "9744871ad32bf9bbd1dd5ce54e3e2e5a",
+		ck:          "5349fbe098649f948f5d2e973a81c00f",
+		ckPrime:     "0093962d0dd84aa5684b045c9edffa04",
+		ikPrime:     "ccfc230ca74fcc96c0a5d61164f5a76c",
+		kEncr:       "766fa0a6c317174b812d52fbcd11a179",
+		kAut:        "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea",
+		kRe:         "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a",
+		msk: "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+			"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a",
+		emsk: "f861703cd775590e16c7679ea3874ada866311de290764d760cf76df647ea01c" +
+			"313f69924bdd7650ca9bac141ea075c4ef9e8029c0e290cdbad5638b63bc23fb",
+	},
+	{ // Test Case 2
+		identity:    "0555444333222111",
+		networkName: "HRPD",
+		rand:        "81e92b6c0ee0e12ebceba8d92a99dfa5",
+		autn:        "bb52e91c747ac3ab2a5c23d15ee351d5",
+		ik:          "9744871ad32bf9bbd1dd5ce54e3e2e5a",
+		ck:          "5349fbe098649f948f5d2e973a81c00f",
+		ckPrime:     "3820f0277fa5f77732b1fb1d90c1a0da",
+		ikPrime:     "db94a0ab557ef6c9ab48619ca05b9a9f",
+		kEncr:       "05ad73ac915fce89ac77e1520d82187b",
+		kAut:        "5b4acaef62c6ebb8882b2f3d534c4b35277337a00184f20ff25d224c04be2afd",
+		kRe:         "3f90bf5c6e5ef325ff04eb5ef6539fa8cca8398194fbd00be425b3f40dba10ac",
+		msk: "87b321570117cd6c95ab6c436fb5073ff15cf85505d2bc5bb7355fc21ea8a757" +
+			"57e8f86a2b138002e05752913bb43b82f868a96117e91a2d95f526677d572900",
+		emsk: "c891d5f20f148a1007553e2dea555c9cb672e9675f4a66b4bafa027379f93aee" +
+			"539a5979d0a0042b9d2ae28bed3b17a31dc8ab75072b80bd0c1da612466e402c",
+	},
+}
+
+func decode(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestAKAPrimeKeys(t *testing.T) {
+	for _, v := range rfc5448Vectors {
+		ckPrime, ikPrime := akaprime.MakeCKIKPrime(decode(t, v.ck), decode(t, v.ik), v.networkName, decode(t, v.autn))
+		assert.Equal(t, v.ckPrime, hex.EncodeToString(ckPrime), v.networkName)
+		assert.Equal(t, v.ikPrime, hex.EncodeToString(ikPrime), v.networkName)
+
+		K_encr, K_aut, K_re, MSK, EMSK := akaprime.MakeAKAPrimeKeys([]byte(v.identity), ikPrime, ckPrime)
+		assert.Equal(t, v.kEncr, hex.EncodeToString(K_encr), v.networkName)
+		assert.Equal(t, v.kAut, hex.EncodeToString(K_aut), v.networkName)
+		assert.Equal(t, v.kRe, hex.EncodeToString(K_re), v.networkName)
+		assert.Equal(t, v.msk, hex.EncodeToString(MSK), v.networkName)
+		assert.Equal(t, v.emsk, hex.EncodeToString(EMSK), v.networkName)
+	}
+}
+
+func TestAKAPrimeMac(t *testing.T) {
+	K_aut := decode(t, rfc5448Vectors[0].kAut)
+	p := eap.NewPacket(eap.RequestCode, 1, []byte{akaprime.TYPE, byte(aka.SubtypeNotification), 0, 0})
+	p, err := p.Append(eap.NewAttribute(aka.AT_NOTIFICATION, []byte{0x40, 0}))
+	assert.NoError(t, err)
+
+	signed, err := akaprime.AppendMac(p, K_aut)
+	assert.NoError(t, err)
+	assert.Equal(t, len(p)+aka.AT_MAC_ATTR_LEN, len(signed))
+	assert.NoError(t, akaprime.VerifyMac(signed, K_aut))
+	assert.Error(t, akaprime.VerifyMac(signed, K_aut, 1, 2, 3))
+	assert.Error(t, akaprime.VerifyMac(signed, decode(t, rfc5448Vectors[1].kAut)))
+
+	// MAC covering the packet & NONCE_S
+	nonceS := decode(t, rfc5448Vectors[0].rand)
+	signed, err = akaprime.AppendMac(p, K_aut, nonceS...)
+	assert.NoError(t, err)
+	assert.NoError(t, akaprime.VerifyMac(signed, K_aut, nonceS...))
+	assert.Error(t, akaprime.VerifyMac(signed, K_aut))
+	signed[len(signed)-1] ^= 1
+	assert.Error(t, akaprime.VerifyMac(signed, K_aut, nonceS...))
+}
+
+func TestAKAPrimeEncryption(t *testing.T) {
+	K_encr := decode(t, rfc5448Vectors[0].kEncr)
+	iv := decode(t, rfc5448Vectors[0].rand)
+	counter := eap.NewAttribute(aka.AT_COUNTER, []byte{0, 5})
+	counter[1] = 1
+	encrypted, err := akaprime.Encrypt(counter, K_encr, iv)
+	assert.NoError(t, err)
+	assert.Len(t, encrypted, 16)
+
+	plain, err := akaprime.Decrypt(encrypted, K_encr, iv)
+	assert.NoError(t, err)
+	attrs, err := akaprime.ScanAttributes(plain)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(5), akaprime.Uint16(attrs[aka.AT_COUNTER]))
+	assert.Contains(t, attrs, aka.AT_PADDING)
+
+	_, err = akaprime.Decrypt(encrypted[:10], K_encr, iv)
+	assert.Error(t, err)
+}
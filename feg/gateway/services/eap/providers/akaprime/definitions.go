@@ -0,0 +1,48 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package akaprime implements EAP-AKA' (RFC 5448) provider
+package akaprime
+
+import (
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+)
+
+const (
+	TYPE = uint8(protos.EapType_AKAPrime)
+)
+
+const (
+	// AKA' specific Attributes, all other attributes are shared with AKA
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+)
+
+const (
+	// KDF_AKA_PRIME - the only defined AKA' Key Derivation Function (RFC 5448, section 3.1)
+	KDF_AKA_PRIME uint16 = 1
+
+	// DefaultNetworkName - access network name used for CK' & IK' derivation (3GPP TS 24.302, 8.1.1.1)
+	DefaultNetworkName = "WLAN"
+
+	// Identity prefixes (RFC 5448, section 3)
+	PermanentIdentityPrefix = '6'
+	PseudonymIdentityPrefix = '7'
+	ReauthIdentityPrefix    = '8'
+)
+
+const (
+	K_ENCR_LEN = 16
+	K_AUT_LEN  = 32
+	K_RE_LEN   = 32
+	MSK_LEN    = 64
+	EMSK_LEN   = 64
+	IV_LEN     = 16
+	NONCE_LEN  = 16
+)
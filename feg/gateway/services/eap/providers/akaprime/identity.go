@@ -0,0 +1,27 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package akaprime
+
+import (
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+func NewIdentityReq(identifier uint8, attr eap.AttrType) eap.Packet {
+	return []byte{
+		eap.RequestCode,
+		identifier,
+		0, 12, // EAP Len
+		TYPE,
+		byte(aka.SubtypeIdentity),
+		0, 0,
+		byte(attr),
+		1,
+		0, 0} // padding
+}
@@ -0,0 +1,176 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"crypto/subtle"
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeChallenge, challengeResponse)
+}
+
+// challengeResponse implements handler for AKA' Challenge Response,
+// see https://tools.ietf.org/html/rfc5448#section-3 for details
+func challengeResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var (
+		success    bool
+		ctxCreated time.Time
+	)
+	metrics.ChallengeRequests.Inc()
+	defer func() {
+		if !ctxCreated.IsZero() {
+			metrics.AuthLatency.Observe(time.Since(ctxCreated).Seconds())
+		}
+		if !success {
+			metrics.FailedChallengeRequests.Inc()
+		}
+	}()
+
+	identifier := req.Identifier()
+	if ctx == nil {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", ctx.SessionId)
+	}
+	if uc == nil {
+		s.UpdateSessionTimeout(sessionId, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No IMSI '%s' found for SessionID: %s", imsi, ctx.SessionId)
+	}
+	ctxCreated = uc.CreatedTime()
+
+	state, _ := uc.State()
+	if state != aka.StateChallenge {
+		log.Printf(
+			"AKA' Challenge Response: Unexpected user state: %d for IMSI: %s, Session: %s",
+			state, imsi, ctx.SessionId)
+	}
+	attrs, err := scanAttributes(req)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	atRes, found := attrs[aka.AT_RES]
+	if _, macFound := attrs[aka.AT_MAC]; !found || !macFound {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing AT_MAC | AT_RES")
+	}
+	// Verify MAC
+	if err = akaprime.VerifyMac(req, uc.K_aut); err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		log.Printf("%v for Session ID: %s; IMSI: %s; EAP: %x", err, ctx.SessionId, imsi, req)
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Unauthenticated,
+			"Invalid MAC for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
+	}
+	// AKA' defines a single KDF which is always offered, the peer must not request another one
+	if kdf, found := attrs[akaprime.AT_KDF]; found && akaprime.Uint16(kdf) != akaprime.KDF_AKA_PRIME {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacketWithMac(
+			identifier, aka.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unimplemented,
+			"Unsupported KDF %d requested for Session ID: %s; IMSI: %s", akaprime.Uint16(kdf), ctx.SessionId, imsi)
+	}
+
+	// Verify AT_RES, its value is prefixed by RES length in bits
+	ueRes := atRes.Value()
+	if resLen := int(akaprime.Uint16(atRes))/8 + 2; resLen <= len(ueRes) {
+		ueRes = ueRes[2:resLen]
+	}
+	if success = subtle.ConstantTimeCompare(ueRes, uc.Xres) == 1; !success {
+		log.Printf("Invalid AT_RES for Session ID: %s; IMSI: %s\n\t%.3v !=\n\t%.3v",
+			sessionId, imsi, ueRes, uc.Xres)
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacketWithMac(
+			identifier, aka.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
+			"Invalid AT_RES for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
+	}
+
+	// All good, set IMSI, MSK & Identity for farther use by Radius and return SuccessCode
+	ctx.Imsi = string(imsi)
+	if uc.Profile != nil {
+		ctx.Msisdn = uc.Profile.Msisdn
+	}
+	ctx.Msk = uc.MSK
+	ctx.Identity = uc.Identity
+	uc.SetState(aka.StateAuthenticated)
+
+	// Save keys for fast re-authentication with the re-authentication ID sent in the challenge
+	s.AddReauthCtx(uc.ReauthId, &servicers.ReauthCtx{
+		Imsi:     imsi,
+		Identity: uc.Identity,
+		Profile:  uc.Profile,
+		K_encr:   uc.K_encr,
+		K_aut:    uc.K_aut,
+		K_re:     uc.K_re,
+		Counter:  1,
+	})
+
+	// Keep session & User Ctx around for some time after authentication and then clean them up
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return successPacket(identifier), nil
+}
+
+// scanAttributes returns attributes of the packet keyed by type, the first attribute of each type is returned
+func scanAttributes(req eap.Packet) (map[eap.AttrType]eap.Attribute, error) {
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[eap.AttrType]eap.Attribute{}
+	var a eap.Attribute
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		if _, found := attrs[a.Type()]; !found {
+			attrs[a.Type()] = a
+		}
+	}
+	if err != io.EOF {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// successPacket returns RFC 3748 p4.2 EAP Success packet
+//
+//	0                   1                   2                   3
+//	0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+//
+// +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+// |     Code      |  Identifier   |            Length             |
+// +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+func successPacket(identifier uint8) eap.Packet {
+	return []byte{
+		eap.SuccessCode, // Code
+		identifier,      // Identifier
+		0, 4}            // Length
+}
@@ -0,0 +1,168 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeIdentity, identityResponse)
+}
+
+// identityResponse implements handler for AKA'-Identity Response, it starts either full authentication or,
+// for a known re-authentication ID, fast re-authentication. See https://tools.ietf.org/html/rfc5448#section-3
+func identityResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.IdentityRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedIdentityRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		log.Printf("Missing Session ID for EAP: %x; Generated new SID: %s", req, ctx.SessionId)
+	}
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Aborted, err.Error())
+	}
+	var a eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		// Find first valid AT_IDENTITY attribute to get UE IMSI
+		if a.Type() != aka.AT_IDENTITY {
+			continue
+		}
+		identity, err := getIdentity(a)
+		if err != nil {
+			continue
+		}
+		if identity[0] == akaprime.ReauthIdentityPrefix {
+			p, err := reauthRequest(s, ctx, identity, identifier)
+			success = err == nil
+			return p, err
+		}
+		imsi, err := getIMSI(identity)
+		if err != nil {
+			continue
+		}
+		if !s.CheckPlmnId(imsi) {
+			s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+			return akaprime.EapErrorResPacket(
+				identifier,
+				aka.NOTIFICATION_FAILURE,
+				codes.PermissionDenied,
+				"PLMN ID of IMSI: %s is not whitelisted", imsi)
+		}
+		ctx.Imsi = string(imsi)                  // set IMSI
+		uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+		state, t := uc.State()
+		if state > aka.StateCreated {
+			log.Printf(
+				"EAP AKA' IdentityResponse: Unexpected user state: %d,%s for IMSI: %s, CTX Identity: %s",
+				state, t, imsi, uc.Identity)
+		}
+		uc.Identity = identity
+		uc.SetState(aka.StateIdentity)
+		p, err := createChallengeRequest(s, uc, identifier, nil)
+		if success = err == nil; success {
+			// Update state
+			uc.SetState(aka.StateChallenge)
+			s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+		} else {
+			s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		}
+		return p, err
+	}
+	s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+	if err != nil && err != io.EOF {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, err.Error())
+	}
+	return akaprime.EapErrorResPacket(
+		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
+}
+
+// reauthRequest starts fast re-authentication for a re-authentication identity or requests the permanent
+// identity if the re-authentication ID is unknown
+func reauthRequest(
+	s *servicers.EapAkaPrimeSrv, ctx *protos.Context, identity string, identifier uint8) (eap.Packet, error) {
+
+	rc := s.FindReauthCtx(identity)
+	if rc == nil {
+		log.Printf("Unknown AKA' re-authentication ID '%s', requesting permanent identity", identity)
+		return akaprime.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), nil
+	}
+	rc.Lock()
+	defer rc.Unlock()
+
+	ctx.Imsi = string(rc.Imsi)
+	uc := s.InitSession(ctx.SessionId, rc.Imsi) // we have Locked User Ctx after this call
+	uc.Identity = identity
+	uc.ReauthId = servicers.ReauthIdUsername(identity)
+	uc.SetState(aka.StateIdentity)
+	p, err := createReauthRequest(uc, rc, s.NewReauthId(), identifier)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "Error creating AKA' Reauthentication: %v", err)
+	}
+	uc.SetState(aka.StateChallenge)
+	s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+	return p, nil
+}
+
+// see https://tools.ietf.org/html/rfc4187#section-10.5
+func getIdentity(a eap.Attribute) (string, error) {
+	if a.Len() <= 4 {
+		return "", fmt.Errorf("AT_IDENTITY is too short: %d", a.Len())
+	}
+	val := a.Value()
+	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen2 > len(val) {
+		return "", fmt.Errorf("Corrupt AT_IDENTITY Attribute: actual len %d > data len %d", actualLen2-2, len(val))
+	}
+	if actualLen2 == 2 {
+		return "", fmt.Errorf("Empty AT_IDENTITY")
+	}
+	return string(val[2:actualLen2]), nil
+}
+
+// getIMSI returns IMSI of a permanent AKA' (or AKA) identity
+func getIMSI(identity string) (aka.IMSI, error) {
+	imsi := aka.IMSI(identity)
+	if atIdx := strings.Index(identity, "@"); atIdx > 0 {
+		imsi = aka.IMSI(identity[:atIdx])
+	}
+	if len(imsi) > 0 && (imsi[0] == akaprime.PermanentIdentityPrefix || imsi[0] == '0') {
+		imsi = imsi[1:]
+	} else {
+		log.Printf("AKA' AT_IDENTITY '%s' is non-permanent type", identity)
+	}
+	return imsi, imsi.Validate()
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"fmt"
+	"log"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeAuthenticationReject, authRejectResponse)
+	servicers.AddHandler(aka.SubtypeClientError, clientErrorResponse)
+	servicers.AddHandler(aka.SubtypeNotification, notificationResponse)
+}
+
+// authRejectResponse implements handler for EAP-Response/AKA'-Authentication-Reject,
+// see https://tools.ietf.org/html/rfc4187#section-9.5 for details
+func authRejectResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var sid string
+	metrics.PeerAuthReject.Inc()
+
+	if ctx == nil || len(ctx.SessionId) == 0 {
+		log.Printf("WARNING: Missing CTX/Empty Session ID in AKA'-Authentication-Reject")
+	} else {
+		sid = ctx.SessionId
+	}
+	return peerFailure(s, sid, req.Identifier(), 0), nil
+}
+
+// clientErrorResponse implements handler for EAP-Response/AKA'-Client-Error,
+// see https://tools.ietf.org/html/rfc4187#section-9.9 for details
+func clientErrorResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var (
+		sid       string
+		errorCode int
+	)
+	metrics.PeerClientError.Inc()
+	if ctx == nil || len(ctx.SessionId) == 0 {
+		log.Printf("WARNING: Missing CTX/Empty Session ID in AKA'-Client-Error")
+	} else {
+		sid = ctx.SessionId
+		attrs, err := scanAttributes(req)
+		if err == nil {
+			if a, found := attrs[aka.AT_CLIENT_ERROR_CODE]; found && len(a.Value()) >= 2 {
+				errorCode = int(a.Value()[0])<<8 + int(a.Value()[1])
+				log.Printf("AKA'-Client-Error for Session ID: %s, code: %d", sid, errorCode)
+			} else {
+				err = fmt.Errorf("missing AT_CLIENT_ERROR_CODE")
+			}
+		}
+		if err != nil {
+			log.Printf("WARNING: Malformed AKA'-Client-Error for Session ID %s: %v", sid, err)
+		}
+	}
+	return peerFailure(s, sid, req.Identifier(), errorCode), nil
+}
+
+// notificationResponse implements handler for EAP-Response/AKA'-Notification
+// see https://tools.ietf.org/html/rfc4187#section-9.11 for details
+func notificationResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var sid string
+	metrics.PeerNotification.Inc()
+	if ctx == nil || len(ctx.SessionId) == 0 {
+		log.Printf("WARNING: Missing CTX/Empty Session ID in AKA'-Notification")
+	} else {
+		sid = ctx.SessionId
+	}
+	return peerFailure(s, sid, req.Identifier(), 0), nil
+}
+
+func peerFailure(s *servicers.EapAkaPrimeSrv, sessionId string, identifier uint8, errorCode int) eap.Packet {
+	metrics.PeerFailures.Inc()
+	if s != nil {
+		imsi := s.RemoveSession(sessionId)
+		if len(imsi) > 0 {
+			log.Printf("EAP-AKA' Peer failure for Session ID: %s, IMSI: %s, Error Code: %d",
+				sessionId, imsi, errorCode)
+		}
+	}
+	// Return RFC 3748 p4.2 EAP Failure packet
+	return []byte{
+		eap.FailureCode, // Code
+		identifier,      // Identifier
+		0, 4}            // Length
+}
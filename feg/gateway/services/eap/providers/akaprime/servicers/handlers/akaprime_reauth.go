@@ -0,0 +1,128 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"log"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+)
+
+func init() {
+	servicers.AddHandler(aka.SubtypeReauthentication, reauthResponse)
+}
+
+// reauthResponse implements handler for EAP-Response/AKA'-Reauthentication,
+// see https://tools.ietf.org/html/rfc4187#section-5 & https://tools.ietf.org/html/rfc5448#section-3.3
+func reauthResponse(s *servicers.EapAkaPrimeSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.ChallengeRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedChallengeRequests.Inc()
+		}
+	}()
+
+	identifier := req.Identifier()
+	if ctx == nil {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok {
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", sessionId)
+	}
+	if uc == nil {
+		s.UpdateSessionTimeout(sessionId, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No IMSI '%s' found for SessionID: %s", imsi, sessionId)
+	}
+	rc := s.FindReauthCtx(uc.ReauthId)
+	if rc == nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No re-authentication context found for Session ID: %s; IMSI: %s", sessionId, imsi)
+	}
+	rc.Lock()
+	defer rc.Unlock()
+
+	// MAC of the Reauthentication response covers the packet & NONCE_S
+	if err := akaprime.VerifyMac(req, rc.K_aut, rc.NonceS...); err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Unauthenticated,
+			"%v for re-authentication Session ID: %s; IMSI: %s", err, sessionId, imsi)
+	}
+	attrs, err := scanAttributes(req)
+	if err == nil {
+		attrs, err = decryptAttributes(attrs[aka.AT_IV], attrs[aka.AT_ENCR_DATA], rc.K_encr)
+	}
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacketWithMac(identifier, aka.NOTIFICATION_FAILURE, rc.K_aut,
+			codes.InvalidArgument, "Invalid re-authentication response: %v", err)
+	}
+	if _, tooSmall := attrs[aka.AT_COUNTER_TOO_SMALL]; tooSmall {
+		// The peer rejected the counter, fall back to full authentication
+		log.Printf("AKA' re-authentication counter is too small for Session ID: %s; IMSI: %s", sessionId, imsi)
+		s.RemoveReauthCtx(uc.ReauthId)
+		uc.ReauthId = ""
+		uc.SetState(aka.StateCreated)
+		success = true
+		s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+		return akaprime.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), nil
+	}
+	atCounter, found := attrs[aka.AT_COUNTER]
+	if !found || akaprime.Uint16(atCounter) != rc.Counter {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return akaprime.EapErrorResPacketWithMac(identifier, aka.NOTIFICATION_FAILURE, rc.K_aut,
+			codes.Unauthenticated, "Invalid AT_COUNTER for re-authentication Session ID: %s; IMSI: %s",
+			sessionId, imsi)
+	}
+
+	// All good, derive re-authentication MSK, set IMSI, MSK & Identity for farther use by Radius
+	ctx.Imsi = string(imsi)
+	if rc.Profile != nil {
+		ctx.Msisdn = rc.Profile.Msisdn
+	}
+	ctx.Msk, _ = akaprime.MakeReauthKeys([]byte(uc.Identity), rc.K_re, rc.Counter, rc.NonceS)
+	ctx.Identity = rc.Identity
+	uc.SetState(aka.StateAuthenticated)
+
+	// Replace used re-authentication ID with the next one
+	s.RemoveReauthCtx(uc.ReauthId)
+	s.AddReauthCtx(rc.NextId, &servicers.ReauthCtx{
+		Imsi:     rc.Imsi,
+		Identity: rc.Identity,
+		Profile:  rc.Profile,
+		K_encr:   rc.K_encr,
+		K_aut:    rc.K_aut,
+		K_re:     rc.K_re,
+		Counter:  rc.Counter + 1,
+	})
+	success = true
+
+	// Keep session & User Ctx around for some time after authentication and then clean them up
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return successPacket(identifier), nil
+}
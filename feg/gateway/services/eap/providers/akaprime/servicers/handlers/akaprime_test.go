@@ -0,0 +1,147 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+
+	cp "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/client"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	eap_test "magma/feg/gateway/services/eap/test"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const testRealm = "@wlan.mnc001.mcc001.3gppnetwork.org"
+
+func TestAkaPrimeAuthAndReauth(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	cp.RegisterSwxProxyServer(srv.GrpcServer, eap_test.SwxProxy{})
+	go srv.RunTest(lis)
+
+	s, err := servicers.NewEapAkaPrimeService(nil, "")
+	assert.NoError(t, err)
+	unit := eap_test.Units[eap_test.IMSI1]
+
+	// Full authentication
+	identity := string(akaprime.PermanentIdentityPrefix) + eap_test.IMSI1 + testRealm
+	req := eap.NewPacket(eap.ResponseCode, 1, []byte{akaprime.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	req, err = req.Append(newIdentityAttribute(aka.AT_IDENTITY, identity))
+	assert.NoError(t, err)
+
+	eapCtx := &protos.Context{}
+	p, err := identityResponse(s, eapCtx, req)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, eapCtx.SessionId)
+	assert.Equal(t, uint8(eap.RequestCode), p.Code())
+	assert.Equal(t, uint8(2), p.Identifier())
+	assert.Equal(t, aka.SubtypeChallenge, aka.Subtype(p[eap.EapSubtype]))
+
+	// Peer side key derivation, test SwxProxy returns EAP-AKA' vectors with CK' & IK'
+	autn := unit.RandAutn[aka.RAND_LEN:aka.RandAutnLen]
+	K_encr, K_aut, K_re, MSK, _ := akaprime.MakeAKAPrimeKeys([]byte(identity), unit.IntegrityKey, unit.ConfidentialityKey)
+	assert.NoError(t, akaprime.VerifyMac(p, K_aut))
+
+	attrs, err := scanAttributes(p)
+	assert.NoError(t, err)
+	assert.Equal(t, unit.RandAutn[:aka.RAND_LEN], attrs[aka.AT_RAND].Value()[2:])
+	assert.Equal(t, autn, attrs[aka.AT_AUTN].Value()[2:])
+	networkName, err := getIdentity(attrs[akaprime.AT_KDF_INPUT])
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.DefaultNetworkName, networkName)
+	assert.Equal(t, akaprime.KDF_AKA_PRIME, akaprime.Uint16(attrs[akaprime.AT_KDF]))
+	encrAttrs, err := decryptAttributes(attrs[aka.AT_IV], attrs[aka.AT_ENCR_DATA], K_encr)
+	assert.NoError(t, err)
+	reauthId, err := getIdentity(encrAttrs[aka.AT_NEXT_REAUTH_ID])
+	assert.NoError(t, err)
+	assert.Equal(t, byte(akaprime.ReauthIdentityPrefix), reauthId[0])
+
+	// Challenge response with invalid MAC must fail
+	req = eap.NewPacket(eap.ResponseCode, 2, []byte{akaprime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	req, err = req.Append(eap.NewAttribute(aka.AT_RES, append(uint16Bytes(uint16(len(unit.Xres)*8)), unit.Xres...)))
+	assert.NoError(t, err)
+	badReq, err := akaprime.AppendMac(req, K_re)
+	assert.NoError(t, err)
+	p, err = challengeResponse(s, &protos.Context{SessionId: eapCtx.SessionId}, badReq)
+	assert.NoError(t, err)
+	assert.Equal(t, akaprime.NewAKAPrimeNotificationReq(2, aka.NOTIFICATION_FAILURE), p)
+
+	req, err = akaprime.AppendMac(req, K_aut)
+	assert.NoError(t, err)
+	p, err = challengeResponse(s, eapCtx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, successPacket(2), p)
+	assert.Equal(t, eap_test.IMSI1, eapCtx.Imsi)
+	assert.Equal(t, unit.MSISDN, eapCtx.Msisdn)
+	assert.Equal(t, MSK, eapCtx.Msk)
+	assert.True(t, s.IsReauthId(reauthId+testRealm))
+
+	// Fast re-authentication
+	reauthIdentity := reauthId + testRealm
+	eapCtx = &protos.Context{}
+	res, err := s.Handle(context.Background(), &protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 5, append([]byte{client.EapMethodIdentity}, reauthIdentity...)),
+		Ctx:     eapCtx,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(akaprime.NewIdentityReq(6, aka.AT_ANY_ID_REQ)), res.GetPayload())
+
+	req = eap.NewPacket(eap.ResponseCode, 6, []byte{akaprime.TYPE, byte(aka.SubtypeIdentity), 0, 0})
+	req, err = req.Append(newIdentityAttribute(aka.AT_IDENTITY, reauthIdentity))
+	assert.NoError(t, err)
+	res, err = s.Handle(context.Background(), &protos.Eap{Payload: req, Ctx: res.GetCtx()})
+	assert.NoError(t, err)
+	p = res.GetPayload()
+	assert.Equal(t, uint8(eap.RequestCode), p.Code())
+	assert.Equal(t, uint8(7), p.Identifier())
+	assert.Equal(t, aka.SubtypeReauthentication, aka.Subtype(p[eap.EapSubtype]))
+	assert.NoError(t, akaprime.VerifyMac(p, K_aut))
+
+	attrs, err = scanAttributes(p)
+	assert.NoError(t, err)
+	encrAttrs, err = decryptAttributes(attrs[aka.AT_IV], attrs[aka.AT_ENCR_DATA], K_encr)
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(1), akaprime.Uint16(encrAttrs[aka.AT_COUNTER]))
+	nonceS := encrAttrs[aka.AT_NONCE_S].Value()[2:]
+	assert.Len(t, nonceS, akaprime.NONCE_LEN)
+	nextReauthId, err := getIdentity(encrAttrs[aka.AT_NEXT_REAUTH_ID])
+	assert.NoError(t, err)
+	assert.NotEqual(t, reauthId, nextReauthId)
+
+	req = eap.NewPacket(eap.ResponseCode, 7, []byte{akaprime.TYPE, byte(aka.SubtypeReauthentication), 0, 0})
+	req, err = appendEncrypted(req, K_encr, eap.NewAttribute(aka.AT_COUNTER, uint16Bytes(1)))
+	assert.NoError(t, err)
+	req, err = akaprime.AppendMac(req, K_aut, nonceS...)
+	assert.NoError(t, err)
+	res, err = s.Handle(context.Background(), &protos.Eap{Payload: req, Ctx: res.GetCtx()})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(successPacket(7)), res.GetPayload())
+
+	reauthMSK, _ := akaprime.MakeReauthKeys([]byte(reauthIdentity), K_re, 1, nonceS)
+	assert.Equal(t, reauthMSK, res.GetCtx().GetMsk())
+	assert.Equal(t, eap_test.IMSI1, res.GetCtx().GetImsi())
+	assert.Equal(t, identity, res.GetCtx().GetIdentity())
+	assert.False(t, s.IsReauthId(reauthIdentity))
+	assert.True(t, s.IsReauthId(nextReauthId))
+
+	// Unknown re-authentication ID falls back to full authentication
+	res, err = s.Handle(context.Background(), &protos.Eap{
+		Payload: eap.NewPacket(eap.ResponseCode, 9, append([]byte{client.EapMethodIdentity}, reauthIdentity...)),
+		Ctx:     &protos.Context{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(akaprime.NewIdentityReq(10, aka.AT_PERMANENT_ID_REQ)), res.GetPayload())
+}
@@ -0,0 +1,207 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provides AKA' Response handlers for supported AKA' subtypes
+package handlers
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	aka_servicers "magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+	"magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+// createChallengeRequest retrieves an AKA' vector over SWx, derives the session keys & returns
+// EAP-Request/AKA'-Challenge with the next fast re-authentication ID
+func createChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *aka_servicers.UserCtx,
+	identifier uint8,
+	resyncInfo []byte) (eap.Packet, error) {
+
+	metrics.SwxRequests.Inc()
+	swxStartTime := time.Now()
+
+	ans, err := swx_proxy.Authenticate(
+		&swx_protos.AuthenticationRequest{
+			UserName:             string(lockedCtx.Imsi),
+			SipNumAuthVectors:    1,
+			AuthenticationScheme: swx_protos.AuthenticationScheme_EAP_AKA_PRIME,
+			ResyncInfo:           resyncInfo,
+			RetrieveUserProfile:  true,
+		})
+
+	metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+	if err != nil {
+		metrics.SwxFailures.Inc()
+		errCode := codes.Internal
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			errCode = se.GRPCStatus().Code()
+		}
+		return akaprime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, errCode, err.Error())
+	}
+	if ans == nil {
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "Error: Nil SWx Response")
+	}
+	if len(ans.SipAuthVectors) == 0 {
+		return akaprime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "Error: Missing/empty SWx Auth Vector: %+v", *ans)
+	}
+	av := ans.SipAuthVectors[0] // Use first vector for now
+	ra := av.GetRandAutn()
+	if len(ra) < aka.RandAutnLen {
+		return akaprime.EapErrorResPacket(
+			identifier,
+			aka.NOTIFICATION_FAILURE,
+			codes.Internal,
+			"Invalid SWx RandAutn len (%d, expected: %d) in Response: %+v",
+			len(ra), aka.RandAutnLen, *ans)
+	}
+
+	identifier++
+
+	lockedCtx.Identifier = identifier
+	lockedCtx.Rand = ra[:aka.RAND_LEN]
+	autn := ra[aka.RAND_LEN:aka.RandAutnLen]
+	lockedCtx.Xres = av.GetXres()
+	lockedCtx.Profile = ans.GetUserProfile()
+	lockedCtx.AuthSessionId = ans.GetSessionId()
+
+	// HSS returns CK' & IK' for EAP-AKA' vectors, derive them locally if HSS returned EAP-AKA vector
+	CK, IK := av.GetConfidentialityKey(), av.GetIntegrityKey()
+	if av.GetAuthenticationScheme() != swx_protos.AuthenticationScheme_EAP_AKA_PRIME {
+		CK, IK = akaprime.MakeCKIKPrime(CK, IK, s.NetworkName(), autn)
+	}
+	lockedCtx.K_encr, lockedCtx.K_aut, lockedCtx.K_re, lockedCtx.MSK, _ =
+		akaprime.MakeAKAPrimeKeys([]byte(lockedCtx.Identity), IK, CK)
+	lockedCtx.ReauthId = s.NewReauthId()
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	p, err = appendAttributes(p,
+		eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, lockedCtx.Rand...)),
+		eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, autn...)),
+		newKdfInputAttribute(s.NetworkName()),
+		eap.NewAttribute(akaprime.AT_KDF, uint16Bytes(akaprime.KDF_AKA_PRIME)))
+	if err == nil {
+		p, err = appendEncrypted(p, lockedCtx.K_encr, newIdentityAttribute(aka.AT_NEXT_REAUTH_ID, lockedCtx.ReauthId))
+	}
+	if err == nil {
+		p, err = akaprime.AppendMac(p, lockedCtx.K_aut)
+	}
+	if err != nil {
+		return akaprime.EapErrorResPacket(
+			identifier-1, aka.NOTIFICATION_FAILURE, codes.Internal, "Error creating AKA' Challenge: %v", err)
+	}
+	return p, nil
+}
+
+// createReauthRequest returns EAP-Request/AKA'-Reauthentication for the peer's re-authentication context,
+// the context must be locked
+func createReauthRequest(
+	lockedCtx *aka_servicers.UserCtx,
+	rc *servicers.ReauthCtx,
+	nextReauthId string,
+	identifier uint8) (eap.Packet, error) {
+
+	nonceS := make([]byte, akaprime.NONCE_LEN)
+	if _, err := rand.Read(nonceS); err != nil {
+		return nil, err
+	}
+	identifier++
+	lockedCtx.Identifier = identifier
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{akaprime.TYPE, byte(aka.SubtypeReauthentication), 0, 0})
+	p, err := appendEncrypted(p, rc.K_encr,
+		eap.NewAttribute(aka.AT_COUNTER, uint16Bytes(rc.Counter)),
+		eap.NewAttribute(aka.AT_NONCE_S, append([]byte{0, 0}, nonceS...)),
+		newIdentityAttribute(aka.AT_NEXT_REAUTH_ID, nextReauthId))
+	if err != nil {
+		return nil, err
+	}
+	p, err = akaprime.AppendMac(p, rc.K_aut)
+	if err != nil {
+		return nil, err
+	}
+	rc.NonceS, rc.NextId = nonceS, nextReauthId
+	return p, nil
+}
+
+// appendEncrypted appends AT_IV & AT_ENCR_DATA attributes with encrypted attrs to the packet
+func appendEncrypted(p eap.Packet, K_encr []byte, attrs ...eap.Attribute) (eap.Packet, error) {
+	iv := make([]byte, akaprime.IV_LEN)
+	if _, err := rand.Read(iv); err != nil {
+		return p, err
+	}
+	var plain []byte
+	for _, a := range attrs {
+		plain = append(plain, a.Marshaled()...)
+	}
+	encrypted, err := akaprime.Encrypt(plain, K_encr, iv)
+	if err != nil {
+		return p, err
+	}
+	return appendAttributes(p,
+		eap.NewAttribute(aka.AT_IV, append([]byte{0, 0}, iv...)),
+		eap.NewAttribute(aka.AT_ENCR_DATA, append([]byte{0, 0}, encrypted...)))
+}
+
+// decryptAttributes decrypts AT_ENCR_DATA of the packet & returns the encrypted attributes
+func decryptAttributes(atIv, atEncrData eap.Attribute, K_encr []byte) (map[eap.AttrType]eap.Attribute, error) {
+	if atIv == nil || atEncrData == nil {
+		return nil, fmt.Errorf("Missing AT_IV | AT_ENCR_DATA")
+	}
+	if len(atIv.Value()) < 2+akaprime.IV_LEN {
+		return nil, fmt.Errorf("Malformed AT_IV")
+	}
+	if len(atEncrData.Value()) < 2 {
+		return nil, fmt.Errorf("Malformed AT_ENCR_DATA")
+	}
+	plain, err := akaprime.Decrypt(atEncrData.Value()[2:], K_encr, atIv.Value()[2:2+akaprime.IV_LEN])
+	if err != nil {
+		return nil, err
+	}
+	return akaprime.ScanAttributes(plain)
+}
+
+func appendAttributes(p eap.Packet, attrs ...eap.Attribute) (eap.Packet, error) {
+	var err error
+	for _, a := range attrs {
+		if p, err = p.Append(a); err != nil {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+// newKdfInputAttribute returns AT_KDF_INPUT with the network name (RFC 5448, section 3.1)
+func newKdfInputAttribute(networkName string) eap.Attribute {
+	return newIdentityAttribute(akaprime.AT_KDF_INPUT, networkName)
+}
+
+// newIdentityAttribute returns an attribute with 2 bytes actual length prefixed value, such as
+// AT_NEXT_REAUTH_ID & AT_KDF_INPUT
+func newIdentityAttribute(typ eap.AttrType, value string) eap.Attribute {
+	return eap.NewAttribute(typ, append(uint16Bytes(uint16(len(value))), value...))
+}
+
+func uint16Bytes(v uint16) []byte {
+	return []byte{byte(v >> 8), byte(v)}
+}
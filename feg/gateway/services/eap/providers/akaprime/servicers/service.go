@@ -0,0 +1,137 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-AKA' GRPC service
+package servicers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/eap/providers/aka"
+	aka_servicers "magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/akaprime"
+)
+
+const reauthIdRandLen = 12
+
+// ReauthCtx - fast re-authentication context of a successfully authenticated peer
+type ReauthCtx struct {
+	sync.Mutex
+	Imsi     aka.IMSI
+	Identity string // identity of the full authentication
+	Profile  *protos.AuthenticationAnswer_UserProfile
+	K_encr,
+	K_aut,
+	K_re []byte
+	Counter uint16 // counter value of the next re-authentication
+
+	// outstanding re-authentication
+	NonceS []byte
+	NextId string
+
+	timer *time.Timer
+}
+
+// EapAkaPrimeSrv - EAP-AKA' service, it shares sessions management, timeouts & PLMN ID filtering with EAP-AKA
+type EapAkaPrimeSrv struct {
+	*aka_servicers.EapAkaSrv
+
+	// access network name for CK' & IK' derivation - Read Only
+	networkName string
+
+	rwl sync.RWMutex
+	// Map of fast re-authentication contexts keyed by re-authentication IDs
+	reauths map[string]*ReauthCtx
+}
+
+// NewEapAkaPrimeService creates new Aka' Service 'object'
+func NewEapAkaPrimeService(config *mconfig.EapAkaConfig, networkName string) (*EapAkaPrimeSrv, error) {
+	akaSrv, err := aka_servicers.NewEapAkaService(config)
+	if err != nil {
+		return nil, err
+	}
+	if len(networkName) == 0 {
+		networkName = akaprime.DefaultNetworkName
+	}
+	return &EapAkaPrimeSrv{EapAkaSrv: akaSrv, networkName: networkName, reauths: map[string]*ReauthCtx{}}, nil
+}
+
+// NetworkName returns access network name used for CK' & IK' derivation & AT_KDF_INPUT
+func (s *EapAkaPrimeSrv) NetworkName() string {
+	return s.networkName
+}
+
+// NewReauthId generates a new random fast re-authentication ID
+func (s *EapAkaPrimeSrv) NewReauthId() string {
+	b := make([]byte, reauthIdRandLen)
+	rand.Read(b)
+	return string(akaprime.ReauthIdentityPrefix) + hex.EncodeToString(b)
+}
+
+// IsReauthId returns true if identity is a known fast re-authentication ID
+func (s *EapAkaPrimeSrv) IsReauthId(identity string) bool {
+	return s.FindReauthCtx(identity) != nil
+}
+
+// AddReauthCtx adds the fast re-authentication context for the given ID, the context is removed after
+// the session timeout
+func (s *EapAkaPrimeSrv) AddReauthCtx(reauthId string, rc *ReauthCtx) {
+	if len(reauthId) == 0 || rc == nil {
+		return
+	}
+	rc.timer = time.AfterFunc(s.SessionTimeout(), func() {
+		s.rwl.Lock()
+		if s.reauths[reauthId] == rc {
+			delete(s.reauths, reauthId)
+		}
+		s.rwl.Unlock()
+	})
+	s.rwl.Lock()
+	old, exist := s.reauths[reauthId]
+	s.reauths[reauthId] = rc
+	s.rwl.Unlock()
+	if exist && old.timer != nil {
+		old.timer.Stop()
+	}
+}
+
+// FindReauthCtx returns the fast re-authentication context for given identity or nil if not found,
+// the identity's realm is ignored
+func (s *EapAkaPrimeSrv) FindReauthCtx(identity string) *ReauthCtx {
+	reauthId := ReauthIdUsername(identity)
+	s.rwl.RLock()
+	defer s.rwl.RUnlock()
+	return s.reauths[reauthId]
+}
+
+// RemoveReauthCtx removes the fast re-authentication context for the given ID & returns it
+func (s *EapAkaPrimeSrv) RemoveReauthCtx(identity string) *ReauthCtx {
+	reauthId := ReauthIdUsername(identity)
+	s.rwl.Lock()
+	rc, exist := s.reauths[reauthId]
+	delete(s.reauths, reauthId)
+	s.rwl.Unlock()
+	if exist && rc.timer != nil {
+		rc.timer.Stop()
+	}
+	return rc
+}
+
+// ReauthIdUsername returns username part of a fast re-authentication identity, peers may append realm to it
+func ReauthIdUsername(identity string) string {
+	if idx := strings.Index(identity, "@"); idx > 0 {
+		return identity[:idx]
+	}
+	return identity
+}
@@ -11,8 +11,10 @@ package registry
 
 import (
 	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/akaprime"
 )
 
 func init() {
 	Register(aka.New())
+	Register(akaprime.New())
 }
@@ -12,6 +12,7 @@ package test
 import (
 	"fmt"
 	"math/rand"
+	"testing"
 	"time"
 
 	"golang.org/x/net/context"
@@ -19,6 +20,10 @@ import (
 	"google.golang.org/grpc/status"
 
 	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	eapp "magma/feg/gateway/services/eap/protos"
+	akaprime_servicers "magma/feg/gateway/services/eap/providers/akaprime/servicers"
+	"magma/orc8r/cloud/go/test_utils"
 )
 
 // SwxProxy - test SwxProxy proxy implementation
@@ -101,3 +106,14 @@ func (s NoUseSwxProxy) Deregister(
 ) (*protos.RegistrationAnswer, error) {
 	return &protos.RegistrationAnswer{}, fmt.Errorf("Deregister is NOT IMPLEMENTED")
 }
+
+// StartAkaPrimeService starts a test EAP AKA' service with the default configuration
+func StartAkaPrimeService(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA_PRIME)
+	servicer, err := akaprime_servicers.NewEapAkaPrimeService(nil, "")
+	if err != nil {
+		t.Fatalf("failed to create EAP AKA' Service: %v", err)
+	}
+	eapp.RegisterEapServiceServer(srv.GrpcServer, servicer)
+	go srv.RunTest(lis)
+}
@@ -80,3 +80,16 @@ func PurgeUE(req *protos.PurgeUERequest) (*protos.PurgeUEAnswer, error) {
 	}
 	return cli.PurgeUE(context.Background(), req)
 }
+
+// Notify sends NOR (Code 323) over diameter connection,
+// waits (blocks) for NOA & returns its RPC representation
+func Notify(req *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+	if req == nil {
+		return nil, errors.New("Invalid Notify Request")
+	}
+	cli, err := getS6aProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.Notify(context.Background(), req)
+}
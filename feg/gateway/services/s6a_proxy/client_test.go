@@ -76,6 +76,20 @@ func TestS6aProxyClient(t *testing.T) {
 		t.Errorf("Unexpected PUA Error Code: %d", r.ErrorCode)
 	}
 
+	noReq := &protos.NotifyRequest{
+		UserName:           test.TEST_IMSI,
+		UeReachableFromMme: true,
+	}
+	// NOR
+	noResp, err := s6a_proxy.Notify(noReq)
+	if err != nil {
+		t.Fatalf("GRPC NOR Error: %v", err)
+	}
+	t.Logf("GRPC NOA: %#+v", *noResp)
+	if noResp.ErrorCode != protos.ErrorCode_SUCCESS {
+		t.Errorf("Unexpected NOA Error Code: %d", noResp.ErrorCode)
+	}
+
 	// Disable connections and ensure subsequent requests fail
 	disableReq := &protos.DisableMessage{
 		DisablePeriodSecs: 10,
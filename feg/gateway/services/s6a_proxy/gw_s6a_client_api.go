@@ -22,7 +22,11 @@ import (
 )
 
 func getCloudConn() (*grpc.ClientConn, error) {
-	conn, err := registry.NewCloudRegistry().GetCloudConnection(feg_relay.ServiceName)
+	return getCloudConnFromRegistry(registry.NewCloudRegistry())
+}
+
+func getCloudConnFromRegistry(cloudRegistry registry.CloudRegistry) (*grpc.ClientConn, error) {
+	conn, err := cloudRegistry.GetCloudConnection(feg_relay.ServiceName)
 	if err != nil {
 		errMsg := fmt.Sprintf("Failed to establish connection to cloud FegToGwRelayClient: %s", err)
 		glog.Error(errMsg)
@@ -52,3 +56,29 @@ func GWS6AProxyReset(in *protos.ResetRequest) (*protos.ResetAnswer, error) {
 	client := protos.NewS6AGatewayServiceClient(conn)
 	return client.Reset(context.Background(), in)
 }
+
+// GWS6AProxyInsertSubscriberData forwards IDR to Controller through the given cloud registry
+func GWS6AProxyInsertSubscriberData(
+	cloudRegistry registry.CloudRegistry, in *protos.InsertSubscriberDataRequest) (*protos.InsertSubscriberDataAnswer, error) {
+
+	conn, err := getCloudConnFromRegistry(cloudRegistry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS6AGatewayServiceClient(conn)
+	return client.InsertSubscriberData(context.Background(), in)
+}
+
+// GWS6AProxyDeleteSubscriberData forwards DSR to Controller through the given cloud registry
+func GWS6AProxyDeleteSubscriberData(
+	cloudRegistry registry.CloudRegistry, in *protos.DeleteSubscriberDataRequest) (*protos.DeleteSubscriberDataAnswer, error) {
+
+	conn, err := getCloudConnFromRegistry(cloudRegistry)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewS6AGatewayServiceClient(conn)
+	return client.DeleteSubscriberData(context.Background(), in)
+}
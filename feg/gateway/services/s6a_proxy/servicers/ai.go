@@ -28,10 +28,6 @@ import (
 
 // sendAIR - sends AIR with given Session ID (sid)
 func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
 	var irp uint32
 	if req.ImmediateResponsePreferred {
 		irp = 1
@@ -61,7 +57,7 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 	}
 	m.NewAVP(avp.RequestedEUTRANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, authInfo)
 
-	err = c.SendRequest(m, retryCount)
+	err := s.peers.SendRequest(m, sid, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
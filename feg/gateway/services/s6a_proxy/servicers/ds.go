@@ -0,0 +1,65 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR messages over diameter connection,
+// waits (blocks) for diameter's AIAs, ULAs & returns their RPC representation
+// It also handles DSR, sends sync rpc request to gateway, then returns a DSA over diameter connection.
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/s6a_proxy"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/golang/glog"
+)
+
+// S6a DSR
+func handleDSR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling DSR\n")
+		var code protos.ErrorCode
+		var dsr DSR
+		err := m.Unmarshal(&dsr)
+		if err != nil {
+			glog.Errorf("DSR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			code, err = s.forwardDSRToGateway(&dsr)
+			if err != nil {
+				glog.Errorf("Failed to forward DSR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendSubscriberDataAnswer(c, m, code, dsr.SessionID, dsr.AuthSessionState, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send DSA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent DSA\n")
+		}
+	}
+}
+
+func (s *s6aProxy) forwardDSRToGateway(dsr *DSR) (protos.ErrorCode, error) {
+	in := &protos.DeleteSubscriberDataRequest{
+		UserName:   dsr.UserName,
+		DsrFlags:   dsr.DSRFlags,
+		ContextIds: dsr.ContextIdentifiers,
+	}
+	res, err := s6a_proxy.GWS6AProxyDeleteSubscriberData(s.CloudRegistry, in)
+	if err != nil {
+		if res != nil {
+			return res.ErrorCode, err
+		}
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	return res.ErrorCode, nil
+}
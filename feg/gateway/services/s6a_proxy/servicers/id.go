@@ -0,0 +1,112 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR messages over diameter connection,
+// waits (blocks) for diameter's AIAs, ULAs & returns their RPC representation
+// It also handles IDR, sends sync rpc request to gateway, then returns an IDA over diameter connection.
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+)
+
+// S6a IDR
+func handleIDR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling IDR\n")
+		var code protos.ErrorCode
+		var idr IDR
+		err := m.Unmarshal(&idr)
+		if err != nil {
+			glog.Errorf("IDR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			code, err = s.forwardIDRToGateway(&idr)
+			if err != nil {
+				glog.Errorf("Failed to forward IDR to gateway. err: %v. Retries left: %v\n", err, retries)
+			} else {
+				break
+			}
+		}
+		err = s.sendSubscriberDataAnswer(c, m, code, idr.SessionID, idr.AuthSessionState, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send IDA: %s", err.Error())
+		} else {
+			glog.V(2).Infof("Successfully sent IDA\n")
+		}
+	}
+}
+
+func (s *s6aProxy) forwardIDRToGateway(idr *IDR) (protos.ErrorCode, error) {
+	data := idr.SubscriptionData
+	in := &protos.InsertSubscriberDataRequest{
+		UserName: idr.UserName,
+		Msisdn:   data.MSISDN.Serialize(),
+		IdrFlags: idr.IDRFlags,
+	}
+	if data.NetworkAccessMode != nil {
+		in.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(*data.NetworkAccessMode)
+		in.NetworkAccessModePresent = true
+	}
+	if data.AMBR != nil {
+		in.TotalAmbr = &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
+			MaxBandwidthUl: data.AMBR.MaxRequestedBandwidthUL,
+			MaxBandwidthDl: data.AMBR.MaxRequestedBandwidthDL,
+		}
+	}
+	if profile := data.APNConfigurationProfile; profile != nil {
+		in.ApnConfigurationPresent = true
+		in.DefaultContextId = profile.ContextIdentifier
+		in.AllApnsIncluded = profile.AllAPNConfigurationsIncludedIndicator == 0
+		in.Apn = convertAPNConfigs(profile.APNConfigs)
+	}
+	res, err := s6a_proxy.GWS6AProxyInsertSubscriberData(s.CloudRegistry, in)
+	if err != nil {
+		if res != nil {
+			return res.ErrorCode, err
+		}
+		return protos.ErrorCode_UNABLE_TO_DELIVER, err
+	}
+	return res.ErrorCode, nil
+}
+
+// sendSubscriberDataAnswer sends an IDA or DSA with the gateway's error code.
+// 3GPP specific errors are sent as Experimental-Result, other errors are
+// mapped to a Result-Code as for CLA.
+func (s *s6aProxy) sendSubscriberDataAnswer(
+	c diam.Conn, m *diam.Message, code protos.ErrorCode, sessionID string, authSessionState int32, retries uint) error {
+
+	var ans *diam.Message
+	if code == protos.ErrorCode_USER_UNKNOWN || code >= protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION {
+		ans = m.Answer(0)
+		ans.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(code)),
+			},
+		})
+	} else {
+		ans = m.Answer(uint32(mapProtoToDiamResult(code)))
+	}
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(authSessionState))
+	s.addDiamOriginAVPs(ans)
+
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
@@ -0,0 +1,138 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR, PUR, NOR messages over diameter connection,
+// waits (blocks) for diameter's AIAs, ULAs, PUAs, NOAs & returns their RPC representation
+package servicers
+
+import (
+	"log"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+)
+
+// sendNOR - sends NOR with given Session ID (sid)
+func (s *s6aProxy) sendNOR(sid string, req *protos.NotifyRequest, retryCount uint) error {
+	m := diameter.NewProxiableRequest(diam.Notify, diam.TGPP_S6A_APP_ID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
+	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	s.addDiamOriginAVPs(m)
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.UserName))
+	if len(req.PgwHost) > 0 {
+		m.NewAVP(avp.MIP6AgentInfo, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.MIPHomeAgentHost, avp.Mbit, 0, &diam.GroupedAVP{
+					AVP: []*diam.AVP{
+						diam.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.clientCfg.Realm)),
+						diam.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(req.PgwHost)),
+					},
+				}),
+			},
+		})
+	}
+	if req.ContextId != 0 {
+		m.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(req.ContextId))
+	}
+	if len(req.ServiceSelection) > 0 {
+		m.NewAVP(avp.ServiceSelection, avp.Mbit, 0, datatype.UTF8String(req.ServiceSelection))
+	}
+	var norFlags uint32
+	if req.SingleRegistrationIndication {
+		norFlags |= NORFlags_SingleRegistrationIndication
+	}
+	if req.UeReachableFromMme {
+		norFlags |= NORFlags_UEReachableFromMME
+	}
+	if req.ReadyForSmFromMme {
+		norFlags |= NORFlags_ReadyForSMFromMME
+	}
+	if norFlags != 0 {
+		m.NewAVP(avp.NORFlags, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(norFlags))
+	}
+
+	err := s.peers.SendRequest(m, sid, retryCount)
+	if err != nil {
+		err = Error(codes.DataLoss, err)
+	}
+	return err
+}
+
+// S6a NOA
+func handleNOA(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var noa NOA
+		err := m.Unmarshal(&noa)
+		if err != nil {
+			log.Printf("NOA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := s.requestTracker.DeregisterRequest(noa.SessionID)
+		if ch != nil {
+			ch <- &noa
+		} else {
+			log.Printf("NOA SessionID %s not found. Message: %s, Remote: %s", noa.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
+
+// NotifyImpl sends NOR over diameter connection,
+// waits (blocks) for NOA & returns its RPC representation
+func (s *s6aProxy) NotifyImpl(req *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+	res := &protos.NotifyAnswer{}
+	if req == nil {
+		return res, Errorf(codes.InvalidArgument, "Nil NO Request")
+	}
+
+	sid := s.genSID()
+	ch := make(chan interface{})
+	s.requestTracker.RegisterRequest(sid, ch)
+	// if request hasn't been removed by end of transaction, remove it
+	defer s.requestTracker.DeregisterRequest(sid)
+
+	var (
+		err     error
+		retries uint = MAX_DIAM_RETRIES
+	)
+
+	err = s.sendNOR(sid, req, retries)
+
+	if err != nil {
+		log.Printf("Error sending NOR with SID %s: %v", sid, err)
+	}
+	if err == nil {
+		select {
+		case resp, open := <-ch:
+			if open {
+				noa, ok := resp.(*NOA)
+				if ok {
+					err = diameter.TranslateDiamResultCode(noa.ResultCode)
+					if noa.ResultCode != 0 {
+						res.ErrorCode = protos.ErrorCode(noa.ResultCode)
+					} else {
+						res.ErrorCode = protos.ErrorCode(noa.ExperimentalResult.ExperimentalResultCode)
+					}
+					return res, err // the only successful "exit" is here
+				}
+				err = Errorf(codes.Internal, "Invalid Response Type: %T, NOA expected.", resp)
+			} else {
+				err = Errorf(codes.Aborted, "NOR for Session ID: %s is canceled", sid)
+			}
+		case <-time.After(time.Second * TIMEOUT_SECONDS):
+			err = Errorf(codes.DeadlineExceeded, "NOR Timed Out for Session ID: %s", sid)
+		}
+	}
+	return res, err
+}
@@ -17,6 +17,14 @@ import (
 const (
 	// 3GPP 29.273 5.2.3.6
 	RadioAccessTechnologyType_EUTRAN = 1004
+
+	// DSR-Flags bits, 3GPP 29.272 Table 7.3.25/1
+	DSRFlags_PDNSubscriptionContextsWithdrawal = 1 << 3
+
+	// NOR-Flags bits, 3GPP 29.272 Table 7.3.49/1
+	NORFlags_SingleRegistrationIndication = 1 << 0
+	NORFlags_UEReachableFromMME           = 1 << 3
+	NORFlags_ReadyForSMFromMME            = 1 << 6
 )
 
 // Definitions for AIA, see sample below:
@@ -343,3 +351,164 @@ type ULR struct {
 	RATType          datatype.Unsigned32       `avp:"RAT-Type"`
 	ULRFlags         datatype.Unsigned32       `avp:"ULR-Flags"`
 }
+
+// IDR is Go representation of Insert-Subscriber-Data-Request message
+//
+// < Insert-Subscriber-Data-Request> ::= < Diameter Header: 319, REQ, PXY, 16777251 >
+//
+// < Session-Id >
+// [ Vendor-Specific-Application-Id ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// { Destination-Host }
+// { Destination-Realm }
+// { User-Name }
+// *[ Supported-Features ]
+// { Subscription-Data }
+// [ IDR-Flags ]
+// *[ AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type IDR struct {
+	SessionID        string                    `avp:"Session-Id"`
+	AuthSessionState int32                     `avp:"Auth-Session-State"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost  datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName         string                    `avp:"User-Name"`
+	SubscriptionData IDRSubscriptionData       `avp:"Subscription-Data"`
+	IDRFlags         uint32                    `avp:"IDR-Flags"`
+}
+
+// IDRSubscriptionData is the Subscription-Data of an IDR, which only carries
+// the data to add or replace at the MME. Optional AVPs are pointers, nil if absent.
+type IDRSubscriptionData struct {
+	MSISDN                  datatype.OctetString     `avp:"MSISDN"`
+	NetworkAccessMode       *int32                   `avp:"Network-Access-Mode"`
+	AMBR                    *AMBR                    `avp:"AMBR"`
+	APNConfigurationProfile *APNConfigurationProfile `avp:"APN-Configuration-Profile"`
+}
+
+// DSR is Go representation of Delete-Subscriber-Data-Request message
+//
+// < Delete-Subscriber-Data-Request > ::= < Diameter Header: 320, REQ, PXY, 16777251 >
+//
+// < Session-Id >
+// [ Vendor-Specific-Application-Id ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// { Destination-Host }
+// { Destination-Realm }
+// { User-Name }
+// *[ Supported-Features ]
+// { DSR-Flags }
+// *[ Context-Identifier ]
+// *[ AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type DSR struct {
+	SessionID          string                    `avp:"Session-Id"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DestinationHost    datatype.DiameterIdentity `avp:"Destination-Host"`
+	DestinationRealm   datatype.DiameterIdentity `avp:"Destination-Realm"`
+	UserName           string                    `avp:"User-Name"`
+	DSRFlags           uint32                    `avp:"DSR-Flags"`
+	ContextIdentifiers []uint32                  `avp:"Context-Identifier"`
+}
+
+// IDA is Go representation of Insert-Subscriber-Data-Answer message
+//
+// < Insert-Subscriber-Data-Answer> ::= < Diameter Header: 319, PXY, 16777251 >
+//
+// < Session-Id >
+// [ Vendor-Specific-Application-Id ]
+// *[ Supported-Features ]
+// [ Result-Code ]
+// [ Experimental-Result ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// [ IDA-Flags ]
+// *[ AVP ]
+// [ Failed-AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type IDA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	IDAFlags           uint32                    `avp:"IDA-Flags"`
+}
+
+// DSA is Go representation of Delete-Subscriber-Data-Answer message
+//
+// < Delete-Subscriber-Data-Answer> ::= < Diameter Header: 320, PXY, 16777251 >
+//
+// < Session-Id >
+// [ Vendor-Specific-Application-Id ]
+// *[ Supported-Features ]
+// [ Result-Code ]
+// [ Experimental-Result ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// [ DSA-Flags ]
+// *[ AVP ]
+// [ Failed-AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type DSA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DSAFlags           uint32                    `avp:"DSA-Flags"`
+}
+
+// NOA is Go representation of Notify-Answer message
+//
+// < Notify-Answer> ::= < Diameter Header: 323, PXY, 16777251 >
+//
+// < Session-Id >
+// [ Vendor-Specific-Application-Id ]
+// [ Result-Code ]
+// [ Experimental-Result ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// *[ Supported-Features ]
+// *[ AVP ]
+// [ Failed-AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type NOA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+}
+
+// NOR is Go representation of Notify-Request message, used by the HSS to
+// parse NORs. See the Notify-Request definition in go-diameter's S6a dictionary
+type NOR struct {
+	SessionID        string                    `avp:"Session-Id"`
+	AuthSessionState int32                     `avp:"Auth-Session-State"`
+	OriginHost       datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm      datatype.DiameterIdentity `avp:"Origin-Realm"`
+	UserName         string                    `avp:"User-Name"`
+	ContextID        uint32                    `avp:"Context-Identifier"`
+	ServiceSelection string                    `avp:"Service-Selection"`
+	NORFlags         uint32                    `avp:"NOR-Flags"`
+}
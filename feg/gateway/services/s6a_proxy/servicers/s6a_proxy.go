@@ -16,6 +16,7 @@ import (
 
 	"magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/registry"
 	"magma/feg/gateway/services/s6a_proxy/metrics"
 	orcprotos "magma/orc8r/cloud/go/protos"
 
@@ -39,9 +40,12 @@ type s6aProxy struct {
 	serverCfg      *diameter.DiameterServerConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peers          *diameter.PeerGroup
 	requestTracker *diameter.RequestTracker
 	healthTracker  *metrics.S6aHealthTracker
 	originStateID  uint32
+	// CloudRegistry is used to relay HSS initiated requests to the gateway
+	CloudRegistry registry.CloudRegistry
 }
 
 func NewS6aProxy(
@@ -96,17 +100,25 @@ func NewS6aProxy(
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, serverCfg)
+	requestTracker := diameter.NewRequestTracker()
+	// route requests through the HSS and its alternate peers (if any)
+	peers, err := diameter.NewPeerGroup(smClient, connMan, requestTracker, serverCfg)
+	if err != nil {
+		return nil, err
+	}
+	// create connections in connection map
+	peers.Connect()
 
 	proxy := &s6aProxy{
 		clientCfg:      clientCfg,
 		serverCfg:      serverCfg,
 		smClient:       smClient,
 		connMan:        connMan,
-		requestTracker: diameter.NewRequestTracker(),
+		peers:          peers,
+		requestTracker: requestTracker,
 		healthTracker:  metrics.NewS6aHealthTracker(),
 		originStateID:  originStateID,
+		CloudRegistry:  registry.NewCloudRegistry(),
 	}
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.AuthenticationInformation, Request: false},
@@ -127,6 +139,18 @@ func NewS6aProxy(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Reset, Request: true},
 		handleRSR(proxy))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: InsertSubscriberData, Request: true},
+		handleIDR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: DeleteSubscriberData, Request: true},
+		handleDSR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Notify, Request: false},
+		handleNOA(proxy))
+
 	return proxy, nil
 }
 
@@ -168,6 +192,14 @@ func (s *s6aProxy) PurgeUE(ctx context.Context, req *protos.PurgeUERequest) (*pr
 	return res, err
 }
 
+// Notify sends NOR (Code 323) over diameter connection,
+// waits (blocks) for NOA & returns its RPC representation
+func (s *s6aProxy) Notify(ctx context.Context, req *protos.NotifyRequest) (*protos.NotifyAnswer, error) {
+	res, err := s.NotifyImpl(req)
+	metrics.UpdateS6aRecentRequestMetrics(err)
+	return res, err
+}
+
 // Disable closes all existing diameter connections and disables
 // connection creation for the time specified in the request
 func (s *s6aProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*orcprotos.Void, error) {
@@ -183,7 +215,7 @@ func (s *s6aProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *s6aProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
+	err := s.peers.Connect()
 	return &orcprotos.Void{}, err
 }
 
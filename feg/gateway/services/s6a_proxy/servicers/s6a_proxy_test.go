@@ -21,6 +21,10 @@ import (
 	"magma/feg/gateway/services/s6a_proxy/servicers/test"
 	orcprotos "magma/orc8r/cloud/go/protos"
 
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
@@ -135,6 +139,22 @@ func TestS6aProxyService(t *testing.T) {
 		if puResp.ErrorCode != protos.ErrorCode_SUCCESS {
 			t.Errorf("Unexpected PUA Error Code: %d", puResp.ErrorCode)
 		}
+
+		noReq := &protos.NotifyRequest{
+			UserName:           test.TEST_IMSI,
+			UeReachableFromMme: true,
+		}
+		// NOR
+		noResp, err := c.Notify(context.Background(), noReq)
+		if err != nil {
+			t.Fatalf("GRPC NOR Error: %v", err)
+			complChan <- err
+			return
+		}
+		t.Logf("GRPC NOA: %#+v", *noResp)
+		if noResp.ErrorCode != protos.ErrorCode_SUCCESS {
+			t.Errorf("Unexpected NOA Error Code: %d", noResp.ErrorCode)
+		}
 		complChan <- nil
 	}
 	go testLoopF()
@@ -197,3 +217,27 @@ func TestS6aProxyService(t *testing.T) {
 		t.Errorf("Unexpected AIA Error Code: %d", airResp.ErrorCode)
 	}
 }
+
+// TestIDRSubscriptionData checks that optional Subscription-Data AVPs absent
+// from an IDR are left unset
+func TestIDRSubscriptionData(t *testing.T) {
+	m := diam.NewRequest(servicers.InsertSubscriberData, diam.TGPP_S6A_APP_ID, dict.Default)
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(test.TEST_IMSI))
+	m.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString("12345")),
+		},
+	})
+	var idr servicers.IDR
+	err := m.Unmarshal(&idr)
+	if err != nil {
+		t.Fatalf("IDR Unmarshal Error: %v", err)
+	}
+	data := idr.SubscriptionData
+	if string(data.MSISDN) != "12345" {
+		t.Errorf("Unexpected MSISDN: %v", data.MSISDN)
+	}
+	if data.NetworkAccessMode != nil || data.AMBR != nil || data.APNConfigurationProfile != nil {
+		t.Errorf("Unexpected Subscription-Data: %#+v", data)
+	}
+}
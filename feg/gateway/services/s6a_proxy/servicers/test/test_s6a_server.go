@@ -54,6 +54,10 @@ func StartTestS6aServer(network, addr string) error {
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.PurgeUE, Request: true},
 		testHandlePUR(settings))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Notify, Request: true},
+		testHandleNOR(settings))
+
 	// Catch All
 	mux.HandleIdx(diam.ALL_CMD_INDEX, testHandleALL(results))
 
@@ -252,6 +256,33 @@ func testSendPUA(w io.Writer, m *diam.Message) (n int64, err error) {
 	return m.WriteTo(w)
 }
 
+func testHandleNOR(settings *sm.Settings) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var req servicers.NOR
+		var code uint32
+
+		err := m.Unmarshal(&req)
+		if err != nil {
+			fmt.Printf("NOR Unmarshal for message: %s failed: %s", m, err)
+			code = diam.UnableToComply
+		} else {
+			code = diam.Success
+		}
+
+		a := m.Answer(code)
+		// SessionID is required to be the AVP in position 1
+		a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(req.SessionID)))
+		a.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(req.AuthSessionState))
+		a.NewAVP(avp.OriginHost, avp.Mbit, 0, settings.OriginHost)
+		a.NewAVP(avp.OriginRealm, avp.Mbit, 0, settings.OriginRealm)
+
+		_, err = a.WriteTo(c)
+		if err != nil {
+			fmt.Printf("Failed to send NOA: %s", err.Error())
+		}
+	}
+}
+
 func testPrintErrors(ec <-chan *diam.ErrorReport) {
 	for err := range ec {
 		fmt.Printf("Error: %v for Message: %s", err.Error, err.Message)
@@ -28,10 +28,6 @@ import (
 
 // sendULR - sends ULR with given Session ID (sid)
 func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
 	m := diameter.NewProxiableRequest(diam.UpdateLocation, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	s.addDiamOriginAVPs(m)
@@ -41,7 +37,7 @@ func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryC
 	m.NewAVP(avp.ULRFlags, avp.Vbit|avp.Mbit, uint32(diameter.Vendor3GPP), datatype.Unsigned32(ULR_FLAGS))
 	m.NewAVP(avp.VisitedPLMNID, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.OctetString(req.VisitedPlmn))
 
-	err = c.SendRequest(m, retryCount)
+	err := s.peers.SendRequest(m, sid, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
@@ -111,25 +107,7 @@ func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*proto
 						ula.SubscriptionData.APNConfigurationProfile.AllAPNConfigurationsIncludedIndicator == 0
 					res.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(ula.SubscriptionData.NetworkAccessMode)
 
-					for _, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
-						res.Apn = append(
-							res.Apn,
-							&protos.UpdateLocationAnswer_APNConfiguration{
-								ContextId:        apnCfg.ContextIdentifier,
-								Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
-								ServiceSelection: apnCfg.ServiceSelection,
-								QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
-									ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
-									PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
-									PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
-									PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
-								},
-								Ambr: &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
-									MaxBandwidthUl: apnCfg.AMBR.MaxRequestedBandwidthUL,
-									MaxBandwidthDl: apnCfg.AMBR.MaxRequestedBandwidthDL,
-								},
-							})
-					}
+					res.Apn = convertAPNConfigs(ula.SubscriptionData.APNConfigurationProfile.APNConfigs)
 					return res, err
 				} else {
 					err = Errorf(codes.Internal, "Invalid Response Type: %T, ULA expected.", resp)
@@ -145,3 +123,28 @@ func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*proto
 	}
 	return res, err
 }
+
+// convertAPNConfigs returns the RPC representation of APN-Configuration AVPs
+func convertAPNConfigs(apnConfigs []APNConfiguration) []*protos.UpdateLocationAnswer_APNConfiguration {
+	var res []*protos.UpdateLocationAnswer_APNConfiguration
+	for _, apnCfg := range apnConfigs {
+		res = append(
+			res,
+			&protos.UpdateLocationAnswer_APNConfiguration{
+				ContextId:        apnCfg.ContextIdentifier,
+				Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
+				ServiceSelection: apnCfg.ServiceSelection,
+				QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
+					ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
+					PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
+					PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
+					PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
+				},
+				Ambr: &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
+					MaxBandwidthUl: apnCfg.AMBR.MaxRequestedBandwidthUL,
+					MaxBandwidthDl: apnCfg.AMBR.MaxRequestedBandwidthDL,
+				},
+			})
+	}
+	return res
+}
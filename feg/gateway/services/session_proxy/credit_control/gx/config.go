@@ -70,10 +70,12 @@ func GetPCRFConfiguration() *diameter.DiameterServerConfig {
 		Protocol: diameter.GetValueOrEnv(
 			diameter.NetworkFlag, GxNetworkEnv, gxCfg.GetProtocol()),
 		LocalAddr: diameter.GetValueOrEnv(
-			diameter.LocalAddrFlag, GxLocalAddr, gxCfg.GetLocalAddress())},
+			diameter.LocalAddrFlag, GxLocalAddr, gxCfg.GetLocalAddress()),
+		TLS: diameter.TLSConfigFromMconfig(gxCfg.GetTls())},
 		DestHost:        diameter.GetValueOrEnv(diameter.DestHostFlag, PCRFHostEnv, gxCfg.GetDestHost()),
 		DestRealm:       diameter.GetValueOrEnv(diameter.DestRealmFlag, PCRFRealmEnv, gxCfg.GetDestHost()),
 		DisableDestHost: diameter.GetBoolValueOrEnv(diameter.DisableDestHostFlag, DisableDestHostEnv, gxCfg.GetDisableDestHost()),
+		Peers:           diameter.PeerConfigsFromMconfig(gxCfg.GetPeers()),
 	}
 }
 
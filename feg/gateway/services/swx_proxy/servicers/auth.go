@@ -128,7 +128,7 @@ func (s *swxProxy) sendMAR(req *protos.AuthenticationRequest, sid string) (*MAA,
 	}
 
 	marStartTime := time.Now()
-	err = s.sendDiameterMsg(marMsg, MAX_DIAM_RETRIES)
+	err = s.sendDiameterMsg(marMsg, sid, MAX_DIAM_RETRIES)
 	if err != nil {
 		metrics.MARSendFailures.Inc()
 		err = status.Errorf(codes.Internal, "Error while sending MAR with SID %s: %s", sid, err)
@@ -61,7 +61,7 @@ func (s *swxProxy) sendSARExt(
 	sarMsg := s.createSAR(sid, userName, serverAssignmentType, originHost, originRealm)
 
 	sarStartTime := time.Now()
-	err := s.sendDiameterMsg(sarMsg, MAX_DIAM_RETRIES)
+	err := s.sendDiameterMsg(sarMsg, sid, MAX_DIAM_RETRIES)
 	if err != nil {
 		metrics.SARSendFailures.Inc()
 		glog.Errorf("Error while sending SAR with SID %s: %s", sid, err)
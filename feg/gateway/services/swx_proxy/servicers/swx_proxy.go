@@ -46,6 +46,7 @@ type swxProxy struct {
 	config         *SwxProxyConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peers          *diameter.PeerGroup
 	requestTracker *diameter.RequestTracker
 	originStateID  uint32
 	cache          *cache.Impl
@@ -127,15 +128,22 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, config.ServerCfg)
+	requestTracker := diameter.NewRequestTracker()
+	// route requests through the HSS and its alternate peers (if any)
+	peers, err := diameter.NewPeerGroup(smClient, connMan, requestTracker, config.ServerCfg)
+	if err != nil {
+		return nil, err
+	}
+	// create connections in connection map
+	peers.Connect()
 
 	proxy := &swxProxy{
 		config:         config,
 		smClient:       smClient,
 		connMan:        connMan,
+		peers:          peers,
 		healthTracker:  metrics.NewSwxHealthTracker(),
-		requestTracker: diameter.NewRequestTracker(),
+		requestTracker: requestTracker,
 		originStateID:  originStateID,
 		cache:          cache,
 		Relay:          &fegRelayClient{registry: registry.NewCloudRegistry()},
@@ -237,7 +245,7 @@ func (s *swxProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *swxProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
+	err := s.peers.Connect()
 	return &orcprotos.Void{}, err
 }
 
@@ -14,12 +14,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func (s *swxProxy) sendDiameterMsg(msg *diam.Message, retryCount uint) error {
-	conn, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
-	err = conn.SendRequest(msg, retryCount)
+// sendDiameterMsg sends the message with session ID sid to the HSS or one of its
+// alternate peers
+func (s *swxProxy) sendDiameterMsg(msg *diam.Message, sid string, retryCount uint) error {
+	err := s.peers.SendRequest(msg, sid, retryCount)
 	if err != nil {
 		err = status.Errorf(codes.DataLoss, err.Error())
 	}
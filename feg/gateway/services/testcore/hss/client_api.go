@@ -140,6 +140,48 @@ func DeregisterSubscriber(id string) error {
 	return err
 }
 
+// InsertSubscriberData sends the subscriber's current subscription data to
+// the MME serving the subscriber.
+// If the subscriber is not found or not served by any MME, an error is returned instead.
+// Input: The id of the subscriber whose data should be sent.
+func InsertSubscriberData(id string) error {
+	err := verifyID(id)
+	if err != nil {
+		errMsg := fmt.Errorf("Invalid InsertSubscriberDataRequest provided: %s", err)
+		return errors.New(errMsg.Error())
+	}
+	cli, err := getHSSClient()
+	if err != nil {
+		return err
+	}
+	subID := &lteprotos.SubscriberID{
+		Id: id,
+	}
+	_, err = cli.InsertSubscriberData(context.Background(), subID)
+	return err
+}
+
+// DeleteSubscriberData asks the MME serving the subscriber to delete
+// (parts of) the subscriber's data.
+// If the subscriber is not found or not served by any MME, an error is returned instead.
+// Input: The id of the subscriber, the DSR-Flags and the context ids to remove.
+func DeleteSubscriberData(req *fegprotos.DeleteSubscriberDataRequest) error {
+	if req == nil {
+		return errors.New("Invalid DeleteSubscriberDataRequest provided: request is nil")
+	}
+	err := verifyID(req.UserName)
+	if err != nil {
+		errMsg := fmt.Errorf("Invalid DeleteSubscriberDataRequest provided: %s", err)
+		return errors.New(errMsg.Error())
+	}
+	cli, err := getHSSClient()
+	if err != nil {
+		return err
+	}
+	_, err = cli.DeleteSubscriberData(context.Background(), req)
+	return err
+}
+
 func VerifySubscriberData(sub *lteprotos.SubscriberData) error {
 	if sub == nil {
 		return fmt.Errorf("subscriber is nil")
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package servicers
+
+import (
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SendDSR sends a Delete Subscriber Data Request with the given DSR-Flags
+// and context identifiers to the MME serving the subscriber
+func (srv *HomeSubscriberServer) SendDSR(sub *protos.SubscriberData, dsrFlags uint32, contextIDs []uint32) error {
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("s6a")
+	msg := srv.createS6aRequest(s6a.DeleteSubscriberData, sid, sub.GetSid().GetId())
+	msg.NewAVP(s6a.DSRFlagsAVPCode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(dsrFlags))
+	for _, contextID := range contextIDs {
+		msg.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(contextID))
+	}
+
+	resp, err := srv.sendMMERequest(sub, sid, msg)
+	if err != nil {
+		return err
+	}
+	dsa, ok := resp.(*s6a.DSA)
+	if !ok {
+		err = status.Errorf(codes.Internal, "Invalid Response Type: %T, DSA expected.", resp)
+		glog.Error(err)
+		return err
+	}
+	return translateS6aResult(dsa.ResultCode, dsa.ExperimentalResult.ExperimentalResultCode)
+}
+
+func handleDSA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var dsa s6a.DSA
+		err := m.Unmarshal(&dsa)
+		if err != nil {
+			glog.Errorf("DSA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(dsa.SessionID)
+		if ch != nil {
+			ch <- &dsa
+		} else {
+			glog.Errorf("DSA SessionID %s not found. Message: %s, Remote: %s", dsa.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
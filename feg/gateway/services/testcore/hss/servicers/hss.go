@@ -11,8 +11,10 @@ package servicers
 import (
 	"time"
 
+	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	"magma/lte/cloud/go/crypto"
 	lteprotos "magma/lte/cloud/go/protos"
@@ -113,6 +115,30 @@ func (srv *HomeSubscriberServer) DeregisterSubscriber(ctx context.Context, req *
 	return &protos.Void{}, srv.TerminateRegistration(sub)
 }
 
+// InsertSubscriberData sends the subscriber's current subscription data to
+// the MME serving the subscriber in an IDR.
+// If the subscriber is not found or not served by any MME, an error is returned instead.
+// Input: The id of the subscriber whose data should be sent.
+func (srv *HomeSubscriberServer) InsertSubscriberData(ctx context.Context, req *lteprotos.SubscriberID) (*protos.Void, error) {
+	sub, err := srv.store.GetSubscriberData(req.Id)
+	if err != nil {
+		return &protos.Void{}, storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	return &protos.Void{}, srv.SendIDR(sub)
+}
+
+// DeleteSubscriberData asks the MME serving the subscriber to delete
+// (parts of) the subscriber's data in a DSR.
+// If the subscriber is not found or not served by any MME, an error is returned instead.
+// Input: The id of the subscriber, the DSR-Flags and the context ids to remove.
+func (srv *HomeSubscriberServer) DeleteSubscriberData(ctx context.Context, req *fegprotos.DeleteSubscriberDataRequest) (*protos.Void, error) {
+	sub, err := srv.store.GetSubscriberData(req.UserName)
+	if err != nil {
+		return &protos.Void{}, storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	return &protos.Void{}, srv.SendDSR(sub, req.DsrFlags, req.ContextIds)
+}
+
 // Start begins the server and blocks, listening to the network
 // Input: a channel to signal when the server is started & return the local server address string
 // Output: error if the server could not be started
@@ -133,9 +159,18 @@ func (srv *HomeSubscriberServer) Start(started chan string) error {
 	mux.Handle(diam.ULR, srv.handleMessage(NewULA))
 	mux.Handle(diam.MAR, srv.handleMessage(NewMAA))
 	mux.Handle(diam.SAR, srv.handleMessage(NewSAA))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Notify, Request: true},
+		srv.handleMessage(NewNOA))
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: false},
 		handleRTA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.InsertSubscriberData, Request: false},
+		handleIDA(srv))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: s6a.DeleteSubscriberData, Request: false},
+		handleDSA(srv))
 
 	clientCfg := diameter.DiameterClientConfig{}
 	clientCfg.FillInDefaults()
@@ -171,6 +206,11 @@ func (srv *HomeSubscriberServer) Start(started chan string) error {
 	if err != nil {
 		return err
 	}
+	tlsCfg := diameter.TLSConfigFromMconfig(serverCfg.GetTls())
+	listener, err = diameter.NewSecureListener(listener, &tlsCfg)
+	if err != nil {
+		return err
+	}
 	localAddress := listener.Addr().String()
 	if cap(started) > len(started) {
 		started <- localAddress
@@ -126,9 +126,13 @@ func testDiameterMessage(t *testing.T, clientHandler diam.HandlerFunc, msg *diam
 // getTestHSSDiameterServer returns a test home subscriber server with a
 // running diameter server listening for new connections.
 func getTestHSSDiameterServer(t *testing.T) *hss.HomeSubscriberServer {
+	return startTestHSSDiameterServer(t, test.NewTestHomeSubscriberServer(t))
+}
+
+// startTestHSSDiameterServer starts the diameter server of the given test hss
+func startTestHSSDiameterServer(t *testing.T, hss *hss.HomeSubscriberServer) *hss.HomeSubscriberServer {
 	// Start s6a diameter server
 	result := make(chan error)
-	hss := test.NewTestHomeSubscriberServer(t)
 	started := make(chan string)
 	go func() {
 		err := hss.Start(started)
@@ -10,15 +10,27 @@ package servicers_test
 
 import (
 	"context"
+	"io/ioutil"
+	"net"
+	"os"
 	"testing"
 
 	"magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	diameter_test "magma/feg/gateway/diameter/test"
+	"magma/feg/gateway/registry"
 	"magma/feg/gateway/services/s6a_proxy/servicers"
+	relay_mocks "magma/feg/gateway/services/session_proxy/relay/mocks"
+	hss "magma/feg/gateway/services/testcore/hss/servicers"
 	"magma/feg/gateway/services/testcore/hss/servicers/test"
 	"magma/lte/cloud/go/crypto"
+	lteprotos "magma/lte/cloud/go/protos"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestAIR_Successful(t *testing.T) {
@@ -93,10 +105,176 @@ func TestULR_UnknownIMSI(t *testing.T) {
 	assert.Equal(t, 0, len(ula.Apn))
 }
 
+func TestNOR_Successful(t *testing.T) {
+	s6aProxy := getTestS6aProxy(t)
+	nor := &protos.NotifyRequest{
+		UserName:           "sub1",
+		UeReachableFromMme: true,
+	}
+
+	noa, err := s6aProxy.Notify(context.Background(), nor)
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_SUCCESS, noa.ErrorCode)
+}
+
+func TestNOR_UnknownIMSI(t *testing.T) {
+	s6aProxy := getTestS6aProxy(t)
+	nor := &protos.NotifyRequest{
+		UserName: "sub_unknown",
+	}
+
+	noa, err := s6aProxy.Notify(context.Background(), nor)
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ErrorCode_USER_UNKNOWN, noa.ErrorCode)
+}
+
+func TestIDR_NoServingMME(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	_, err := hss.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	dsr := &protos.DeleteSubscriberDataRequest{UserName: "sub1"}
+	_, err = hss.DeleteSubscriberData(context.Background(), dsr)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestIDR_UnknownIMSI(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	_, err := hss.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub_unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIDR_ForwardedByS6aProxy(t *testing.T) {
+	hss := getTestHSSDiameterServer(t)
+	s6aProxy := getTestS6aProxyForHSS(t, hss)
+	ulr := &protos.UpdateLocationRequest{
+		UserName:    "sub1",
+		VisitedPlmn: []byte{0, 0, 0},
+	}
+	_, err := s6aProxy.UpdateLocation(context.Background(), ulr)
+	assert.NoError(t, err)
+
+	sub := &lteprotos.SubscriberID{Id: "sub1"}
+	subData, err := hss.GetSubscriberData(context.Background(), sub)
+	assert.NoError(t, err)
+	assert.Equal(t, hss.Config.Server.DestHost, subData.GetState().GetMmeHost())
+
+	// The s6a proxy answers IDR & DSR after relaying them to the gateway,
+	// which isn't reachable in this test
+	_, err = hss.InsertSubscriberData(context.Background(), sub)
+	assert.EqualError(t, err, "rpc error: code = Code(3002) desc = Diameter Error: 3002 (UNABLE_TO_DELIVER)")
+
+	dsr := &protos.DeleteSubscriberDataRequest{
+		UserName:   "sub1",
+		DsrFlags:   servicers.DSRFlags_PDNSubscriptionContextsWithdrawal,
+		ContextIds: []uint32{1},
+	}
+	_, err = hss.DeleteSubscriberData(context.Background(), dsr)
+	assert.EqualError(t, err, "rpc error: code = Code(3002) desc = Diameter Error: 3002 (UNABLE_TO_DELIVER)")
+}
+
+func TestIDR_RelayedToGateway(t *testing.T) {
+	gateway, cloudRegistry := startMockS6aGateway(t)
+	hss := getTestHSSDiameterServer(t)
+	s6aProxy := getTestS6aProxyWithRegistry(t, hss, cloudRegistry)
+	ulr := &protos.UpdateLocationRequest{
+		UserName:    "sub1",
+		VisitedPlmn: []byte{0, 0, 0},
+	}
+	_, err := s6aProxy.UpdateLocation(context.Background(), ulr)
+	assert.NoError(t, err)
+
+	sub := &lteprotos.SubscriberID{Id: "sub1"}
+	_, err = hss.InsertSubscriberData(context.Background(), sub)
+	assert.NoError(t, err)
+
+	idr := <-gateway.idrs
+	assert.Equal(t, "sub1", idr.UserName)
+	assert.Equal(t, []byte("12345"), idr.Msisdn)
+	assert.True(t, idr.NetworkAccessModePresent)
+	assert.Equal(t, protos.UpdateLocationAnswer_ONLY_PACKET, idr.NetworkAccessMode)
+	assert.Equal(t, uint32(test.DefaultMaxUlBitRate), idr.GetTotalAmbr().GetMaxBandwidthUl())
+	assert.Equal(t, uint32(test.DefaultMaxDlBitRate), idr.GetTotalAmbr().GetMaxBandwidthDl())
+	assert.True(t, idr.ApnConfigurationPresent)
+	assert.True(t, idr.AllApnsIncluded)
+	assert.Equal(t, 1, len(idr.Apn))
+	assert.Equal(t, "oai.ipv4", idr.Apn[0].ServiceSelection)
+
+	dsr := &protos.DeleteSubscriberDataRequest{
+		UserName:   "sub1",
+		DsrFlags:   servicers.DSRFlags_PDNSubscriptionContextsWithdrawal,
+		ContextIds: []uint32{1, 2},
+	}
+	_, err = hss.DeleteSubscriberData(context.Background(), dsr)
+	assert.NoError(t, err)
+
+	relayedDSR := <-gateway.dsrs
+	assert.Equal(t, "sub1", relayedDSR.UserName)
+	assert.Equal(t, uint32(servicers.DSRFlags_PDNSubscriptionContextsWithdrawal), relayedDSR.DsrFlags)
+	assert.Equal(t, []uint32{1, 2}, relayedDSR.ContextIds)
+
+	// The gateway's error code is returned in the answer
+	gateway.errorCode = protos.ErrorCode_USER_UNKNOWN
+	_, err = hss.InsertSubscriberData(context.Background(), sub)
+	assert.EqualError(t, err, "rpc error: code = Code(5001) desc = Diameter Error: 5001 (USER_UNKNOWN)")
+	<-gateway.idrs
+}
+
+func TestS6aProxyTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hss_tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	files, err := diameter_test.GenerateTLSFiles(dir)
+	assert.NoError(t, err)
+
+	for _, security := range []string{diameter.SecurityTLS, diameter.SecurityInband} {
+		hss := test.NewTestHomeSubscriberServer(t)
+		hss.Config.Server.Tls = &mconfig.DiamTLSConfig{
+			Security: security,
+			CaFile:   files.CAFile,
+			CertFile: files.CertFile,
+			KeyFile:  files.KeyFile,
+		}
+		hss = startTestHSSDiameterServer(t, hss)
+		s6aProxy := getTestS6aProxyForHSS(t, hss)
+
+		air := &protos.AuthenticationInformationRequest{
+			UserName:                  "sub1",
+			VisitedPlmn:               []byte{0, 0, 0},
+			NumRequestedEutranVectors: 1,
+		}
+		aia, err := s6aProxy.AuthenticationInformation(context.Background(), air)
+		assert.NoError(t, err, security)
+		assert.Equal(t, protos.ErrorCode_UNDEFINED, aia.GetErrorCode(), security)
+		assert.Equal(t, 1, len(aia.GetEutranVectors()), security)
+
+		ulr := &protos.UpdateLocationRequest{UserName: "sub1", VisitedPlmn: []byte{0, 0, 0}}
+		_, err = s6aProxy.UpdateLocation(context.Background(), ulr)
+		assert.NoError(t, err, security)
+
+		// HSS initiated requests are sent over the same secured connection
+		_, err = hss.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+		assert.EqualError(t, err, "rpc error: code = Code(3002) desc = Diameter Error: 3002 (UNABLE_TO_DELIVER)", security)
+	}
+}
+
 // getTestS6aProxy creates a s6a proxy server and test hss diameter
 // server which are configured to communicate with each other.
 func getTestS6aProxy(t *testing.T) protos.S6AProxyServer {
-	hss := getTestHSSDiameterServer(t)
+	return getTestS6aProxyForHSS(t, getTestHSSDiameterServer(t))
+}
+
+// getTestS6aProxyForHSS creates a s6a proxy server configured to
+// communicate with the given test hss diameter server.
+func getTestS6aProxyForHSS(t *testing.T, hss *hss.HomeSubscriberServer) protos.S6AProxyServer {
+	return getTestS6aProxyWithRegistry(t, hss, registry.NewCloudRegistry())
+}
+
+// getTestS6aProxyWithRegistry creates a s6a proxy server configured to
+// communicate with the given test hss diameter server, which relays HSS
+// initiated requests through the given cloud registry.
+func getTestS6aProxyWithRegistry(
+	t *testing.T, hss *hss.HomeSubscriberServer, cloudRegistry registry.CloudRegistry) protos.S6AProxyServer {
 	serverCfg := hss.Config.Server
 
 	// Create an s6a proxy server.
@@ -114,12 +292,53 @@ func getTestS6aProxy(t *testing.T) protos.S6AProxyServer {
 		DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 			Addr:      serverCfg.Address,
 			Protocol:  serverCfg.Protocol,
-			LocalAddr: serverCfg.LocalAddress},
+			LocalAddr: serverCfg.LocalAddress,
+			TLS:       diameter.TLSConfigFromMconfig(serverCfg.GetTls())},
 		DestHost:  serverCfg.DestHost,
 		DestRealm: serverCfg.DestRealm,
 	}
 	s6aProxy, err := servicers.NewS6aProxy(clientCfg, diameterServerCfg)
 	assert.NoError(t, err)
+	s6aProxy.CloudRegistry = cloudRegistry
 
 	return s6aProxy
 }
+
+// mockS6aGateway records the requests relayed by the s6a proxy and answers
+// them with errorCode
+type mockS6aGateway struct {
+	protos.UnimplementedS6AGatewayServiceServer
+	idrs      chan *protos.InsertSubscriberDataRequest
+	dsrs      chan *protos.DeleteSubscriberDataRequest
+	errorCode protos.ErrorCode
+}
+
+func (gw *mockS6aGateway) InsertSubscriberData(
+	_ context.Context, req *protos.InsertSubscriberDataRequest) (*protos.InsertSubscriberDataAnswer, error) {
+	gw.idrs <- req
+	return &protos.InsertSubscriberDataAnswer{ErrorCode: gw.errorCode}, nil
+}
+
+func (gw *mockS6aGateway) DeleteSubscriberData(
+	_ context.Context, req *protos.DeleteSubscriberDataRequest) (*protos.DeleteSubscriberDataAnswer, error) {
+	gw.dsrs <- req
+	return &protos.DeleteSubscriberDataAnswer{ErrorCode: gw.errorCode}, nil
+}
+
+// startMockS6aGateway starts a mock gateway S6a service and returns it with
+// a cloud registry connecting to it
+func startMockS6aGateway(t *testing.T) (*mockS6aGateway, registry.CloudRegistry) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	gateway := &mockS6aGateway{
+		idrs:      make(chan *protos.InsertSubscriberDataRequest, 1),
+		dsrs:      make(chan *protos.DeleteSubscriberDataRequest, 1),
+		errorCode: protos.ErrorCode_SUCCESS,
+	}
+	grpcServer := grpc.NewServer()
+	protos.RegisterS6AGatewayServiceServer(grpcServer, gateway)
+	go grpcServer.Serve(lis)
+
+	return gateway, &relay_mocks.MockCloudRegistry{ServerAddr: lis.Addr().String()}
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package servicers
+
+import (
+	"time"
+
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SendIDR sends an Insert Subscriber Data Request with the subscriber's
+// current subscription data to the MME serving the subscriber
+func (srv *HomeSubscriberServer) SendIDR(sub *protos.SubscriberData) error {
+	profile, err := srv.getSubscriptionProfile(sub)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "InsertSubscriberData error: %s", err)
+	}
+	sid := (&diameter.DiameterClientConfig{}).GenSessionID("s6a")
+	msg := srv.createS6aRequest(s6a.InsertSubscriberData, sid, sub.GetSid().GetId())
+	msg.AddAVP(newSubscriptionDataAVP(profile))
+
+	resp, err := srv.sendMMERequest(sub, sid, msg)
+	if err != nil {
+		return err
+	}
+	ida, ok := resp.(*s6a.IDA)
+	if !ok {
+		err = status.Errorf(codes.Internal, "Invalid Response Type: %T, IDA expected.", resp)
+		glog.Error(err)
+		return err
+	}
+	return translateS6aResult(ida.ResultCode, ida.ExperimentalResult.ExperimentalResultCode)
+}
+
+// createS6aRequest creates an HSS initiated S6a request with the provided
+// command code, SessionID (sid) and userName to be sent over diameter to an MME
+func (srv *HomeSubscriberServer) createS6aRequest(code uint32, sessionID string, username string) *diam.Message {
+	msg := diameter.NewProxiableRequest(code, diam.TGPP_S6A_APP_ID, dict.Default)
+	msg.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	msg.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_S6A_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
+	// Set origin host and realm to server's host and realm since the request is sent from HSS
+	msg.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestHost))
+	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.Config.Server.DestRealm))
+	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(username))
+	return msg
+}
+
+// sendMMERequest sends the request to the MME serving the subscriber and
+// waits for its answer
+func (srv *HomeSubscriberServer) sendMMERequest(sub *protos.SubscriberData, sid string, msg *diam.Message) (interface{}, error) {
+	mmeHost := sub.GetState().GetMmeHost()
+	if mmeHost == "" {
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "No MME found for subscriber: %s. Cannot send %s", sub.GetSid().GetId(), commandName(msg))
+	}
+	mmeCfg, err := srv.genClientServerConfig(mmeHost)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s error: %s", commandName(msg), err)
+	}
+
+	ch := make(chan interface{})
+	srv.requestTracker.RegisterRequest(sid, ch)
+	// if request hasn't been removed by end of transaction, remove it
+	defer srv.requestTracker.DeregisterRequest(sid)
+
+	err = srv.sendDiameterMsg(msg, mmeCfg, maxDiamRetries)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case resp, open := <-ch:
+		if !open {
+			err = status.Errorf(codes.Aborted, "%s for Session ID: %s is cancelled", commandName(msg), sid)
+			glog.Error(err)
+			return nil, err
+		}
+		return resp, nil
+	case <-time.After(time.Second * timeoutSeconds):
+		err = status.Errorf(codes.DeadlineExceeded, "%s Timed Out for Session ID: %s", commandName(msg), sid)
+		glog.Error(err)
+		return nil, err
+	}
+}
+
+func commandName(msg *diam.Message) string {
+	switch msg.Header.CommandCode {
+	case s6a.InsertSubscriberData:
+		return "IDR"
+	case s6a.DeleteSubscriberData:
+		return "DSR"
+	default:
+		return "request"
+	}
+}
+
+// translateS6aResult returns an error if either the base or the experimental
+// result code of an answer indicates a failure
+func translateS6aResult(resultCode, experimentalResultCode uint32) error {
+	if err := diameter.TranslateDiamResultCode(resultCode); err != nil {
+		return err
+	}
+	// If there is no base diameter error, check that there is no experimental error either
+	return diameter.TranslateDiamResultCode(experimentalResultCode)
+}
+
+func handleIDA(srv *HomeSubscriberServer) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var ida s6a.IDA
+		err := m.Unmarshal(&ida)
+		if err != nil {
+			glog.Errorf("IDA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		ch := srv.requestTracker.DeregisterRequest(ida.SessionID)
+		if ch != nil {
+			ch <- &ida
+		} else {
+			glog.Errorf("IDA SessionID %s not found. Message: %s, Remote: %s", ida.SessionID, m, c.RemoteAddr())
+		}
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/s6a_proxy/servicers"
+	"magma/feg/gateway/services/testcore/hss/storage"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/golang/glog"
+)
+
+// NewNOA outputs a notify answer (NOA) to reply to a notify request (NOR) message.
+func NewNOA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error) {
+	var nor servicers.NOR
+	if err := msg.Unmarshal(&nor); err != nil {
+		return msg.Answer(diam.UnableToComply), fmt.Errorf("NOR Unmarshal failed for message: %v failed: %v", msg, err)
+	}
+	sessionID := datatype.UTF8String(nor.SessionID)
+
+	_, err := srv.store.GetSubscriberData(nor.UserName)
+	if err != nil {
+		if _, ok := err.(storage.UnknownSubscriberError); ok {
+			return ConstructFailureAnswer(msg, sessionID, srv.Config.Server, uint32(protos.ErrorCode_USER_UNKNOWN)), err
+		}
+		return ConstructFailureAnswer(msg, sessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
+	}
+	glog.V(2).Infof("NOR for subscriber %s: context %d, APN '%s', flags %d",
+		nor.UserName, nor.ContextID, nor.ServiceSelection, nor.NORFlags)
+	return ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID), nil
+}
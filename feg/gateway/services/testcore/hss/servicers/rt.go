@@ -33,7 +33,7 @@ func (srv *HomeSubscriberServer) TerminateRegistration(sub *protos.SubscriberDat
 	if sub.GetState().GetTgppAaaServerName() == "" {
 		return fmt.Errorf("No AAA server found for subscriber: %s. Cannot send RTR", sub.GetSid().GetId())
 	}
-	aaaServerCfg, err := srv.genAAAServerConfig(sub.GetState().GetTgppAaaServerName())
+	aaaServerCfg, err := srv.genClientServerConfig(sub.GetState().GetTgppAaaServerName())
 	if err != nil {
 		return fmt.Errorf("TerminateRegistration error: %s", err)
 	}
@@ -122,7 +122,9 @@ func (srv *HomeSubscriberServer) deregisterSubscriber(subscriber *protos.Subscri
 	return srv.store.UpdateSubscriber(subscriber)
 }
 
-func (srv *HomeSubscriberServer) genAAAServerConfig(serverName string) (*diameter.DiameterServerConfig, error) {
+// genClientServerConfig returns the config needed to send HSS initiated
+// requests to a diameter client (AAA server or MME) which connected to the HSS
+func (srv *HomeSubscriberServer) genClientServerConfig(serverName string) (*diameter.DiameterServerConfig, error) {
 	var destRealm string
 	splitServerName := strings.Split(serverName, ".")
 	if len(splitServerName) < 2 {
@@ -132,7 +134,7 @@ func (srv *HomeSubscriberServer) genAAAServerConfig(serverName string) (*diamete
 	}
 	addr, ok := srv.clientMapping[serverName]
 	if !ok {
-		return nil, fmt.Errorf("could not find IP address for AAA server: %s", serverName)
+		return nil, fmt.Errorf("could not find IP address for diameter client: %s", serverName)
 	}
 	return &diameter.DiameterServerConfig{
 		DestHost:  serverName,
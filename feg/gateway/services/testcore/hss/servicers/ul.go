@@ -17,6 +17,7 @@ import (
 	"magma/feg/gateway/diameter"
 	"magma/feg/gateway/services/s6a_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
+	lteprotos "magma/lte/cloud/go/protos"
 
 	"github.com/fiorix/go-diameter/v4/diam"
 	"github.com/fiorix/go-diameter/v4/diam/avp"
@@ -60,15 +61,10 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE)), err
 	}
 
-	profile, ok := srv.Config.SubProfiles[subscriber.SubProfile]
-	if !ok || profile == nil {
-		profile = srv.Config.DefaultSubProfile
-		if profile == nil {
-			answer := ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION))
-			return answer, fmt.Errorf("unknown subscriber profile: %s and default profile was not initialized", subscriber.SubProfile)
-		} else {
-			glog.V(2).Infof("Subscriber profile '%s' not found, using default profile instead", subscriber.SubProfile)
-		}
+	profile, err := srv.getSubscriptionProfile(subscriber)
+	if err != nil {
+		answer := ConstructFailureAnswer(msg, ulr.SessionID, srv.Config.Server, uint32(protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION))
+		return answer, err
 	}
 
 	if !isRATTypeAllowed(uint32(ulr.RATType)) {
@@ -76,16 +72,46 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return answer, fmt.Errorf("RAT-Type not allowed: %v", uint32(ulr.RATType))
 	}
 
+	// Remember the serving MME, so HSS initiated requests (IDR, DSR) can be sent to it
+	if subscriber.State == nil {
+		subscriber.State = &lteprotos.SubscriberState{}
+	}
+	subscriber.State.MmeHost = string(ulr.OriginHost)
+	err = srv.store.UpdateSubscriber(subscriber)
+	if err != nil {
+		glog.Errorf("Failed to store MME host for subscriber %s: %v", ulr.UserName, err)
+	}
+
 	return srv.NewSuccessfulULA(msg, ulr.SessionID, profile), nil
 }
 
+// getSubscriptionProfile returns the subscription profile of the subscriber,
+// or the default profile if the subscriber's profile isn't configured.
+func (srv *HomeSubscriberServer) getSubscriptionProfile(subscriber *lteprotos.SubscriberData) (*mconfig.HSSConfig_SubscriptionProfile, error) {
+	profile, ok := srv.Config.SubProfiles[subscriber.SubProfile]
+	if ok && profile != nil {
+		return profile, nil
+	}
+	if srv.Config.DefaultSubProfile == nil {
+		return nil, fmt.Errorf("unknown subscriber profile: %s and default profile was not initialized", subscriber.SubProfile)
+	}
+	glog.V(2).Infof("Subscriber profile '%s' not found, using default profile instead", subscriber.SubProfile)
+	return srv.Config.DefaultSubProfile, nil
+}
+
 // NewSuccessfulULA outputs a successful update location answer (ULA) to reply to an
 // update location request (ULR) message. It populates the ULA with all of the mandatory fields
 // and adds the subscriber profile information.
 func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID datatype.UTF8String, profile *mconfig.HSSConfig_SubscriptionProfile) *diam.Message {
 	ula := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID)
 	ula.NewAVP(avp.ULAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ulaFlags))
-	ula.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+	ula.AddAVP(newSubscriptionDataAVP(profile))
+	return ula
+}
+
+// newSubscriptionDataAVP returns the Subscription-Data AVP sent in ULAs and IDRs
+func newSubscriptionDataAVP(profile *mconfig.HSSConfig_SubscriptionProfile) *diam.AVP {
+	return diam.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(msisdn)),
 			diam.NewAVP(avp.AccessRestrictionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(accessRestrictionData)),
@@ -130,7 +156,6 @@ func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID d
 			}),
 		},
 	})
-	return ula
 }
 
 // ValidateULR returns an error if the message is missing any mandatory AVPs.
@@ -166,7 +166,7 @@ func (srv *OCSDiamServer) StartListener() (net.Listener, error) {
 	if e != nil {
 		return nil, e
 	}
-	return l, nil
+	return diameter.NewSecureListener(l, &serverConfig.TLS)
 }
 
 // NewAccount adds a subscriber to the OCS to be tracked
@@ -155,7 +155,7 @@ func (srv *PCRFDiamServer) StartListener() (net.Listener, error) {
 	if e != nil {
 		return nil, e
 	}
-	return l, nil
+	return diameter.NewSecureListener(l, &serverConfig.TLS)
 }
 
 // logErrors logs errors received during transmission
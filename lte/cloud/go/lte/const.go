@@ -8,6 +8,10 @@ LICENSE file in the root directory of this source tree.
 
 package lte
 
+import (
+	"time"
+)
+
 const ModuleName = "lte"
 
 const (
@@ -26,3 +30,14 @@ const (
 
 	RatingGroupEntityType = "rating_group"
 )
+
+// EnodebStateTTL is how long a reported enodeb state is considered current.
+// Enodeb states which aren't re-reported within this duration (e.g. from a
+// decommissioned gateway) are treated as stale and eventually deleted.
+const EnodebStateTTL = time.Hour * 24
+
+// SubscriberStateTTL is how long a reported subscriber state is considered
+// current. Gateways re-report the state of attached subscribers, so the
+// state of subscribers which detached or whose gateway was decommissioned
+// expires.
+const SubscriberStateTTL = time.Hour * 24
@@ -68,6 +68,8 @@ const (
 	ActivateSubscriberPath   = ManageSubscriberPath + obsidian.UrlSep + "activate"
 	DeactivateSubscriberPath = ManageSubscriberPath + obsidian.UrlSep + "deactivate"
 	SubscriberProfilePath    = ManageSubscriberPath + obsidian.UrlSep + "lte" + obsidian.UrlSep + "sub_profile"
+	ImportSubscribersPath    = ListSubscribersPath + obsidian.UrlSep + "import"
+	ExportSubscribersPath    = ListSubscribersPath + obsidian.UrlSep + "export"
 
 	policiesRootPath         = handlers.ManageNetworkPath + obsidian.UrlSep + "policies"
 	policyRuleRootPath       = policiesRootPath + obsidian.UrlSep + "rules"
@@ -110,6 +112,8 @@ func GetHandlers() []obsidian.Handler {
 		{Path: ActivateSubscriberPath, Methods: obsidian.POST, HandlerFunc: makeSubscriberStateHandler(ltemodels.LteSubscriptionStateACTIVE)},
 		{Path: DeactivateSubscriberPath, Methods: obsidian.POST, HandlerFunc: makeSubscriberStateHandler(ltemodels.LteSubscriptionStateINACTIVE)},
 		{Path: SubscriberProfilePath, Methods: obsidian.PUT, HandlerFunc: updateSubscriberProfile},
+		{Path: ImportSubscribersPath, Methods: obsidian.POST, HandlerFunc: importSubscribers},
+		{Path: ExportSubscribersPath, Methods: obsidian.GET, HandlerFunc: exportSubscribers},
 
 		{Path: policyBaseNameRootPath, Methods: obsidian.GET, HandlerFunc: ListBaseNames},
 		{Path: policyBaseNameRootPath, Methods: obsidian.POST, HandlerFunc: CreateBaseName},
@@ -255,12 +259,12 @@ func listEnodebs(c echo.Context) error {
 		return nerr
 	}
 
-	ents, err := configurator.LoadAllEntitiesInNetwork(
-		nid, lte.CellularEnodebType,
+	ents, nerr := handlers.LoadEntitiesForListRequest(
+		c, nid, lte.CellularEnodebType,
 		configurator.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsToThis: true},
 	)
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	if nerr != nil {
+		return nerr
 	}
 
 	ret := make(map[string]*ltemodels.Enodeb, len(ents))
@@ -287,7 +291,7 @@ func createEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "attached_gateway_id is a read-only property")
 	}
 
-	_, err := configurator.CreateEntity(nid, configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(c.Request().Context(), nid, configurator.NetworkEntity{
 		Type:       lte.CellularEnodebType,
 		Key:        payload.Serial,
 		Name:       payload.Name,
@@ -342,7 +346,7 @@ func updateEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "serial in body must match serial in path")
 	}
 
-	_, err := configurator.UpdateEntity(nid, payload.ToEntityUpdateCriteria())
+	_, err := configurator.UpdateEntity(c.Request().Context(), nid, payload.ToEntityUpdateCriteria())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -355,7 +359,7 @@ func deleteEnodeb(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(nid, lte.CellularEnodebType, eid)
+	err := configurator.DeleteEntity(c.Request().Context(), nid, lte.CellularEnodebType, eid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -401,7 +405,7 @@ func deleteConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, (&ltemodels.EnodebSerials{}).ToDeleteUpdateCriteria(networkID, gatewayID, enodebSerial))
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, (&ltemodels.EnodebSerials{}).ToDeleteUpdateCriteria(networkID, gatewayID, enodebSerial))
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -419,7 +423,7 @@ func addConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, (&ltemodels.EnodebSerials{}).ToCreateUpdateCriteria(networkID, gatewayID, enodebSerial))
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, (&ltemodels.EnodebSerials{}).ToCreateUpdateCriteria(networkID, gatewayID, enodebSerial))
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -432,9 +436,9 @@ func listSubscribers(c echo.Context) error {
 		return nerr
 	}
 
-	ents, err := configurator.LoadAllEntitiesInNetwork(networkID, lte.SubscriberEntityType, configurator.EntityLoadCriteria{LoadConfig: true})
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	ents, nerr := handlers.LoadEntitiesForListRequest(c, networkID, lte.SubscriberEntityType, configurator.EntityLoadCriteria{LoadConfig: true})
+	if nerr != nil {
+		return nerr
 	}
 
 	ret := make(map[string]*ltemodels.Subscriber, len(ents))
@@ -462,7 +466,7 @@ func createSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	_, err := configurator.CreateEntity(networkID, configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, configurator.NetworkEntity{
 		Type:   lte.SubscriberEntityType,
 		Key:    string(payload.ID),
 		Config: payload.Lte,
@@ -518,7 +522,7 @@ func updateSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	err = configurator.CreateOrUpdateEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, payload.Lte)
+	err = configurator.CreateOrUpdateEntityConfig(c.Request().Context(), networkID, lte.SubscriberEntityType, subscriberID, payload.Lte)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -531,7 +535,7 @@ func deleteSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.SubscriberEntityType, subscriberID)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, lte.SubscriberEntityType, subscriberID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -566,7 +570,7 @@ func updateSubscriberProfile(c echo.Context) error {
 		return nerr
 	}
 
-	_, err = configurator.UpdateEntity(networkID, configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg})
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg})
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to update profile"), http.StatusInternalServerError)
 	}
@@ -590,7 +594,7 @@ func makeSubscriberStateHandler(desiredState string) echo.HandlerFunc {
 
 		newConfig := cfg.(*ltemodels.LteSubscription)
 		newConfig.State = desiredState
-		err = configurator.CreateOrUpdateEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, newConfig)
+		err = configurator.CreateOrUpdateEntityConfig(c.Request().Context(), networkID, lte.SubscriberEntityType, subscriberID, newConfig)
 		if err != nil {
 			return obsidian.HttpError(err, http.StatusInternalServerError)
 		}
@@ -25,6 +25,7 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
+	orc8rhandlers "magma/orc8r/cloud/go/pluginimpl/handlers"
 	"magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/key"
@@ -495,7 +496,7 @@ func TestCellularPartialGet(t *testing.T) {
 	// add 'n2' as FegNetworkID to n1
 	cellularConfig := models2.NewDefaultTDDNetworkConfig()
 	cellularConfig.FegNetworkID = "n2"
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{
+	err := configurator.UpdateNetworks(context.Background(), []configurator.NetworkUpdateCriteria{
 		{
 			ID: "n1",
 			ConfigsToAddOrUpdate: map[string]interface{}{
@@ -724,9 +725,9 @@ func TestCreateGateway(t *testing.T) {
 	deviceTestInit.StartTestService(t)
 
 	// setup fixtures in backend
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.UpgradeTierEntityType, Key: "t1"},
@@ -916,7 +917,7 @@ func TestListAndGetGateways(t *testing.T) {
 	test_init.StartTestService(t)
 	stateTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -928,7 +929,7 @@ func TestListAndGetGateways(t *testing.T) {
 
 	// Create 2 gateways, 1 with state and device, the other without
 	// g2 will associate to 2 enodebs
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.CellularEnodebType, Key: "enb1"},
@@ -1111,7 +1112,7 @@ func TestUpdateGateway(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1119,7 +1120,7 @@ func TestUpdateGateway(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	updateGateway := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.PUT).HandlerFunc
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.CellularEnodebType, Key: "enb1"},
@@ -1255,7 +1256,7 @@ func TestDeleteGateway(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1263,7 +1264,7 @@ func TestDeleteGateway(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	deleteGateway := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.DELETE).HandlerFunc
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.CellularEnodebType, Key: "enb1"},
@@ -1339,7 +1340,7 @@ func TestGetCellularGatewayConfig(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1351,7 +1352,7 @@ func TestGetCellularGatewayConfig(t *testing.T) {
 	getNonEps := tests.GetHandlerByPathAndMethod(t, handlers, fmt.Sprintf("%s/cellular/non_eps", testURLRoot), obsidian.GET).HandlerFunc
 	getEnodebs := tests.GetHandlerByPathAndMethod(t, handlers, fmt.Sprintf("%s/connected_enodeb_serials", testURLRoot), obsidian.GET).HandlerFunc
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.CellularEnodebType, Key: "enb1"},
@@ -1449,7 +1450,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1463,7 +1464,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 	postEnodeb := tests.GetHandlerByPathAndMethod(t, handlers, fmt.Sprintf("%s/connected_enodeb_serials", testURLRoot), obsidian.POST).HandlerFunc
 	deleteEnodeb := tests.GetHandlerByPathAndMethod(t, handlers, fmt.Sprintf("%s/connected_enodeb_serials", testURLRoot), obsidian.DELETE).HandlerFunc
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.CellularEnodebType, Key: "enb1"},
@@ -1658,7 +1659,7 @@ func TestUpdateCellularGatewayConfig(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, entities.ToEntitiesByID())
 
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: lte.CellularEnodebType, Key: "enb3"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: lte.CellularEnodebType, Key: "enb3"})
 	assert.NoError(t, err)
 
 	// happy case
@@ -1773,7 +1774,7 @@ func TestListAndGetEnodebs(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1783,7 +1784,7 @@ func TestListAndGetEnodebs(t *testing.T) {
 	listEnodebs := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.GET).HandlerFunc
 	getEnodeb := tests.GetHandlerByPathAndMethod(t, handlers, fmt.Sprintf("%s/:enodeb_serial", testURLRoot), obsidian.GET).HandlerFunc
 
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type:       lte.CellularEnodebType,
 			Key:        "abcdefg",
@@ -1909,7 +1910,7 @@ func TestCreateEnodeb(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -1999,7 +2000,7 @@ func TestUpdateEnodeb(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2008,7 +2009,7 @@ func TestUpdateEnodeb(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	updateEnodeb := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.PUT).HandlerFunc
 
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type:       lte.CellularEnodebType,
 			Key:        "abcdefg",
@@ -2110,7 +2111,7 @@ func TestDeleteEnodeb(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2119,7 +2120,7 @@ func TestDeleteEnodeb(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	deleteEnodeb := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.DELETE).HandlerFunc
 
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type:       lte.CellularEnodebType,
 			Key:        "abcdefg",
@@ -2161,7 +2162,7 @@ func TestGetEnodebState(t *testing.T) {
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
 	stateTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2170,7 +2171,7 @@ func TestGetEnodebState(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	getEnodebState := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.GET).HandlerFunc
 
-	_, err = configurator.CreateEntities("n1",
+	_, err = configurator.CreateEntities(context.Background(), "n1",
 		[]configurator.NetworkEntity{
 			{
 				Type: lte.CellularEnodebType, Key: "serial1",
@@ -2225,7 +2226,7 @@ func TestCreateSubscriber(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2293,7 +2294,7 @@ func TestCreateSubscriber(t *testing.T) {
 	assert.EqualError(t, err, "Not found")
 
 	// nonexistent sub profile should be 400
-	err = configurator.UpdateNetworkConfig(
+	err = configurator.UpdateNetworkConfig(context.Background(),
 		"n1", lte.CellularNetworkType,
 		&models2.NetworkCellularConfigs{
 			Epc: &models2.NetworkEpcConfigs{
@@ -2358,7 +2359,7 @@ func TestListSubscribers(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2377,7 +2378,7 @@ func TestListSubscribers(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -2434,6 +2435,56 @@ func TestListSubscribers(t *testing.T) {
 		}),
 	}
 	tests.RunUnitTest(t, e, tc)
+
+	// Search on config
+	tc.URL = testURLRoot + "?filter=config.sub_profile=foo"
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]*models2.Subscriber{
+		"IMSI0987654321": {
+			ID: "IMSI0987654321",
+			Lte: &models2.LteSubscription{
+				AuthAlgo:   "MILENAGE",
+				AuthKey:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+				AuthOpc:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+				State:      "ACTIVE",
+				SubProfile: "foo",
+			},
+		},
+	})
+	tests.RunUnitTest(t, e, tc)
+
+	// Paginated
+	tc.URL = testURLRoot + "?page_size=1"
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]*models2.Subscriber{
+		"IMSI0987654321": {
+			ID: "IMSI0987654321",
+			Lte: &models2.LteSubscription{
+				AuthAlgo:   "MILENAGE",
+				AuthKey:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+				AuthOpc:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+				State:      "ACTIVE",
+				SubProfile: "foo",
+			},
+		},
+	})
+	rec := tests.RunUnitTestWithResponse(t, e, tc)
+	nextPageToken := rec.Header().Get(orc8rhandlers.NextPageTokenHeader)
+	assert.NotEmpty(t, nextPageToken)
+
+	tc.URL = testURLRoot + "?page_size=1&page_token=" + nextPageToken
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]*models2.Subscriber{
+		"IMSI1234567890": {
+			ID: "IMSI1234567890",
+			Lte: &models2.LteSubscription{
+				AuthAlgo:   "MILENAGE",
+				AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+				AuthOpc:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+				State:      "ACTIVE",
+				SubProfile: "default",
+			},
+		},
+	})
+	rec = tests.RunUnitTestWithResponse(t, e, tc)
+	assert.Empty(t, rec.Header().Get(orc8rhandlers.NextPageTokenHeader))
 }
 
 func TestGetSubscriber(t *testing.T) {
@@ -2442,7 +2493,7 @@ func TestGetSubscriber(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2462,7 +2513,7 @@ func TestGetSubscriber(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// No sub profile configured, we should return "default"
-	_, err = configurator.CreateEntity(
+	_, err = configurator.CreateEntity(context.Background(),
 		"n1",
 		configurator.NetworkEntity{
 			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
@@ -2503,7 +2554,7 @@ func TestUpdateSubscriber(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2535,7 +2586,7 @@ func TestUpdateSubscriber(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// Happy path
-	err = configurator.UpdateNetworkConfig(
+	err = configurator.UpdateNetworkConfig(context.Background(),
 		"n1", lte.CellularNetworkType,
 		&models2.NetworkCellularConfigs{
 			Epc: &models2.NetworkEpcConfigs{
@@ -2549,7 +2600,7 @@ func TestUpdateSubscriber(t *testing.T) {
 		},
 	)
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity(
+	_, err = configurator.CreateEntity(context.Background(),
 		"n1",
 		configurator.NetworkEntity{
 			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
@@ -2617,7 +2668,7 @@ func TestDeleteSubscriber(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2625,7 +2676,7 @@ func TestDeleteSubscriber(t *testing.T) {
 	handlers := handlers.GetHandlers()
 	deleteSubscriber := tests.GetHandlerByPathAndMethod(t, handlers, testURLRoot, obsidian.DELETE).HandlerFunc
 
-	_, err = configurator.CreateEntity(
+	_, err = configurator.CreateEntity(context.Background(),
 		"n1",
 		configurator.NetworkEntity{
 			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
@@ -2659,7 +2710,7 @@ func TestActivateDeactivateSubscriber(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -2677,7 +2728,7 @@ func TestActivateDeactivateSubscriber(t *testing.T) {
 			State:    "ACTIVE",
 		},
 	}
-	_, err = configurator.CreateEntity("n1", expected)
+	_, err = configurator.CreateEntity(context.Background(), "n1", expected)
 	assert.NoError(t, err)
 	expected.NetworkID = "n1"
 	expected.GraphID = "2"
@@ -2734,9 +2785,9 @@ func TestUpdateSubscriberProfile(t *testing.T) {
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	err = configurator.UpdateNetworkConfig(
+	err = configurator.UpdateNetworkConfig(context.Background(),
 		"n1", lte.CellularNetworkType,
 		&models2.NetworkCellularConfigs{
 			Epc: &models2.NetworkEpcConfigs{
@@ -2750,7 +2801,7 @@ func TestUpdateSubscriberProfile(t *testing.T) {
 		},
 	)
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity(
+	_, err = configurator.CreateEntity(context.Background(),
 		"n1",
 		configurator.NetworkEntity{
 			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
@@ -2875,7 +2926,7 @@ func reportEnodebState(t *testing.T, ctx context.Context, enodebSerial string, r
 
 // n1, n3 are lte networks, n2 is not
 func seedNetworks(t *testing.T) {
-	_, err := configurator.CreateNetworks(
+	_, err := configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          "n1",
@@ -17,6 +17,7 @@ import (
 	"magma/lte/cloud/go/plugin/models"
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
 	"magma/orc8r/cloud/go/services/configurator"
 
 	"github.com/labstack/echo"
@@ -38,9 +39,9 @@ func ListBaseNames(c echo.Context) error {
 
 	view := c.QueryParam("view")
 	if strings.ToLower(view) == "full" {
-		baseNames, err := configurator.LoadAllEntitiesInNetwork(networkID, lte.BaseNameEntityType, configurator.EntityLoadCriteria{LoadAssocsFromThis: true})
-		if err != nil {
-			return obsidian.HttpError(err, http.StatusInternalServerError)
+		baseNames, nerr := loadEntityPage(c, networkID, lte.BaseNameEntityType, configurator.EntityLoadCriteria{LoadAssocsFromThis: true})
+		if nerr != nil {
+			return nerr
 		}
 
 		ret := map[string]*models.BaseNameRecord{}
@@ -49,11 +50,10 @@ func ListBaseNames(c echo.Context) error {
 		}
 		return c.JSON(http.StatusOK, ret)
 	} else {
-		names, err := configurator.ListEntityKeys(networkID, lte.BaseNameEntityType)
-		if err != nil {
-			return obsidian.HttpError(err, http.StatusInternalServerError)
+		names, nerr := listEntityKeyPage(c, networkID, lte.BaseNameEntityType)
+		if nerr != nil {
+			return nerr
 		}
-		sort.Strings(names)
 		return c.JSON(http.StatusOK, names)
 	}
 }
@@ -68,7 +68,7 @@ func CreateBaseName(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(networkID, bnr.ToEntity())
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, bnr.ToEntity())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -120,7 +120,7 @@ func UpdateBaseName(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	_, err = configurator.UpdateEntity(networkID, bnr.ToEntityUpdateCriteria())
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, bnr.ToEntityUpdateCriteria())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -133,7 +133,7 @@ func DeleteBaseName(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.BaseNameEntityType, baseName)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, lte.BaseNameEntityType, baseName)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -150,12 +150,12 @@ func ListRules(c echo.Context) error {
 
 	view := c.QueryParam("view")
 	if strings.ToLower(view) == "full" {
-		rules, err := configurator.LoadAllEntitiesInNetwork(
-			networkID, lte.PolicyRuleEntityType,
+		rules, nerr := loadEntityPage(
+			c, networkID, lte.PolicyRuleEntityType,
 			configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true},
 		)
-		if err != nil {
-			return obsidian.HttpError(err, http.StatusInternalServerError)
+		if nerr != nil {
+			return nerr
 		}
 
 		ret := map[string]*models.PolicyRule{}
@@ -164,11 +164,10 @@ func ListRules(c echo.Context) error {
 		}
 		return c.JSON(http.StatusOK, ret)
 	} else {
-		ruleIDs, err := configurator.ListEntityKeys(networkID, lte.PolicyRuleEntityType)
-		if err != nil {
-			return obsidian.HttpError(err, http.StatusInternalServerError)
+		ruleIDs, nerr := listEntityKeyPage(c, networkID, lte.PolicyRuleEntityType)
+		if nerr != nil {
+			return nerr
 		}
-		sort.Strings(ruleIDs)
 		return c.JSON(http.StatusOK, ruleIDs)
 	}
 }
@@ -187,7 +186,7 @@ func CreateRule(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(networkID, rule.ToEntity())
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, rule.ToEntity())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -242,7 +241,7 @@ func UpdateRule(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	_, err = configurator.UpdateEntity(networkID, rule.ToEntityUpdateCriteria())
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, rule.ToEntityUpdateCriteria())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -255,7 +254,7 @@ func DeleteRule(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.PolicyRuleEntityType, ruleID)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, lte.PolicyRuleEntityType, ruleID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -277,3 +276,47 @@ func getNetworkAndRuleIDs(c echo.Context) (string, string, *echo.HTTPError) {
 	}
 	return vals[0], vals[1], nil
 }
+
+// loadEntityPage loads all entities of a type in a network, or the page of
+// them given by the page query parameters of the request
+func loadEntityPage(c echo.Context, networkID string, entityType string, criteria configurator.EntityLoadCriteria) ([]configurator.NetworkEntity, *echo.HTTPError) {
+	criteria, nerr := handlers.GetPageCriteria(c, criteria)
+	if nerr != nil {
+		return nil, nerr
+	}
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(networkID, entityType, nil, criteria)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	handlers.SetNextPageToken(c, nextPageToken)
+	return ents, nil
+}
+
+// listEntityKeyPage lists the sorted keys of all entities of a type in a
+// network, or of the page of them given by the page query parameters of the
+// request
+func listEntityKeyPage(c echo.Context, networkID string, entityType string) ([]string, *echo.HTTPError) {
+	criteria, nerr := handlers.GetPageCriteria(c, configurator.EntityLoadCriteria{})
+	if nerr != nil {
+		return nil, nerr
+	}
+	if criteria.PageSize == 0 {
+		keys, err := configurator.ListEntityKeys(networkID, entityType)
+		if err != nil {
+			return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+		sort.Strings(keys)
+		return keys, nil
+	}
+
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(networkID, entityType, nil, criteria)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	handlers.SetNextPageToken(c, nextPageToken)
+	keys := make([]string, 0, len(ents))
+	for _, ent := range ents {
+		keys = append(keys, ent.Key)
+	}
+	return keys, nil
+}
@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -20,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/obsidian/tests"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
+	orc8rhandlers "magma/orc8r/cloud/go/pluginimpl/handlers"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/configurator/test_init"
 	"magma/orc8r/cloud/go/storage"
@@ -37,7 +39,7 @@ func TestPolicyDBHandlersBasic(t *testing.T) {
 	e := echo.New()
 
 	obsidianHandlers := handlers.GetHandlers()
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Type: lte.LteNetworkType})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1", Type: lte.LteNetworkType})
 	assert.NoError(t, err)
 
 	listPolicies := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/policies/rules", obsidian.GET).HandlerFunc
@@ -118,6 +120,15 @@ func TestPolicyDBHandlersBasic(t *testing.T) {
 	tc.URL = "/magma/v1/networks/n1/policies/rules"
 	tc.ExpectedResult = tests.JSONMarshaler([]string{"PolicyRule1"})
 	tests.RunUnitTest(t, e, tc)
+	// Last page has no next page token
+	tc.URL = "/magma/v1/networks/n1/policies/rules?page_size=1"
+	rec := tests.RunUnitTestWithResponse(t, e, tc)
+	assert.Empty(t, rec.Header().Get(orc8rhandlers.NextPageTokenHeader))
+	tc.URL = "/magma/v1/networks/n1/policies/rules?view=full&page_size=1"
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]*models.PolicyRule{
+		"PolicyRule1": testRule,
+	})
+	tests.RunUnitTest(t, e, tc)
 
 	// Test Read Rule Using URL based ID
 	tc = tests.Test{
@@ -456,7 +467,7 @@ func TestPolicyHandlersAssociations(t *testing.T) {
 	e := echo.New()
 
 	obsidianHandlers := handlers.GetHandlers()
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Type: lte.LteNetworkType})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1", Type: lte.LteNetworkType})
 	assert.NoError(t, err)
 
 	createPolicy := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/policies/rules", obsidian.POST).HandlerFunc
@@ -469,7 +480,7 @@ func TestPolicyHandlersAssociations(t *testing.T) {
 
 	// preseed 3 subscribers
 	imsi1, imsi2, imsi3 := "IMSI1234567890", "IMSI0987654321", "IMSI1111111111"
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.SubscriberEntityType, Key: imsi1},
@@ -65,7 +65,7 @@ func CreateRatingGroup(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.CreateEntity(networkID, group.ToEntity())
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, group.ToEntity())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -125,7 +125,7 @@ func UpdateRatingGroup(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	_, err = configurator.UpdateEntity(networkID, ratingGroup.ToEntityUpdateCriteria(groupID))
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, ratingGroup.ToEntityUpdateCriteria(groupID))
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -138,7 +138,7 @@ func DeleteRatingGroup(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.RatingGroupEntityType, ratingGroupID)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, lte.RatingGroupEntityType, ratingGroupID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
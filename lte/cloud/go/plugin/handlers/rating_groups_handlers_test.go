@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"testing"
 
 	"magma/lte/cloud/go/lte"
@@ -35,7 +36,7 @@ func TestRatingGroupHandlersBasic(t *testing.T) {
 	e := echo.New()
 
 	obsidianHandlers := handlers.GetHandlers()
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Type: lte.LteNetworkType})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1", Type: lte.LteNetworkType})
 	assert.NoError(t, err)
 
 	listRatingGroups := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/rating_groups", obsidian.GET).HandlerFunc
@@ -0,0 +1,429 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"magma/lte/cloud/go/lte"
+	ltemodels "magma/lte/cloud/go/plugin/models"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MIMETextCSV and MIMEApplicationNDJSON are the content types of the
+	// subscriber import and export formats
+	MIMETextCSV           = "text/csv"
+	MIMEApplicationNDJSON = "application/x-ndjson"
+
+	exportFormatParam = "format"
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+
+	csvIMSICol       = "imsi"
+	csvAuthKeyCol    = "auth_key"
+	csvAuthOpcCol    = "auth_opc"
+	csvSubProfileCol = "sub_profile"
+	csvStateCol      = "state"
+
+	defaultSubProfile = "default"
+
+	// exportErrorField is the first field of the record which terminates an
+	// export which failed after the response status was sent
+	exportErrorField = "error"
+)
+
+var (
+	// SubscriberImportBatchSize is the number of subscribers which are
+	// created in each configurator transaction of an import
+	SubscriberImportBatchSize = 500
+	// SubscriberExportPageSize is the number of subscribers which are loaded
+	// from configurator at a time during an export
+	SubscriberExportPageSize = 500
+
+	csvColumns         = []string{csvIMSICol, csvAuthKeyCol, csvAuthOpcCol, csvSubProfileCol, csvStateCol}
+	requiredCSVColumns = []string{csvIMSICol, csvAuthKeyCol, csvStateCol}
+
+	errSubscriberExists = errors.New("subscriber already exists")
+)
+
+// importRow is a row of a subscriber import. err is set if the row can't be
+// imported.
+type importRow struct {
+	index      int
+	subscriber *ltemodels.Subscriber
+	err        error
+}
+
+func importSubscribers(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	contentType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "invalid content type"), http.StatusUnsupportedMediaType)
+	}
+	var rows []*importRow
+	switch contentType {
+	case MIMETextCSV:
+		rows, err = parseSubscriberCSV(c.Request().Body)
+	case MIMEApplicationNDJSON:
+		rows, err = parseSubscriberJSONLines(c.Request().Body)
+	default:
+		return obsidian.HttpError(fmt.Errorf("unsupported content type %s, expected %s or %s", contentType, MIMETextCSV, MIMEApplicationNDJSON), http.StatusUnsupportedMediaType)
+	}
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+
+	if nerr := validateImportRows(networkID, rows); nerr != nil {
+		return nerr
+	}
+
+	createdCount := 0
+	for start := 0; start < len(rows); start += SubscriberImportBatchSize {
+		end := start + SubscriberImportBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+		createdCount += createSubscriberBatch(c.Request().Context(), networkID, rows[start:end])
+	}
+
+	ret := &ltemodels.SubscriberImportResult{
+		CreatedCount: swag.Int64(int64(createdCount)),
+		Errors:       []*ltemodels.SubscriberImportError{},
+	}
+	for _, row := range rows {
+		if row.err == nil {
+			continue
+		}
+		importErr := &ltemodels.SubscriberImportError{Row: swag.Int64(int64(row.index)), Error: swag.String(row.err.Error())}
+		if row.subscriber != nil {
+			importErr.ID = string(row.subscriber.ID)
+		}
+		ret.Errors = append(ret.Errors, importErr)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// parseSubscriberCSV parses a CSV subscriber import. Rows which can't be
+// parsed into a subscriber are returned with an error, but a malformed CSV
+// file fails the whole import.
+func parseSubscriberCSV(body io.Reader) ([]*importRow, error) {
+	reader := csv.NewReader(body)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("CSV is missing a header row")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse CSV header")
+	}
+	colIdxs := map[string]int{}
+	for i, col := range header {
+		colIdxs[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	for _, col := range requiredCSVColumns {
+		if _, ok := colIdxs[col]; !ok {
+			return nil, errors.Errorf("CSV header is missing column %s", col)
+		}
+	}
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse CSV")
+		}
+		getField := func(col string) string {
+			if idx, ok := colIdxs[col]; ok {
+				return strings.TrimSpace(record[idx])
+			}
+			return ""
+		}
+
+		row := &importRow{index: len(rows) + 1}
+		rows = append(rows, row)
+		sub := &ltemodels.Subscriber{
+			ID: getSubscriberID(getField(csvIMSICol)),
+			Lte: &ltemodels.LteSubscription{
+				AuthAlgo:   ltemodels.LteSubscriptionAuthAlgoMILENAGE,
+				State:      strings.ToUpper(getField(csvStateCol)),
+				SubProfile: ltemodels.SubProfile(getField(csvSubProfileCol)),
+			},
+		}
+		row.subscriber = sub
+		if sub.Lte.SubProfile == "" {
+			sub.Lte.SubProfile = defaultSubProfile
+		}
+		sub.Lte.AuthKey, row.err = hex.DecodeString(getField(csvAuthKeyCol))
+		if row.err != nil {
+			row.err = errors.Wrap(row.err, "auth_key must be hex-encoded")
+			continue
+		}
+		sub.Lte.AuthOpc, row.err = hex.DecodeString(getField(csvAuthOpcCol))
+		if row.err != nil {
+			row.err = errors.Wrap(row.err, "auth_opc must be hex-encoded")
+			continue
+		}
+	}
+}
+
+// parseSubscriberJSONLines parses a subscriber import where each non-empty
+// line is a JSON subscriber object
+func parseSubscriberJSONLines(body io.Reader) ([]*importRow, error) {
+	scanner := bufio.NewScanner(body)
+	// Allow lines much longer than a subscriber
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024)
+
+	var rows []*importRow
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		row := &importRow{index: len(rows) + 1}
+		rows = append(rows, row)
+		sub := &ltemodels.Subscriber{}
+		if err := json.Unmarshal(line, sub); err != nil {
+			row.err = errors.Wrap(err, "failed to parse subscriber")
+			continue
+		}
+		row.subscriber = sub
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read subscribers")
+	}
+	return rows, nil
+}
+
+// getSubscriberID returns the subscriber ID for an IMSI, which may be given
+// with or without the IMSI prefix
+func getSubscriberID(imsi string) ltemodels.SubscriberID {
+	if imsi == "" || strings.HasPrefix(imsi, "IMSI") {
+		return ltemodels.SubscriberID(imsi)
+	}
+	return ltemodels.SubscriberID("IMSI" + imsi)
+}
+
+// validateImportRows sets an error on the rows which don't hold a valid
+// subscriber. Sub profiles are validated against the network once per
+// profile. An error is returned if the network config can't be loaded.
+func validateImportRows(networkID string, rows []*importRow) *echo.HTTPError {
+	profileErrs := map[ltemodels.SubProfile]*echo.HTTPError{}
+	seenIDs := map[ltemodels.SubscriberID]int{}
+	for _, row := range rows {
+		if row.err != nil {
+			continue
+		}
+		sub := row.subscriber
+		if err := sub.ValidateModel(); err != nil {
+			row.err = err
+			continue
+		}
+		if prevRow, seen := seenIDs[sub.ID]; seen {
+			row.err = errors.Errorf("duplicate of row %d", prevRow)
+			continue
+		}
+		seenIDs[sub.ID] = row.index
+
+		profileErr, validated := profileErrs[sub.Lte.SubProfile]
+		if !validated {
+			profileErr = validateSubscriberProfile(networkID, sub.Lte)
+			if profileErr != nil && profileErr.Code != http.StatusBadRequest {
+				return profileErr
+			}
+			profileErrs[sub.Lte.SubProfile] = profileErr
+		}
+		if profileErr != nil {
+			row.err = errors.Errorf("%v", profileErr.Message)
+		}
+	}
+	return nil
+}
+
+// createSubscriberBatch creates the subscribers of the valid rows of a batch
+// in a single configurator transaction. If the transaction fails, e.g.
+// because one of the subscribers was created concurrently, the subscribers
+// are created one at a time so that a single row can't fail the whole batch.
+// Rows which aren't created are set an error, so an import which fails part
+// way through still reports what was created. The number of created
+// subscribers is returned.
+func createSubscriberBatch(ctx context.Context, networkID string, batch []*importRow) int {
+	var toCreate []*importRow
+	var tks []storage.TypeAndKey
+	for _, row := range batch {
+		if row.err == nil {
+			toCreate = append(toCreate, row)
+			tks = append(tks, storage.TypeAndKey{Type: lte.SubscriberEntityType, Key: string(row.subscriber.ID)})
+		}
+	}
+	if len(toCreate) == 0 {
+		return 0
+	}
+
+	existing, _, err := configurator.LoadEntities(networkID, nil, nil, nil, tks, configurator.EntityLoadCriteria{})
+	if err != nil {
+		err = errors.Wrap(err, "failed to load existing subscribers")
+		for _, row := range toCreate {
+			row.err = err
+		}
+		return 0
+	}
+	existingIDs := existing.ToEntitiesByID()
+
+	var newRows []*importRow
+	var ents []configurator.NetworkEntity
+	for _, row := range toCreate {
+		tk := storage.TypeAndKey{Type: lte.SubscriberEntityType, Key: string(row.subscriber.ID)}
+		if _, exists := existingIDs[tk]; exists {
+			row.err = errSubscriberExists
+			continue
+		}
+		newRows = append(newRows, row)
+		ents = append(ents, configurator.NetworkEntity{Type: tk.Type, Key: tk.Key, Config: row.subscriber.Lte})
+	}
+	if len(ents) == 0 {
+		return 0
+	}
+	_, err = configurator.CreateEntities(ctx, networkID, ents)
+	if err == nil {
+		return len(ents)
+	}
+
+	glog.Warningf("Failed to create batch of %d subscribers in network %s, creating them one at a time: %s", len(ents), networkID, err)
+	created := 0
+	for i, row := range newRows {
+		_, err := configurator.CreateEntity(ctx, networkID, ents[i])
+		if err == nil {
+			created++
+			continue
+		}
+		if exists, existsErr := configurator.DoesEntityExist(networkID, ents[i].Type, ents[i].Key); existsErr == nil && exists {
+			row.err = errSubscriberExists
+		} else {
+			row.err = errors.Wrap(err, "failed to create subscriber")
+		}
+	}
+	return created
+}
+
+func exportSubscribers(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	format := c.QueryParam(exportFormatParam)
+	var contentType string
+	var writeHeader func() error
+	var writeSubscriber func(sub *ltemodels.Subscriber) error
+	var writeError func(err error) error
+	// flush writes out records buffered by the encoder
+	var flush func() error
+	switch format {
+	case exportFormatCSV:
+		contentType = MIMETextCSV
+		writer := csv.NewWriter(c.Response())
+		writeHeader = func() error {
+			return writer.Write(csvColumns)
+		}
+		writeSubscriber = func(sub *ltemodels.Subscriber) error {
+			return writer.Write([]string{
+				string(sub.ID),
+				hex.EncodeToString(sub.Lte.AuthKey),
+				hex.EncodeToString(sub.Lte.AuthOpc),
+				string(sub.Lte.SubProfile),
+				sub.Lte.State,
+			})
+		}
+		writeError = func(err error) error {
+			return writer.Write([]string{exportErrorField, err.Error()})
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	case exportFormatJSONL, "":
+		contentType = MIMEApplicationNDJSON
+		encoder := json.NewEncoder(c.Response())
+		writeHeader = func() error { return nil }
+		writeSubscriber = func(sub *ltemodels.Subscriber) error {
+			return encoder.Encode(sub)
+		}
+		writeError = func(err error) error {
+			return encoder.Encode(map[string]string{exportErrorField: err.Error()})
+		}
+		flush = func() error { return nil }
+	default:
+		return obsidian.HttpError(fmt.Errorf("invalid %s %q, expected %s or %s", exportFormatParam, format, exportFormatCSV, exportFormatJSONL), http.StatusBadRequest)
+	}
+
+	// Load the first page before writing the response so that failures
+	// can still be reported with an error status
+	criteria := configurator.EntityLoadCriteria{LoadConfig: true, PageSize: uint32(SubscriberExportPageSize)}
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(networkID, lte.SubscriberEntityType, nil, criteria)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, contentType)
+	c.Response().WriteHeader(http.StatusOK)
+	if err := writeHeader(); err != nil {
+		return err
+	}
+	for {
+		for _, ent := range ents {
+			if err := writeSubscriber((&ltemodels.Subscriber{}).FromBackendModels(ent)); err != nil {
+				return err
+			}
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		c.Response().Flush()
+		if nextPageToken == "" {
+			return nil
+		}
+
+		criteria.PageToken = nextPageToken
+		ents, nextPageToken, err = configurator.LoadEntitiesPage(networkID, lte.SubscriberEntityType, nil, criteria)
+		if err != nil {
+			// The status has already been sent, so end the export with an
+			// error record which can't be mistaken for a subscriber
+			glog.Errorf("Failed to load subscribers of network %s for export: %s", networkID, err)
+			if err := writeError(errors.New("failed to load subscribers, the export is incomplete")); err != nil {
+				return err
+			}
+			return flush()
+		}
+	}
+}
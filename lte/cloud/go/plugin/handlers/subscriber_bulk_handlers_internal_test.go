@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	ltemodels "magma/lte/cloud/go/plugin/models"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubscriberBatch_Conflict(t *testing.T) {
+	serde.UnregisterSerdesForDomain(t, configurator.NetworkEntitySerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, configurator.NetworkEntitySerdeDomain)
+	err := serde.RegisterSerdes(configurator.NewNetworkEntityConfigSerde(lte.SubscriberEntityType, &ltemodels.LteSubscription{}))
+	assert.NoError(t, err)
+	test_init.StartTestService(t)
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+
+	// The second row stands in for a subscriber which is created
+	// concurrently, after the batch checked which subscribers exist. The
+	// batch transaction fails and the rows are created one at a time.
+	rows := []*importRow{
+		newTestImportRow(1, "IMSI1234567890"),
+		newTestImportRow(2, "IMSI1234567890"),
+		newTestImportRow(3, "IMSI0987654321"),
+	}
+	created := createSubscriberBatch(context.Background(), "n1", rows)
+	assert.Equal(t, 2, created)
+	assert.NoError(t, rows[0].err)
+	assert.Equal(t, errSubscriberExists, rows[1].err)
+	assert.NoError(t, rows[2].err)
+
+	keys, err := configurator.ListEntityKeys("n1", lte.SubscriberEntityType)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"IMSI0987654321", "IMSI1234567890"}, keys)
+}
+
+func newTestImportRow(index int, id string) *importRow {
+	return &importRow{
+		index: index,
+		subscriber: &ltemodels.Subscriber{
+			ID: ltemodels.SubscriberID(id),
+			Lte: &ltemodels.LteSubscription{
+				AuthAlgo:   ltemodels.LteSubscriptionAuthAlgoMILENAGE,
+				AuthKey:    []byte(strings.Repeat("\x11", 16)),
+				State:      "ACTIVE",
+				SubProfile: defaultSubProfile,
+			},
+		},
+	}
+}
@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	plugin2 "magma/lte/cloud/go/plugin"
+	"magma/lte/cloud/go/plugin/handlers"
+	models2 "magma/lte/cloud/go/plugin/models"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportSubscribers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
+	test_init.StartTestService(t)
+
+	cellularConfig := models2.NewDefaultTDDNetworkConfig()
+	cellularConfig.Epc.SubProfiles = map[string]models2.NetworkEpcConfigsSubProfilesAnon{
+		"foo": {MaxDlBitRate: 100, MaxUlBitRate: 100},
+	}
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{
+		ID:      "n1",
+		Configs: map[string]interface{}{lte.CellularNetworkType: cellularConfig},
+	})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
+		Type:   lte.SubscriberEntityType,
+		Key:    "IMSI1111111111",
+		Config: newTestSubscription("\x11", "ACTIVE", "default"),
+	})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, handlers.GetHandlers(), "/magma/v1/lte/:network_id/subscribers/import", obsidian.POST).HandlerFunc
+
+	// CSV with a row of each kind of error
+	csvBody := strings.Join([]string{
+		"imsi,auth_key,auth_opc,sub_profile,state",
+		"IMSI1234567890,22222222222222222222222222222222,22222222222222222222222222222222,default,ACTIVE",
+		"0987654321,33333333333333333333333333333333,,foo,inactive",
+		"IMSI1111111111,11111111111111111111111111111111,,,ACTIVE",
+		"IMSI1234567890,22222222222222222222222222222222,,,ACTIVE",
+		"IMSI5555555555,xyz,,,ACTIVE",
+		"IMSI6666666666,6666,,,ACTIVE",
+		"IMSI7777777777,77777777777777777777777777777777,,bar,ACTIVE",
+	}, "\n")
+	rec := doImport(t, e, importSubscribers, handlers.MIMETextCSV, csvBody)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assertImportResult(t, rec, 2, []*models2.SubscriberImportError{
+		{Row: swag.Int64(3), ID: "IMSI1111111111", Error: swag.String("subscriber already exists")},
+		{Row: swag.Int64(4), ID: "IMSI1234567890", Error: swag.String("duplicate of row 1")},
+		{Row: swag.Int64(5), ID: "IMSI5555555555", Error: swag.String("auth_key must be hex-encoded: encoding/hex: invalid byte: U+0078 'x'")},
+		{Row: swag.Int64(6), ID: "IMSI6666666666", Error: swag.String("expected lte auth key to be 16 bytes but got 2 bytes")},
+		{Row: swag.Int64(7), ID: "IMSI7777777777", Error: swag.String("subscriber profile bar does not exist for the network")},
+	})
+
+	actual, err := configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567890", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, newTestSubscription("\x22", "ACTIVE", "default"), actual.Config)
+	actual, err = configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI0987654321", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	expected := newTestSubscription("\x33", "INACTIVE", "foo")
+	expected.AuthOpc = nil
+	assert.Equal(t, expected, actual.Config)
+
+	// JSON lines
+	jsonBody := strings.Join([]string{
+		`{"id":"IMSI2222222222","lte":{"auth_algo":"MILENAGE","auth_key":"RERERERERERERERERERERA==","state":"ACTIVE","sub_profile":"default"}}`,
+		``,
+		`{"id":"IMSI3333333333","lte":`,
+		`{"id":"IMSI4444444444","lte":{"auth_algo":"MILENAGE","auth_key":"RERERERERERERERERERERA==","state":"UNKNOWN","sub_profile":"default"}}`,
+	}, "\n")
+	rec = doImport(t, e, importSubscribers, handlers.MIMEApplicationNDJSON, jsonBody)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assertImportResult(t, rec, 1, []*models2.SubscriberImportError{
+		{Row: swag.Int64(2), Error: swag.String("failed to parse subscriber: unexpected end of JSON input")},
+		{Row: swag.Int64(3), ID: "IMSI4444444444", Error: swag.String("validation failure list:\nvalidation failure list:\nstate in body should be one of [INACTIVE ACTIVE]")},
+	})
+	actual, err = configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI2222222222", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	expected = newTestSubscription("\x44", "ACTIVE", "default")
+	expected.AuthOpc = nil
+	assert.Equal(t, expected, actual.Config)
+
+	// Malformed imports fail as a whole
+	rec = doImport(t, e, importSubscribers, handlers.MIMETextCSV, "imsi,auth_key\nIMSI1234567890,11")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doImport(t, e, importSubscribers, handlers.MIMETextCSV, "imsi,auth_key,state\nIMSI1234567890")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doImport(t, e, importSubscribers, echo.MIMEApplicationJSON, "{}")
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+}
+
+func TestExportSubscribers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
+	test_init.StartTestService(t)
+
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
+		{Type: lte.SubscriberEntityType, Key: "IMSI1234567890", Config: newTestSubscription("\x11", "ACTIVE", "default")},
+		{Type: lte.SubscriberEntityType, Key: "IMSI0987654321", Config: newTestSubscription("\x22", "INACTIVE", "foo")},
+	})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	exportSubscribers := tests.GetHandlerByPathAndMethod(t, handlers.GetHandlers(), "/magma/v1/lte/:network_id/subscribers/export", obsidian.GET).HandlerFunc
+
+	rec := doExport(t, e, exportSubscribers, "?format=csv")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, handlers.MIMETextCSV, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(
+		t,
+		"imsi,auth_key,auth_opc,sub_profile,state\n"+
+			"IMSI0987654321,22222222222222222222222222222222,22222222222222222222222222222222,foo,INACTIVE\n"+
+			"IMSI1234567890,11111111111111111111111111111111,11111111111111111111111111111111,default,ACTIVE\n",
+		rec.Body.String(),
+	)
+
+	rec = doExport(t, e, exportSubscribers, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, handlers.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(
+		t,
+		`{"id":"IMSI0987654321","lte":{"auth_algo":"MILENAGE","auth_key":"IiIiIiIiIiIiIiIiIiIiIg==","auth_opc":"IiIiIiIiIiIiIiIiIiIiIg==","state":"INACTIVE","sub_profile":"foo"}}`+"\n"+
+			`{"id":"IMSI1234567890","lte":{"auth_algo":"MILENAGE","auth_key":"EREREREREREREREREREREQ==","auth_opc":"EREREREREREREREREREREQ==","state":"ACTIVE","sub_profile":"default"}}`+"\n",
+		rec.Body.String(),
+	)
+
+	rec = doExport(t, e, exportSubscribers, "?format=xml")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Exports spanning several pages
+	defer func(pageSize int) { handlers.SubscriberExportPageSize = pageSize }(handlers.SubscriberExportPageSize)
+	handlers.SubscriberExportPageSize = 2
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
+		{Type: lte.SubscriberEntityType, Key: "IMSI5555555555", Config: newTestSubscription("\x55", "ACTIVE", "default")},
+		{Type: lte.SubscriberEntityType, Key: "IMSI6666666666", Config: newTestSubscription("\x66", "ACTIVE", "default")},
+		{Type: lte.SubscriberEntityType, Key: "IMSI7777777777", Config: newTestSubscription("\x77", "ACTIVE", "default")},
+	})
+	assert.NoError(t, err)
+	rec = doExport(t, e, exportSubscribers, "?format=csv")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(
+		t,
+		"imsi,auth_key,auth_opc,sub_profile,state\n"+
+			"IMSI0987654321,22222222222222222222222222222222,22222222222222222222222222222222,foo,INACTIVE\n"+
+			"IMSI1234567890,11111111111111111111111111111111,11111111111111111111111111111111,default,ACTIVE\n"+
+			"IMSI5555555555,55555555555555555555555555555555,55555555555555555555555555555555,default,ACTIVE\n"+
+			"IMSI6666666666,66666666666666666666666666666666,66666666666666666666666666666666,default,ACTIVE\n"+
+			"IMSI7777777777,77777777777777777777777777777777,77777777777777777777777777777777,default,ACTIVE\n",
+		rec.Body.String(),
+	)
+
+	// Each page is written out before the response is flushed
+	flushRec := &flushRecorder{ResponseRecorder: httptest.NewRecorder()}
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/magma/v1/lte/n1/subscribers/export?format=csv", nil), flushRec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("n1")
+	assert.NoError(t, exportSubscribers(c))
+	assert.Equal(t, []int{3, 5, 6}, flushRec.flushedLines)
+}
+
+// flushRecorder records the number of lines written to the response at
+// each flush
+type flushRecorder struct {
+	*httptest.ResponseRecorder
+	flushedLines []int
+}
+
+func (r *flushRecorder) Flush() {
+	r.flushedLines = append(r.flushedLines, strings.Count(r.Body.String(), "\n"))
+	r.ResponseRecorder.Flush()
+}
+
+func newTestSubscription(keyByte string, state string, subProfile string) *models2.LteSubscription {
+	key := []byte(strings.Repeat(keyByte, 16))
+	return &models2.LteSubscription{
+		AuthAlgo:   "MILENAGE",
+		AuthKey:    key,
+		AuthOpc:    key,
+		State:      state,
+		SubProfile: models2.SubProfile(subProfile),
+	}
+}
+
+func doImport(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, contentType string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/magma/v1/lte/n1/subscribers/import", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+	return runHandler(t, e, handler, req)
+}
+
+func doExport(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/magma/v1/lte/n1/subscribers/export"+query, nil)
+	return runHandler(t, e, handler, req)
+}
+
+func runHandler(t *testing.T, e *echo.Echo, handler echo.HandlerFunc, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("n1")
+	if err := handler(c); err != nil {
+		c.Error(err)
+	}
+	return rec
+}
+
+func assertImportResult(t *testing.T, rec *httptest.ResponseRecorder, expectedCreated int64, expectedErrors []*models2.SubscriberImportError) {
+	actual := &models2.SubscriberImportResult{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actual))
+	assert.Equal(t, expectedCreated, *actual.CreatedCount)
+	assert.Equal(t, expectedErrors, actual.Errors)
+}
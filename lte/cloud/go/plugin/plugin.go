@@ -44,7 +44,8 @@ func (*LteOrchestratorPlugin) GetServices() []registry.ServiceLocation {
 
 func (*LteOrchestratorPlugin) GetSerdes() []serde.Serde {
 	return []serde.Serde{
-		state.NewStateSerde(lte.EnodebStateType, &lteModels.EnodebState{}),
+		state.NewStateSerdeWithTTL(lte.EnodebStateType, &lteModels.EnodebState{}, lte.EnodebStateTTL),
+		state.NewStateSerdeWithTTL(lte.SubscriberStateType, &state.ArbitraryJSON{}, lte.SubscriberStateTTL),
 
 		// Configurator serdes
 		configurator.NewNetworkConfigSerde(lte.CellularNetworkType, &lteModels.NetworkCellularConfigs{}),
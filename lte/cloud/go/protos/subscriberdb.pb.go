@@ -506,10 +506,14 @@ type SubscriberState struct {
 	// An empty string indicates that no server is currently serving the user.
 	TgppAaaServerName string `protobuf:"bytes,2,opt,name=tgpp_aaa_server_name,json=tgppAaaServerName,proto3" json:"tgpp_aaa_server_name,omitempty"`
 	// Whether the subscribers User Status is REGISTERED or NOT_REGISTERED.
-	TgppAaaServerRegistered bool     `protobuf:"varint,3,opt,name=tgpp_aaa_server_registered,json=tgppAaaServerRegistered,proto3" json:"tgpp_aaa_server_registered,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	TgppAaaServerRegistered bool `protobuf:"varint,3,opt,name=tgpp_aaa_server_registered,json=tgppAaaServerRegistered,proto3" json:"tgpp_aaa_server_registered,omitempty"`
+	// The Diameter host of the MME which is serving the user, set by the last
+	// successful Update-Location. An empty string indicates that no MME is
+	// currently serving the user.
+	MmeHost              string   `protobuf:"bytes,4,opt,name=mme_host,json=mmeHost,proto3" json:"mme_host,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SubscriberState) Reset()         { *m = SubscriberState{} }
@@ -558,6 +562,13 @@ func (m *SubscriberState) GetTgppAaaServerRegistered() bool {
 	return false
 }
 
+func (m *SubscriberState) GetMmeHost() string {
+	if m != nil {
+		return m.MmeHost
+	}
+	return ""
+}
+
 type APNConfiguration struct {
 	// APN identifier
 	ContextId uint32 `protobuf:"varint,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
@@ -1200,110 +1211,111 @@ func init() {
 func init() { proto.RegisterFile("lte/protos/subscriberdb.proto", fileDescriptor_d870e4203d378ec0) }
 
 var fileDescriptor_d870e4203d378ec0 = []byte{
-	// 1650 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x72, 0xe3, 0x48,
-	0x15, 0xf6, 0x5f, 0x7e, 0x7c, 0x9c, 0x1f, 0xa5, 0xc9, 0x4e, 0x1c, 0x67, 0x87, 0x0d, 0xda, 0x02,
-	0xb2, 0xbb, 0xac, 0x3d, 0xe5, 0x61, 0x97, 0x85, 0xad, 0x02, 0xe4, 0xd8, 0x93, 0x51, 0x61, 0x2b,
-	0xa6, 0xed, 0x64, 0x60, 0xb9, 0x50, 0xb5, 0xa5, 0x8e, 0x47, 0x15, 0xfd, 0x45, 0xdd, 0x9a, 0x4d,
-	0x1e, 0x84, 0x97, 0xe0, 0x05, 0xa8, 0xe2, 0x82, 0x47, 0xe0, 0x92, 0x1b, 0xee, 0x79, 0x0d, 0xa8,
-	0x6e, 0x4b, 0xb6, 0xe2, 0xc8, 0x66, 0x07, 0xaa, 0xb8, 0x4a, 0xf7, 0xf9, 0xeb, 0x3e, 0xdf, 0xf9,
-	0x74, 0xfa, 0x38, 0xf0, 0xdc, 0xe5, 0xb4, 0x15, 0x46, 0x01, 0x0f, 0x58, 0x8b, 0xc5, 0x13, 0x66,
-	0x45, 0xce, 0x84, 0x46, 0xf6, 0xa4, 0x29, 0x65, 0xa8, 0xea, 0x91, 0xa9, 0x47, 0x9a, 0x2e, 0xa7,
-	0x8d, 0xe3, 0x20, 0xb2, 0xbe, 0x8a, 0x52, 0x5b, 0x2b, 0xf0, 0xbc, 0xc0, 0x9f, 0x59, 0x35, 0x4e,
-	0xa7, 0x41, 0x30, 0x75, 0x93, 0x38, 0x93, 0xf8, 0xa6, 0x75, 0xe3, 0x50, 0xd7, 0x36, 0x3d, 0xc2,
-	0x6e, 0x67, 0x16, 0xea, 0x0d, 0xec, 0x8c, 0xe6, 0xd1, 0xf5, 0x2e, 0xda, 0x83, 0x92, 0x63, 0xd7,
-	0x8b, 0xa7, 0xc5, 0xb3, 0x2a, 0x2e, 0x39, 0x36, 0x6a, 0x43, 0x85, 0x3f, 0x84, 0xb4, 0x5e, 0x3a,
-	0x2d, 0x9e, 0xed, 0xb5, 0xbf, 0xdf, 0x9c, 0x1f, 0xdb, 0xcc, 0xba, 0x35, 0xf5, 0xee, 0xf8, 0x21,
-	0xa4, 0x58, 0xda, 0xaa, 0x08, 0x36, 0x67, 0x7b, 0xb4, 0x0d, 0x15, 0x7d, 0x30, 0xd2, 0x95, 0x82,
-	0xfa, 0x4b, 0xd8, 0xcf, 0x3a, 0x8c, 0x28, 0x47, 0x9f, 0x41, 0x85, 0x39, 0x36, 0xab, 0x17, 0x4f,
-	0xcb, 0x67, 0xb5, 0xf6, 0xd1, 0x8a, 0xd0, 0x58, 0x1a, 0xa9, 0x7f, 0x2e, 0xc1, 0xfe, 0xc5, 0x68,
-	0x90, 0x68, 0x42, 0xee, 0x04, 0x3e, 0xea, 0xc1, 0x06, 0xe3, 0x84, 0x53, 0x79, 0xdd, 0xbd, 0x76,
-	0x2b, 0x13, 0x61, 0xc9, 0x74, 0x79, 0x3f, 0x12, 0x6e, 0x78, 0xe6, 0x8d, 0xce, 0xa1, 0x4a, 0x62,
-	0xfe, 0xd6, 0x24, 0xee, 0x34, 0x48, 0xf2, 0xfc, 0xd1, 0xfa, 0x50, 0x5a, 0xcc, 0xdf, 0x6a, 0xee,
-	0x34, 0xc0, 0xdb, 0x24, 0x59, 0xa1, 0x63, 0x90, 0x6b, 0xf3, 0x96, 0x3e, 0xd4, 0xcb, 0xa7, 0xc5,
-	0xb3, 0x1d, 0xbc, 0x25, 0xf6, 0xbf, 0xa1, 0x0f, 0xe8, 0x23, 0xa8, 0x49, 0x15, 0x8f, 0x43, 0x97,
-	0xb2, 0x7a, 0xe5, 0xb4, 0x7c, 0xb6, 0x83, 0x41, 0x88, 0xc6, 0x52, 0xa2, 0xbe, 0x80, 0xc3, 0xbc,
-	0xfb, 0xa1, 0x1d, 0xd8, 0xd6, 0x0d, 0xed, 0x7c, 0xac, 0x5f, 0xf7, 0x94, 0x02, 0x02, 0xd8, 0x4c,
-	0xd6, 0x45, 0xf5, 0x53, 0xa8, 0x65, 0xae, 0x81, 0x4e, 0xe0, 0x68, 0x88, 0x7b, 0xe7, 0x97, 0x83,
-	0xe1, 0xd5, 0xb8, 0xd7, 0x35, 0xb5, 0xab, 0xf1, 0x6b, 0x73, 0x7c, 0x35, 0xec, 0xf7, 0x46, 0x4a,
-	0x41, 0xfd, 0x57, 0x09, 0xf6, 0xfb, 0xe3, 0xde, 0x77, 0x45, 0x6e, 0xc9, 0x74, 0x79, 0xff, 0x3e,
-	0xc8, 0xe5, 0x84, 0x7a, 0x3f, 0xe4, 0x52, 0x55, 0x10, 0x5a, 0xf5, 0xca, 0x42, 0x75, 0x19, 0x5a,
-	0xa8, 0x09, 0xdf, 0x23, 0x8c, 0x39, 0x53, 0x9f, 0xda, 0xe6, 0x84, 0x30, 0x6a, 0xfa, 0xc4, 0xa3,
-	0xac, 0x0e, 0xa7, 0xe5, 0xb3, 0x2a, 0x3e, 0x48, 0x55, 0x1d, 0xc2, 0xa8, 0x21, 0x14, 0xe8, 0x33,
-	0x98, 0x0b, 0xcd, 0x30, 0x70, 0x1d, 0xcb, 0xa1, 0xac, 0x5e, 0x93, 0xd6, 0x4a, 0xaa, 0x18, 0x26,
-	0x72, 0x51, 0x90, 0xbc, 0xb4, 0xd7, 0x14, 0xe4, 0x04, 0x6a, 0x99, 0xec, 0x84, 0xe1, 0x40, 0xef,
-	0xf7, 0x0c, 0xed, 0xa2, 0xa7, 0x14, 0xd4, 0x3f, 0x15, 0xb3, 0xe4, 0x9f, 0x85, 0xfa, 0x04, 0x0e,
-	0x5c, 0x4e, 0x4d, 0x99, 0x9e, 0x4f, 0xef, 0xb9, 0xc9, 0xe8, 0x9d, 0xac, 0x46, 0x05, 0xef, 0xb9,
-	0x9c, 0x8a, 0x48, 0x06, 0xbd, 0xe7, 0x23, 0x7a, 0x87, 0x5a, 0x70, 0xc8, 0xa7, 0x61, 0x68, 0x12,
-	0x42, 0x4c, 0x46, 0xa3, 0x77, 0x34, 0x92, 0xc9, 0x4a, 0xc0, 0xab, 0xf8, 0x40, 0xe8, 0x34, 0x42,
-	0x46, 0x52, 0x23, 0x92, 0x45, 0x5f, 0x43, 0x63, 0xd9, 0x21, 0xa2, 0x53, 0x87, 0x71, 0x1a, 0x51,
-	0x5b, 0x62, 0xbc, 0x8d, 0x8f, 0x1e, 0xb9, 0xe1, 0xb9, 0x5a, 0xfd, 0x63, 0x05, 0x14, 0x6d, 0x68,
-	0x9c, 0x07, 0xfe, 0x8d, 0x33, 0x8d, 0x23, 0x22, 0xf9, 0xf2, 0x1c, 0xc0, 0x0a, 0x7c, 0x2e, 0xee,
-	0x99, 0x74, 0x87, 0x5d, 0x5c, 0x4d, 0x24, 0xba, 0x2d, 0xc0, 0x15, 0xe7, 0x38, 0x16, 0x35, 0x19,
-	0x75, 0xa9, 0x25, 0x7c, 0x92, 0xeb, 0x29, 0x89, 0x62, 0x94, 0xca, 0xd1, 0x05, 0xd4, 0xee, 0x02,
-	0x66, 0x86, 0x51, 0x70, 0xe3, 0xb8, 0x54, 0x5e, 0xa7, 0xf6, 0x88, 0x36, 0xcb, 0xa7, 0x37, 0x7f,
-	0x1b, 0x8c, 0x86, 0x33, 0x6b, 0x0c, 0x77, 0x01, 0x4b, 0xd6, 0xe8, 0x67, 0x50, 0x21, 0xde, 0x24,
-	0x92, 0xcc, 0xa8, 0xb5, 0x3f, 0xce, 0x46, 0x98, 0x4e, 0x23, 0x3a, 0x25, 0x9c, 0xda, 0x03, 0x72,
-	0xef, 0x78, 0xb1, 0xd7, 0x71, 0x78, 0x24, 0x78, 0x2b, 0x1d, 0xd0, 0x17, 0x50, 0x0e, 0x6d, 0xbf,
-	0xbe, 0x21, 0x09, 0xfb, 0xf1, 0xba, 0x93, 0x87, 0x5d, 0x43, 0xf6, 0x35, 0x61, 0xdf, 0xf8, 0x6b,
-	0x11, 0x60, 0x71, 0x15, 0x41, 0x4e, 0xcb, 0x25, 0x8c, 0xa5, 0x88, 0x6c, 0xe0, 0x2d, 0xb9, 0xd7,
-	0x6d, 0xf4, 0x43, 0xd8, 0x0b, 0x23, 0x27, 0x88, 0x1c, 0xfe, 0x60, 0xba, 0xf4, 0x1d, 0x75, 0x25,
-	0x18, 0xbb, 0x78, 0x37, 0x95, 0xf6, 0x85, 0x10, 0xbd, 0x84, 0x0f, 0xc2, 0x88, 0x52, 0x4f, 0x32,
-	0xcc, 0xb4, 0x48, 0x48, 0x26, 0x8e, 0xeb, 0xf0, 0x87, 0xa4, 0x44, 0x87, 0x0b, 0xe5, 0xf9, 0x5c,
-	0x87, 0x7e, 0x0e, 0xf5, 0x8c, 0xd3, 0xbb, 0xd8, 0xf5, 0x69, 0x94, 0xfa, 0x55, 0x66, 0xa5, 0x5d,
-	0xe8, 0xaf, 0xb3, 0x6a, 0xf5, 0x6b, 0xd8, 0x4a, 0x12, 0x92, 0x8d, 0x79, 0x78, 0xfd, 0x53, 0xa5,
-	0x90, 0xac, 0xbe, 0x54, 0x8a, 0x82, 0xcf, 0x42, 0x76, 0xfd, 0xa5, 0x52, 0x42, 0x0a, 0xec, 0x88,
-	0xb5, 0x79, 0x89, 0x4d, 0xa9, 0x2d, 0xab, 0x3e, 0xd4, 0x57, 0xc1, 0x8a, 0xce, 0x40, 0xf1, 0xc8,
-	0xbd, 0x39, 0x21, 0xbe, 0xfd, 0xad, 0x63, 0xf3, 0xb7, 0x66, 0xec, 0x26, 0x24, 0xd9, 0xf3, 0xc8,
-	0x7d, 0x27, 0x15, 0x5f, 0xb9, 0x4f, 0x2d, 0xed, 0x14, 0x9b, 0x47, 0x96, 0x5d, 0x57, 0xfd, 0x5b,
-	0x05, 0x90, 0x11, 0xf8, 0x2f, 0x2f, 0x86, 0xc3, 0x2b, 0x46, 0xa3, 0x14, 0xf5, 0x67, 0xb0, 0xe9,
-	0x31, 0x87, 0xd9, 0x7e, 0xf2, 0x46, 0x25, 0x3b, 0xf4, 0x0d, 0x20, 0x3f, 0xf0, 0xcd, 0x97, 0x82,
-	0xf7, 0x4e, 0x68, 0x12, 0xcb, 0xa2, 0x8c, 0x25, 0x3d, 0xe9, 0xf3, 0x4c, 0x89, 0x9f, 0x86, 0x4c,
-	0x45, 0xfa, 0x50, 0x93, 0x4e, 0x78, 0xdf, 0x0f, 0x7c, 0x11, 0x47, 0x0f, 0x67, 0x02, 0x64, 0xc3,
-	0xb3, 0xa7, 0xb1, 0x4d, 0x12, 0xfa, 0xb2, 0x50, 0x7b, 0xed, 0x17, 0xef, 0x15, 0x5f, 0x1b, 0x1a,
-	0x18, 0x2d, 0x1d, 0xa1, 0x85, 0xfe, 0x7f, 0x4f, 0xe7, 0x5f, 0x00, 0x90, 0xd0, 0x37, 0x2d, 0xc9,
-	0x5c, 0xc9, 0xea, 0x5a, 0xfb, 0x64, 0x0d, 0xab, 0x71, 0x95, 0x84, 0xfe, 0x4c, 0x82, 0x5e, 0xc1,
-	0x6e, 0x92, 0x8e, 0x4f, 0xe5, 0xb7, 0xbd, 0x29, 0x33, 0x52, 0xb3, 0xee, 0x52, 0x6f, 0x50, 0xfe,
-	0x6d, 0x10, 0xdd, 0xea, 0x36, 0xf5, 0xb9, 0x73, 0xe3, 0xd0, 0x08, 0xd7, 0x48, 0xaa, 0xd0, 0x6d,
-	0xf5, 0x1a, 0xf6, 0x97, 0xd2, 0x44, 0x3f, 0x80, 0xe7, 0xc6, 0xa5, 0x61, 0x0a, 0x99, 0x39, 0xba,
-	0xea, 0x8c, 0xce, 0xb1, 0x3e, 0x1c, 0xeb, 0x97, 0x86, 0xa9, 0xf5, 0xfb, 0x97, 0x6f, 0x7a, 0x5d,
-	0xa5, 0x80, 0x4e, 0xe1, 0xc3, 0x7c, 0x93, 0x8e, 0x86, 0x71, 0xaf, 0xab, 0x14, 0x55, 0x7d, 0x4e,
-	0x82, 0x0c, 0x7c, 0xa8, 0x0e, 0x87, 0x73, 0x3f, 0x6d, 0x68, 0x8c, 0xcc, 0x9e, 0xa1, 0x75, 0xfa,
-	0xa2, 0x27, 0x1f, 0xc3, 0x07, 0x8f, 0x35, 0x5d, 0x7d, 0x24, 0x55, 0x45, 0xf5, 0x1f, 0x25, 0xd8,
-	0x5b, 0x74, 0xe1, 0x2e, 0xe1, 0x04, 0x7d, 0x02, 0x65, 0x96, 0x7c, 0xbd, 0x6b, 0x06, 0x10, 0x61,
-	0x83, 0x7e, 0x02, 0xe5, 0x29, 0xf3, 0x24, 0xa1, 0x6a, 0xed, 0xc6, 0xea, 0xf1, 0x00, 0x0b, 0x33,
-	0x61, 0xed, 0xf2, 0xb4, 0xb7, 0x35, 0x56, 0x3f, 0x89, 0x58, 0x98, 0xa1, 0x2f, 0x00, 0xfc, 0x19,
-	0xbc, 0xa2, 0x02, 0xb3, 0xfa, 0x3f, 0x4b, 0x9c, 0xe4, 0x6c, 0xd7, 0x4c, 0xd1, 0xef, 0xe2, 0xaa,
-	0x9f, 0x16, 0x02, 0xbd, 0x48, 0x1f, 0xf1, 0x8d, 0x27, 0xc7, 0x2c, 0xbd, 0x36, 0xe9, 0x7b, 0xfd,
-	0x11, 0xd4, 0x58, 0x3c, 0x99, 0xb7, 0xde, 0x4d, 0xf9, 0x05, 0x01, 0x8b, 0x27, 0xe9, 0xd7, 0xf5,
-	0x15, 0x6c, 0xa7, 0x4c, 0xaf, 0x6f, 0xc9, 0xa8, 0xcf, 0xd7, 0x72, 0x1b, 0x6f, 0x25, 0x44, 0x56,
-	0xef, 0x40, 0x59, 0x1c, 0x7a, 0x15, 0xda, 0xe2, 0xb8, 0xcf, 0xa1, 0x62, 0x13, 0x4e, 0x12, 0x7c,
-	0x8f, 0x73, 0xef, 0x27, 0xea, 0x80, 0xa5, 0x19, 0x6a, 0x42, 0x45, 0x0c, 0xa6, 0x73, 0x8c, 0x67,
-	0xb3, 0x6b, 0x33, 0x9d, 0x5d, 0x9b, 0xaf, 0xc4, 0xec, 0x3a, 0x20, 0xec, 0x16, 0x4b, 0x3b, 0x75,
-	0x0a, 0x47, 0x3d, 0x9f, 0x4c, 0x5c, 0x2a, 0x72, 0x74, 0x2c, 0x1c, 0xbb, 0x14, 0xd3, 0xbb, 0x98,
-	0x32, 0x8e, 0x10, 0x54, 0x1c, 0x8f, 0x39, 0x49, 0x8f, 0x90, 0x6b, 0xd1, 0xaf, 0xa3, 0xd8, 0xa5,
-	0xa6, 0x18, 0x39, 0x4b, 0xf2, 0xe1, 0xdf, 0x12, 0x7b, 0xdd, 0x66, 0xe2, 0x79, 0xcb, 0xcc, 0x10,
-	0x65, 0xa9, 0xac, 0x4e, 0xd2, 0xd9, 0x41, 0x7d, 0x0b, 0xf5, 0xae, 0xc3, 0xfe, 0x1f, 0x27, 0xf1,
-	0x2c, 0x8a, 0xfd, 0x20, 0xb8, 0x8d, 0xc3, 0x25, 0x76, 0x14, 0xbf, 0x2b, 0x3b, 0x12, 0x6e, 0x97,
-	0xfe, 0x33, 0xb7, 0xd5, 0x3f, 0xc0, 0x87, 0x17, 0x94, 0x6b, 0xae, 0xbb, 0x54, 0x16, 0xca, 0xc2,
-	0xc0, 0x67, 0x62, 0x9e, 0xa8, 0x2d, 0x7e, 0x81, 0xa4, 0xf3, 0xfa, 0x9a, 0x72, 0x66, 0xad, 0x3f,
-	0x7d, 0x05, 0x47, 0x2b, 0x3a, 0x88, 0x78, 0x7a, 0x5e, 0xe3, 0xa1, 0x68, 0x04, 0x55, 0xd8, 0x78,
-	0xa3, 0x0f, 0xb4, 0xdf, 0x29, 0x45, 0x21, 0x7c, 0xd3, 0xd7, 0x0c, 0xa5, 0x24, 0x86, 0xa8, 0xde,
-	0xf8, 0x75, 0x0f, 0x1b, 0xbd, 0xb1, 0x52, 0x6e, 0xff, 0xa5, 0x08, 0x0d, 0x39, 0xa0, 0x3d, 0x68,
-	0x72, 0x5c, 0xf3, 0xa8, 0xcf, 0xcf, 0x03, 0x9f, 0x47, 0x81, 0xeb, 0xd2, 0x08, 0xf5, 0xe1, 0x60,
-	0x99, 0x0c, 0x0c, 0x65, 0xdb, 0xd8, 0x0a, 0xaa, 0x34, 0x0e, 0x1e, 0x41, 0x79, 0x1d, 0x38, 0xb6,
-	0x5a, 0x40, 0x06, 0xa0, 0x27, 0x15, 0x67, 0x28, 0xdb, 0x93, 0x57, 0x11, 0x22, 0x37, 0x5e, 0xfb,
-	0x9f, 0xa5, 0xec, 0xcf, 0xac, 0x6e, 0x07, 0xfd, 0x0a, 0x76, 0x35, 0xdb, 0x5e, 0x88, 0xd0, 0x6a,
-	0x38, 0xf3, 0x6f, 0xf8, 0x6b, 0x50, 0xba, 0xd4, 0xa5, 0x9c, 0x66, 0x62, 0xac, 0xaa, 0x72, 0x7e,
-	0x84, 0x2e, 0x28, 0xb3, 0xef, 0x34, 0x13, 0xe1, 0x24, 0x37, 0xc2, 0xcc, 0x2c, 0x3f, 0x8a, 0x0e,
-	0x07, 0x17, 0x94, 0x2f, 0xf5, 0xd5, 0x95, 0x17, 0x59, 0x9d, 0xa5, 0x5a, 0x40, 0x1d, 0xd8, 0xef,
-	0x3b, 0x2c, 0x13, 0x8b, 0xa1, 0xa7, 0x47, 0x36, 0x1a, 0x2b, 0x62, 0x8f, 0x28, 0x57, 0x0b, 0xed,
-	0xbf, 0x97, 0xe1, 0x59, 0x16, 0xe8, 0x0c, 0x43, 0xfe, 0x67, 0xc8, 0xbb, 0x39, 0x90, 0xe7, 0x03,
-	0x36, 0xfb, 0x72, 0xf3, 0xa3, 0x74, 0x72, 0x60, 0x7f, 0xdf, 0x9b, 0x0c, 0xf2, 0x40, 0x5f, 0x7b,
-	0x95, 0xb5, 0xc0, 0x5f, 0x3c, 0x05, 0x7e, 0x45, 0x83, 0x59, 0x8f, 0x3e, 0xfa, 0x3d, 0x1c, 0xe6,
-	0x35, 0x92, 0x95, 0xd1, 0x7e, 0x9c, 0x7d, 0x47, 0xd7, 0x74, 0x20, 0xb5, 0xd0, 0x39, 0xf9, 0xe6,
-	0x58, 0xda, 0xb6, 0x5c, 0x4e, 0x5b, 0x96, 0x1b, 0xc4, 0x76, 0x6b, 0x1a, 0x24, 0xff, 0xf3, 0x98,
-	0x6c, 0xca, 0xbf, 0x2f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xa6, 0x13, 0x8a, 0x34, 0x11,
+	// 1666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x73, 0xeb, 0x48,
+	0x15, 0xf6, 0x2b, 0x0f, 0x1f, 0xe7, 0xa1, 0x34, 0x99, 0x1b, 0xc7, 0x99, 0xcb, 0x04, 0x4d, 0x01,
+	0x99, 0x19, 0xc6, 0xb9, 0xe5, 0xcb, 0x0c, 0x03, 0x53, 0x05, 0xc8, 0xb1, 0x6f, 0xae, 0x0a, 0x47,
+	0x31, 0x6d, 0x27, 0x17, 0x86, 0x85, 0xaa, 0x6d, 0x75, 0x1c, 0x55, 0x24, 0xb5, 0xa2, 0x6e, 0xdd,
+	0x49, 0x7e, 0x08, 0xbf, 0x85, 0x2a, 0x16, 0xec, 0xd8, 0xb2, 0x64, 0xc3, 0x9e, 0xbf, 0x01, 0xd5,
+	0x6d, 0x29, 0x56, 0x1c, 0xd9, 0x4c, 0xa0, 0x8a, 0x55, 0xd4, 0xe7, 0xf1, 0x75, 0x9f, 0xef, 0x9c,
+	0x3e, 0x7d, 0x62, 0x78, 0xe9, 0x09, 0x7a, 0x1c, 0x46, 0x4c, 0x30, 0x7e, 0xcc, 0xe3, 0x11, 0x1f,
+	0x47, 0xee, 0x88, 0x46, 0xce, 0xa8, 0xa9, 0x64, 0xa8, 0xea, 0x93, 0x89, 0x4f, 0x9a, 0x9e, 0xa0,
+	0x8d, 0x7d, 0x16, 0x8d, 0xbf, 0x8a, 0x52, 0xdb, 0x31, 0xf3, 0x7d, 0x16, 0x4c, 0xad, 0x1a, 0x87,
+	0x13, 0xc6, 0x26, 0x5e, 0x82, 0x33, 0x8a, 0xaf, 0x8e, 0xaf, 0x5c, 0xea, 0x39, 0xb6, 0x4f, 0xf8,
+	0xcd, 0xd4, 0x42, 0xbf, 0x82, 0x8d, 0xc1, 0x03, 0xba, 0xd9, 0x41, 0x5b, 0x50, 0x72, 0x9d, 0x7a,
+	0xf1, 0xb0, 0x78, 0x54, 0xc5, 0x25, 0xd7, 0x41, 0x2d, 0xa8, 0x88, 0xfb, 0x90, 0xd6, 0x4b, 0x87,
+	0xc5, 0xa3, 0xad, 0xd6, 0xf7, 0x9b, 0x0f, 0xdb, 0x36, 0xb3, 0x6e, 0x4d, 0xb3, 0x33, 0xbc, 0x0f,
+	0x29, 0x56, 0xb6, 0x3a, 0x82, 0xd5, 0xe9, 0x1a, 0xad, 0x43, 0xc5, 0x3c, 0x1b, 0x98, 0x5a, 0x41,
+	0xff, 0x25, 0x6c, 0x67, 0x1d, 0x06, 0x54, 0xa0, 0xcf, 0xa0, 0xc2, 0x5d, 0x87, 0xd7, 0x8b, 0x87,
+	0xe5, 0xa3, 0x5a, 0x6b, 0x6f, 0x01, 0x34, 0x56, 0x46, 0xfa, 0x9f, 0x4a, 0xb0, 0x7d, 0x3a, 0x38,
+	0x4b, 0x34, 0xa1, 0x70, 0x59, 0x80, 0xba, 0xb0, 0xc2, 0x05, 0x11, 0x54, 0x1d, 0x77, 0xab, 0x75,
+	0x9c, 0x41, 0x98, 0x33, 0x9d, 0x5f, 0x0f, 0xa4, 0x1b, 0x9e, 0x7a, 0xa3, 0x13, 0xa8, 0x92, 0x58,
+	0x5c, 0xdb, 0xc4, 0x9b, 0xb0, 0x24, 0xce, 0x1f, 0x2d, 0x87, 0x32, 0x62, 0x71, 0x6d, 0x78, 0x13,
+	0x86, 0xd7, 0x49, 0xf2, 0x85, 0xf6, 0x41, 0x7d, 0xdb, 0x37, 0xf4, 0xbe, 0x5e, 0x3e, 0x2c, 0x1e,
+	0x6d, 0xe0, 0x35, 0xb9, 0xfe, 0x0d, 0xbd, 0x47, 0x1f, 0x41, 0x4d, 0xa9, 0x44, 0x1c, 0x7a, 0x94,
+	0xd7, 0x2b, 0x87, 0xe5, 0xa3, 0x0d, 0x0c, 0x52, 0x34, 0x54, 0x12, 0xfd, 0x15, 0xec, 0xe6, 0x9d,
+	0x0f, 0x6d, 0xc0, 0xba, 0x69, 0x19, 0x27, 0x43, 0xf3, 0xb2, 0xab, 0x15, 0x10, 0xc0, 0x6a, 0xf2,
+	0x5d, 0xd4, 0x3f, 0x85, 0x5a, 0xe6, 0x18, 0xe8, 0x00, 0xf6, 0xfa, 0xb8, 0x7b, 0x72, 0x7e, 0xd6,
+	0xbf, 0x18, 0x76, 0x3b, 0xb6, 0x71, 0x31, 0x7c, 0x6b, 0x0f, 0x2f, 0xfa, 0xbd, 0xee, 0x40, 0x2b,
+	0xe8, 0xff, 0x2a, 0xc1, 0x76, 0x6f, 0xd8, 0xfd, 0xae, 0xcc, 0xcd, 0x99, 0xce, 0xaf, 0x9f, 0xc3,
+	0x5c, 0x0e, 0xd4, 0xf3, 0x98, 0x4b, 0x55, 0x2c, 0x1c, 0xd7, 0x2b, 0x33, 0xd5, 0x79, 0x38, 0x46,
+	0x4d, 0xf8, 0x1e, 0xe1, 0xdc, 0x9d, 0x04, 0xd4, 0xb1, 0x47, 0x84, 0x53, 0x3b, 0x20, 0x3e, 0xe5,
+	0x75, 0x38, 0x2c, 0x1f, 0x55, 0xf1, 0x4e, 0xaa, 0x6a, 0x13, 0x4e, 0x2d, 0xa9, 0x40, 0x9f, 0xc1,
+	0x83, 0xd0, 0x0e, 0x99, 0xe7, 0x8e, 0x5d, 0xca, 0xeb, 0x35, 0x65, 0xad, 0xa5, 0x8a, 0x7e, 0x22,
+	0x97, 0x09, 0xc9, 0x0b, 0x7b, 0x49, 0x42, 0x0e, 0xa0, 0x96, 0x89, 0x4e, 0x1a, 0x9e, 0x99, 0xbd,
+	0xae, 0x65, 0x9c, 0x76, 0xb5, 0x82, 0xfe, 0xd7, 0x62, 0xb6, 0xf8, 0xa7, 0x50, 0x9f, 0xc0, 0x8e,
+	0x27, 0xa8, 0xad, 0xc2, 0x0b, 0xe8, 0x9d, 0xb0, 0x39, 0xbd, 0x55, 0xd9, 0xa8, 0xe0, 0x2d, 0x4f,
+	0x50, 0x89, 0x64, 0xd1, 0x3b, 0x31, 0xa0, 0xb7, 0xe8, 0x18, 0x76, 0xc5, 0x24, 0x0c, 0x6d, 0x42,
+	0x88, 0xcd, 0x69, 0xf4, 0x9e, 0x46, 0x2a, 0x58, 0x45, 0x78, 0x15, 0xef, 0x48, 0x9d, 0x41, 0xc8,
+	0x40, 0x69, 0x64, 0xb0, 0xe8, 0x6b, 0x68, 0xcc, 0x3b, 0x44, 0x74, 0xe2, 0x72, 0x41, 0x23, 0xea,
+	0x28, 0x8e, 0xd7, 0xf1, 0xde, 0x23, 0x37, 0xfc, 0xa0, 0x96, 0x9c, 0xfb, 0x3e, 0xb5, 0xaf, 0x19,
+	0x17, 0x8a, 0xf3, 0x2a, 0x5e, 0xf3, 0x7d, 0xfa, 0x96, 0x71, 0xa1, 0xff, 0xb1, 0x02, 0x9a, 0xd1,
+	0xb7, 0x4e, 0x58, 0x70, 0xe5, 0x4e, 0xe2, 0x88, 0xa8, 0x52, 0x7a, 0x09, 0x30, 0x66, 0x81, 0x90,
+	0x21, 0x24, 0x8d, 0x63, 0x13, 0x57, 0x13, 0x89, 0xe9, 0x48, 0xde, 0xe5, 0x11, 0xdc, 0x31, 0xb5,
+	0x39, 0xf5, 0xe8, 0x58, 0xfa, 0x24, 0x27, 0xd7, 0x12, 0xc5, 0x20, 0x95, 0xa3, 0x53, 0xa8, 0xdd,
+	0x32, 0x6e, 0x87, 0x11, 0xbb, 0x72, 0x3d, 0xaa, 0x4e, 0x5a, 0x7b, 0x54, 0x51, 0xf3, 0xbb, 0x37,
+	0x7f, 0xcb, 0x06, 0xfd, 0xa9, 0x35, 0x86, 0x5b, 0xc6, 0x93, 0x6f, 0xf4, 0x33, 0xa8, 0x10, 0x7f,
+	0x14, 0xa9, 0x00, 0x6a, 0xad, 0x8f, 0xb3, 0x08, 0x93, 0x49, 0x44, 0x27, 0x44, 0x50, 0xe7, 0x8c,
+	0xdc, 0xb9, 0x7e, 0xec, 0xb7, 0x5d, 0x11, 0xc9, 0x92, 0x56, 0x0e, 0xe8, 0x0b, 0x28, 0x87, 0x4e,
+	0x50, 0x5f, 0x51, 0xb5, 0xfc, 0xf1, 0xb2, 0x9d, 0xfb, 0x1d, 0x4b, 0xb5, 0x3c, 0x69, 0xdf, 0xf8,
+	0x4b, 0x11, 0x60, 0x76, 0x14, 0xc9, 0xe1, 0xd8, 0x23, 0x9c, 0xa7, 0x8c, 0xac, 0xe0, 0x35, 0xb5,
+	0x36, 0x1d, 0xf4, 0x43, 0xd8, 0x0a, 0x23, 0x97, 0x45, 0xae, 0xb8, 0xb7, 0x3d, 0xfa, 0x9e, 0x7a,
+	0x8a, 0x8c, 0x4d, 0xbc, 0x99, 0x4a, 0x7b, 0x52, 0x88, 0x5e, 0xc3, 0x07, 0x61, 0x44, 0xa9, 0xaf,
+	0x8a, 0xcf, 0x1e, 0x93, 0x90, 0x8c, 0x5c, 0xcf, 0x15, 0xf7, 0x49, 0xf6, 0x76, 0x67, 0xca, 0x93,
+	0x07, 0x1d, 0xfa, 0x39, 0xd4, 0x33, 0x4e, 0xef, 0x63, 0x2f, 0xa0, 0x51, 0xea, 0x57, 0x99, 0x66,
+	0x7d, 0xa6, 0xbf, 0xcc, 0xaa, 0xf5, 0xaf, 0x61, 0x2d, 0x09, 0x48, 0xf5, 0xec, 0xfe, 0xe5, 0x4f,
+	0xb5, 0x42, 0xf2, 0xf5, 0xa5, 0x56, 0x94, 0xa5, 0x2e, 0x65, 0x97, 0x5f, 0x6a, 0x25, 0xa4, 0xc1,
+	0x86, 0xfc, 0xb6, 0xcf, 0xb1, 0xad, 0xb4, 0x65, 0x3d, 0x80, 0xfa, 0x22, 0x5a, 0xd1, 0x11, 0x68,
+	0x3e, 0xb9, 0xb3, 0x47, 0x24, 0x70, 0xbe, 0x75, 0x1d, 0x71, 0x6d, 0xc7, 0x5e, 0x52, 0x24, 0x5b,
+	0x3e, 0xb9, 0x6b, 0xa7, 0xe2, 0x0b, 0xef, 0xa9, 0xa5, 0x93, 0x72, 0xf3, 0xc8, 0xb2, 0xe3, 0xe9,
+	0x7f, 0xab, 0x00, 0xb2, 0x58, 0xf0, 0xfa, 0xb4, 0xdf, 0xbf, 0xe0, 0x34, 0x4a, 0x59, 0x7f, 0x01,
+	0xab, 0x3e, 0x77, 0xb9, 0x13, 0x24, 0xcf, 0x57, 0xb2, 0x42, 0xdf, 0x00, 0x0a, 0x58, 0x60, 0xbf,
+	0x96, 0x57, 0xc2, 0x0d, 0x6d, 0x32, 0x1e, 0x53, 0xce, 0x93, 0x76, 0xf5, 0x79, 0x26, 0xc5, 0x4f,
+	0x21, 0x53, 0x91, 0xd9, 0x37, 0x94, 0x13, 0xde, 0x0e, 0x58, 0x20, 0x71, 0xcc, 0x70, 0x2a, 0x40,
+	0x0e, 0xbc, 0x78, 0x8a, 0x6d, 0x93, 0x30, 0x50, 0x89, 0xda, 0x6a, 0xbd, 0x7a, 0x16, 0xbe, 0xd1,
+	0xb7, 0x30, 0x9a, 0xdb, 0xc2, 0x08, 0x83, 0xff, 0xbe, 0x9c, 0x7f, 0x01, 0x40, 0xc2, 0xc0, 0x1e,
+	0xab, 0xca, 0x55, 0x55, 0x5d, 0x6b, 0x1d, 0x2c, 0xa9, 0x6a, 0x5c, 0x25, 0x61, 0x30, 0x95, 0xa0,
+	0x37, 0xb0, 0x99, 0x84, 0x13, 0x50, 0x75, 0xb7, 0x57, 0x55, 0x44, 0x7a, 0xd6, 0x5d, 0xe9, 0x2d,
+	0x2a, 0xbe, 0x65, 0xd1, 0x8d, 0xe9, 0xd0, 0x40, 0xb8, 0x57, 0x2e, 0x8d, 0x70, 0x8d, 0xa4, 0x0a,
+	0xd3, 0xd1, 0x2f, 0x61, 0x7b, 0x2e, 0x4c, 0xf4, 0x03, 0x78, 0x69, 0x9d, 0x5b, 0xb6, 0x94, 0xd9,
+	0x83, 0x8b, 0xf6, 0xe0, 0x04, 0x9b, 0xfd, 0xa1, 0x79, 0x6e, 0xd9, 0x46, 0xaf, 0x77, 0xfe, 0xae,
+	0xdb, 0xd1, 0x0a, 0xe8, 0x10, 0x3e, 0xcc, 0x37, 0x69, 0x1b, 0x18, 0x77, 0x3b, 0x5a, 0x51, 0x37,
+	0x01, 0xcd, 0xe1, 0x1a, 0x7d, 0x0b, 0xd5, 0x61, 0xf7, 0xc1, 0xcf, 0xe8, 0x5b, 0x03, 0xbb, 0x6b,
+	0x19, 0xed, 0x9e, 0x6c, 0xd7, 0xfb, 0xf0, 0xc1, 0x63, 0x4d, 0xc7, 0x1c, 0x28, 0x55, 0x51, 0xff,
+	0x47, 0x09, 0xb6, 0x66, 0x0d, 0xba, 0x43, 0x04, 0x41, 0x9f, 0x40, 0x99, 0x27, 0xb7, 0x77, 0xc9,
+	0x6c, 0x22, 0x6d, 0xd0, 0x4f, 0xa0, 0x3c, 0xe1, 0xbe, 0x2a, 0xa8, 0x5a, 0xab, 0xb1, 0x78, 0x72,
+	0xc0, 0xd2, 0x4c, 0x5a, 0x7b, 0x22, 0xed, 0x6d, 0x8d, 0xc5, 0xaf, 0x25, 0x96, 0x66, 0xe8, 0x0b,
+	0x80, 0x60, 0x4a, 0xaf, 0xcc, 0xc0, 0x34, 0xff, 0x2f, 0x12, 0x27, 0x35, 0xf6, 0x35, 0x53, 0xf6,
+	0x3b, 0xb8, 0x1a, 0xa4, 0x89, 0x40, 0xaf, 0xd2, 0xf7, 0x7d, 0xe5, 0xc9, 0x36, 0x73, 0x0f, 0x51,
+	0xfa, 0x94, 0x7f, 0x04, 0x35, 0x1e, 0x8f, 0x1e, 0x5a, 0xef, 0xaa, 0xba, 0x41, 0xc0, 0xe3, 0x51,
+	0x7a, 0xbb, 0xbe, 0x82, 0xf5, 0xb4, 0xd2, 0xeb, 0x6b, 0x0a, 0xf5, 0xe5, 0xd2, 0xda, 0xc6, 0x6b,
+	0x49, 0x21, 0xeb, 0xb7, 0xa0, 0xcd, 0x36, 0xbd, 0x08, 0x1d, 0xb9, 0xdd, 0xe7, 0x50, 0x71, 0x88,
+	0x20, 0x09, 0xbf, 0xfb, 0xb9, 0xe7, 0x93, 0x79, 0xc0, 0xca, 0x0c, 0x35, 0xa1, 0x22, 0x67, 0xd6,
+	0x07, 0x8e, 0xa7, 0x63, 0x6d, 0x33, 0x1d, 0x6b, 0x9b, 0x6f, 0xe4, 0x58, 0x7b, 0x46, 0xf8, 0x0d,
+	0x56, 0x76, 0xfa, 0x04, 0xf6, 0xba, 0x01, 0x19, 0x79, 0x54, 0xc6, 0xe8, 0x8e, 0x71, 0xec, 0x51,
+	0x4c, 0x6f, 0x63, 0xca, 0x05, 0x42, 0x50, 0x71, 0x7d, 0xee, 0x26, 0x3d, 0x42, 0x7d, 0xcb, 0x7e,
+	0x1d, 0xc5, 0x1e, 0xb5, 0xe5, 0x34, 0x5a, 0x52, 0x33, 0xc1, 0x9a, 0x5c, 0x9b, 0x0e, 0x97, 0xcf,
+	0x5b, 0x66, 0xbc, 0x28, 0x2b, 0x65, 0x75, 0x94, 0x8e, 0x15, 0xfa, 0x35, 0xd4, 0x3b, 0x2e, 0xff,
+	0x7f, 0xec, 0x24, 0xb2, 0x2c, 0xf6, 0x18, 0xbb, 0x89, 0xc3, 0xb9, 0xea, 0x28, 0x7e, 0xd7, 0xea,
+	0x48, 0x6a, 0xbb, 0xf4, 0x9f, 0x6b, 0x5b, 0xff, 0x03, 0x7c, 0x78, 0x4a, 0x85, 0xe1, 0x79, 0x73,
+	0x69, 0xa1, 0x3c, 0x64, 0x01, 0x97, 0xa3, 0x46, 0x6d, 0xf6, 0xcf, 0x49, 0x3a, 0xca, 0x2f, 0x49,
+	0x67, 0xd6, 0xfa, 0xd3, 0x37, 0xb0, 0xb7, 0xa0, 0x83, 0xc8, 0xa7, 0xe7, 0x2d, 0xee, 0xcb, 0x46,
+	0x50, 0x85, 0x95, 0x77, 0xe6, 0x99, 0xf1, 0x3b, 0xad, 0x28, 0x85, 0xef, 0x7a, 0x86, 0xa5, 0x95,
+	0xe4, 0x7c, 0xd5, 0x1d, 0xbe, 0xed, 0x62, 0xab, 0x3b, 0xd4, 0xca, 0xad, 0x3f, 0x17, 0xa1, 0xa1,
+	0x66, 0xb7, 0x7b, 0x43, 0x4d, 0x72, 0x3e, 0x0d, 0xc4, 0x09, 0x0b, 0x44, 0xc4, 0x3c, 0x8f, 0x46,
+	0xa8, 0x07, 0x3b, 0xf3, 0xc5, 0xc0, 0x51, 0xb6, 0x8d, 0x2d, 0x28, 0x95, 0xc6, 0xce, 0x23, 0x2a,
+	0x2f, 0x99, 0xeb, 0xe8, 0x05, 0x64, 0x01, 0x7a, 0x92, 0x71, 0x8e, 0xb2, 0x3d, 0x79, 0x51, 0x41,
+	0xe4, 0xe2, 0xb5, 0xfe, 0x59, 0xca, 0xfe, 0x07, 0xd6, 0x69, 0xa3, 0x5f, 0xc1, 0xa6, 0xe1, 0x38,
+	0x33, 0x11, 0x5a, 0x4c, 0x67, 0xfe, 0x09, 0x7f, 0x0d, 0x5a, 0x87, 0x7a, 0x54, 0xd0, 0x0c, 0xc6,
+	0xa2, 0x2c, 0xe7, 0x23, 0x74, 0x40, 0x9b, 0xde, 0xd3, 0x0c, 0xc2, 0x41, 0x2e, 0xc2, 0xd4, 0x2c,
+	0x1f, 0xc5, 0x84, 0x9d, 0x53, 0x2a, 0xe6, 0xfa, 0xea, 0xc2, 0x83, 0x2c, 0x8e, 0x52, 0x2f, 0xa0,
+	0x36, 0x6c, 0xf7, 0x5c, 0x9e, 0xc1, 0xe2, 0xe8, 0xe9, 0x96, 0x8d, 0xc6, 0x02, 0xec, 0x01, 0x15,
+	0x7a, 0xa1, 0xf5, 0xf7, 0x32, 0xbc, 0xc8, 0x12, 0x9d, 0xa9, 0x90, 0xff, 0x99, 0xf2, 0x4e, 0x0e,
+	0xe5, 0xf9, 0x84, 0x4d, 0x6f, 0x6e, 0x3e, 0x4a, 0x3b, 0x87, 0xf6, 0xe7, 0x9e, 0xe4, 0x2c, 0x8f,
+	0xf4, 0xa5, 0x47, 0x59, 0x4a, 0xfc, 0xe9, 0x53, 0xe2, 0x17, 0x34, 0x98, 0xe5, 0xec, 0xa3, 0xdf,
+	0xc3, 0x6e, 0x5e, 0x23, 0x59, 0x88, 0xf6, 0xe3, 0xec, 0x3b, 0xba, 0xa4, 0x03, 0xe9, 0x85, 0xf6,
+	0xc1, 0x37, 0xfb, 0xca, 0xf6, 0x58, 0xfe, 0x62, 0x32, 0xf6, 0x58, 0xec, 0x1c, 0x4f, 0x58, 0xf2,
+	0x73, 0xc8, 0x68, 0x55, 0xfd, 0x7d, 0xfd, 0xef, 0x01, 0x00, 0xd2, 0x11, 0x85, 0x66, 0x4f, 0x11,
 	0x00, 0x00,
 }
 
@@ -85,7 +85,7 @@ func TestEpsAuthSuite(t *testing.T) {
 			},
 		},
 	}
-	err = configurator.CreateNetwork(configurator.Network{
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{
 		ID:      "test",
 		Type:    "lte",
 		Configs: map[string]interface{}{lte.CellularNetworkType: cellularConfig},
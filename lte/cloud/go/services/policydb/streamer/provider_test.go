@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package streamer_test
 
 import (
+	"context"
 	"testing"
 
 	"magma/lte/cloud/go/lte"
@@ -33,13 +34,13 @@ func TestPolicyStreamers(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
 	assert.NoError(t, err)
 
 	// create the rules first otherwise base names can't associate to them
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type: lte.PolicyRuleEntityType,
 			Key:  "r1",
@@ -79,7 +80,7 @@ func TestPolicyStreamers(t *testing.T) {
 		},
 	})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type:   lte.BaseNameEntityType,
 			Key:    "b1",
@@ -162,12 +163,12 @@ func TestRuleMappingsProvider(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: lte.SubscriberEntityType, Key: "s1"},
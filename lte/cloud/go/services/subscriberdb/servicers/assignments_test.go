@@ -45,11 +45,11 @@ func TestAssignmentsServicer(t *testing.T) {
 	testBaseName := "b1"
 
 	// Initialize network
-	err := configurator.CreateNetwork(configurator.Network{ID: testNetworkId})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: testNetworkId})
 	assert.NoError(t, err)
 
 	// Initialize gateway -> subscriber, and create a policy rule
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		testNetworkId,
 		[]configurator.NetworkEntity{
 			{Type: lte.SubscriberEntityType, Key: testSubscriberId},
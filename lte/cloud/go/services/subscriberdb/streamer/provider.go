@@ -9,13 +9,16 @@ LICENSE file in the root directory of this source tree.
 package streamer
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 
 	"magma/lte/cloud/go/lte"
 	models2 "magma/lte/cloud/go/plugin/models"
 	protos2 "magma/lte/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
@@ -37,15 +40,68 @@ func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any
 	if err != nil {
 		return nil, err
 	}
+	return entitiesToUpdates(ent.NetworkID, subEnts)
+}
+
+// GetVersions returns the version of each subscriber of the gateway's
+// network without loading their configs. The value of a subscriber depends
+// on its config, which bumps the entity version when it changes, and on its
+// parent associations, which don't. The graph ID is included as well, since
+// a subscriber which is deleted and recreated starts over at version 0.
+func (provider *SubscribersProvider) GetVersions(gatewayId string, extraArgs *any.Any) (map[string]string, error) {
+	ent, err := configurator.LoadEntityForPhysicalID(gatewayId, configurator.EntityLoadCriteria{})
+	if err != nil {
+		return nil, err
+	}
+
+	subEnts, err := configurator.LoadAllEntitiesInNetwork(ent.NetworkID, lte.SubscriberEntityType, configurator.EntityLoadCriteria{LoadAssocsToThis: true})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string]string, len(subEnts))
+	for _, sub := range subEnts {
+		sid, err := protos2.SidProto(sub.Key)
+		if err != nil {
+			return nil, err
+		}
+		parents := make([]string, 0, len(sub.ParentAssociations))
+		for _, assoc := range sub.ParentAssociations {
+			parents = append(parents, assoc.String())
+		}
+		sort.Strings(parents)
+		ret[protos2.SidString(sid)] = fmt.Sprintf("%d/%s/%s", sub.Version, sub.GraphID, strings.Join(parents, ","))
+	}
+	return ret, nil
+}
+
+// GetUpdatesForKeys returns the updates of the given subscribers. The key of
+// a subscriber's update is its entity key.
+func (provider *SubscribersProvider) GetUpdatesForKeys(gatewayId string, keys []string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	ent, err := configurator.LoadEntityForPhysicalID(gatewayId, configurator.EntityLoadCriteria{})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: lte.SubscriberEntityType, Key: key})
+	}
+	subEnts, _, err := configurator.LoadEntities(ent.NetworkID, nil, nil, nil, ids, configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsToThis: true})
+	if err != nil {
+		return nil, err
+	}
+	return entitiesToUpdates(ent.NetworkID, subEnts)
+}
 
+func entitiesToUpdates(networkID string, subEnts []configurator.NetworkEntity) ([]*protos.DataUpdate, error) {
 	subProtos := make([]*protos2.SubscriberData, 0, len(subEnts))
 	for _, sub := range subEnts {
-		subProto := &protos2.SubscriberData{}
-		subProto, err = subscriberToMconfig(sub)
+		subProto, err := subscriberToMconfig(sub)
 		if err != nil {
 			return nil, err
 		}
-		subProto.NetworkId = &protos.NetworkID{Id: ent.NetworkID}
+		subProto.NetworkId = &protos.NetworkID{Id: networkID}
 		subProtos = append(subProtos, subProto)
 	}
 	return subscribersToUpdates(subProtos)
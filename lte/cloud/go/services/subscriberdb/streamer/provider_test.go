@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package streamer_test
 
 import (
+	"context"
 	"testing"
 
 	"magma/lte/cloud/go/lte"
@@ -21,6 +22,7 @@ import (
 	orcprotos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/configurator"
 	cfg_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/streamer/providers"
 	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
@@ -32,14 +34,14 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	cfg_test_init.StartTestService(t)
 	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
 	assert.NoError(t, err)
 
 	// 1 sub without a profile on the backend (should fill as "default"), the
 	// other inactive with a sub profile
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type: lte.SubscriberEntityType, Key: "IMSI12345",
 			Config: &models2.LteSubscription{
@@ -84,7 +86,7 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	assert.Equal(t, expected, actual)
 
 	// Create policies and base name associated to sub
-	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
 		{
 			Type: lte.BaseNameEntityType, Key: "bn1",
 			Associations: []storage.TypeAndKey{{Type: lte.SubscriberEntityType, Key: "IMSI12345"}},
@@ -114,3 +116,46 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 }
+
+func TestSubscriberdbStreamer_Versions(t *testing.T) {
+	cfg_test_init.StartTestService(t)
+	_ = plugin.RegisterPluginForTests(t, &plugin2.LteOrchestratorPlugin{})
+
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(context.Background(), "n1", []configurator.NetworkEntity{
+		{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"},
+		{Type: lte.SubscriberEntityType, Key: "IMSI12345", Config: &models2.LteSubscription{State: "ACTIVE"}},
+		{Type: lte.SubscriberEntityType, Key: "IMSI67890", Config: &models2.LteSubscription{State: "INACTIVE"}},
+	})
+	assert.NoError(t, err)
+
+	var pro providers.VersionedStreamProvider = &sdbstreamer.SubscribersProvider{}
+	versions, err := pro.GetVersions("hw1", nil)
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+
+	// Changing a subscriber's config or its parents changes its version
+	_, err = configurator.UpdateEntity(context.Background(), "n1", configurator.EntityUpdateCriteria{
+		Type: lte.SubscriberEntityType, Key: "IMSI12345",
+		NewConfig: &models2.LteSubscription{State: "INACTIVE"},
+	})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
+		Type: lte.PolicyRuleEntityType, Key: "r1",
+		Associations: []storage.TypeAndKey{{Type: lte.SubscriberEntityType, Key: "IMSI67890"}},
+	})
+	assert.NoError(t, err)
+	newVersions, err := pro.GetVersions("hw1", nil)
+	assert.NoError(t, err)
+	assert.Len(t, newVersions, 2)
+	assert.NotEqual(t, versions["IMSI12345"], newVersions["IMSI12345"])
+	assert.NotEqual(t, versions["IMSI67890"], newVersions["IMSI67890"])
+
+	// Updates for keys match the full updates of the same subscribers
+	all, err := pro.GetUpdates("hw1", nil)
+	assert.NoError(t, err)
+	actual, err := pro.GetUpdatesForKeys("hw1", []string{"IMSI67890", "IMSI00000"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []*orcprotos.DataUpdate{all[1]}, actual)
+}
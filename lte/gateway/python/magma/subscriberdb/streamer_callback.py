@@ -8,13 +8,15 @@ of patent rights can be found in the PATENTS file in the same directory.
 """
 
 import logging
-from typing import Any
+from typing import Any, Optional
 
 from lte.protos.s6a_service_pb2 import DeleteSubscriberRequest
 from lte.protos.s6a_service_pb2_grpc import S6aServiceStub
 from lte.protos.subscriberdb_pb2 import SubscriberData, LTESubscription
 from magma.common.service_registry import ServiceRegistry
 from magma.common.streamer import StreamerClient
+from magma.subscriberdb.sid import SIDUtils
+from magma.subscriberdb.store.base import DuplicateSubscriberError
 
 
 class SubscriberDBStreamerCallback(StreamerClient.Callback):
@@ -25,10 +27,52 @@ class SubscriberDBStreamerCallback(StreamerClient.Callback):
     def __init__(self, store, loop):
         self._store = store
         self._loop = loop
+        # Digest of the subscribers received from the cloud. Only kept in
+        # memory, so the first stream after a restart is a full resync.
+        self._digest = ''
 
     def get_request_args(self, stream_name: str) -> Any:
         return None
 
+    def get_stream_digest(self, stream_name: str) -> Optional[str]:
+        return self._digest
+
+    def process_digest_update(self, stream_name, updates, resync, digest):
+        """
+        Incremental batches only carry the subscribers which were added,
+        changed or deleted since the digest we hold. Deleted and inactive
+        subscribers are detached.
+        """
+        if resync:
+            self.process_update(stream_name, updates, resync)
+        else:
+            logging.info("Processing %d incremental subscriber updates",
+                         len(updates))
+            self._apply_updates(updates)
+        self._digest = digest
+
+    def _apply_updates(self, updates):
+        detached_sub_ids = []
+        for update in updates:
+            if update.deleted:
+                self._store.delete_subscriber(update.key)
+                detached_sub_ids.append(update.key)
+                continue
+
+            sub = SubscriberData()
+            sub.ParseFromString(update.value)
+            try:
+                self._store.add_subscriber(sub)
+            except DuplicateSubscriberError:
+                # Keep the local state of the subscriber, as resync does
+                with self._store.edit_subscriber(
+                        SIDUtils.to_str(sub.sid)) as subscriber_data:
+                    sub.state.CopyFrom(subscriber_data.state)
+                    subscriber_data.CopyFrom(sub)
+            if sub.lte.state != LTESubscription.ACTIVE:
+                detached_sub_ids.append(update.key)
+        self.detach_deleted_subscribers(detached_sub_ids, [])
+
     def process_update(self, stream_name, updates, resync):
         """
         The cloud streams ALL subscribers registered, both active and inactive.
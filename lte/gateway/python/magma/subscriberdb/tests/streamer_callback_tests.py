@@ -14,10 +14,13 @@ import unittest
 import unittest.mock
 
 from lte.protos.s6a_service_pb2 import DeleteSubscriberRequest
+from lte.protos.subscriberdb_pb2 import LTESubscription, SubscriberData
+from magma.subscriberdb.sid import SIDUtils
 from magma.subscriberdb.store.sqlite import SqliteStore
 from magma.subscriberdb.streamer_callback import SubscriberDBStreamerCallback
 
 from magma.common.service_registry import ServiceRegistry
+from orc8r.protos.streamer_pb2 import DataUpdate
 
 
 class MockFuture(object):
@@ -83,6 +86,57 @@ class SubscriberDBStreamerCallbackTests(unittest.TestCase):
         mock.DeleteSubscriber.future.assert_called_once_with(
             DeleteSubscriberRequest(imsi_list=["101", "303"]))
 
+    @unittest.mock.patch('magma.subscriberdb.streamer_callback.S6aServiceStub')
+    def test_process_digest_update(self, s6a_service_mock_stub):
+        """
+        Test that incremental updates are applied to the store, and that
+        deleted and inactive subscribers are detached.
+        """
+        mock = unittest.mock.Mock()
+        mock.DeleteSubscriber.future.side_effect = [unittest.mock.Mock()]
+        s6a_service_mock_stub.side_effect = [mock]
+        store = self._streamer_callback._store
+
+        def update(sid, state):
+            sub = SubscriberData(sid=SIDUtils.to_pb(sid),
+                                 lte=LTESubscription(state=state))
+            return DataUpdate(key=sid, value=sub.SerializeToString())
+
+        self.assertEqual(
+            '', self._streamer_callback.get_stream_digest('subscriberdb'))
+        self._streamer_callback.process_digest_update(
+            'subscriberdb',
+            [update('IMSI101', LTESubscription.ACTIVE),
+             update('IMSI202', LTESubscription.ACTIVE)],
+            True,
+            'digest1',
+        )
+        self.assertEqual(['IMSI101', 'IMSI202'],
+                         sorted(store.list_subscribers()))
+        self.assertEqual(
+            'digest1',
+            self._streamer_callback.get_stream_digest('subscriberdb'))
+        mock.DeleteSubscriber.future.assert_not_called()
+
+        # Deactivate IMSI101, delete IMSI202 and add IMSI303
+        self._streamer_callback.process_digest_update(
+            'subscriberdb',
+            [update('IMSI101', LTESubscription.INACTIVE),
+             update('IMSI303', LTESubscription.ACTIVE),
+             DataUpdate(key='IMSI202', deleted=True)],
+            False,
+            'digest2',
+        )
+        self.assertEqual(['IMSI101', 'IMSI303'],
+                         sorted(store.list_subscribers()))
+        self.assertEqual(LTESubscription.INACTIVE,
+                         store.get_subscriber_data('IMSI101').lte.state)
+        self.assertEqual(
+            'digest2',
+            self._streamer_callback.get_stream_digest('subscriberdb'))
+        mock.DeleteSubscriber.future.assert_called_once_with(
+            DeleteSubscriberRequest(imsi_list=["101", "202"]))
+
 
 if __name__ == "__main__":
     unittest.main()
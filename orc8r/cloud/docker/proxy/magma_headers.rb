@@ -8,8 +8,18 @@
 # Nghttpx can run mcruby scripts as part of each request handling:
 # See: https://nghttp2.org/documentation/nghttpx.1.html?highlight=mruby-file#mruby-scripting
 
+# The cloud proxy prepends the serial numbers of revoked certificates, as
+# published in the certifier CRLs, to this script as REVOKED_SERIALS. Requests
+# presenting a revoked client cert are rejected.
+
 class App
   def on_req(env)
+    if Object.const_defined?(:REVOKED_SERIALS) && REVOKED_SERIALS[env.tls_client_serial.upcase]
+      env.resp.status = 403
+      env.resp.return("client certificate has been revoked")
+      return
+    end
+
     # Inject Magma headers to inform the backend services about the client cert.
     # The headers would be present for all requests, and a empty string as
     # value indicate invalid cert.
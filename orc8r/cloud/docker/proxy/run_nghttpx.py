@@ -10,15 +10,24 @@
 import argparse
 import jinja2
 import os
+import signal
 import subprocess
+import time
+import urllib.request
 import yaml
-from typing import Any, Dict
+from typing import Any, Dict, Optional, Set
 
 CONFIGS_DIR = "/etc/magma/configs"
 TEMPLATES_DIR = "/etc/magma/templates"
 OUTPUT_DIR = "/etc/nghttpx"
 OBSIDIAN_PORT = 9081
 
+CERTIFIER_CA = "/var/opt/magma/certs/certifier.pem"
+MRUBY_FILE = "/etc/nghttpx/magma_headers.rb"
+CLIENTCERT_MRUBY_FILE = os.path.join(OUTPUT_DIR, "magma_headers_clientcert.rb")
+# How often the CRLs in CRL_URLS are fetched, in seconds
+DEFAULT_CRL_REFRESH_INTERVAL = 60
+
 
 def _load_services() -> Dict[Any, Any]:
     """ Return the services from the registry configs of all modules """
@@ -46,17 +55,72 @@ def _generate_config(proxy_type: str, context: Dict[str, Any]) -> str:
     return outfile
 
 
-def _run_nghttpx(conf: str) -> None:
-    """ Runs the nghttpx process given the config file """
-    try:
-        subprocess.run([
-            "/usr/local/bin/nghttpx",
-            "--conf=%s" % conf,
-            "/var/opt/magma/certs/controller.key",
-            "/var/opt/magma/certs/controller.crt",
-        ], check=True)
-    except subprocess.CalledProcessError as err:
-        exit(err.returncode)
+def _fetch_revoked_serials(crl_urls: str) -> Optional[Set[str]]:
+    """
+    Return the serial numbers of the certificates revoked by the CRLs at the
+    comma separated crl_urls, formatted as nghttpx formats client cert serial
+    numbers. None is returned if any CRL can't be fetched or verified.
+    """
+    serials = set()  # type: Set[str]
+    for url in crl_urls.split(","):
+        try:
+            with urllib.request.urlopen(url, timeout=10) as res:
+                crl = res.read()
+            out = subprocess.run(
+                ["openssl", "crl", "-inform", "DER", "-CAfile", CERTIFIER_CA,
+                 "-noout", "-text"],
+                input=crl, stdout=subprocess.PIPE, stderr=subprocess.PIPE,
+                check=True,
+            ).stdout.decode()
+        except Exception as err:  # pylint: disable=broad-except
+            print("Failed to fetch CRL from %s: %s" % (url, err))
+            return None
+        for line in out.splitlines():
+            line = line.strip()
+            if line.startswith("Serial Number:"):
+                serial = "%X" % int(line.split(":", 1)[1].strip(), 16)
+                serials.add(serial.zfill(len(serial) + len(serial) % 2))
+    return serials
+
+
+def _write_clientcert_mruby(revoked_serials: Set[str]) -> None:
+    """ Prepend the revoked serial numbers to the mruby script """
+    with open(MRUBY_FILE) as file:
+        script = file.read()
+    entries = ", ".join('"%s" => true' % sn for sn in sorted(revoked_serials))
+    with open(CLIENTCERT_MRUBY_FILE, "w") as file:
+        file.write("REVOKED_SERIALS = {%s}\n\n%s" % (entries, script))
+
+
+def _run_nghttpx(conf: str, crl_urls: str) -> None:
+    """
+    Runs the nghttpx process given the config file. If crl_urls is set, the
+    CRLs are fetched periodically and nghttpx is reloaded when the set of
+    revoked certificates changes. Until the CRLs are fetched successfully,
+    or if a fetch fails, the last known revocations are enforced.
+    """
+    proc = subprocess.Popen([
+        "/usr/local/bin/nghttpx",
+        "--conf=%s" % conf,
+        "/var/opt/magma/certs/controller.key",
+        "/var/opt/magma/certs/controller.crt",
+    ])
+    if not crl_urls:
+        exit(proc.wait())
+
+    interval = int(os.environ.get(
+        "CRL_REFRESH_INTERVAL", DEFAULT_CRL_REFRESH_INTERVAL))
+    revoked_serials = set()  # type: Set[str]
+    while proc.poll() is None:
+        serials = _fetch_revoked_serials(crl_urls)
+        if serials is not None and serials != revoked_serials:
+            print("Reloading nghttpx with %d revoked certificates"
+                  % len(serials))
+            revoked_serials = serials
+            _write_clientcert_mruby(revoked_serials)
+            proc.send_signal(signal.SIGHUP)
+        time.sleep(interval)
+    exit(proc.returncode)
 
 
 def main() -> None:
@@ -71,12 +135,19 @@ def main() -> None:
     context["proxy_backends"] = os.environ["PROXY_BACKENDS"]
     context["obsidian_port"] = OBSIDIAN_PORT
     context["env"] = os.environ
+    context["clientcert_mruby_file"] = CLIENTCERT_MRUBY_FILE
+
+    # Reject revoked client certs, see magma_headers.rb
+    crl_urls = ""
+    if args.proxy_type == "clientcert":
+        crl_urls = os.environ.get("CRL_URLS", "")
+        _write_clientcert_mruby(set())
 
     # Generate the nghttpx config
     conf = _generate_config(args.proxy_type, context)
 
     # Run the nghttpx process
-    _run_nghttpx(conf)
+    _run_nghttpx(conf, crl_urls)
 
 
 if __name__ == '__main__':
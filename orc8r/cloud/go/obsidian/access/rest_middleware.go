@@ -17,6 +17,7 @@ import (
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/services/accessd"
 	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+	"magma/orc8r/cloud/go/services/configurator"
 
 	"github.com/golang/glog"
 	"github.com/labstack/echo"
@@ -73,6 +74,12 @@ func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 					c, http.StatusForbidden, "Access Denied (%s)", err)
 			}
 		}
+		// Mutations made by the handler are attributed to the operator
+		req := c.Request()
+		if author := req.Header.Get(CLIENT_CERT_CN_KEY); author != "" {
+			c.SetRequest(req.WithContext(configurator.WithRevisionAuthor(req.Context(), author)))
+		}
+
 		// all good, call next handler
 		if next != nil {
 			return next(c)
@@ -91,6 +98,10 @@ func requestPermissions(c echo.Context) accessprotos.AccessControl_Permission {
 	switch c.Request().Method {
 	case "GET", "HEAD":
 		perm = accessprotos.AccessControl_READ
+		// Websocket sessions, such as remote shells, can change anything
+		if c.IsWebSocket() {
+			perm |= accessprotos.AccessControl_WRITE
+		}
 	case "PUT", "POST", "DELETE":
 		perm = accessprotos.AccessControl_WRITE
 	default:
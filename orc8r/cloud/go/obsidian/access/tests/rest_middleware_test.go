@@ -9,13 +9,16 @@ LICENSE file in the root directory of this source tree.
 package tests
 
 import (
+	"io/ioutil"
 	"net/http"
 	"testing"
 
 	"github.com/labstack/echo"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
 
 	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 	magmadh "magma/orc8r/cloud/go/services/magmad/obsidian/handlers"
 )
 
@@ -91,6 +94,21 @@ func TestMiddleware(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 200, s)
 
+	// Test websocket upgrades require READ and WRITE
+	s, err = sendWebsocketRequest(
+		urlPrefix+magmadh.RegisterNetwork+"/"+TEST_NETWORK_ID,
+		operCertSn,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 403, s)
+
+	s, err = sendWebsocketRequest(
+		urlPrefix+magmadh.RegisterNetwork+"/"+WRITE_TEST_NETWORK_ID,
+		operCertSn,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 403, s)
+
 	// Test regular operator wildcard failures
 	// Test READ network Wildcard
 	s, err = SendRequest(
@@ -156,6 +174,11 @@ func TestMiddleware(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 200, s)
 
+	// Super - Test websocket upgrade on any URL
+	s, err = sendWebsocketRequest(urlPrefix+"/malformed/url", superCertSn)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+
 	// Super - Test WRITE  Any URL
 	s, err = SendRequest(
 		"GET", // READ
@@ -173,6 +196,35 @@ func TestMiddleware(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	assert.Equal(t, 200, s)
+
+	// Mutations are attributed to the operator's certificate CN
+	request, err := http.NewRequest("PUT", urlPrefix+"/malformed/url", nil)
+	assert.NoError(t, err)
+	request.Header.Set(access.CLIENT_CERT_SN_KEY, superCertSn)
+	request.Header.Set(access.CLIENT_CERT_CN_KEY, "alice")
+	response, err := http.DefaultClient.Do(request)
+	assert.NoError(t, err)
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", string(body))
+}
+
+func sendWebsocketRequest(url, certSn string) (int, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set(access.CLIENT_CERT_SN_KEY, certSn)
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Upgrade", "websocket")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	return response.StatusCode, nil
 }
 
 func startTestMidlewareServer(t *testing.T) *echo.Echo {
@@ -205,8 +257,13 @@ func startTestMidlewareServer(t *testing.T) *echo.Echo {
 		return c.String(http.StatusOK, "All good!")
 	})
 
-	// Endpoint requiring Write supervisor permissions
+	// Endpoint requiring Write supervisor permissions, returns the revision
+	// author of the request
 	e.PUT("/malformed/url", func(c echo.Context) error {
+		md, _ := metadata.FromOutgoingContext(c.Request().Context())
+		if authors := md.Get(storage.RevisionAuthorMetadataKey); len(authors) > 0 {
+			return c.String(http.StatusOK, authors[0])
+		}
 		return c.String(http.StatusOK, "!")
 	})
 
@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package audit records mutating requests to the northbound REST API and
+// remote access sessions to gateways, so that there is a durable record of
+// which operator changed or accessed what.
+package audit
+
+import (
+	"time"
+)
+
+// Entry is the audit record of a single mutating REST request
+type Entry struct {
+	ID string `json:"id"`
+	// Time the request was received
+	Timestamp time.Time `json:"timestamp"`
+	// Common name of the operator's client certificate
+	Operator string `json:"operator"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	// Network the request was scoped to, if any
+	NetworkID string `json:"network_id,omitempty"`
+	// Hex-encoded SHA-256 digest of the request body. Empty if the request
+	// had no body.
+	BodyDigest string `json:"body_digest,omitempty"`
+	// HTTP status of the response
+	Status int `json:"status"`
+}
+
+// Filter specifies which audit entries to query. Empty fields match all
+// entries.
+type Filter struct {
+	Operator  string
+	NetworkID string
+	// Start and End bound the timestamps of the entries, inclusively
+	Start time.Time
+	End   time.Time
+	// Limit is the maximum number of entries to return. Defaults to
+	// DefaultQueryLimit if 0.
+	Limit uint64
+}
+
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+
+	// MaxTranscriptSize is the maximum number of bytes of a shell session's
+	// input and output which are recorded, each
+	MaxTranscriptSize = 1 << 20
+
+	// DefaultMaxBodySize is the default maximum size of the body of a
+	// mutating request. Bodies are buffered to compute their digest, larger
+	// requests are rejected.
+	DefaultMaxBodySize = 64 << 20
+)
+
+// SessionType is the kind of a remote access session
+type SessionType string
+
+const (
+	ShellSession    SessionType = "shell"
+	UploadSession   SessionType = "upload"
+	DownloadSession SessionType = "download"
+)
+
+// Session is the audit record of a remote access session to a gateway, i.e.
+// a remote shell or a file transfer
+type Session struct {
+	ID        string      `json:"id"`
+	Type      SessionType `json:"type"`
+	Operator  string      `json:"operator"`
+	NetworkID string      `json:"network_id"`
+	GatewayID string      `json:"gateway_id"`
+	// Command run by a shell session, or path of the transferred file
+	Target    string    `json:"target,omitempty"`
+	StartTime time.Time `json:"start_time"`
+	// Nil while the session is in progress
+	EndTime *time.Time `json:"end_time,omitempty"`
+	// Number of bytes sent to and received from the gateway
+	BytesIn  int64 `json:"bytes_in"`
+	BytesOut int64 `json:"bytes_out"`
+	// Exit code of a shell session's shell, if it exited
+	ExitCode *int32 `json:"exit_code,omitempty"`
+	// Hex-encoded SHA-256 digest of a transferred file
+	Digest string `json:"digest,omitempty"`
+	// Error which ended the session, if any
+	Error string `json:"error,omitempty"`
+	// Input sent to and output received from a shell session, truncated to
+	// MaxTranscriptSize bytes each. Only returned by GetSession.
+	Input  []byte `json:"input,omitempty"`
+	Output []byte `json:"output,omitempty"`
+	// Whether the input or output was truncated
+	TranscriptTruncated bool `json:"transcript_truncated,omitempty"`
+}
+
+// Store persists audit entries
+type Store interface {
+	// Initialize creates the tables of the store if they don't exist
+	Initialize() error
+
+	// Write records an audit entry
+	Write(entry Entry) error
+
+	// Query returns the entries matching the filter, most recent first
+	Query(filter Filter) ([]Entry, error)
+
+	// CreateSession records the start of a remote access session
+	CreateSession(session Session) error
+
+	// UpdateSession updates the record of a remote access session created
+	// by CreateSession
+	UpdateSession(session Session) error
+
+	// QuerySessions returns the sessions matching the filter without their
+	// transcripts, most recently started first. Start and End of the filter
+	// bound the start time of the sessions.
+	QuerySessions(filter Filter) ([]Session, error)
+
+	// GetSession returns the session with the given ID including its
+	// transcript. Returns errors.ErrNotFound if it doesn't exist.
+	GetSession(id string) (Session, error)
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/labstack/echo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+// testMaxBodySize is the body size limit of the middleware under test
+const testMaxBodySize = 4 << 10
+
+func TestMiddlewareAndQueryHandler(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+
+	e := echo.New()
+	e.Use(audit.Middleware(store, testMaxBodySize))
+	e.GET(audit.QueryPath, audit.GetQueryHandler(store))
+	e.GET("/magma/v1/networks/:network_id", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.PUT("/magma/v1/networks/:network_id", func(c echo.Context) error {
+		var body map[string]string
+		if err := c.Bind(&body); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, body)
+	})
+	e.DELETE("/magma/v1/networks/:network_id", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusForbidden, "Access Denied")
+	})
+
+	// Timestamps are recorded with millisecond precision
+	clock.SetAndFreezeClock(t, time.Unix(1000, int64(5*time.Millisecond)))
+	defer clock.UnfreezeClock(t)
+	rec := doRequest(e, http.MethodPut, "/magma/v1/networks/n1", "alice", []byte(`{"name":"foo"}`))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	// The handler should still see the request body
+	assert.JSONEq(t, `{"name":"foo"}`, rec.Body.String())
+
+	clock.SetAndFreezeClock(t, time.Unix(2000, 0))
+	rec = doRequest(e, http.MethodDelete, "/magma/v1/networks/n2", "bob", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// Reads aren't recorded
+	rec = doRequest(e, http.MethodGet, "/magma/v1/networks/n1", "alice", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	putEntry := audit.Entry{
+		Timestamp:  time.Unix(1000, int64(5*time.Millisecond)).UTC(),
+		Operator:   "alice",
+		Method:     http.MethodPut,
+		Path:       "/magma/v1/networks/n1",
+		NetworkID:  "n1",
+		BodyDigest: "5dca85e76989e55ebbdec9e5304832c06a9ead7138b04372c65553003cfd2849",
+		Status:     http.StatusOK,
+	}
+	deleteEntry := audit.Entry{
+		Timestamp: time.Unix(2000, 0).UTC(),
+		Operator:  "bob",
+		Method:    http.MethodDelete,
+		Path:      "/magma/v1/networks/n2",
+		NetworkID: "n2",
+		Status:    http.StatusForbidden,
+	}
+
+	// Bodies over the limit are rejected, and the rejection is recorded
+	clock.SetAndFreezeClock(t, time.Unix(3000, 0))
+	rec = doRequest(e, http.MethodPut, "/magma/v1/networks/n3", "alice", make([]byte, testMaxBodySize+1))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	tooLargeEntry := audit.Entry{
+		Timestamp: time.Unix(3000, 0).UTC(),
+		Operator:  "alice",
+		Method:    http.MethodPut,
+		Path:      "/magma/v1/networks/n3",
+		NetworkID: "n3",
+		Status:    http.StatusRequestEntityTooLarge,
+	}
+	assertQueryResult(t, e, "?network_id=n3", []audit.Entry{tooLargeEntry})
+
+	// Most recent first
+	assertQueryResult(t, e, "?end=1970-01-01T00:33:20Z", []audit.Entry{deleteEntry, putEntry})
+	assertQueryResult(t, e, "?operator=alice&end=1970-01-01T00:33:20Z", []audit.Entry{putEntry})
+	assertQueryResult(t, e, "?network_id=n2", []audit.Entry{deleteEntry})
+	assertQueryResult(t, e, "?start=1970-01-01T00:20:00Z&end=1970-01-01T00:33:20Z", []audit.Entry{deleteEntry})
+	assertQueryResult(t, e, "?end=1970-01-01T00:20:00Z", []audit.Entry{putEntry})
+	assertQueryResult(t, e, "?start=1970-01-01T00:16:40Z&end=1970-01-01T00:33:20Z", []audit.Entry{deleteEntry, putEntry})
+	assertQueryResult(t, e, "?limit=1", []audit.Entry{tooLargeEntry})
+	assertQueryResult(t, e, "?operator=carol", []audit.Entry{})
+
+	// Bad query params
+	rec = doRequest(e, http.MethodGet, audit.QueryPath+"?start=yesterday", "alice", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doRequest(e, http.MethodGet, audit.QueryPath+"?start=1970-01-01T00:33:20Z&end=1970-01-01T00:16:40Z", "alice", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = doRequest(e, http.MethodGet, audit.QueryPath+"?limit=0", "alice", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func doRequest(e *echo.Echo, method string, url string, operator string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, url, bytes.NewReader(body))
+	req.Header.Set(access.CLIENT_CERT_CN_KEY, operator)
+	if body != nil {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func assertQueryResult(t *testing.T, e *echo.Echo, query string, expected []audit.Entry) {
+	rec := doRequest(e, http.MethodGet, audit.QueryPath+query, "alice", nil)
+	if !assert.Equal(t, http.StatusOK, rec.Code) {
+		return
+	}
+	var actual []audit.Entry
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	// IDs are random, so only check that they're set
+	for i := range actual {
+		assert.NotEmpty(t, actual[i].ID)
+		actual[i].ID = ""
+	}
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	// QueryPath is the path of the audit log query endpoint
+	QueryPath = obsidian.V1Root + "audit"
+	// SessionsPath is the path of the remote access session query endpoint
+	SessionsPath = QueryPath + obsidian.UrlSep + "sessions"
+	// SessionPath is the path of the endpoint returning a single remote
+	// access session with its transcript
+	SessionPath = SessionsPath + obsidian.UrlSep + ":" + sessionIDParam
+
+	sessionIDParam = "session_id"
+
+	queryParamOperator  = "operator"
+	queryParamNetworkID = "network_id"
+	queryParamStart     = "start"
+	queryParamEnd       = "end"
+	queryParamLimit     = "limit"
+)
+
+// GetQueryHandler returns a handler which queries audit entries from the
+// store. Entries can be filtered by operator, network ID and a time range
+// given as RFC 3339 timestamps.
+func GetQueryHandler(store Store) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		filter, err := getQueryFilter(c)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		entries, err := store.Query(filter)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, entries)
+	}
+}
+
+// GetQuerySessionsHandler returns a handler which queries remote access
+// sessions from the store, with the same filters as GetQueryHandler
+func GetQuerySessionsHandler(store Store) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		filter, err := getQueryFilter(c)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		sessions, err := store.QuerySessions(filter)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, sessions)
+	}
+}
+
+// GetSessionHandler returns a handler which returns a remote access session
+// with its transcript
+func GetSessionHandler(store Store) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		session, err := store.GetSession(c.Param(sessionIDParam))
+		if err == merrors.ErrNotFound {
+			return obsidian.HttpError(err, http.StatusNotFound)
+		}
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, session)
+	}
+}
+
+func getQueryFilter(c echo.Context) (Filter, error) {
+	filter := Filter{
+		Operator:  c.QueryParam(queryParamOperator),
+		NetworkID: c.QueryParam(queryParamNetworkID),
+	}
+
+	var err error
+	if start := c.QueryParam(queryParamStart); start != "" {
+		filter.Start, err = time.Parse(time.RFC3339, start)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %q: must be an RFC 3339 timestamp", queryParamStart, start)
+		}
+	}
+	if end := c.QueryParam(queryParamEnd); end != "" {
+		filter.End, err = time.Parse(time.RFC3339, end)
+		if err != nil {
+			return filter, fmt.Errorf("invalid %s %q: must be an RFC 3339 timestamp", queryParamEnd, end)
+		}
+	}
+	if !filter.Start.IsZero() && !filter.End.IsZero() && filter.End.Before(filter.Start) {
+		return filter, fmt.Errorf("%s must not be before %s", queryParamEnd, queryParamStart)
+	}
+
+	if limit := c.QueryParam(queryParamLimit); limit != "" {
+		filter.Limit, err = strconv.ParseUint(limit, 10, 64)
+		if err != nil || filter.Limit == 0 || filter.Limit > MaxQueryLimit {
+			return filter, fmt.Errorf("invalid %s %q: must be between 1 and %d", queryParamLimit, limit, MaxQueryLimit)
+		}
+	}
+	return filter, nil
+}
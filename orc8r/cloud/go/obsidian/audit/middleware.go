@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian/access"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+	"github.com/labstack/echo"
+)
+
+// storeContextKey is the key of the audit store in the echo context
+const storeContextKey = "audit_store"
+
+// Middleware returns an echo middleware which records an audit entry in the
+// store for every POST, PUT and DELETE request. Requests are recorded
+// whether or not they succeed, including requests which are denied by the
+// access middleware, so this middleware should be used before it.
+// Failing to record an entry is logged but doesn't fail the request.
+// Request bodies larger than maxBodySize bytes are rejected.
+// The store is also made available to handlers through GetStore.
+func Middleware(store Store, maxBodySize int) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(storeContextKey, store)
+			req := c.Request()
+			if req == nil || !isMutatingMethod(req.Method) {
+				return next(c)
+			}
+
+			entry := Entry{
+				ID:        uuid.New().String(),
+				Timestamp: clock.Now(),
+				Operator:  req.Header.Get(access.CLIENT_CERT_CN_KEY),
+				Method:    req.Method,
+				Path:      req.URL.Path,
+			}
+			var err error
+			if req.Body != nil {
+				var body []byte
+				body, err = ioutil.ReadAll(io.LimitReader(req.Body, int64(maxBodySize)+1))
+				switch {
+				case err != nil:
+					err = echo.NewHTTPError(http.StatusBadRequest, "failed to read request body")
+				case len(body) > maxBodySize:
+					err = echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", maxBodySize))
+				default:
+					req.Body = ioutil.NopCloser(bytes.NewReader(body))
+					entry.BodyDigest = getBodyDigest(body)
+				}
+			}
+
+			if err == nil {
+				err = next(c)
+			}
+
+			// Route params are only set once the request has been routed
+			entry.NetworkID = c.Param("network_id")
+			entry.Status = getResponseStatus(c, err)
+			if writeErr := store.Write(entry); writeErr != nil {
+				glog.Error(access.LogDecorator(c)("Failed to record audit entry: %s", writeErr))
+			}
+			return err
+		}
+	}
+}
+
+// GetStore returns the audit store of a request, or nil if the audit log is
+// disabled
+func GetStore(c echo.Context) Store {
+	store, _ := c.Get(storeContextKey).(Store)
+	return store
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func getBodyDigest(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	digest := sha256.Sum256(body)
+	return hex.EncodeToString(digest[:])
+}
+
+// getResponseStatus returns the status of the response to a request, given
+// the error returned by its handler. Errors returned by handlers are only
+// written to the response by the outermost middleware.
+func getResponseStatus(c echo.Context, err error) int {
+	if c.Response().Committed {
+		return c.Response().Status
+	}
+	if err == nil {
+		return http.StatusOK
+	}
+	if httpErr, ok := err.(*echo.HTTPError); ok {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
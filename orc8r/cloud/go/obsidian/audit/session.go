@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"sync"
+
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/golang/glog"
+	"github.com/google/uuid"
+)
+
+// SessionRecorder records a remote access session in the store as it
+// progresses. It is safe for concurrent use.
+type SessionRecorder struct {
+	store   Store
+	session Session
+	digest  hash.Hash
+	sync.Mutex
+}
+
+// StartSession records the start of a session and returns a recorder for the
+// rest of it. ID and StartTime of the session are set by StartSession.
+func StartSession(store Store, session Session) (*SessionRecorder, error) {
+	session.ID = uuid.New().String()
+	session.StartTime = clock.Now()
+	err := store.CreateSession(session)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionRecorder{store: store, session: session}, nil
+}
+
+// ID returns the ID of the session
+func (r *SessionRecorder) ID() string {
+	return r.session.ID
+}
+
+// RecordInput records data sent to the gateway. The data of shell sessions is
+// added to the transcript, and the digest of uploaded files is computed.
+func (r *SessionRecorder) RecordInput(data []byte) {
+	r.Lock()
+	defer r.Unlock()
+	r.session.BytesIn += int64(len(data))
+	r.record(&r.session.Input, data)
+}
+
+// RecordOutput records data received from the gateway. The data of shell
+// sessions is added to the transcript, and the digest of downloaded files is
+// computed.
+func (r *SessionRecorder) RecordOutput(data []byte) {
+	r.Lock()
+	defer r.Unlock()
+	r.session.BytesOut += int64(len(data))
+	r.record(&r.session.Output, data)
+}
+
+// RecordExit records the exit code of a shell session's shell
+func (r *SessionRecorder) RecordExit(exitCode int32) {
+	r.Lock()
+	defer r.Unlock()
+	r.session.ExitCode = &exitCode
+}
+
+// End records the end of the session, and the error which ended it if any.
+// Failing to record it is logged.
+func (r *SessionRecorder) End(err error) {
+	r.Lock()
+	defer r.Unlock()
+	endTime := clock.Now()
+	r.session.EndTime = &endTime
+	if err != nil {
+		r.session.Error = err.Error()
+	}
+	if r.digest != nil {
+		r.session.Digest = hex.EncodeToString(r.digest.Sum(nil))
+	}
+	if writeErr := r.store.UpdateSession(r.session); writeErr != nil {
+		glog.Errorf("Failed to record end of session %s: %s", r.session.ID, writeErr)
+	}
+}
+
+func (r *SessionRecorder) record(transcript *[]byte, data []byte) {
+	if r.session.Type != ShellSession {
+		if r.digest == nil {
+			r.digest = sha256.New()
+		}
+		r.digest.Write(data)
+		return
+	}
+	room := MaxTranscriptSize - len(*transcript)
+	if len(data) > room {
+		data = data[:room]
+		r.session.TranscriptTruncated = true
+	}
+	*transcript = append(*transcript, data...)
+}
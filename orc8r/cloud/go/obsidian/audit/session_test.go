@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/labstack/echo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessions(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+
+	e := echo.New()
+	e.Use(audit.Middleware(store, audit.DefaultMaxBodySize))
+	e.GET(audit.SessionsPath, audit.GetQuerySessionsHandler(store))
+	e.GET(audit.SessionPath, audit.GetSessionHandler(store))
+	e.GET("/store", func(c echo.Context) error {
+		assert.Equal(t, store, audit.GetStore(c))
+		return c.NoContent(http.StatusOK)
+	})
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/store", "alice", nil).Code)
+	assert.Nil(t, audit.GetStore(echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())))
+
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	defer clock.UnfreezeClock(t)
+	shell, err := audit.StartSession(store, audit.Session{
+		Type:      audit.ShellSession,
+		Operator:  "alice",
+		NetworkID: "n1",
+		GatewayID: "g1",
+	})
+	assert.NoError(t, err)
+	shell.RecordInput([]byte("ls\n"))
+	shell.RecordOutput([]byte("ls\r\n"))
+	shell.RecordOutput(bytes.Repeat([]byte("a"), audit.MaxTranscriptSize))
+	shell.RecordExit(0)
+
+	clock.SetAndFreezeClock(t, time.Unix(2000, 0))
+	upload, err := audit.StartSession(store, audit.Session{
+		Type:      audit.UploadSession,
+		Operator:  "bob",
+		NetworkID: "n2",
+		GatewayID: "g2",
+		Target:    "/tmp/magma/foo",
+	})
+	assert.NoError(t, err)
+	upload.RecordInput([]byte("foo"))
+	upload.End(errors.New("gateway unreachable"))
+
+	// Sessions are listed while in progress, without their transcripts
+	shellSession := audit.Session{
+		ID:        shell.ID(),
+		Type:      audit.ShellSession,
+		Operator:  "alice",
+		NetworkID: "n1",
+		GatewayID: "g1",
+		StartTime: time.Unix(1000, 0).UTC(),
+	}
+	endTime := time.Unix(2000, 0).UTC()
+	uploadSession := audit.Session{
+		ID:        upload.ID(),
+		Type:      audit.UploadSession,
+		Operator:  "bob",
+		NetworkID: "n2",
+		GatewayID: "g2",
+		Target:    "/tmp/magma/foo",
+		StartTime: time.Unix(2000, 0).UTC(),
+		EndTime:   &endTime,
+		BytesIn:   3,
+		Digest:    "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		Error:     "gateway unreachable",
+	}
+	assertSessionsResult(t, e, "", []audit.Session{uploadSession, shellSession})
+	assertSessionsResult(t, e, "?operator=alice", []audit.Session{shellSession})
+	assertSessionsResult(t, e, "?network_id=n2&limit=1", []audit.Session{uploadSession})
+
+	clock.SetAndFreezeClock(t, time.Unix(3000, 0))
+	shell.End(nil)
+	endTime = time.Unix(3000, 0).UTC()
+	exitCode := int32(0)
+	shellSession.EndTime = &endTime
+	shellSession.ExitCode = &exitCode
+	shellSession.BytesIn = 3
+	shellSession.BytesOut = 4 + audit.MaxTranscriptSize
+	assertSessionsResult(t, e, "?start=1970-01-01T00:00:00Z&end=1970-01-01T00:16:40Z", []audit.Session{shellSession})
+
+	// The transcript is returned for a single session, up to its maximum size
+	rec := doRequest(e, http.MethodGet, audit.SessionsPath+"/"+shell.ID(), "alice", nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var actual audit.Session
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	shellSession.Input = []byte("ls\n")
+	shellSession.Output = append([]byte("ls\r\n"), bytes.Repeat([]byte("a"), audit.MaxTranscriptSize-4)...)
+	shellSession.TranscriptTruncated = true
+	assert.Equal(t, shellSession, actual)
+
+	rec = doRequest(e, http.MethodGet, audit.SessionsPath+"/missing", "alice", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = doRequest(e, http.MethodGet, audit.SessionsPath+"?limit=1001", "alice", nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func assertSessionsResult(t *testing.T, e *echo.Echo, query string, expected []audit.Session) {
+	rec := doRequest(e, http.MethodGet, audit.SessionsPath+query, "alice", nil)
+	if !assert.Equal(t, http.StatusOK, rec.Code) {
+		return
+	}
+	var actual []audit.Session
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+	assert.Equal(t, expected, actual)
+}
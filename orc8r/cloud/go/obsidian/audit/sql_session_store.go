@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit
+
+import (
+	"database/sql"
+	"fmt"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+const (
+	sessionTableName = "obsidian_audit_sessions"
+
+	sessionTypeCol      = "type"
+	sessionGatewayIDCol = "gateway_id"
+	sessionTargetCol    = "target"
+	sessionStartTimeCol = "start_time"
+	sessionEndTimeCol   = "end_time"
+	sessionBytesInCol   = "bytes_in"
+	sessionBytesOutCol  = "bytes_out"
+	sessionExitCodeCol  = "exit_code"
+	sessionDigestCol    = "digest"
+	sessionErrorCol     = "error"
+	sessionInputCol     = "input"
+	sessionOutputCol    = "output"
+	sessionTruncatedCol = "truncated"
+	sessionStartTimeIdx = "obsidian_audit_sessions_start_idx"
+)
+
+var sessionSummaryCols = []string{
+	idCol, sessionTypeCol, operatorCol, networkIDCol, sessionGatewayIDCol, sessionTargetCol,
+	sessionStartTimeCol, sessionEndTimeCol, sessionBytesInCol, sessionBytesOutCol,
+	sessionExitCodeCol, sessionDigestCol, sessionErrorCol,
+}
+
+func (s *sqlStore) createSessionTable(tx *sql.Tx) error {
+	_, err := s.builder.CreateTable(sessionTableName).
+		IfNotExists().
+		Column(idCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(sessionTypeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(operatorCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(networkIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(sessionGatewayIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(sessionTargetCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(sessionStartTimeCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(sessionEndTimeCol).Type(sqorc.ColumnTypeBigInt).EndColumn().
+		Column(sessionBytesInCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(sessionBytesOutCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(sessionExitCodeCol).Type(sqorc.ColumnTypeInt).EndColumn().
+		Column(sessionDigestCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(sessionErrorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(sessionInputCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(sessionOutputCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(sessionTruncatedCol).Type(sqorc.ColumnTypeBool).NotNull().Default(false).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create audit session table")
+	}
+	_, err = s.builder.CreateIndex(sessionStartTimeIdx).
+		IfNotExists().
+		On(sessionTableName).
+		Columns(sessionStartTimeCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create audit session index")
+	}
+	return nil
+}
+
+func (s *sqlStore) CreateSession(session Session) error {
+	_, err := s.builder.Insert(sessionTableName).
+		Columns(idCol, sessionTypeCol, operatorCol, networkIDCol, sessionGatewayIDCol, sessionTargetCol, sessionStartTimeCol).
+		Values(
+			session.ID,
+			string(session.Type),
+			session.Operator,
+			session.NetworkID,
+			session.GatewayID,
+			session.Target,
+			toMillis(session.StartTime),
+		).
+		RunWith(s.db).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create audit session")
+	}
+	return nil
+}
+
+func (s *sqlStore) UpdateSession(session Session) error {
+	var endTime, exitCode interface{}
+	if session.EndTime != nil {
+		endTime = toMillis(*session.EndTime)
+	}
+	if session.ExitCode != nil {
+		exitCode = *session.ExitCode
+	}
+	_, err := s.builder.Update(sessionTableName).
+		Set(sessionEndTimeCol, endTime).
+		Set(sessionBytesInCol, session.BytesIn).
+		Set(sessionBytesOutCol, session.BytesOut).
+		Set(sessionExitCodeCol, exitCode).
+		Set(sessionDigestCol, session.Digest).
+		Set(sessionErrorCol, session.Error).
+		Set(sessionInputCol, session.Input).
+		Set(sessionOutputCol, session.Output).
+		Set(sessionTruncatedCol, session.TranscriptTruncated).
+		Where(sq.Eq{idCol: session.ID}).
+		RunWith(s.db).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to update audit session")
+	}
+	return nil
+}
+
+func (s *sqlStore) QuerySessions(filter Filter) ([]Session, error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	where := sq.And{}
+	if filter.Operator != "" {
+		where = append(where, sq.Eq{operatorCol: filter.Operator})
+	}
+	if filter.NetworkID != "" {
+		where = append(where, sq.Eq{networkIDCol: filter.NetworkID})
+	}
+	if !filter.Start.IsZero() {
+		where = append(where, sq.GtOrEq{sessionStartTimeCol: toMillis(filter.Start)})
+	}
+	if !filter.End.IsZero() {
+		where = append(where, sq.LtOrEq{sessionStartTimeCol: toMillis(filter.End)})
+	}
+
+	rows, err := s.builder.Select(sessionSummaryCols...).
+		From(sessionTableName).
+		Where(where).
+		OrderBy(fmt.Sprintf("%s DESC", sessionStartTimeCol), idCol).
+		Limit(limit).
+		RunWith(s.db).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query audit sessions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "QuerySessions")
+
+	ret := []Session{}
+	for rows.Next() {
+		session, err := scanSession(rows, false)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read audit sessions")
+	}
+	return ret, nil
+}
+
+func (s *sqlStore) GetSession(id string) (Session, error) {
+	cols := append(append([]string{}, sessionSummaryCols...), sessionInputCol, sessionOutputCol, sessionTruncatedCol)
+	rows, err := s.builder.Select(cols...).
+		From(sessionTableName).
+		Where(sq.Eq{idCol: id}).
+		RunWith(s.db).
+		Query()
+	if err != nil {
+		return Session{}, errors.Wrap(err, "failed to get audit session")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "GetSession")
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return Session{}, errors.Wrap(err, "failed to read audit session")
+		}
+		return Session{}, merrors.ErrNotFound
+	}
+	return scanSession(rows, true)
+}
+
+func scanSession(rows *sql.Rows, withTranscript bool) (Session, error) {
+	var session Session
+	var sessionType string
+	var startTime int64
+	var target, digest, sessionError sql.NullString
+	var endTime, exitCode sql.NullInt64
+	dests := []interface{}{
+		&session.ID, &sessionType, &session.Operator, &session.NetworkID, &session.GatewayID, &target,
+		&startTime, &endTime, &session.BytesIn, &session.BytesOut,
+		&exitCode, &digest, &sessionError,
+	}
+	if withTranscript {
+		dests = append(dests, &session.Input, &session.Output, &session.TranscriptTruncated)
+	}
+	err := rows.Scan(dests...)
+	if err != nil {
+		return Session{}, errors.Wrap(err, "failed to scan audit session")
+	}
+
+	session.Type = SessionType(sessionType)
+	session.Target = target.String
+	session.StartTime = fromMillis(startTime)
+	if endTime.Valid {
+		t := fromMillis(endTime.Int64)
+		session.EndTime = &t
+	}
+	if exitCode.Valid {
+		code := int32(exitCode.Int64)
+		session.ExitCode = &code
+	}
+	session.Digest = digest.String
+	session.Error = sessionError.String
+	return session, nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package audit
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+const (
+	auditTableName = "obsidian_audit_log"
+
+	idCol         = "id"
+	timestampCol  = "timestamp"
+	operatorCol   = "operator"
+	methodCol     = "method"
+	pathCol       = "path"
+	networkIDCol  = "network_id"
+	bodyDigestCol = "body_digest"
+	statusCol     = "status"
+)
+
+type sqlStore struct {
+	db      *sql.DB
+	builder sqorc.StatementBuilder
+}
+
+// NewSQLStore returns an audit Store backed by a SQL database
+func NewSQLStore(db *sql.DB, builder sqorc.StatementBuilder) Store {
+	return &sqlStore{db: db, builder: builder}
+}
+
+func (s *sqlStore) Initialize() error {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		_, err := s.builder.CreateTable(auditTableName).
+			IfNotExists().
+			Column(idCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+			Column(timestampCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+			Column(operatorCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(methodCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(pathCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+			Column(networkIDCol).Type(sqorc.ColumnTypeText).EndColumn().
+			Column(bodyDigestCol).Type(sqorc.ColumnTypeText).EndColumn().
+			Column(statusCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create audit log table")
+		}
+		_, err = s.builder.CreateIndex("obsidian_audit_log_ts_idx").
+			IfNotExists().
+			On(auditTableName).
+			Columns(timestampCol).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create audit log index")
+		}
+		return nil, s.createSessionTable(tx)
+	}
+	_, err := sqorc.ExecInTx(s.db, func(*sql.Tx) error { return nil }, txFn)
+	return err
+}
+
+func (s *sqlStore) Write(entry Entry) error {
+	_, err := s.builder.Insert(auditTableName).
+		Columns(idCol, timestampCol, operatorCol, methodCol, pathCol, networkIDCol, bodyDigestCol, statusCol).
+		Values(
+			entry.ID,
+			toMillis(entry.Timestamp),
+			entry.Operator,
+			entry.Method,
+			entry.Path,
+			entry.NetworkID,
+			entry.BodyDigest,
+			entry.Status,
+		).
+		RunWith(s.db).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to write audit entry")
+	}
+	return nil
+}
+
+func (s *sqlStore) Query(filter Filter) ([]Entry, error) {
+	limit := filter.Limit
+	if limit == 0 {
+		limit = DefaultQueryLimit
+	}
+	where := sq.And{}
+	if filter.Operator != "" {
+		where = append(where, sq.Eq{operatorCol: filter.Operator})
+	}
+	if filter.NetworkID != "" {
+		where = append(where, sq.Eq{networkIDCol: filter.NetworkID})
+	}
+	if !filter.Start.IsZero() {
+		where = append(where, sq.GtOrEq{timestampCol: toMillis(filter.Start)})
+	}
+	if !filter.End.IsZero() {
+		where = append(where, sq.LtOrEq{timestampCol: toMillis(filter.End)})
+	}
+
+	rows, err := s.builder.Select(idCol, timestampCol, operatorCol, methodCol, pathCol, networkIDCol, bodyDigestCol, statusCol).
+		From(auditTableName).
+		Where(where).
+		OrderBy(fmt.Sprintf("%s DESC", timestampCol), idCol).
+		Limit(limit).
+		RunWith(s.db).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query audit entries")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "Query")
+
+	ret := []Entry{}
+	for rows.Next() {
+		var entry Entry
+		var timestamp int64
+		var networkID, bodyDigest sql.NullString
+		err = rows.Scan(&entry.ID, &timestamp, &entry.Operator, &entry.Method, &entry.Path, &networkID, &bodyDigest, &entry.Status)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan audit entry")
+		}
+		entry.Timestamp = fromMillis(timestamp)
+		entry.NetworkID = networkID.String
+		entry.BodyDigest = bodyDigest.String
+		ret = append(ret, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to read audit entries")
+	}
+	return ret, nil
+}
+
+// Timestamps are stored as milliseconds since the epoch
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(millis int64) time.Time {
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC()
+}
@@ -30,4 +30,15 @@ var (
 	ClientCAPoolPath   string
 	AllowAnyClientCert bool
 	StaticFolder       string
+	// AuditLog enables recording mutating requests in the audit log
+	AuditLog bool
+	// AuditMaxBodySize is the maximum size in bytes of the body of a
+	// mutating request when the audit log is enabled
+	AuditMaxBodySize int
+	// RemoteAccessAllowedPaths are the gateway directories which files can
+	// be uploaded to and downloaded from
+	RemoteAccessAllowedPaths []string
+	// RemoteAccessAllowedOrigins are the web origins which can open remote
+	// shell websockets
+	RemoteAccessAllowedOrigins []string
 )
@@ -14,11 +14,26 @@ import (
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	"magma/orc8r/cloud/go/obsidian/server"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
 )
 
+const (
+	// auditLogParam enables the audit log of mutating REST requests
+	auditLogParam = "audit_log_enabled"
+	// auditMaxBodySizeParam is the maximum size of the body of a mutating
+	// REST request when the audit log is enabled
+	auditMaxBodySizeParam = "audit_max_body_size"
+	// remoteAccessAllowedPathsParam lists the gateway directories which
+	// files can be transferred to and from
+	remoteAccessAllowedPathsParam = "remote_access_allowed_paths"
+	// remoteAccessAllowedOriginsParam lists the web origins which can open
+	// remote shells
+	remoteAccessAllowedOriginsParam = "remote_access_allowed_origins"
+)
+
 func main() {
 	flag.IntVar(&obsidian.Port, "port", -1, "HTTP (REST) Server Port")
 	flag.IntVar(&obsidian.Port, "p", -1, "HTTP (REST) Server Port (shorthand)")
@@ -53,6 +68,21 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating service: %s", err)
 	}
+	obsidian.AuditMaxBodySize = audit.DefaultMaxBodySize
+	if srv.Config != nil {
+		if auditLog, err := srv.Config.GetBoolParam(auditLogParam); err == nil {
+			obsidian.AuditLog = auditLog
+		}
+		if maxBodySize, err := srv.Config.GetIntParam(auditMaxBodySizeParam); err == nil {
+			obsidian.AuditMaxBodySize = maxBodySize
+		}
+		if paths, err := srv.Config.GetStringArrayParam(remoteAccessAllowedPathsParam); err == nil {
+			obsidian.RemoteAccessAllowedPaths = paths
+		}
+		if origins, err := srv.Config.GetStringArrayParam(remoteAccessAllowedOriginsParam); err == nil {
+			obsidian.RemoteAccessAllowedOrigins = origins
+		}
+	}
 
 	if obsidian.Port == -1 {
 		obsidian.Port = obsidian.DefaultPort
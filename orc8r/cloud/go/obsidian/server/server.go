@@ -16,8 +16,11 @@ import (
 	"io/ioutil"
 	"log"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/sqorc"
 
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
@@ -30,6 +33,9 @@ func Start() {
 	// metrics middleware is used before all other middlewares
 	e.Use(CollectStats)
 	e.Use(middleware.Recover())
+	if obsidian.AuditLog {
+		attachAuditLog(e)
+	}
 	// Serve static pages for the API docs
 	e.Static(obsidian.StaticURLPrefix, obsidian.StaticFolder+"/apidocs")
 	e.Static(obsidian.StaticURLPrefix+"/swagger-ui/dist", obsidian.StaticFolder+"/swagger-ui/dist")
@@ -103,3 +109,22 @@ func Start() {
 		log.Println(err)
 	}
 }
+
+// attachAuditLog records mutating requests in the audit log and serves the
+// audit log and remote access session query endpoints. The audit middleware
+// must run before the access middleware so that denied requests are recorded
+// too.
+func attachAuditLog(e *echo.Echo) {
+	db, err := sqorc.Open(datastore.SQL_DRIVER, datastore.DATABASE_SOURCE)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %s", err)
+	}
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	if err := store.Initialize(); err != nil {
+		log.Fatalf("Failed to initialize audit log: %s", err)
+	}
+	e.Use(audit.Middleware(store, obsidian.AuditMaxBodySize))
+	e.GET(audit.QueryPath, audit.GetQueryHandler(store))
+	e.GET(audit.SessionsPath, audit.GetQuerySessionsHandler(store))
+	e.GET(audit.SessionPath, audit.GetSessionHandler(store))
+}
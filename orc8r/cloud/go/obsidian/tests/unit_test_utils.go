@@ -41,11 +41,17 @@ type Test struct {
 // RunUnitTest runs a test case using the given Echo instance. This function
 // does not start an obsidian server..
 func RunUnitTest(t *testing.T, e *echo.Echo, test Test) {
+	RunUnitTestWithResponse(t, e, test)
+}
+
+// RunUnitTestWithResponse runs a test case like RunUnitTest, and returns the
+// recorded response for further assertions (e.g. on response headers).
+func RunUnitTestWithResponse(t *testing.T, e *echo.Echo, test Test) *httptest.ResponseRecorder {
 	var req *http.Request
 	if test.Payload != nil {
 		payloadBytes, err := test.Payload.MarshalBinary()
 		if !assert.NoError(t, err) {
-			return
+			return nil
 		}
 		req = httptest.NewRequest(test.Method, test.URL, bytes.NewReader(payloadBytes))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
@@ -79,6 +85,7 @@ func RunUnitTest(t *testing.T, e *echo.Echo, test Test) {
 			}
 		}
 	}
+	return rec
 }
 
 // GetHandlerByPathAndMethod fetches the first obsidian.Handler that matches the
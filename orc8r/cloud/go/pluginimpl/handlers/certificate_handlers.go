@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/labstack/echo"
+)
+
+const (
+	queryParamWithinHours = "within_hours"
+
+	defaultExpiringWithin = time.Hour * 24 * 7
+)
+
+func listExpiringCertificates(c echo.Context) error {
+	certs, err := getExpiringCertificates(c, nil)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, certs)
+}
+
+func listNetworkExpiringCertificates(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	certs, err := getExpiringCertificates(c, []string{networkID})
+	if err != nil {
+		return err
+	}
+	ret := []*models.ExpiringCertificate{}
+	for _, cert := range certs {
+		if cert.NetworkID == networkID {
+			ret = append(ret, cert)
+		}
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// getExpiringCertificates returns the expiring certificates within the
+// requested window, soonest expiring first, with gateways resolved to their
+// network and logical ID. Only gateways of the given networks are resolved,
+// or of all networks if networkIDs is empty.
+func getExpiringCertificates(c echo.Context, networkIDs []string) ([]*models.ExpiringCertificate, error) {
+	within := defaultExpiringWithin
+	if withinParam := c.QueryParam(queryParamWithinHours); withinParam != "" {
+		hours, err := strconv.ParseUint(withinParam, 10, 32)
+		if err != nil {
+			return nil, obsidian.HttpError(fmt.Errorf("invalid %s %q", queryParamWithinHours, withinParam), http.StatusBadRequest)
+		}
+		within = time.Duration(hours) * time.Hour
+	}
+
+	certInfos, err := certifier.GetExpiringCertificates(within)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	gateways, err := configurator.LoadEntitiesByPhysicalID(networkIDs, orc8r.MagmadGatewayType)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	ret := []*models.ExpiringCertificate{}
+	for sn, certInfo := range certInfos {
+		notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+		cert := &models.ExpiringCertificate{
+			SerialNumber: swag.String(sn),
+			CertType:     swag.String(certInfo.CertType.String()),
+			NotAfter:     (*strfmt.DateTime)(&notAfter),
+		}
+		switch {
+		case certInfo.Id.GetGateway() != nil:
+			hwID := certInfo.Id.GetGateway().HardwareId
+			cert.IdentityType = swag.String(models.ExpiringCertificateIdentityTypeGateway)
+			cert.Identity = swag.String(hwID)
+			entity := gateways[hwID]
+			cert.NetworkID, cert.GatewayID = entity.NetworkID, entity.Key
+		case certInfo.Id.GetOperator() != "":
+			cert.IdentityType = swag.String(models.ExpiringCertificateIdentityTypeOperator)
+			cert.Identity = swag.String(certInfo.Id.GetOperator())
+		default:
+			continue
+		}
+		ret = append(ret, cert)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return time.Time(*ret[i].NotAfter).Before(time.Time(*ret[j].NotAfter))
+	})
+	return ret, nil
+}
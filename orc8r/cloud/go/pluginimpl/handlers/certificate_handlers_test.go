@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+	certifierTestInit "magma/orc8r/cloud/go/services/certifier/test_init"
+	certifierTestUtils "magma/orc8r/cloud/go/services/certifier/test_utils"
+	"magma/orc8r/cloud/go/services/configurator"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListExpiringCertificates(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	configuratorTestInit.StartTestService(t)
+	certifierTestInit.StartTestService(t)
+	// certificate validity is encoded with a precision of seconds
+	clock.SetAndFreezeClock(t, time.Now().Truncate(time.Second))
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listExpiring := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/certificates/expiring", obsidian.GET).HandlerFunc
+	listNetworkExpiring := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/certificates/expiring", obsidian.GET).HandlerFunc
+
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"})
+	assert.NoError(t, err)
+
+	gwCert := signCert(t, time.Hour*24, protos.NewGatewayIdentity("hw1", "", ""))
+	unregisteredCert := signCert(t, time.Hour*48, protos.NewGatewayIdentity("hw2", "", ""))
+	operatorCert := signCert(t, time.Hour*24*3, protos.NewOperatorIdentity("op1"))
+	signCert(t, time.Hour*24*30, protos.NewOperatorIdentity("op2"))
+
+	expectedGateway := &models.ExpiringCertificate{
+		SerialNumber: swag.String(gwCert.Sn.Sn),
+		CertType:     swag.String(models.ExpiringCertificateCertTypeDEFAULT),
+		IdentityType: swag.String(models.ExpiringCertificateIdentityTypeGateway),
+		Identity:     swag.String("hw1"),
+		NetworkID:    "n1",
+		GatewayID:    "g1",
+		NotAfter:     getNotAfter(t, gwCert),
+	}
+	expectedUnregistered := &models.ExpiringCertificate{
+		SerialNumber: swag.String(unregisteredCert.Sn.Sn),
+		CertType:     swag.String(models.ExpiringCertificateCertTypeDEFAULT),
+		IdentityType: swag.String(models.ExpiringCertificateIdentityTypeGateway),
+		Identity:     swag.String("hw2"),
+		NotAfter:     getNotAfter(t, unregisteredCert),
+	}
+	expectedOperator := &models.ExpiringCertificate{
+		SerialNumber: swag.String(operatorCert.Sn.Sn),
+		CertType:     swag.String(models.ExpiringCertificateCertTypeDEFAULT),
+		IdentityType: swag.String(models.ExpiringCertificateIdentityTypeOperator),
+		Identity:     swag.String("op1"),
+		NotAfter:     getNotAfter(t, operatorCert),
+	}
+
+	// default window of a week
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/certificates/expiring",
+		Handler:        listExpiring,
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ExpiringCertificate{expectedGateway, expectedUnregistered, expectedOperator}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = "/magma/v1/certificates/expiring?within_hours=36"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.ExpiringCertificate{expectedGateway})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = "/magma/v1/certificates/expiring?within_hours=-1"
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "invalid within_hours \"-1\""
+	tests.RunUnitTest(t, e, tc)
+
+	// only gateways of the network are listed
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/certificates/expiring",
+		Handler:        listNetworkExpiring,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ExpiringCertificate{expectedGateway}),
+	}
+	tests.RunUnitTest(t, e, tc)
+}
+
+func signCert(t *testing.T, validTime time.Duration, id *protos.Identity) *protos.Certificate {
+	csr, err := certifierTestUtils.CreateCSRForId(validTime, id)
+	assert.NoError(t, err)
+	certMsg, err := certifier.SignCSR(csr)
+	assert.NoError(t, err)
+	return certMsg
+}
+
+func getNotAfter(t *testing.T, certMsg *protos.Certificate) *strfmt.DateTime {
+	cert, err := x509.ParseCertificate(certMsg.CertDer)
+	assert.NoError(t, err)
+	notAfter := strfmt.DateTime(cert.NotAfter.UTC())
+	return &notAfter
+}
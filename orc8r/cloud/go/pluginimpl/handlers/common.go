@@ -9,11 +9,14 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
+	"strconv"
 
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
 
 	"github.com/labstack/echo"
 )
@@ -36,3 +39,86 @@ func GetAndValidatePayload(c echo.Context, model interface{}) (serde.Validatable
 	}
 	return iModel, nil
 }
+
+// SearchFilterParam is the query parameter list endpoints accept search terms
+// in. See configurator.ParseEntitySearchFilter for the syntax of search terms.
+const SearchFilterParam = "filter"
+
+// GetEntitySearchFilter returns the search filter given by the filter query
+// parameters of a list request, or nil if the request has none.
+func GetEntitySearchFilter(c echo.Context) (*configurator.EntitySearchFilter, *echo.HTTPError) {
+	terms := c.QueryParams()[SearchFilterParam]
+	if len(terms) == 0 {
+		return nil, nil
+	}
+	filter, err := configurator.ParseEntitySearchFilter(terms)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	return &filter, nil
+}
+
+// Query parameters and response header for paginated list requests. The
+// token of the next page of results is returned in NextPageTokenHeader, and
+// is passed back in PageTokenParam to load that page. The header is omitted
+// on the last page.
+const (
+	PageSizeParam       = "page_size"
+	PageTokenParam      = "page_token"
+	NextPageTokenHeader = "X-Next-Page-Token"
+)
+
+// GetPageCriteria returns the load criteria with the page size and page
+// token given by the query parameters of a list request. The criteria is
+// returned unchanged if the request isn't paginated.
+func GetPageCriteria(c echo.Context, criteria configurator.EntityLoadCriteria) (configurator.EntityLoadCriteria, *echo.HTTPError) {
+	pageSizeParam := c.QueryParam(PageSizeParam)
+	pageToken := c.QueryParam(PageTokenParam)
+	if pageSizeParam == "" {
+		if pageToken != "" {
+			return criteria, obsidian.HttpError(fmt.Errorf("%s requires %s", PageTokenParam, PageSizeParam), http.StatusBadRequest)
+		}
+		return criteria, nil
+	}
+	pageSize, err := strconv.ParseUint(pageSizeParam, 10, 32)
+	if err != nil || pageSize == 0 {
+		return criteria, obsidian.HttpError(fmt.Errorf("invalid %s %q: must be a positive integer", PageSizeParam, pageSizeParam), http.StatusBadRequest)
+	}
+	if pageToken != "" {
+		if err := configurator.ValidatePageToken(pageToken); err != nil {
+			return criteria, obsidian.HttpError(fmt.Errorf("invalid %s %q", PageTokenParam, pageToken), http.StatusBadRequest)
+		}
+	}
+	criteria.PageSize = uint32(pageSize)
+	criteria.PageToken = pageToken
+	return criteria, nil
+}
+
+// SetNextPageToken sets the token of the next page of results on the
+// response to a list request. Empty tokens are ignored.
+func SetNextPageToken(c echo.Context, nextPageToken string) {
+	if nextPageToken != "" {
+		c.Response().Header().Set(NextPageTokenHeader, nextPageToken)
+	}
+}
+
+// LoadEntitiesForListRequest loads the entities of a type in a network which
+// a list request asks for. Entities are filtered by the search terms of the
+// request and paginated according to its page query parameters.
+func LoadEntitiesForListRequest(c echo.Context, networkID string, entityType string, criteria configurator.EntityLoadCriteria) ([]configurator.NetworkEntity, *echo.HTTPError) {
+	filter, nerr := GetEntitySearchFilter(c)
+	if nerr != nil {
+		return nil, nerr
+	}
+	criteria, nerr = GetPageCriteria(c, criteria)
+	if nerr != nil {
+		return nil, nerr
+	}
+
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(networkID, entityType, filter, criteria)
+	if err != nil {
+		return nil, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	SetNextPageToken(c, nextPageToken)
+	return ents, nil
+}
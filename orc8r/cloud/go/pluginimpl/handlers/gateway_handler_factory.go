@@ -50,9 +50,9 @@ type MakeTypedGateways func(
 
 // GetPartialGatewayHandlers returns both GET and PUT handlers for modifying the portion of a
 // network entity specified by the model.
-// - path : the url at which the handler will be registered.
-// - model: the input and output of the handler and it also provides FromBackendModels
-//   and ToUpdateCriteria to go between the configurator model.
+//   - path : the url at which the handler will be registered.
+//   - model: the input and output of the handler and it also provides FromBackendModels
+//     and ToUpdateCriteria to go between the configurator model.
 func GetPartialGatewayHandlers(path string, model PartialGatewayModel) []obsidian.Handler {
 	return []obsidian.Handler{
 		GetPartialReadGatewayHandler(path, model),
@@ -63,12 +63,13 @@ func GetPartialGatewayHandlers(path string, model PartialGatewayModel) []obsidia
 // GetPartialReadGatewayHandler returns a GET obsidian handler at the specified path.
 // This function loads a portion of the gateway specified by the model's FromBackendModels function.
 // Example:
-//      (m *MagmadGatewayConfigs) FromBackendModels(networkID, gatewayID) (PartialGatewayModel, error) {
-// 			return configurator.LoadEntityConfig(networkID, orc8r.MagmadGatewayType, gatewayID)
-// 		}
-// 		getMagmadConfigsHandler := handlers.GetPartialReadGatewayHandler(URL, &models.MagmadGatewayConfigs{})
 //
-//      would return a GET handler that can read the magmad gateway config of a gw with the specified ID.
+//	     (m *MagmadGatewayConfigs) FromBackendModels(networkID, gatewayID) (PartialGatewayModel, error) {
+//				return configurator.LoadEntityConfig(networkID, orc8r.MagmadGatewayType, gatewayID)
+//			}
+//			getMagmadConfigsHandler := handlers.GetPartialReadGatewayHandler(URL, &models.MagmadGatewayConfigs{})
+//
+//	     would return a GET handler that can read the magmad gateway config of a gw with the specified ID.
 func GetPartialReadGatewayHandler(path string, model PartialGatewayModel) obsidian.Handler {
 	return obsidian.Handler{
 		Path:    path,
@@ -93,18 +94,19 @@ func GetPartialReadGatewayHandler(path string, model PartialGatewayModel) obsidi
 // GetPartialUpdateGatewayHandler returns a PUT obsidian handler at the specified path.
 // This function updates a portion of the network entity specified by the model's ToUpdateCriteria function.
 // Example:
-//      (m *MagmadGatewayConfigs) ToUpdateCriteria(networkID, gatewayID) ([]configurator.EntityUpdateCriteria, error) {
-// 			return []configurator.EntityUpdateCriteria{
-//				{
-// 					Key: gatewayID,
-//					Type: orc8r.MagmadGatewayType,
-//					NewConfig: m,
-//				}
-//          }
-// 		}
-// 		updateMagmadConfigsHandler := handlers.GetPartialUpdateGatewayHandler(URL, &models.MagmadGatewayConfigs{})
 //
-//      would return a PUT handler that updates the magmad gateway config of a gw with the specified ID.
+//	     (m *MagmadGatewayConfigs) ToUpdateCriteria(networkID, gatewayID) ([]configurator.EntityUpdateCriteria, error) {
+//				return []configurator.EntityUpdateCriteria{
+//					{
+//						Key: gatewayID,
+//						Type: orc8r.MagmadGatewayType,
+//						NewConfig: m,
+//					}
+//	         }
+//			}
+//			updateMagmadConfigsHandler := handlers.GetPartialUpdateGatewayHandler(URL, &models.MagmadGatewayConfigs{})
+//
+//	     would return a PUT handler that updates the magmad gateway config of a gw with the specified ID.
 func GetPartialUpdateGatewayHandler(path string, model PartialGatewayModel) obsidian.Handler {
 	return obsidian.Handler{
 		Path:    path,
@@ -124,7 +126,7 @@ func GetPartialUpdateGatewayHandler(path string, model PartialGatewayModel) obsi
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			_, err = configurator.UpdateEntities(networkID, updates)
+			_, err = configurator.UpdateEntities(c.Request().Context(), networkID, updates)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -213,10 +215,28 @@ func GetListGatewaysHandler(path string, gatewayType string, makeTypedGateways M
 				return nerr
 			}
 
-			ids, err := configurator.ListEntityKeys(nid, gatewayType)
+			filter, nerr := GetEntitySearchFilter(c)
+			if nerr != nil {
+				return nerr
+			}
+
+			pageCriteria, nerr := GetPageCriteria(c, configurator.EntityLoadCriteria{})
+			if nerr != nil {
+				return nerr
+			}
+
+			ids, err := listGatewayIDs(c, nid, gatewayType, pageCriteria)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
+			// search terms apply to the magmad gateway, which holds the
+			// gateway's name and description
+			if filter != nil {
+				ids, err = filterGatewayIDs(nid, ids, *filter)
+				if err != nil {
+					return obsidian.HttpError(err, http.StatusInternalServerError)
+				}
+			}
 			// for each ID, we want to load the gateway and the magmad gateway
 			magmadTKs := make([]storage.TypeAndKey, 0, len(ids))
 			for _, id := range ids {
@@ -288,7 +308,7 @@ func GetDeleteGatewayHandler(path string, gatewayType string) obsidian.Handler {
 				return obsidian.HttpError(errors.Wrap(err, "failed to load gateway"), http.StatusInternalServerError)
 			}
 
-			err = configurator.DeleteEntities(
+			err = configurator.DeleteEntities(c.Request().Context(),
 				nid,
 				[]storage.TypeAndKey{
 					{Type: orc8r.MagmadGatewayType, Key: gid},
@@ -314,3 +334,40 @@ func GetDeleteGatewayHandler(path string, gatewayType string) obsidian.Handler {
 		},
 	}
 }
+
+// listGatewayIDs lists the IDs of all gateways of a type, or of the page of
+// gateways specified by the page criteria if it has a page size
+func listGatewayIDs(c echo.Context, networkID string, gatewayType string, pageCriteria configurator.EntityLoadCriteria) ([]string, error) {
+	if pageCriteria.PageSize == 0 {
+		return configurator.ListEntityKeys(networkID, gatewayType)
+	}
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(networkID, gatewayType, nil, pageCriteria)
+	if err != nil {
+		return nil, err
+	}
+	SetNextPageToken(c, nextPageToken)
+	ids := make([]string, 0, len(ents))
+	for _, ent := range ents {
+		ids = append(ids, ent.Key)
+	}
+	return ids, nil
+}
+
+func filterGatewayIDs(networkID string, gatewayIDs []string, filter configurator.EntitySearchFilter) ([]string, error) {
+	matching, err := configurator.SearchEntitiesInNetwork(networkID, orc8r.MagmadGatewayType, filter, configurator.EntityLoadCriteria{})
+	if err != nil {
+		return nil, err
+	}
+	matchingIDs := make(map[string]struct{}, len(matching))
+	for _, ent := range matching {
+		matchingIDs[ent.Key] = struct{}{}
+	}
+
+	ret := make([]string, 0, len(gatewayIDs))
+	for _, id := range gatewayIDs {
+		if _, ok := matchingIDs[id]; ok {
+			ret = append(ret, id)
+		}
+	}
+	return ret, nil
+}
@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -58,13 +59,13 @@ func Test_GetPartialReadGatewayHandler(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
-	assert.NoError(t, configurator.CreateNetwork(network))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), network))
 	gateway := configurator.NetworkEntity{
 		Key:  "gw1",
 		Type: orc8r.MagmadGatewayType,
 		Name: "gateway 1",
 	}
-	_, err := configurator.CreateEntity(networkID, gateway)
+	_, err := configurator.CreateEntity(context.Background(), networkID, gateway)
 	assert.NoError(t, err)
 
 	tc = tests.Test{
@@ -110,13 +111,13 @@ func Test_GetPartialUpdateGatewayHandler(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
-	assert.NoError(t, configurator.CreateNetwork(network))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), network))
 	Gateway := configurator.NetworkEntity{
 		Key:  "test_gateway_1",
 		Type: orc8r.MagmadGatewayType,
 		Name: "Gateway 1",
 	}
-	_, err := configurator.CreateEntity(networkID, Gateway)
+	_, err := configurator.CreateEntity(context.Background(), networkID, Gateway)
 	assert.NoError(t, err)
 
 	// validation failure
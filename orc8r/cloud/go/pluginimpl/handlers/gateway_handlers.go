@@ -21,7 +21,6 @@ import (
 	"magma/orc8r/cloud/go/services/state"
 	"magma/orc8r/cloud/go/storage"
 
-	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/pkg/errors"
 )
@@ -74,11 +73,11 @@ func ListGatewaysHandler(c echo.Context) error {
 		return nerr
 	}
 
-	ents, _, err := configurator.LoadEntities(nid, swag.String(orc8r.MagmadGatewayType), nil, nil, nil, configurator.FullEntityLoadCriteria())
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	ents, nerr := LoadEntitiesForListRequest(c, nid, orc8r.MagmadGatewayType, configurator.FullEntityLoadCriteria())
+	if nerr != nil {
+		return nerr
 	}
-	entsByTK := ents.ToEntitiesByID()
+	entsByTK := configurator.NetworkEntities(ents).ToEntitiesByID()
 
 	// for each magmad gateway, we have to load its corresponding device and
 	// its reported status
@@ -175,7 +174,7 @@ func CreateMagmadGatewayFromModel(c echo.Context, model MagmadEncompassingGatewa
 		writes = append(writes, encompassingGateway.GetAdditionalWritesOnCreate()...)
 	}
 
-	if err = configurator.WriteEntities(nid, writes...); err != nil {
+	if err = configurator.WriteEntities(c.Request().Context(), nid, writes...); err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to create gateway"), http.StatusInternalServerError)
 	}
 	return nil
@@ -272,7 +271,7 @@ func UpdateMagmadGatewayFromModel(c echo.Context, nid string, gid string, model
 		return nerr
 	}
 
-	err = configurator.WriteEntities(nid, writes...)
+	err = configurator.WriteEntities(c.Request().Context(), nid, writes...)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -335,7 +334,7 @@ func DeleteGatewayHandler(c echo.Context) error {
 		return obsidian.HttpError(errors.Wrap(err, "failed to load gateway"), http.StatusInternalServerError)
 	}
 
-	err = configurator.DeleteEntity(nid, orc8r.MagmadGatewayType, gid)
+	err = configurator.DeleteEntity(c.Request().Context(), nid, orc8r.MagmadGatewayType, gid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
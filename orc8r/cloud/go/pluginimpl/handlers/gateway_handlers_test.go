@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"crypto/x509"
 	"testing"
 	"time"
@@ -41,7 +42,7 @@ func TestListGateways(t *testing.T) {
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
 	stateTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -63,7 +64,7 @@ func TestListGateways(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// happy path
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{Type: orc8r.MagmadGatewayType, Key: "g1", Config: &models.MagmadGatewayConfigs{}, PhysicalID: "hw1"},
@@ -78,6 +79,58 @@ func TestListGateways(t *testing.T) {
 	tc.ExpectedResult = tests.JSONMarshaler(expectedResult)
 	tests.RunUnitTest(t, e, tc)
 
+	// search on config
+	tc.URL = testURLRoot + "?filter=config.checkin_interval=15"
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]models.MagmadGateway{
+		"g2": {ID: "g2", Magmad: &models.MagmadGatewayConfigs{CheckinInterval: 15}},
+	})
+	tests.RunUnitTest(t, e, tc)
+
+	// bad search term
+	tc.URL = testURLRoot + "?filter=foo"
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "invalid search term \"foo\", expected <field>=<value> or <field>~<value>"
+	tests.RunUnitTest(t, e, tc)
+	tc.URL = testURLRoot
+	tc.ExpectedStatus = 200
+	tc.ExpectedError = ""
+
+	// paginated
+	tc.URL = testURLRoot + "?page_size=1"
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]models.MagmadGateway{
+		"g1": {ID: "g1", Magmad: &models.MagmadGatewayConfigs{}},
+	})
+	rec := tests.RunUnitTestWithResponse(t, e, tc)
+	nextPageToken := rec.Header().Get(handlers.NextPageTokenHeader)
+	assert.NotEmpty(t, nextPageToken)
+	tc.URL = testURLRoot + "?page_size=1&page_token=" + nextPageToken
+	tc.ExpectedResult = tests.JSONMarshaler(map[string]models.MagmadGateway{
+		"g2": {ID: "g2", Magmad: &models.MagmadGatewayConfigs{CheckinInterval: 15}},
+	})
+	rec = tests.RunUnitTestWithResponse(t, e, tc)
+	assert.Empty(t, rec.Header().Get(handlers.NextPageTokenHeader))
+
+	// paginated search on config, the page is filled past g1
+	tc.URL = testURLRoot + "?filter=config.checkin_interval=15&page_size=1"
+	rec = tests.RunUnitTestWithResponse(t, e, tc)
+	assert.Empty(t, rec.Header().Get(handlers.NextPageTokenHeader))
+
+	// bad page size
+	tc.URL = testURLRoot + "?page_size=0"
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "invalid page_size \"0\": must be a positive integer"
+	tests.RunUnitTest(t, e, tc)
+	tc.URL = testURLRoot + "?page_token=foo"
+	tc.ExpectedError = "page_token requires page_size"
+	tests.RunUnitTest(t, e, tc)
+	tc.URL = testURLRoot + "?page_size=1&page_token=foo"
+	tc.ExpectedError = "invalid page_token \"foo\""
+	tests.RunUnitTest(t, e, tc)
+	tc.URL = testURLRoot
+	tc.ExpectedStatus = 200
+	tc.ExpectedError = ""
+	tc.ExpectedResult = tests.JSONMarshaler(expectedResult)
+
 	// add device and state to g1
 	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
 	defer clock.GetUnfreezeClockDeferFunc(t)()
@@ -103,13 +156,13 @@ func TestCreateGateway(t *testing.T) {
 	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	// create 2 tiers
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "t1"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "t1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "t2"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.UpgradeTierEntityType, Key: "t2"})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -319,10 +372,10 @@ func TestGetGateway(t *testing.T) {
 	deviceTestInit.StartTestService(t)
 	stateTestInit.StartTestService(t)
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -440,10 +493,10 @@ func TestUpdateGateway(t *testing.T) {
 	stateTestInit.StartTestService(t)
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -564,10 +617,10 @@ func TestDeleteGateway(t *testing.T) {
 
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -636,7 +689,7 @@ func TestGetPartialReadHandlers(t *testing.T) {
 	deviceTestInit.StartTestService(t)
 	stateTestInit.StartTestService(t)
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	gwConfig := &models.MagmadGatewayConfigs{
@@ -645,7 +698,7 @@ func TestGetPartialReadHandlers(t *testing.T) {
 		CheckinInterval:         15,
 		CheckinTimeout:          5,
 	}
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -790,10 +843,10 @@ func TestGetGatewayTierHandler(t *testing.T) {
 	obsidianHandlers := handlers.GetObsidianHandlers()
 	getGatewayTier := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/tier", obsidian.GET).HandlerFunc
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -816,7 +869,7 @@ func TestGetGatewayTierHandler(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// add a tier and tier -> gateway association
-	_, err = configurator.CreateEntity(
+	_, err = configurator.CreateEntity(context.Background(),
 		"n1",
 		configurator.NetworkEntity{
 			Type: orc8r.UpgradeTierEntityType,
@@ -856,10 +909,10 @@ func TestUpdateGatewayTierHandler(t *testing.T) {
 	obsidianHandlers := handlers.GetObsidianHandlers()
 	updateGatewayTier := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/tier", obsidian.PUT).HandlerFunc
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -883,7 +936,7 @@ func TestUpdateGatewayTierHandler(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// add 2 tiers
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
@@ -993,7 +1046,7 @@ func TestGetPartialUpdateHandlers(t *testing.T) {
 	test_init.StartTestService(t)
 	deviceTestInit.StartTestService(t)
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
 
 	gwConfig := &models.MagmadGatewayConfigs{
@@ -1002,7 +1055,7 @@ func TestGetPartialUpdateHandlers(t *testing.T) {
 		CheckinInterval:         15,
 		CheckinTimeout:          5,
 	}
-	_, err = configurator.CreateEntities(
+	_, err = configurator.CreateEntities(context.Background(),
 		"n1",
 		[]configurator.NetworkEntity{
 			{
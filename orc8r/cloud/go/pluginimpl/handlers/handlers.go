@@ -58,6 +58,14 @@ const (
 	ManageTierGatewayPath  = ManageTierGatewaysPath + obsidian.UrlSep + ":gateway_id"
 
 	LogQueryPath = ManageNetworkPath + obsidian.UrlSep + "logs"
+
+	Revisions              = "revisions"
+	ListRevisionsPath      = ManageNetworkPath + obsidian.UrlSep + Revisions
+	RollbackToRevisionPath = ListRevisionsPath + obsidian.UrlSep + ":revision_id" + obsidian.UrlSep + "rollback"
+
+	Certificates                        = "certificates"
+	ListExpiringCertificatesPath        = obsidian.V1Root + Certificates + obsidian.UrlSep + "expiring"
+	ListNetworkExpiringCertificatesPath = ManageNetworkPath + obsidian.UrlSep + Certificates + obsidian.UrlSep + "expiring"
 )
 
 // GetObsidianHandlers returns all plugin-level obsidian handlers for orc8r
@@ -98,6 +106,14 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageTierImagePath, Methods: obsidian.DELETE, HandlerFunc: deleteImage},
 		{Path: ManageTierGatewaysPath, Methods: obsidian.POST, HandlerFunc: createTierGateway},
 		{Path: ManageTierGatewayPath, Methods: obsidian.DELETE, HandlerFunc: deleteTierGateway},
+
+		// Config revisions
+		{Path: ListRevisionsPath, Methods: obsidian.GET, HandlerFunc: listRevisions},
+		{Path: RollbackToRevisionPath, Methods: obsidian.POST, HandlerFunc: rollbackToRevision},
+
+		// Certificates
+		{Path: ListExpiringCertificatesPath, Methods: obsidian.GET, HandlerFunc: listExpiringCertificates},
+		{Path: ListNetworkExpiringCertificatesPath, Methods: obsidian.GET, HandlerFunc: listNetworkExpiringCertificates},
 	}
 	ret = append(ret, GetPartialNetworkHandlers(ManageNetworkNamePath, new(models.NetworkName), "")...)
 	ret = append(ret, GetPartialNetworkHandlers(ManageNetworkTypePath, new(models.NetworkType), "")...)
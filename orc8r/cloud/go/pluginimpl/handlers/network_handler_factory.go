@@ -73,12 +73,13 @@ func GetPartialNetworkHandlers(path string, model PartialNetworkModel, configKey
 // This function loads a network specified by the networkID and returns the
 // part of the network that corresponds to the given model.
 // Example:
-//      (m *NetworkName) GetFromNetwork(network configurator.Network) interface{} {
-// 			return string(network.Name)
-// 		}
-// 		getNameHandler := handlers.GetPartialReadNetworkHandler(URL, &models.NetworkName{})
 //
-//      would return a GET handler that can read the network name of a network with the specified ID.
+//	     (m *NetworkName) GetFromNetwork(network configurator.Network) interface{} {
+//				return string(network.Name)
+//			}
+//			getNameHandler := handlers.GetPartialReadNetworkHandler(URL, &models.NetworkName{})
+//
+//	     would return a GET handler that can read the network name of a network with the specified ID.
 func GetPartialReadNetworkHandler(path string, model PartialNetworkModel) obsidian.Handler {
 	return obsidian.Handler{
 		Path:    path,
@@ -107,15 +108,16 @@ func GetPartialReadNetworkHandler(path string, model PartialNetworkModel) obsidi
 // The handler will fetch the payload into the configModel and perform validations according to the swagger spec.
 // updater will take the model and apply the change into an existing network.
 // Example:
-//      (m *NetworkName) ToUpdateCriteria(network configurator.Network) interface{} {
-// 			return configurator.NetworkUpdateCriteria{
-//				ID:   network.ID,
-// 				Name: *m,
-//			}
-//      }
-// 		putNameHandler := handlers.GetPartialUpdateNetworkHandler(URL, &models.NetworkName{})
 //
-//      would return a PUT handler that will intake a NetworkName model and update the corresponding network
+//	     (m *NetworkName) ToUpdateCriteria(network configurator.Network) interface{} {
+//				return configurator.NetworkUpdateCriteria{
+//					ID:   network.ID,
+//					Name: *m,
+//				}
+//	     }
+//			putNameHandler := handlers.GetPartialUpdateNetworkHandler(URL, &models.NetworkName{})
+//
+//	     would return a PUT handler that will intake a NetworkName model and update the corresponding network
 func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel) obsidian.Handler {
 	return obsidian.Handler{
 		Path:    path,
@@ -141,7 +143,7 @@ func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel) obsi
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria})
+			err = configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{updateCriteria})
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -153,9 +155,10 @@ func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel) obsi
 // GetPartialDeleteNetworkHandler returns a DELETE obsidian handler at the specified path.
 // The handler will delete a network config specified by the key.
 // Example:
-// 		deleteNetworkFeaturesHandler := handlers.GetPartialDeleteNetworkHandler(URL, "orc8r_features")
 //
-//      would return a DELETE handler that will remove the network features config from the corresponding network
+//			deleteNetworkFeaturesHandler := handlers.GetPartialDeleteNetworkHandler(URL, "orc8r_features")
+//
+//	     would return a DELETE handler that will remove the network features config from the corresponding network
 func GetPartialDeleteNetworkHandler(path string, key string) obsidian.Handler {
 	return obsidian.Handler{
 		Path:    path,
@@ -169,7 +172,7 @@ func GetPartialDeleteNetworkHandler(path string, key string) obsidian.Handler {
 				ID:              networkID,
 				ConfigsToDelete: []string{key},
 			}
-			err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update})
+			err := configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{update})
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -212,7 +215,7 @@ func getCreateTypedNetworkHandler(path string, networkType string, network Netwo
 			if err != nil {
 				return err
 			}
-			err = configurator.CreateNetwork(payload.ToConfiguratorNetwork())
+			err = configurator.CreateNetwork(c.Request().Context(), payload.ToConfiguratorNetwork())
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -275,7 +278,7 @@ func getUpdateTypedNetworkHandler(path string, networkType string, networkModel
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
+			err = configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -305,7 +308,7 @@ func getDeleteTypedNetworkHandler(path string, networkType string) obsidian.Hand
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.DeleteNetwork(nid)
+			err = configurator.DeleteNetwork(c.Request().Context(), nid)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -51,7 +52,7 @@ func Test_GetPartialReadNetworkHandler(t *testing.T) {
 		Name:        "Test Network 1",
 		Description: "Test Network 1",
 	}
-	assert.NoError(t, configurator.CreateNetwork(network))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), network))
 
 	networkURL := fmt.Sprintf("%s/%s", testURLRoot, networkID)
 
@@ -89,7 +90,7 @@ func Test_GetPartialReadNetworkHandler(t *testing.T) {
 			"test": &TestFeature1{ID: &ID{Name: "hello!"}, Desc: "goodbye!"},
 		},
 	}
-	assert.NoError(t, configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update}))
+	assert.NoError(t, configurator.UpdateNetworks(context.Background(), []configurator.NetworkUpdateCriteria{update}))
 
 	// happy full case
 	getFullConfig = handlers.GetPartialReadNetworkHandler(networkURL, &TestFeature1{})
@@ -136,7 +137,7 @@ func TestGetUpdateNetworkConfigHandler(t *testing.T) {
 		Description: "Test Network 1",
 		Configs:     map[string]interface{}{"test": &TestFeature1{ID: &ID{Name: "hello!"}, Desc: "goodbye!"}},
 	}
-	assert.NoError(t, configurator.CreateNetwork(network))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), network))
 
 	networkURL := fmt.Sprintf("%s/%s", testURLRoot, networkID)
 
@@ -221,7 +222,7 @@ func TestGetDeleteNetworkConfigHandler(t *testing.T) {
 		Description: "Test Network 1",
 		Configs:     map[string]interface{}{"test": &TestFeature1{ID: &ID{Name: "hello!"}, Desc: "goodbye!"}},
 	}
-	assert.NoError(t, configurator.CreateNetwork(network))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), network))
 
 	networkURL := fmt.Sprintf("%s/%s", testURLRoot, networkID)
 
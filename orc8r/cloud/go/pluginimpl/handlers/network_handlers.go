@@ -9,6 +9,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -38,7 +39,7 @@ func registerNetwork(c echo.Context) error {
 		return nerr
 	}
 	network := payload.(*models.Network).ToConfiguratorNetwork()
-	createdNetworks, err := configurator.CreateNetworks([]configurator.Network{network})
+	createdNetworks, err := configurator.CreateNetworks(c.Request().Context(), []configurator.Network{network})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
@@ -67,7 +68,7 @@ func updateNetwork(c echo.Context) error {
 		return nerr
 	}
 	update := network.(*models.Network).ToUpdateCriteria()
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update})
+	err := configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{update})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -79,7 +80,7 @@ func deleteNetwork(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteNetwork(networkID)
+	err := configurator.DeleteNetwork(c.Request().Context(), networkID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -110,7 +111,7 @@ func CreateDNSRecord(c echo.Context) error {
 	}
 
 	dnsConfig.Records = append(dnsConfig.Records, record)
-	nerr = updateDNSConfig(networkID, dnsConfig)
+	nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 	if nerr != nil {
 		return nerr
 	}
@@ -136,7 +137,7 @@ func UpdateDNSRecord(c echo.Context) error {
 	for i, existingRecord := range dnsConfig.Records {
 		if existingRecord.Domain == domain {
 			dnsConfig.Records[i] = record
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -182,7 +183,7 @@ func DeleteDNSRecord(c echo.Context) error {
 			} else {
 				dnsConfig.Records = append(dnsConfig.Records[:i], dnsConfig.Records[i+1:]...)
 			}
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c.Request().Context(), networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -192,8 +193,8 @@ func DeleteDNSRecord(c echo.Context) error {
 	return echo.NewHTTPError(http.StatusNotFound)
 }
 
-func updateDNSConfig(networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{
+func updateDNSConfig(ctx context.Context, networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
+	err := configurator.UpdateNetworks(ctx, []configurator.NetworkUpdateCriteria{
 		{
 			ID:                   networkID,
 			ConfigsToAddOrUpdate: map[string]interface{}{orc8r.DnsdNetworkType: dnsConfig},
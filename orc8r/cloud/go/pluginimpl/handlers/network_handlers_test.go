@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -70,7 +71,7 @@ func Test_GetNetworkHandlers(t *testing.T) {
 		ID:   "n1",
 		Name: networkName1,
 	}
-	err := configurator.CreateNetwork(network1)
+	err := configurator.CreateNetwork(context.Background(), network1)
 	assert.NoError(t, err)
 
 	tc = tests.Test{
@@ -108,7 +109,7 @@ func Test_GetNetworkHandlers(t *testing.T) {
 		ID:                   "n1",
 		ConfigsToAddOrUpdate: map[string]interface{}{orc8r.NetworkFeaturesConfig: networkFeatures1},
 	}
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update1})
+	err = configurator.UpdateNetworks(context.Background(), []configurator.NetworkUpdateCriteria{update1})
 	assert.NoError(t, err)
 
 	expectedNetwork1 = models.Network{
@@ -138,7 +139,7 @@ func Test_GetNetworkHandlers(t *testing.T) {
 		NewDescription:       &description1,
 		ConfigsToAddOrUpdate: map[string]interface{}{orc8r.DnsdNetworkType: dnsdConfig},
 	}
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update1})
+	err = configurator.UpdateNetworks(context.Background(), []configurator.NetworkUpdateCriteria{update1})
 	assert.NoError(t, err)
 
 	expectedNetwork1 = models.Network{
@@ -169,7 +170,7 @@ func Test_GetNetworkHandlers(t *testing.T) {
 		ID:   networkID2,
 		Name: networkName2,
 	}
-	err = configurator.CreateNetwork(network2)
+	err = configurator.CreateNetwork(context.Background(), network2)
 	assert.NoError(t, err)
 
 	tc = tests.Test{
@@ -1034,7 +1035,7 @@ func Test_DeleteNetworkDNSHandlers(t *testing.T) {
 }
 
 func seedNetworks(t *testing.T) {
-	_, err := configurator.CreateNetworks(
+	_, err := configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{
 			{
 				ID:          "n1",
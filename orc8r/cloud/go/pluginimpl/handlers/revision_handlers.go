@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	cfgstorage "magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	queryParamEntityType  = "entity_type"
+	queryParamEntityKey   = "entity_key"
+	queryParamNetworkOnly = "network_only"
+)
+
+func listRevisions(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	entityType, entityKey := c.QueryParam(queryParamEntityType), c.QueryParam(queryParamEntityKey)
+	if (entityType == "") != (entityKey == "") {
+		return obsidian.HttpError(fmt.Errorf("%s and %s must be set together", queryParamEntityType, queryParamEntityKey), http.StatusBadRequest)
+	}
+	var entity *storage.TypeAndKey
+	if entityType != "" {
+		entity = &storage.TypeAndKey{Type: entityType, Key: entityKey}
+	}
+	networkOnly := false
+	if networkOnlyParam := c.QueryParam(queryParamNetworkOnly); networkOnlyParam != "" {
+		var err error
+		networkOnly, err = strconv.ParseBool(networkOnlyParam)
+		if err != nil {
+			return obsidian.HttpError(fmt.Errorf("invalid %s %q", queryParamNetworkOnly, networkOnlyParam), http.StatusBadRequest)
+		}
+	}
+	if networkOnly && entity != nil {
+		return obsidian.HttpError(fmt.Errorf("%s can't be set with %s", queryParamNetworkOnly, queryParamEntityType), http.StatusBadRequest)
+	}
+
+	revisions, err := configurator.ListRevisions(networkID, entity, networkOnly)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	ret := make([]*models.ConfigRevision, 0, len(revisions))
+	for _, rev := range revisions {
+		ret = append(ret, (&models.ConfigRevision{}).FromBackendModel(rev))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func rollbackToRevision(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	revisionIDParam := c.Param("revision_id")
+	revisionID, err := strconv.ParseUint(revisionIDParam, 10, 64)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("invalid revision ID %q", revisionIDParam), http.StatusBadRequest)
+	}
+
+	err = configurator.RollbackToRevision(c.Request().Context(), networkID, revisionID)
+	if err == merrors.ErrNotFound {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	if _, ok := err.(cfgstorage.RollbackError); ok {
+		return obsidian.HttpError(err, http.StatusConflict)
+	}
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionHandlers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/:network_id/revisions"
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listRevisions := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, testURLRoot, obsidian.GET).HandlerFunc
+	rollback := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, testURLRoot+"/:revision_id/rollback", obsidian.POST).HandlerFunc
+
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1", Type: "t1", Name: "network1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: "foo", Key: "bar", Name: "bar"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: "baz", Key: "quz"})
+	assert.NoError(t, err)
+	// Mutations are attributed to the author set on the context
+	_, err = configurator.UpdateEntity(configurator.WithRevisionAuthor(context.Background(), "alice"), "n1", configurator.EntityUpdateCriteria{
+		Type:              "foo",
+		Key:               "bar",
+		NewName:           swag.String("bar2"),
+		AssociationsToAdd: []storage.TypeAndKey{{Type: "baz", Key: "quz"}},
+	})
+	assert.NoError(t, err)
+
+	expectedNetworkRevision := &models.ConfigRevision{
+		ID:        swag.Uint64(1),
+		Operation: swag.String(models.ConfigRevisionOperationCREATE),
+		Timestamp: swag.Int64(1000000),
+		After: map[string]interface{}{
+			"id":          "n1",
+			"type":        "t1",
+			"name":        "network1",
+			"description": "",
+			"configs":     map[string]interface{}{},
+		},
+		AssociationsAdded:   []*models.ConfigRevisionAssociation{},
+		AssociationsRemoved: []*models.ConfigRevisionAssociation{},
+	}
+	expectedUpdateRevision := &models.ConfigRevision{
+		ID:         swag.Uint64(4),
+		EntityType: "foo",
+		EntityKey:  "bar",
+		Operation:  swag.String(models.ConfigRevisionOperationUPDATE),
+		Timestamp:  swag.Int64(1000000),
+		Author:     "alice",
+		Before: map[string]interface{}{
+			"type":         "foo",
+			"key":          "bar",
+			"name":         "bar",
+			"description":  "",
+			"physical_id":  "",
+			"config":       nil,
+			"associations": []*models.ConfigRevisionAssociation{},
+		},
+		After: map[string]interface{}{
+			"type":         "foo",
+			"key":          "bar",
+			"name":         "bar2",
+			"description":  "",
+			"physical_id":  "",
+			"config":       nil,
+			"associations": []*models.ConfigRevisionAssociation{{Type: swag.String("baz"), Key: swag.String("quz")}},
+		},
+		AssociationsAdded:   []*models.ConfigRevisionAssociation{{Type: swag.String("baz"), Key: swag.String("quz")}},
+		AssociationsRemoved: []*models.ConfigRevisionAssociation{},
+	}
+
+	// List revisions of the network itself
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            "/magma/v1/networks/n1/revisions?network_only=true",
+		Handler:        listRevisions,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ConfigRevision{expectedNetworkRevision}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// List revisions of an entity
+	tc.URL = "/magma/v1/networks/n1/revisions?entity_type=foo&entity_key=bar"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.ConfigRevision{
+		{
+			ID:         swag.Uint64(2),
+			EntityType: "foo",
+			EntityKey:  "bar",
+			Operation:  swag.String(models.ConfigRevisionOperationCREATE),
+			Timestamp:  swag.Int64(1000000),
+			After:      expectedUpdateRevision.Before,
+
+			AssociationsAdded:   []*models.ConfigRevisionAssociation{},
+			AssociationsRemoved: []*models.ConfigRevisionAssociation{},
+		},
+		expectedUpdateRevision,
+	})
+	tests.RunUnitTest(t, e, tc)
+
+	// Bad filters
+	tc.URL = "/magma/v1/networks/n1/revisions?entity_type=foo"
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "entity_type and entity_key must be set together"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = "/magma/v1/networks/n1/revisions?entity_type=foo&entity_key=bar&network_only=true"
+	tc.ExpectedError = "network_only can't be set with entity_type"
+	tests.RunUnitTest(t, e, tc)
+
+	// Roll the entity back to its creation
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            "/magma/v1/networks/n1/revisions/2/rollback",
+		Handler:        rollback,
+		ParamNames:     []string{"network_id", "revision_id"},
+		ParamValues:    []string{"n1", "2"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	actual, err := configurator.LoadEntity("n1", "foo", "bar", configurator.FullEntityLoadCriteria())
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", actual.Name)
+	assert.Empty(t, actual.Associations)
+	revisions, err := configurator.ListRevisions("n1", &storage.TypeAndKey{Type: "foo", Key: "bar"}, false)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 3)
+	assert.Equal(t, "UPDATE", revisions[2].Operation)
+	assert.Equal(t, "bar2", revisions[2].EntityBefore.Name)
+	assert.Equal(t, []configurator.GraphEdge{{From: storage.TypeAndKey{Type: "foo", Key: "bar"}, To: storage.TypeAndKey{Type: "baz", Key: "quz"}}}, revisions[2].EdgesRemoved)
+
+	// Nonexistent and invalid revisions
+	tc.ParamValues = []string{"n1", "42"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "Not Found"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.ParamValues = []string{"n1", "abc"}
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "invalid revision ID \"abc\""
+	tests.RunUnitTest(t, e, tc)
+
+	// A deleted entity can't be recreated without its associated entities
+	err = configurator.DeleteEntities(context.Background(), "n1", []storage.TypeAndKey{{Type: "foo", Key: "bar"}, {Type: "baz", Key: "quz"}})
+	assert.NoError(t, err)
+	tc.ParamValues = []string{"n1", "4"}
+	tc.ExpectedStatus = 409
+	tc.ExpectedError = "entity foo-bar can't be recreated, since its associated entity baz-quz no longer exists"
+	tests.RunUnitTest(t, e, tc)
+}
@@ -46,7 +46,7 @@ func createChannelHandler(c echo.Context) error {
 		Name:   string(channel.Name),
 		Config: channel,
 	}
-	_, err := configurator.CreateInternalEntity(entity)
+	_, err := configurator.CreateInternalEntity(c.Request().Context(), entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -86,7 +86,7 @@ func updateChannelHandler(c echo.Context) error {
 		NewName:   swag.String(string(channel.Name)),
 		NewConfig: channel,
 	}
-	_, err := configurator.UpdateInternalEntity(update)
+	_, err := configurator.UpdateInternalEntity(c.Request().Context(), update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -98,7 +98,7 @@ func deleteChannelHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteInternalEntity(orc8r.UpgradeReleaseChannelEntityType, channelID)
+	err := configurator.DeleteInternalEntity(c.Request().Context(), orc8r.UpgradeReleaseChannelEntityType, channelID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -129,7 +129,7 @@ func createTierHandler(c echo.Context) error {
 	}
 	tier := payload.(*models.Tier)
 	entity := tier.ToNetworkEntity()
-	_, err := configurator.CreateEntity(networkID, entity)
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -151,7 +151,7 @@ func updateTierHandler(c echo.Context) error {
 		return obsidian.HttpError(fmt.Errorf("TierID in URL and payload do not match."), http.StatusBadRequest)
 	}
 	update := tier.ToUpdateCriteria()
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -182,7 +182,7 @@ func deleteTierHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteEntity(networkID, orc8r.UpgradeTierEntityType, tierID)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, orc8r.UpgradeTierEntityType, tierID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -206,7 +206,7 @@ func createTierImage(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntities(networkID, updates)
+	_, err = configurator.UpdateEntities(c.Request().Context(), networkID, updates)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -229,7 +229,7 @@ func deleteImage(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntity(networkID, update)
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -247,7 +247,7 @@ func createTierGateway(c echo.Context) error {
 	}
 
 	update := (&models.TierGateways{}).ToAddGatewayUpdateCriteria(tierID, gatewayID)
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -264,7 +264,7 @@ func deleteTierGateway(c echo.Context) error {
 		return nerr
 	}
 	update := (&models.TierGateways{}).ToDeleteGatewayUpdateCriteria(tierID, gatewayID)
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
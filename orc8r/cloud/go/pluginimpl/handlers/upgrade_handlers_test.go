@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"testing"
 
 	models1 "magma/orc8r/cloud/go/models"
@@ -49,7 +50,7 @@ func Test_ListReleaseChannels(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// add a channel
-	_, err := configurator.CreateInternalEntity(
+	_, err := configurator.CreateInternalEntity(context.Background(),
 		configurator.NetworkEntity{
 			Type: orc8r.UpgradeReleaseChannelEntityType, Key: "channel1",
 			Config: &models.ReleaseChannel{
@@ -139,7 +140,7 @@ func Test_ReleaseChannel(t *testing.T) {
 	tests.RunUnitTest(t, e, tc)
 
 	// add a channel
-	_, err := configurator.CreateInternalEntity(
+	_, err := configurator.CreateInternalEntity(context.Background(),
 		configurator.NetworkEntity{
 			Type: orc8r.UpgradeReleaseChannelEntityType, Key: "channel1",
 			Config: &models.ReleaseChannel{
@@ -215,7 +216,7 @@ func Test_Tiers(t *testing.T) {
 	readTier := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageTiers, obsidian.GET).HandlerFunc
 	deleteTier := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageTiers, obsidian.DELETE).HandlerFunc
 
-	assert.NoError(t, configurator.CreateNetwork(configurator.Network{ID: "n1"}))
+	assert.NoError(t, configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"}))
 
 	// happy case list
 	tc := tests.Test{
@@ -423,7 +424,7 @@ func TestPartialTierReads(t *testing.T) {
 		Version:  "1-1-1-1",
 	}
 
-	_, err := configurator.CreateEntity("n1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
 		Type: orc8r.UpgradeTierEntityType, Key: "tier1",
 		Name:         string(tier.Name),
 		Config:       tier,
@@ -519,7 +520,7 @@ func TestPartialTierUpdates(t *testing.T) {
 		Version:  "1-1-1-1",
 	}
 
-	_, err := configurator.CreateEntity("n1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{
 		Type: orc8r.UpgradeTierEntityType, Key: "tier1",
 		Name:         string(tier.Name),
 		Config:       tier,
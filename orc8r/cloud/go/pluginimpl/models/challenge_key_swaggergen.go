@@ -19,14 +19,17 @@ import (
 // swagger:model challenge_key
 type ChallengeKey struct {
 
-	// key
+	// DER encoded public key. For TPM2_QUOTE, the public key of the gateway's TPM attestation key
 	// Format: byte
 	Key *strfmt.Base64 `json:"key,omitempty"`
 
 	// key type
 	// Required: true
-	// Enum: [ECHO SOFTWARE_ECDSA_SHA256]
+	// Enum: [ECHO SOFTWARE_ECDSA_SHA256 TPM2_QUOTE]
 	KeyType string `json:"key_type"`
+
+	// tpm pcr policy
+	TpmPcrPolicy *TpmPcrPolicy `json:"tpm_pcr_policy,omitempty"`
 }
 
 // Validate validates this challenge key
@@ -41,6 +44,10 @@ func (m *ChallengeKey) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTpmPcrPolicy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -62,7 +69,7 @@ var challengeKeyTypeKeyTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ECHO","SOFTWARE_ECDSA_SHA256"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ECHO","SOFTWARE_ECDSA_SHA256","TPM2_QUOTE"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -77,6 +84,9 @@ const (
 
 	// ChallengeKeyKeyTypeSOFTWAREECDSASHA256 captures enum value "SOFTWARE_ECDSA_SHA256"
 	ChallengeKeyKeyTypeSOFTWAREECDSASHA256 string = "SOFTWARE_ECDSA_SHA256"
+
+	// ChallengeKeyKeyTypeTPM2QUOTE captures enum value "TPM2_QUOTE"
+	ChallengeKeyKeyTypeTPM2QUOTE string = "TPM2_QUOTE"
 )
 
 // prop value enum
@@ -101,6 +111,24 @@ func (m *ChallengeKey) validateKeyType(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ChallengeKey) validateTpmPcrPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TpmPcrPolicy) { // not required
+		return nil
+	}
+
+	if m.TpmPcrPolicy != nil {
+		if err := m.TpmPcrPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tpm_pcr_policy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ChallengeKey) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -507,3 +507,70 @@ func getGatewayIDs(gatewayTKs []storage.TypeAndKey) []models.GatewayID {
 			return models.GatewayID(tk.Key)
 		}).([]models.GatewayID)
 }
+
+// FromBackendModel converts a configurator revision into its REST
+// representation. Since revisions can be of any network or entity type,
+// the states before and after the revision are rendered generically rather
+// than as the type-specific REST models.
+func (m *ConfigRevision) FromBackendModel(rev configurator.Revision) *ConfigRevision {
+	m.ID = swag.Uint64(rev.ID)
+	m.Operation = swag.String(rev.Operation)
+	m.Author = rev.Author
+	m.Timestamp = swag.Int64(rev.Timestamp)
+	if rev.Entity != nil {
+		m.EntityType = rev.Entity.Type
+		m.EntityKey = rev.Entity.Key
+		m.Before = getRevisionEntityState(rev.EntityBefore)
+		m.After = getRevisionEntityState(rev.EntityAfter)
+	} else {
+		m.Before = getRevisionNetworkState(rev.NetworkBefore)
+		m.After = getRevisionNetworkState(rev.NetworkAfter)
+	}
+	m.AssociationsAdded = getRevisionAssociations(rev.EdgesAdded)
+	m.AssociationsRemoved = getRevisionAssociations(rev.EdgesRemoved)
+	return m
+}
+
+func getRevisionNetworkState(network *configurator.Network) interface{} {
+	if network == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"id":          network.ID,
+		"type":        network.Type,
+		"name":        network.Name,
+		"description": network.Description,
+		"configs":     network.Configs,
+	}
+}
+
+func getRevisionEntityState(ent *configurator.NetworkEntity) interface{} {
+	if ent == nil {
+		return nil
+	}
+	return map[string]interface{}{
+		"type":         ent.Type,
+		"key":          ent.Key,
+		"name":         ent.Name,
+		"description":  ent.Description,
+		"physical_id":  ent.PhysicalID,
+		"config":       ent.Config,
+		"associations": getTKAssociations(ent.Associations),
+	}
+}
+
+func getRevisionAssociations(edges []configurator.GraphEdge) []*ConfigRevisionAssociation {
+	ret := make([]*ConfigRevisionAssociation, 0, len(edges))
+	for _, edge := range edges {
+		ret = append(ret, &ConfigRevisionAssociation{Type: swag.String(edge.To.Type), Key: swag.String(edge.To.Key)})
+	}
+	return ret
+}
+
+func getTKAssociations(tks []storage.TypeAndKey) []*ConfigRevisionAssociation {
+	ret := make([]*ConfigRevisionAssociation, 0, len(tks))
+	for _, tk := range tks {
+		ret = append(ret, &ConfigRevisionAssociation{Type: swag.String(tk.Type), Key: swag.String(tk.Key)})
+	}
+	return ret
+}
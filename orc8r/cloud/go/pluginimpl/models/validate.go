@@ -9,15 +9,32 @@
 package models
 
 import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/x509"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/go-openapi/strfmt"
 )
 
 const echoKeyType = "ECHO"
 const ecdsaKeyType = "SOFTWARE_ECDSA_SHA256"
+const tpmKeyType = "TPM2_QUOTE"
+
+// maxPCRIndex is the highest PCR index of TPM 2.0 PC client platforms
+const maxPCRIndex = 23
+
+var pcrValueLengths = map[string]int{
+	TpmPcrPolicyHashAlgorithmSha1:   sha1.Size,
+	TpmPcrPolicyHashAlgorithmSha256: sha256.Size,
+	TpmPcrPolicyHashAlgorithmSha384: sha512.Size384,
+}
 
 func (m *Network) ValidateModel() error {
 	return m.Validate(strfmt.Default)
@@ -51,6 +68,9 @@ func (m *GatewayDevice) ValidateModel() error {
 }
 
 func (m *ChallengeKey) ValidateModel() error {
+	if m.TpmPcrPolicy != nil && m.KeyType != tpmKeyType {
+		return fmt.Errorf("PCR policy is only supported for %s keys", tpmKeyType)
+	}
 	switch m.KeyType {
 	case echoKeyType:
 		if m.Key != nil {
@@ -66,11 +86,48 @@ func (m *ChallengeKey) ValidateModel() error {
 			return fmt.Errorf("Failed to parse key: %s", err)
 		}
 		return nil
+	case tpmKeyType:
+		if m.Key == nil {
+			return fmt.Errorf("No attestation key supplied")
+		}
+		pub, err := x509.ParsePKIXPublicKey(*m.Key)
+		if err != nil {
+			return fmt.Errorf("Failed to parse key: %s", err)
+		}
+		switch pub.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+		default:
+			return fmt.Errorf("Unsupported attestation key type %T", pub)
+		}
+		if m.TpmPcrPolicy != nil {
+			return m.TpmPcrPolicy.ValidateModel()
+		}
+		return nil
 	default:
 		return fmt.Errorf("Unknown key type %s", m.KeyType)
 	}
 }
 
+func (m *TpmPcrPolicy) ValidateModel() error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	if len(m.Pcrs) == 0 {
+		return errors.New("PCR policy must have at least one PCR value")
+	}
+	for pcr, value := range m.Pcrs {
+		index, err := strconv.Atoi(pcr)
+		if err != nil || index < 0 || index > maxPCRIndex {
+			return fmt.Errorf("Invalid PCR index %s", pcr)
+		}
+		decoded, err := hex.DecodeString(value)
+		if err != nil || len(decoded) != pcrValueLengths[m.HashAlgorithm] {
+			return fmt.Errorf("PCR %s must be a hex encoded %s digest", pcr, m.HashAlgorithm)
+		}
+	}
+	return nil
+}
+
 func (m *MagmadGatewayConfigs) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
@@ -10,6 +10,7 @@ package pluginimpl
 
 import (
 	"net/http"
+	"time"
 
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/orc8r"
@@ -32,6 +33,7 @@ import (
 	"magma/orc8r/cloud/go/services/metricsd/confignames"
 	"magma/orc8r/cloud/go/services/metricsd/exporters"
 	metricsdh "magma/orc8r/cloud/go/services/metricsd/obsidian/handlers"
+	otelExp "magma/orc8r/cloud/go/services/metricsd/opentelemetry/exporters"
 	promeExp "magma/orc8r/cloud/go/services/metricsd/prometheus/exporters"
 	"magma/orc8r/cloud/go/services/state"
 	stateh "magma/orc8r/cloud/go/services/state/obsidian/handlers"
@@ -123,8 +125,10 @@ func (*BaseOrchestratorPlugin) GetStreamerProviders() []providers.StreamProvider
 }
 
 const (
-	ProfileNamePrometheus = "prometheus"
-	ProfileNameExportAll  = "exportall"
+	ProfileNamePrometheus  = "prometheus"
+	ProfileNameRemoteWrite = "remotewrite"
+	ProfileNameOTLP        = "otlp"
+	ProfileNameExportAll   = "exportall"
 )
 
 func getMetricsProfiles(metricsConfig *config.ConfigMap) []metricsd.MetricsProfile {
@@ -149,15 +153,56 @@ func getMetricsProfiles(metricsConfig *config.ConfigMap) []metricsd.MetricsProfi
 		Exporters:  []exporters.Exporter{prometheusCustomPushExporter},
 	}
 
+	profiles := []metricsd.MetricsProfile{prometheusProfile}
+	allExporters := []exporters.Exporter{prometheusCustomPushExporter}
+
+	// Remote-write and OTLP profiles - Export to a remote-write or OTLP
+	// endpoint if one is configured
+	batchConfig := getExporterBatchConfig(metricsConfig)
+	if remoteWriteURL, err := metricsConfig.GetStringParam(confignames.PrometheusRemoteWriteURL); err == nil && remoteWriteURL != "" {
+		remoteWriteExporter := promeExp.NewRemoteWriteExporter(remoteWriteURL, batchConfig)
+		profiles = append(profiles, metricsd.MetricsProfile{
+			Name:       ProfileNameRemoteWrite,
+			Collectors: controllerCollectors,
+			Exporters:  []exporters.Exporter{remoteWriteExporter},
+		})
+		allExporters = append(allExporters, remoteWriteExporter)
+	}
+	if otlpURL, err := metricsConfig.GetStringParam(confignames.OTLPMetricsURL); err == nil && otlpURL != "" {
+		otlpExporter := otelExp.NewOTLPExporter(otlpURL, batchConfig)
+		profiles = append(profiles, metricsd.MetricsProfile{
+			Name:       ProfileNameOTLP,
+			Collectors: controllerCollectors,
+			Exporters:  []exporters.Exporter{otlpExporter},
+		})
+		allExporters = append(allExporters, otlpExporter)
+	}
+
 	// ExportAllProfile - Exports to all exporters
 	exportAllProfile := metricsd.MetricsProfile{
 		Name:       ProfileNameExportAll,
 		Collectors: controllerCollectors,
-		Exporters:  []exporters.Exporter{prometheusCustomPushExporter},
+		Exporters:  allExporters,
 	}
 
-	return []metricsd.MetricsProfile{
-		prometheusProfile,
-		exportAllProfile,
+	return append(profiles, exportAllProfile)
+}
+
+// getExporterBatchConfig reads the optional batching settings of the
+// remote-write and OTLP exporters
+func getExporterBatchConfig(metricsConfig *config.ConfigMap) exporters.BatchConfig {
+	batchConfig := exporters.DefaultBatchConfig()
+	if batchSize, err := metricsConfig.GetIntParam(confignames.ExporterBatchSize); err == nil {
+		batchConfig.BatchSize = batchSize
+	}
+	if flushIntervalSecs, err := metricsConfig.GetIntParam(confignames.ExporterFlushIntervalSecs); err == nil {
+		batchConfig.FlushInterval = time.Duration(flushIntervalSecs) * time.Second
+	}
+	if maxBuffered, err := metricsConfig.GetIntParam(confignames.ExporterMaxBufferedMetrics); err == nil {
+		batchConfig.MaxBuffered = maxBuffered
+	}
+	if maxRetries, err := metricsConfig.GetIntParam(confignames.ExporterMaxRetries); err == nil {
+		batchConfig.MaxRetries = maxRetries
 	}
+	return batchConfig
 }
@@ -30,18 +30,22 @@ const (
 	ChallengeKey_ECHO                  ChallengeKey_KeyType = 0
 	ChallengeKey_SOFTWARE_RSA_SHA256   ChallengeKey_KeyType = 1
 	ChallengeKey_SOFTWARE_ECDSA_SHA256 ChallengeKey_KeyType = 2
+	// TPM 2.0 quote over the challenge signed by the attestation key (AK)
+	ChallengeKey_TPM2_QUOTE ChallengeKey_KeyType = 3
 )
 
 var ChallengeKey_KeyType_name = map[int32]string{
 	0: "ECHO",
 	1: "SOFTWARE_RSA_SHA256",
 	2: "SOFTWARE_ECDSA_SHA256",
+	3: "TPM2_QUOTE",
 }
 
 var ChallengeKey_KeyType_value = map[string]int32{
 	"ECHO":                  0,
 	"SOFTWARE_RSA_SHA256":   1,
 	"SOFTWARE_ECDSA_SHA256": 2,
+	"TPM2_QUOTE":            3,
 }
 
 func (x ChallengeKey_KeyType) String() string {
@@ -157,6 +161,7 @@ type Response struct {
 	//	*Response_EchoResponse
 	//	*Response_RsaResponse
 	//	*Response_EcdsaResponse
+	//	*Response_Tpm2QuoteResponse
 	Response             isResponse_Response `protobuf_oneof:"response"`
 	Csr                  *CSR                `protobuf:"bytes,6,opt,name=csr,proto3" json:"csr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -219,12 +224,18 @@ type Response_EcdsaResponse struct {
 	EcdsaResponse *Response_ECDSA `protobuf:"bytes,5,opt,name=ecdsa_response,json=ecdsaResponse,proto3,oneof"`
 }
 
+type Response_Tpm2QuoteResponse struct {
+	Tpm2QuoteResponse *Response_TPM2Quote `protobuf:"bytes,7,opt,name=tpm2_quote_response,json=tpm2QuoteResponse,proto3,oneof"`
+}
+
 func (*Response_EchoResponse) isResponse_Response() {}
 
 func (*Response_RsaResponse) isResponse_Response() {}
 
 func (*Response_EcdsaResponse) isResponse_Response() {}
 
+func (*Response_Tpm2QuoteResponse) isResponse_Response() {}
+
 func (m *Response) GetResponse() isResponse_Response {
 	if m != nil {
 		return m.Response
@@ -253,6 +264,13 @@ func (m *Response) GetEcdsaResponse() *Response_ECDSA {
 	return nil
 }
 
+func (m *Response) GetTpm2QuoteResponse() *Response_TPM2Quote {
+	if x, ok := m.GetResponse().(*Response_Tpm2QuoteResponse); ok {
+		return x.Tpm2QuoteResponse
+	}
+	return nil
+}
+
 func (m *Response) GetCsr() *CSR {
 	if m != nil {
 		return m.Csr
@@ -266,6 +284,7 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_EchoResponse)(nil),
 		(*Response_RsaResponse)(nil),
 		(*Response_EcdsaResponse)(nil),
+		(*Response_Tpm2QuoteResponse)(nil),
 	}
 }
 
@@ -394,6 +413,57 @@ func (m *Response_ECDSA) GetS() []byte {
 	return nil
 }
 
+// TPM2_Quote output, whose qualifying data is the SHA-256 digest of the
+// challenge
+type Response_TPM2Quote struct {
+	// TPMS_ATTEST structure in TPM wire format
+	Quoted []byte `protobuf:"bytes,1,opt,name=quoted,proto3" json:"quoted,omitempty"`
+	// TPMT_SIGNATURE of quoted by the attestation key in TPM wire format
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Response_TPM2Quote) Reset()         { *m = Response_TPM2Quote{} }
+func (m *Response_TPM2Quote) String() string { return proto.CompactTextString(m) }
+func (*Response_TPM2Quote) ProtoMessage()    {}
+func (*Response_TPM2Quote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b592b3c4e9ae6813, []int{2, 3}
+}
+
+func (m *Response_TPM2Quote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Response_TPM2Quote.Unmarshal(m, b)
+}
+func (m *Response_TPM2Quote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Response_TPM2Quote.Marshal(b, m, deterministic)
+}
+func (m *Response_TPM2Quote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_TPM2Quote.Merge(m, src)
+}
+func (m *Response_TPM2Quote) XXX_Size() int {
+	return xxx_messageInfo_Response_TPM2Quote.Size(m)
+}
+func (m *Response_TPM2Quote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_TPM2Quote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_TPM2Quote proto.InternalMessageInfo
+
+func (m *Response_TPM2Quote) GetQuoted() []byte {
+	if m != nil {
+		return m.Quoted
+	}
+	return nil
+}
+
+func (m *Response_TPM2Quote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.ChallengeKey_KeyType", ChallengeKey_KeyType_name, ChallengeKey_KeyType_value)
 	proto.RegisterType((*Challenge)(nil), "magma.orc8r.Challenge")
@@ -402,44 +472,50 @@ func init() {
 	proto.RegisterType((*Response_Echo)(nil), "magma.orc8r.Response.Echo")
 	proto.RegisterType((*Response_RSA)(nil), "magma.orc8r.Response.RSA")
 	proto.RegisterType((*Response_ECDSA)(nil), "magma.orc8r.Response.ECDSA")
+	proto.RegisterType((*Response_TPM2Quote)(nil), "magma.orc8r.Response.TPM2Quote")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_b592b3c4e9ae6813) }
 
 var fileDescriptor_b592b3c4e9ae6813 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0x6f, 0xda, 0x30,
-	0x14, 0x4d, 0x0a, 0x6d, 0xe9, 0x25, 0xad, 0x90, 0xa7, 0x6e, 0x34, 0x30, 0xad, 0x4b, 0x5f, 0xfa,
-	0x14, 0x34, 0xa6, 0x4d, 0x7b, 0x98, 0xa6, 0x85, 0x8f, 0x42, 0xd5, 0x87, 0x4a, 0x4e, 0xa5, 0x49,
-	0x7b, 0x41, 0xa9, 0xb9, 0x0b, 0x11, 0x14, 0x67, 0xb6, 0x11, 0xca, 0x3f, 0xd9, 0x3f, 0xd8, 0xff,
-	0xd8, 0x2f, 0x9b, 0x62, 0x42, 0x42, 0x24, 0xd6, 0x97, 0x3d, 0xc5, 0xf6, 0x39, 0xf7, 0x9c, 0x9b,
-	0x63, 0x5f, 0x78, 0xc3, 0x05, 0xfb, 0x24, 0x3a, 0xb1, 0xe0, 0x8a, 0xcb, 0xce, 0x23, 0xe7, 0x4a,
-	0x2a, 0x11, 0xc4, 0x31, 0x0a, 0x57, 0x9f, 0x91, 0xfa, 0x53, 0x10, 0x3e, 0x05, 0xae, 0xa6, 0xd9,
-	0xed, 0x12, 0x9b, 0xa1, 0x50, 0xd1, 0x8f, 0x68, 0x4b, 0xb5, 0x5b, 0x25, 0x34, 0x9a, 0xe2, 0x52,
-	0x45, 0x2a, 0xd9, 0x80, 0x4e, 0x08, 0x27, 0xfd, 0x59, 0xb0, 0x58, 0xe0, 0x32, 0x44, 0xf2, 0x19,
-	0x6a, 0x73, 0x4c, 0x26, 0x2a, 0x89, 0xb1, 0x69, 0x5e, 0x9a, 0xd7, 0x67, 0xdd, 0xb7, 0xee, 0x8e,
-	0x8f, 0x9b, 0x33, 0xef, 0x30, 0x71, 0xef, 0x30, 0x79, 0x48, 0x62, 0xa4, 0xc7, 0xf3, 0xcd, 0x82,
-	0xb4, 0xe1, 0x84, 0x6d, 0x09, 0xcd, 0x83, 0x4b, 0xf3, 0xda, 0xa2, 0xc5, 0x81, 0xf3, 0xdb, 0x04,
-	0x6b, 0xb7, 0xfe, 0x3f, 0xcd, 0x1a, 0x50, 0x99, 0x63, 0x92, 0xd9, 0xa4, 0x4b, 0x67, 0x04, 0xc7,
-	0x19, 0x8b, 0xd4, 0xa0, 0x3a, 0xec, 0x8f, 0xef, 0x1b, 0x06, 0x79, 0x05, 0x2f, 0xfc, 0xfb, 0x9b,
-	0x87, 0x6f, 0x1e, 0x1d, 0x4e, 0xa8, 0xef, 0x4d, 0xfc, 0xb1, 0xd7, 0xfd, 0xf0, 0xb1, 0x61, 0x92,
-	0x0b, 0x38, 0xcf, 0x81, 0x61, 0x7f, 0x50, 0x40, 0x07, 0xce, 0x9f, 0x0a, 0xd4, 0x28, 0xca, 0x98,
-	0x2f, 0x25, 0x92, 0x77, 0x70, 0x38, 0x5b, 0x4f, 0xa2, 0xa9, 0x6e, 0xb1, 0xde, 0x6d, 0x97, 0x5a,
-	0xf4, 0x18, 0x43, 0x29, 0x47, 0x81, 0xc2, 0x75, 0x90, 0xdc, 0x0e, 0x68, 0x75, 0xb6, 0xbe, 0x9d,
-	0x3e, 0x9f, 0x03, 0xf1, 0xe0, 0x14, 0xd9, 0x8c, 0x4f, 0x44, 0xe6, 0xd0, 0xac, 0x68, 0x61, 0xbb,
-	0x24, 0xbc, 0xb5, 0x77, 0x87, 0x6c, 0xc6, 0xc7, 0x06, 0xb5, 0xd2, 0x92, 0xbc, 0xa7, 0x2f, 0x60,
-	0x09, 0x19, 0x14, 0x0a, 0x55, 0xad, 0x70, 0xb1, 0x5f, 0x81, 0xfa, 0xde, 0xd8, 0xa0, 0x75, 0x21,
-	0x83, 0xbc, 0x7e, 0x00, 0x67, 0xc8, 0xa6, 0xbb, 0x0a, 0x87, 0x5a, 0xa1, 0xf5, 0x8f, 0x1e, 0xd2,
-	0x78, 0xc6, 0x06, 0x3d, 0xd5, 0x45, 0xb9, 0x8a, 0x03, 0x15, 0x26, 0x45, 0xf3, 0x48, 0x97, 0x36,
-	0xca, 0x57, 0xe7, 0x53, 0x9a, 0x82, 0xb6, 0x03, 0xd5, 0xf4, 0x0f, 0x88, 0x0d, 0xb5, 0xdc, 0xcb,
-	0xd4, 0x89, 0xe4, 0x7b, 0xfb, 0x0a, 0x2a, 0xd4, 0xf7, 0xd2, 0xd4, 0x64, 0x14, 0x2e, 0x03, 0xb5,
-	0x12, 0x5b, 0x4e, 0x71, 0x60, 0x5f, 0xc1, 0xa1, 0x6e, 0x83, 0x58, 0x60, 0x8a, 0x0c, 0x36, 0x45,
-	0xba, 0x93, 0x59, 0xc4, 0xa6, 0xec, 0x41, 0xe1, 0xd2, 0xfd, 0x65, 0x82, 0xd5, 0xdb, 0x19, 0x1b,
-	0x72, 0x03, 0xd6, 0x08, 0x55, 0xf1, 0xd6, 0x9f, 0xbd, 0x49, 0xfb, 0xe5, 0xfe, 0xa7, 0xe8, 0x18,
-	0xe4, 0x2b, 0xd4, 0x29, 0xfe, 0x5c, 0xa1, 0x54, 0x7e, 0x14, 0x2e, 0xc9, 0xf9, 0xde, 0xcc, 0xec,
-	0x66, 0xb9, 0x7e, 0x33, 0x91, 0x2c, 0x50, 0xe8, 0x18, 0xbd, 0xd7, 0xdf, 0x5b, 0x1a, 0xec, 0x6c,
-	0xe6, 0x92, 0x2d, 0xf8, 0x6a, 0xda, 0x09, 0x79, 0x36, 0xa0, 0x8f, 0x47, 0xfa, 0xfb, 0xfe, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0xaa, 0x0d, 0x91, 0x03, 0x04, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x51, 0x4f, 0x9b, 0x50,
+	0x14, 0xc7, 0xc1, 0x56, 0xad, 0xa7, 0x68, 0xd8, 0x35, 0xba, 0x8a, 0x2e, 0x3a, 0xdc, 0x83, 0x4f,
+	0x34, 0x63, 0xd9, 0xb2, 0x87, 0x65, 0x19, 0x6a, 0x15, 0x63, 0x16, 0xe7, 0xa5, 0x8b, 0xc9, 0x5e,
+	0x08, 0xc2, 0x1d, 0x25, 0x6d, 0x01, 0xef, 0xbd, 0x4d, 0xc3, 0x27, 0xdb, 0x57, 0xd9, 0xa7, 0xd8,
+	0x67, 0x58, 0xb8, 0xa5, 0x50, 0x4c, 0xd7, 0x97, 0x3d, 0xc1, 0x3d, 0xe7, 0x7f, 0x7e, 0xff, 0xc3,
+	0xe1, 0xde, 0x0b, 0xc7, 0x09, 0xf5, 0x3f, 0xd2, 0x6e, 0x4a, 0x13, 0x9e, 0xb0, 0xee, 0x63, 0x92,
+	0x70, 0xc6, 0xa9, 0x97, 0xa6, 0x84, 0x1a, 0x22, 0x86, 0xda, 0x63, 0x2f, 0x1c, 0x7b, 0x86, 0x90,
+	0x69, 0x47, 0x35, 0xb5, 0x4f, 0x28, 0x8f, 0x7e, 0x46, 0x73, 0xa9, 0x76, 0x58, 0xcb, 0x46, 0x01,
+	0x89, 0x79, 0xc4, 0xb3, 0x59, 0x52, 0x0f, 0x61, 0xeb, 0x62, 0xe0, 0x8d, 0x46, 0x24, 0x0e, 0x09,
+	0xfa, 0x04, 0xad, 0x21, 0xc9, 0x5c, 0x9e, 0xa5, 0xa4, 0x23, 0x9f, 0xc8, 0x67, 0x3b, 0xe6, 0x6b,
+	0x63, 0xc1, 0xc7, 0x28, 0x95, 0xb7, 0x24, 0x33, 0x6e, 0x49, 0xd6, 0xcf, 0x52, 0x82, 0x37, 0x87,
+	0xb3, 0x17, 0x74, 0x04, 0x5b, 0xfe, 0x5c, 0xd0, 0x59, 0x3b, 0x91, 0xcf, 0x14, 0x5c, 0x05, 0xf4,
+	0x5f, 0x32, 0x28, 0x8b, 0xf5, 0xff, 0x69, 0xa6, 0x42, 0x63, 0x48, 0xb2, 0xc2, 0x26, 0x7f, 0xd5,
+	0x1f, 0x60, 0xb3, 0x50, 0xa1, 0x16, 0x34, 0x7b, 0x17, 0xf6, 0x9d, 0x2a, 0xa1, 0x97, 0xb0, 0xeb,
+	0xdc, 0x5d, 0xf5, 0x1f, 0x2c, 0xdc, 0x73, 0xb1, 0x63, 0xb9, 0x8e, 0x6d, 0x99, 0xef, 0x3f, 0xa8,
+	0x32, 0x3a, 0x80, 0xbd, 0x32, 0xd1, 0xbb, 0xb8, 0xac, 0x52, 0x6b, 0x68, 0x07, 0xa0, 0xff, 0xed,
+	0xab, 0xe9, 0xde, 0x7f, 0xbf, 0xeb, 0xf7, 0xd4, 0x86, 0xfe, 0xbb, 0x09, 0x2d, 0x4c, 0x58, 0x9a,
+	0xc4, 0x8c, 0xa0, 0xb7, 0xb0, 0x3e, 0x98, 0xba, 0x51, 0x20, 0x5a, 0x6e, 0x9b, 0x47, 0xb5, 0x96,
+	0x2d, 0xdf, 0x27, 0x8c, 0x5d, 0x7b, 0x9c, 0x4c, 0xbd, 0xec, 0xe6, 0x12, 0x37, 0x07, 0xd3, 0x9b,
+	0x60, 0xf5, 0x5c, 0x90, 0x05, 0xdb, 0xc4, 0x1f, 0x24, 0x2e, 0x2d, 0x1c, 0x3a, 0x0d, 0x01, 0xd6,
+	0x6a, 0xe0, 0xb9, 0xbd, 0xd1, 0xf3, 0x07, 0x89, 0x2d, 0x61, 0x25, 0x2f, 0x29, 0x7b, 0xfa, 0x0c,
+	0x0a, 0x65, 0x5e, 0x45, 0x68, 0x0a, 0xc2, 0xc1, 0x72, 0x02, 0x76, 0x2c, 0x5b, 0xc2, 0x6d, 0xca,
+	0xbc, 0xb2, 0xfe, 0x12, 0x76, 0x88, 0x1f, 0x2c, 0x12, 0xd6, 0x05, 0xe1, 0xf0, 0x1f, 0x3d, 0xe4,
+	0xe3, 0xb2, 0x25, 0xbc, 0x2d, 0x8a, 0x4a, 0xca, 0x3d, 0xec, 0xf2, 0x74, 0x6c, 0xba, 0x4f, 0x93,
+	0x84, 0x93, 0x0a, 0xb5, 0x29, 0x50, 0xc7, 0xcb, 0x51, 0xf9, 0x9c, 0xef, 0x73, 0xbd, 0x2d, 0xe1,
+	0x17, 0x79, 0xb5, 0x58, 0x94, 0x48, 0x1d, 0x1a, 0x3e, 0xa3, 0x9d, 0x0d, 0x81, 0x50, 0xeb, 0xbb,
+	0xc3, 0xc1, 0x38, 0x4f, 0x6a, 0x3a, 0x34, 0xf3, 0xa1, 0x20, 0x0d, 0x5a, 0xa5, 0xa7, 0x2c, 0x86,
+	0x5c, 0xae, 0xb5, 0x53, 0x68, 0x60, 0xc7, 0xca, 0x7f, 0x04, 0x8b, 0xc2, 0xd8, 0xe3, 0x13, 0x3a,
+	0xd7, 0x54, 0x01, 0xed, 0x14, 0xd6, 0xc5, 0x97, 0x21, 0x05, 0x64, 0x5a, 0xa4, 0x65, 0x9a, 0xaf,
+	0x58, 0xf1, 0xd7, 0x64, 0xa6, 0x59, 0xb0, 0x55, 0xf6, 0x8c, 0xf6, 0x61, 0x43, 0x7c, 0x6c, 0x50,
+	0xa8, 0x8b, 0x55, 0xdd, 0x67, 0xed, 0x99, 0xcf, 0x39, 0x54, 0x8d, 0x9a, 0x7f, 0x64, 0x50, 0xce,
+	0x17, 0x0e, 0x37, 0xba, 0x02, 0xe5, 0x9a, 0xf0, 0xea, 0x44, 0xae, 0xdc, 0x5f, 0xda, 0xfe, 0xf2,
+	0x03, 0xa3, 0x4b, 0xe8, 0x0b, 0xb4, 0x31, 0x79, 0x9a, 0x10, 0xc6, 0x9d, 0x28, 0x8c, 0xd1, 0xde,
+	0xd2, 0xf1, 0x6b, 0x9d, 0x7a, 0xfd, 0xec, 0xde, 0xf0, 0x3d, 0x9e, 0x13, 0xfa, 0xa0, 0x62, 0x12,
+	0x93, 0xe9, 0x42, 0x14, 0xbd, 0x79, 0x86, 0xa9, 0xa7, 0x0b, 0xc3, 0x55, 0xd4, 0xf3, 0x57, 0x3f,
+	0x0e, 0x45, 0xb2, 0x3b, 0xbb, 0x93, 0xfc, 0x51, 0x32, 0x09, 0xba, 0x61, 0x52, 0x5c, 0x4e, 0x8f,
+	0x1b, 0xe2, 0xf9, 0xee, 0xef, 0x00, 0xf1, 0xba, 0xec, 0x30, 0xff, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -459,6 +535,10 @@ type BootstrapperClient interface {
 	// send back response and csr for signing
 	// Returns signed certificate.
 	RequestSign(ctx context.Context, in *Response, opts ...grpc.CallOption) (*Certificate, error)
+	// renew a gateway certificate by presenting the current valid certificate
+	// and a new csr, without going through the challenge
+	// Returns signed certificate.
+	RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*Certificate, error)
 }
 
 type bootstrapperClient struct {
@@ -487,6 +567,15 @@ func (c *bootstrapperClient) RequestSign(ctx context.Context, in *Response, opts
 	return out, nil
 }
 
+func (c *bootstrapperClient) RenewCertificate(ctx context.Context, in *RenewCertificateRequest, opts ...grpc.CallOption) (*Certificate, error) {
+	out := new(Certificate)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Bootstrapper/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BootstrapperServer is the server API for Bootstrapper service.
 type BootstrapperServer interface {
 	// get the challange for gateway specified in hw_id (AccessGatewayID)
@@ -494,6 +583,10 @@ type BootstrapperServer interface {
 	// send back response and csr for signing
 	// Returns signed certificate.
 	RequestSign(context.Context, *Response) (*Certificate, error)
+	// renew a gateway certificate by presenting the current valid certificate
+	// and a new csr, without going through the challenge
+	// Returns signed certificate.
+	RenewCertificate(context.Context, *RenewCertificateRequest) (*Certificate, error)
 }
 
 // UnimplementedBootstrapperServer can be embedded to have forward compatible implementations.
@@ -506,6 +599,9 @@ func (*UnimplementedBootstrapperServer) GetChallenge(ctx context.Context, req *A
 func (*UnimplementedBootstrapperServer) RequestSign(ctx context.Context, req *Response) (*Certificate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestSign not implemented")
 }
+func (*UnimplementedBootstrapperServer) RenewCertificate(ctx context.Context, req *RenewCertificateRequest) (*Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
 
 func RegisterBootstrapperServer(s *grpc.Server, srv BootstrapperServer) {
 	s.RegisterService(&_Bootstrapper_serviceDesc, srv)
@@ -547,6 +643,24 @@ func _Bootstrapper_RequestSign_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bootstrapper_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapperServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Bootstrapper/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapperServer).RenewCertificate(ctx, req.(*RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Bootstrapper_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.Bootstrapper",
 	HandlerType: (*BootstrapperServer)(nil),
@@ -559,6 +673,10 @@ var _Bootstrapper_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestSign",
 			Handler:    _Bootstrapper_RequestSign_Handler,
 		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Bootstrapper_RenewCertificate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orc8r/protos/bootstrapper.proto",
@@ -251,41 +251,102 @@ func (m *CACert) GetCert() []byte {
 	return nil
 }
 
+type RenewCertificateRequest struct {
+	CertDer []byte `protobuf:"bytes,1,opt,name=cert_der,json=certDer,proto3" json:"cert_der,omitempty"`
+	Csr     *CSR   `protobuf:"bytes,2,opt,name=csr,proto3" json:"csr,omitempty"`
+	// SHA-256 signature of csr.csr_der made with the private key of cert_der,
+	// proving possession of the current certificate
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenewCertificateRequest) Reset()         { *m = RenewCertificateRequest{} }
+func (m *RenewCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewCertificateRequest) ProtoMessage()    {}
+func (*RenewCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_309897dc79f61bc0, []int{3}
+}
+
+func (m *RenewCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewCertificateRequest.Unmarshal(m, b)
+}
+func (m *RenewCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewCertificateRequest.Marshal(b, m, deterministic)
+}
+func (m *RenewCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewCertificateRequest.Merge(m, src)
+}
+func (m *RenewCertificateRequest) XXX_Size() int {
+	return xxx_messageInfo_RenewCertificateRequest.Size(m)
+}
+func (m *RenewCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewCertificateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewCertificateRequest proto.InternalMessageInfo
+
+func (m *RenewCertificateRequest) GetCertDer() []byte {
+	if m != nil {
+		return m.CertDer
+	}
+	return nil
+}
+
+func (m *RenewCertificateRequest) GetCsr() *CSR {
+	if m != nil {
+		return m.Csr
+	}
+	return nil
+}
+
+func (m *RenewCertificateRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.CertType", CertType_name, CertType_value)
 	proto.RegisterType((*CSR)(nil), "magma.orc8r.CSR")
 	proto.RegisterType((*Certificate)(nil), "magma.orc8r.Certificate")
 	proto.RegisterType((*Certificate_SN)(nil), "magma.orc8r.Certificate.SN")
 	proto.RegisterType((*CACert)(nil), "magma.orc8r.CACert")
+	proto.RegisterType((*RenewCertificateRequest)(nil), "magma.orc8r.RenewCertificateRequest")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/certifier.proto", fileDescriptor_309897dc79f61bc0) }
 
 var fileDescriptor_309897dc79f61bc0 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x5d, 0xab, 0xd3, 0x30,
-	0x18, 0xc7, 0x6d, 0x37, 0xd6, 0xf5, 0xe9, 0xe1, 0x70, 0x08, 0x8a, 0x3d, 0xdd, 0x51, 0xcb, 0x40,
-	0x18, 0x0a, 0x29, 0x1c, 0x2f, 0x3c, 0x5e, 0x6e, 0xad, 0x82, 0x20, 0x43, 0xb2, 0xea, 0x85, 0x37,
-	0xa5, 0x6b, 0xd3, 0x12, 0x58, 0x9b, 0x92, 0x66, 0xc2, 0x3e, 0x97, 0x1f, 0xc8, 0xaf, 0x22, 0x49,
-	0x53, 0xd1, 0x0d, 0xce, 0x55, 0x93, 0x3e, 0xbf, 0xf0, 0x7f, 0xe1, 0x81, 0x3b, 0x2e, 0x8a, 0x07,
-	0x11, 0x75, 0x82, 0x4b, 0xde, 0x47, 0x05, 0x15, 0x92, 0x55, 0x8c, 0x0a, 0xac, 0x7f, 0x20, 0xaf,
-	0xc9, 0xeb, 0x26, 0xc7, 0x9a, 0x09, 0x16, 0xff, 0xa1, 0xac, 0xa4, 0xad, 0x64, 0xf2, 0x34, 0x90,
-	0xc1, 0xab, 0x9a, 0xf3, 0xfa, 0x40, 0x87, 0xe9, 0xfe, 0x58, 0x45, 0x92, 0x35, 0xb4, 0x97, 0x79,
-	0xd3, 0x19, 0xe0, 0xe5, 0x39, 0x50, 0x1e, 0x45, 0x2e, 0x19, 0x6f, 0x87, 0xf9, 0xf2, 0x97, 0x05,
-	0x93, 0x78, 0x47, 0xd0, 0x6b, 0xb0, 0x59, 0xe9, 0x5b, 0xa1, 0xb5, 0xf2, 0xee, 0x9f, 0xe1, 0x7f,
-	0xf4, 0xf1, 0x67, 0xa3, 0x48, 0x6c, 0x56, 0xa2, 0x07, 0x80, 0x9f, 0xf9, 0x81, 0x95, 0x99, 0xd2,
-	0xf1, 0x6d, 0x8d, 0xdf, 0xe2, 0x41, 0x03, 0x8f, 0x1a, 0x38, 0x31, 0x1a, 0xc4, 0xd5, 0x70, 0xca,
-	0x1a, 0x8a, 0x9e, 0x83, 0x53, 0xf4, 0x22, 0x2b, 0xa9, 0xf0, 0x27, 0xa1, 0xb5, 0xba, 0x22, 0xb3,
-	0xa2, 0x17, 0x09, 0x15, 0xe8, 0x1e, 0x5c, 0x95, 0x3f, 0x93, 0xa7, 0x8e, 0xfa, 0xd3, 0xd0, 0x5a,
-	0x5d, 0x9f, 0x19, 0x88, 0xa9, 0x90, 0xe9, 0xa9, 0xa3, 0x64, 0x5e, 0x98, 0xd3, 0xf2, 0xb7, 0x05,
-	0x5e, 0x3c, 0x94, 0x56, 0xe4, 0x92, 0xa2, 0xb7, 0x60, 0xf7, 0xad, 0x71, 0xbf, 0xb8, 0x78, 0x6c,
-	0x28, 0xbc, 0xdb, 0x12, 0xbb, 0x6f, 0xd1, 0x07, 0x80, 0x96, 0xcb, 0x6c, 0x4f, 0x2b, 0x2e, 0xc6,
-	0x0c, 0xc1, 0x45, 0x86, 0x74, 0x2c, 0x92, 0xb8, 0x2d, 0x97, 0x1b, 0x0d, 0xa3, 0xf7, 0xa0, 0x2e,
-	0x59, 0x5e, 0x49, 0x13, 0xe3, 0xf1, 0x97, 0xf3, 0x96, 0xcb, 0xb5, 0x62, 0xd1, 0x2d, 0x68, 0xf3,
-	0x3a, 0xfe, 0x54, 0xc7, 0x77, 0xd4, 0x3d, 0xa1, 0x22, 0x78, 0x0a, 0xf6, 0x6e, 0x8b, 0xae, 0xff,
-	0x26, 0x70, 0x95, 0xc9, 0xe5, 0x1d, 0xcc, 0xe2, 0xb5, 0x32, 0x8f, 0x10, 0x4c, 0x15, 0xaa, 0x67,
-	0x57, 0x44, 0x9f, 0xdf, 0x84, 0x30, 0x1f, 0x5b, 0x41, 0x1e, 0x38, 0xc9, 0xc7, 0x4f, 0xeb, 0x6f,
-	0x5f, 0xd2, 0x9b, 0x27, 0xc8, 0x81, 0xc9, 0xf7, 0xaf, 0xdb, 0x1b, 0x6b, 0xf3, 0xe2, 0xc7, 0x42,
-	0xd7, 0x10, 0x0d, 0xdb, 0x53, 0x1c, 0xf8, 0xb1, 0x8c, 0x6a, 0x6e, 0xd6, 0x68, 0x3f, 0xd3, 0xdf,
-	0x77, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xa8, 0xb0, 0xa6, 0x88, 0x02, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x86, 0x4d, 0x5a, 0xda, 0xe6, 0x74, 0x59, 0xca, 0xa0, 0x6c, 0xb7, 0xad, 0x5a, 0x02, 0x42,
+	0x51, 0x48, 0x60, 0xbd, 0x70, 0xbd, 0xec, 0xb6, 0x0a, 0x82, 0x14, 0x99, 0x56, 0x2f, 0xbc, 0x09,
+	0x69, 0x72, 0x1a, 0x06, 0x9a, 0x99, 0x3a, 0x33, 0x59, 0xe9, 0x73, 0xf9, 0x40, 0xbe, 0x8a, 0xcc,
+	0x64, 0xaa, 0x9b, 0x2d, 0x78, 0x95, 0x99, 0x39, 0xdf, 0xe4, 0xff, 0xff, 0x33, 0x07, 0x26, 0x42,
+	0x66, 0xb7, 0x32, 0x3e, 0x48, 0xa1, 0x85, 0x8a, 0x33, 0x94, 0x9a, 0xed, 0x18, 0xca, 0xc8, 0x1e,
+	0x90, 0x7e, 0x99, 0x16, 0x65, 0x1a, 0x59, 0x66, 0x34, 0x6e, 0xa0, 0x2c, 0x47, 0xae, 0x99, 0x3e,
+	0xd6, 0xe4, 0xe8, 0x65, 0x21, 0x44, 0xb1, 0xc7, 0xba, 0xba, 0xad, 0x76, 0xb1, 0x66, 0x25, 0x2a,
+	0x9d, 0x96, 0x07, 0x07, 0xbc, 0x78, 0x0c, 0xe4, 0x95, 0x4c, 0x35, 0x13, 0xbc, 0xae, 0x87, 0xbf,
+	0x3c, 0x68, 0x2d, 0xd6, 0x94, 0xbc, 0x02, 0x9f, 0xe5, 0x43, 0x6f, 0xea, 0xcd, 0xfa, 0x37, 0xcf,
+	0xa2, 0x07, 0xfa, 0xd1, 0x27, 0xa7, 0x48, 0x7d, 0x96, 0x93, 0x5b, 0x80, 0xfb, 0x74, 0xcf, 0xf2,
+	0xc4, 0xe8, 0x0c, 0x7d, 0x8b, 0x5f, 0x47, 0xb5, 0x46, 0x74, 0xd2, 0x88, 0x96, 0x4e, 0x83, 0x06,
+	0x16, 0xde, 0xb0, 0x12, 0xc9, 0x15, 0x74, 0x33, 0x25, 0x93, 0x1c, 0xe5, 0xb0, 0x35, 0xf5, 0x66,
+	0x17, 0xb4, 0x93, 0x29, 0xb9, 0x44, 0x49, 0x6e, 0x20, 0x30, 0xf9, 0x13, 0x7d, 0x3c, 0xe0, 0xb0,
+	0x3d, 0xf5, 0x66, 0x97, 0x8f, 0x0c, 0x2c, 0x50, 0xea, 0xcd, 0xf1, 0x80, 0xb4, 0x97, 0xb9, 0x55,
+	0xf8, 0xdb, 0x83, 0xfe, 0xa2, 0x6e, 0x5a, 0x96, 0x6a, 0x24, 0x6f, 0xc0, 0x57, 0xdc, 0xb9, 0x1f,
+	0x9f, 0x5d, 0x76, 0x54, 0xb4, 0x5e, 0x51, 0x5f, 0x71, 0xf2, 0x1e, 0x80, 0x0b, 0x9d, 0x6c, 0x71,
+	0x27, 0xe4, 0x29, 0xc3, 0xe8, 0x2c, 0xc3, 0xe6, 0xd4, 0x48, 0x1a, 0x70, 0xa1, 0xef, 0x2c, 0x4c,
+	0xde, 0x81, 0xd9, 0x24, 0xe9, 0x4e, 0xbb, 0x18, 0xff, 0xbf, 0xd9, 0xe3, 0x42, 0xcf, 0x0d, 0x4b,
+	0xae, 0xc1, 0x9a, 0xb7, 0xf1, 0xdb, 0x36, 0x7e, 0xd7, 0xec, 0x97, 0x28, 0x47, 0x4f, 0xc1, 0x5f,
+	0xaf, 0xc8, 0xe5, 0xdf, 0x04, 0x81, 0x31, 0x19, 0x4e, 0xa0, 0xb3, 0x98, 0x1b, 0xf3, 0x84, 0x40,
+	0xdb, 0xa0, 0xb6, 0x76, 0x41, 0xed, 0x3a, 0xbc, 0x87, 0x2b, 0x8a, 0x1c, 0x7f, 0x3e, 0x48, 0x47,
+	0xf1, 0x47, 0x85, 0x4a, 0x37, 0x94, 0xbc, 0x86, 0x12, 0x09, 0xa1, 0x95, 0x29, 0xe9, 0x12, 0x0f,
+	0x9a, 0x6d, 0x5a, 0x53, 0x6a, 0x8a, 0x64, 0x02, 0x81, 0x62, 0x05, 0x4f, 0x75, 0x25, 0xd1, 0x3d,
+	0xd4, 0xbf, 0x83, 0xd7, 0x53, 0xe8, 0x9d, 0x5e, 0x83, 0xf4, 0xa1, 0xbb, 0xfc, 0xf0, 0x71, 0xfe,
+	0xf5, 0xf3, 0x66, 0xf0, 0x84, 0x74, 0xa1, 0xf5, 0xed, 0xcb, 0x6a, 0xe0, 0xdd, 0x3d, 0xff, 0x3e,
+	0xb6, 0xff, 0x8d, 0xeb, 0xa9, 0xcd, 0xf6, 0xa2, 0xca, 0xe3, 0x42, 0xb8, 0xf1, 0xdd, 0x76, 0xec,
+	0xf7, 0xed, 0x9f, 0x01, 0x00, 0x96, 0x3d, 0xea, 0xb4, 0x00, 0x03, 0x00, 0x00,
 }
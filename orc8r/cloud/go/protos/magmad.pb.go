@@ -699,6 +699,338 @@ func (m *LogLine) GetLine() string {
 	return ""
 }
 
+type ShellRequest struct {
+	// Unique ID of the session, which input to the shell is sent to
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Command to run in the gateway's shell. An interactive shell is started if
+	// empty.
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// Initial size of the shell's terminal
+	Rows                 uint32   `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols                 uint32   `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShellRequest) Reset()         { *m = ShellRequest{} }
+func (m *ShellRequest) String() string { return proto.CompactTextString(m) }
+func (*ShellRequest) ProtoMessage()    {}
+func (*ShellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{14}
+}
+
+func (m *ShellRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShellRequest.Unmarshal(m, b)
+}
+func (m *ShellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShellRequest.Marshal(b, m, deterministic)
+}
+func (m *ShellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShellRequest.Merge(m, src)
+}
+func (m *ShellRequest) XXX_Size() int {
+	return xxx_messageInfo_ShellRequest.Size(m)
+}
+func (m *ShellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShellRequest proto.InternalMessageInfo
+
+func (m *ShellRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *ShellRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *ShellRequest) GetRows() uint32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *ShellRequest) GetCols() uint32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+type ShellInput struct {
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data      []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Resizes the shell's terminal if non-zero
+	Rows uint32 `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols uint32 `protobuf:"varint,4,opt,name=cols,proto3" json:"cols,omitempty"`
+	// Terminates the shell
+	Close                bool     `protobuf:"varint,5,opt,name=close,proto3" json:"close,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShellInput) Reset()         { *m = ShellInput{} }
+func (m *ShellInput) String() string { return proto.CompactTextString(m) }
+func (*ShellInput) ProtoMessage()    {}
+func (*ShellInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{15}
+}
+
+func (m *ShellInput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShellInput.Unmarshal(m, b)
+}
+func (m *ShellInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShellInput.Marshal(b, m, deterministic)
+}
+func (m *ShellInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShellInput.Merge(m, src)
+}
+func (m *ShellInput) XXX_Size() int {
+	return xxx_messageInfo_ShellInput.Size(m)
+}
+func (m *ShellInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShellInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShellInput proto.InternalMessageInfo
+
+func (m *ShellInput) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *ShellInput) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ShellInput) GetRows() uint32 {
+	if m != nil {
+		return m.Rows
+	}
+	return 0
+}
+
+func (m *ShellInput) GetCols() uint32 {
+	if m != nil {
+		return m.Cols
+	}
+	return 0
+}
+
+func (m *ShellInput) GetClose() bool {
+	if m != nil {
+		return m.Close
+	}
+	return false
+}
+
+type ShellOutput struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Set on the last output of a session, once the shell has exited
+	Exited               bool     `protobuf:"varint,2,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode             int32    `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShellOutput) Reset()         { *m = ShellOutput{} }
+func (m *ShellOutput) String() string { return proto.CompactTextString(m) }
+func (*ShellOutput) ProtoMessage()    {}
+func (*ShellOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{16}
+}
+
+func (m *ShellOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShellOutput.Unmarshal(m, b)
+}
+func (m *ShellOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShellOutput.Marshal(b, m, deterministic)
+}
+func (m *ShellOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShellOutput.Merge(m, src)
+}
+func (m *ShellOutput) XXX_Size() int {
+	return xxx_messageInfo_ShellOutput.Size(m)
+}
+func (m *ShellOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShellOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShellOutput proto.InternalMessageInfo
+
+func (m *ShellOutput) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ShellOutput) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ShellOutput) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+type UploadFileRequest struct {
+	// Absolute path of the file on the gateway
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Permission bits of the file
+	Mode                 uint32   `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UploadFileRequest) Reset()         { *m = UploadFileRequest{} }
+func (m *UploadFileRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadFileRequest) ProtoMessage()    {}
+func (*UploadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{17}
+}
+
+func (m *UploadFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadFileRequest.Unmarshal(m, b)
+}
+func (m *UploadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadFileRequest.Marshal(b, m, deterministic)
+}
+func (m *UploadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadFileRequest.Merge(m, src)
+}
+func (m *UploadFileRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadFileRequest.Size(m)
+}
+func (m *UploadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadFileRequest proto.InternalMessageInfo
+
+func (m *UploadFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *UploadFileRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *UploadFileRequest) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+type DownloadFileRequest struct {
+	// Absolute path of the file on the gateway
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadFileRequest) Reset()         { *m = DownloadFileRequest{} }
+func (m *DownloadFileRequest) String() string { return proto.CompactTextString(m) }
+func (*DownloadFileRequest) ProtoMessage()    {}
+func (*DownloadFileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{18}
+}
+
+func (m *DownloadFileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadFileRequest.Unmarshal(m, b)
+}
+func (m *DownloadFileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadFileRequest.Marshal(b, m, deterministic)
+}
+func (m *DownloadFileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadFileRequest.Merge(m, src)
+}
+func (m *DownloadFileRequest) XXX_Size() int {
+	return xxx_messageInfo_DownloadFileRequest.Size(m)
+}
+func (m *DownloadFileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadFileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadFileRequest proto.InternalMessageInfo
+
+func (m *DownloadFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type FileChunk struct {
+	Content              []byte   `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FileChunk) Reset()         { *m = FileChunk{} }
+func (m *FileChunk) String() string { return proto.CompactTextString(m) }
+func (*FileChunk) ProtoMessage()    {}
+func (*FileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e01809f6f4dd6f6, []int{19}
+}
+
+func (m *FileChunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileChunk.Unmarshal(m, b)
+}
+func (m *FileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileChunk.Marshal(b, m, deterministic)
+}
+func (m *FileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileChunk.Merge(m, src)
+}
+func (m *FileChunk) XXX_Size() int {
+	return xxx_messageInfo_FileChunk.Size(m)
+}
+func (m *FileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileChunk proto.InternalMessageInfo
+
+func (m *FileChunk) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PingParams)(nil), "magma.orc8r.PingParams")
 	proto.RegisterType((*TracerouteParams)(nil), "magma.orc8r.TracerouteParams")
@@ -714,66 +1046,88 @@ func init() {
 	proto.RegisterType((*GenericCommandResponse)(nil), "magma.orc8r.GenericCommandResponse")
 	proto.RegisterType((*TailLogsRequest)(nil), "magma.orc8r.TailLogsRequest")
 	proto.RegisterType((*LogLine)(nil), "magma.orc8r.LogLine")
+	proto.RegisterType((*ShellRequest)(nil), "magma.orc8r.ShellRequest")
+	proto.RegisterType((*ShellInput)(nil), "magma.orc8r.ShellInput")
+	proto.RegisterType((*ShellOutput)(nil), "magma.orc8r.ShellOutput")
+	proto.RegisterType((*UploadFileRequest)(nil), "magma.orc8r.UploadFileRequest")
+	proto.RegisterType((*DownloadFileRequest)(nil), "magma.orc8r.DownloadFileRequest")
+	proto.RegisterType((*FileChunk)(nil), "magma.orc8r.FileChunk")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/magmad.proto", fileDescriptor_9e01809f6f4dd6f6) }
 
 var fileDescriptor_9e01809f6f4dd6f6 = []byte{
-	// 861 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0xb6, 0xec, 0xd8, 0xb1, 0x4f, 0x9a, 0x38, 0x63, 0xb3, 0x4e, 0x55, 0x13, 0xd4, 0x63, 0x81,
-	0xc1, 0xc3, 0x30, 0xbb, 0x68, 0xbb, 0x9f, 0xab, 0x0d, 0x68, 0x81, 0x25, 0x45, 0x93, 0x2d, 0xa0,
-	0x8d, 0x0e, 0xe8, 0x8d, 0x40, 0x4b, 0xac, 0x2a, 0xd4, 0x12, 0x39, 0x92, 0x72, 0xd3, 0xfb, 0xed,
-	0x9d, 0xf6, 0x42, 0x7b, 0x8f, 0x41, 0x14, 0x65, 0x4b, 0x8e, 0x9d, 0x65, 0xdb, 0x95, 0x74, 0xce,
-	0xf9, 0xce, 0xff, 0x47, 0x4a, 0x70, 0x9f, 0xcb, 0xe0, 0x7b, 0x39, 0x16, 0x92, 0x6b, 0xae, 0xc6,
-	0x09, 0x8d, 0x12, 0x1a, 0x8e, 0x8c, 0x84, 0xf6, 0x8c, 0x34, 0x32, 0x00, 0xaf, 0x8e, 0x0b, 0x78,
-	0x92, 0xf0, 0xb4, 0xc0, 0x79, 0x5e, 0x3d, 0x44, 0xc0, 0xd3, 0xb7, 0x71, 0x64, 0x6d, 0xc7, 0x11,
-	0xe7, 0xd1, 0x9c, 0x15, 0xc6, 0x59, 0xf6, 0x76, 0xac, 0xb4, 0xcc, 0x02, 0x5d, 0x58, 0xf1, 0x2b,
-	0x80, 0xcb, 0x38, 0x8d, 0x2e, 0xa9, 0xa4, 0x89, 0x42, 0xc7, 0x00, 0xef, 0xb8, 0xd2, 0x3e, 0x97,
-	0x7e, 0x2c, 0x5c, 0x67, 0xe0, 0x0c, 0x7b, 0xa4, 0x9b, 0x6b, 0x7e, 0x91, 0x2f, 0x05, 0x7a, 0x08,
-	0x7b, 0x69, 0x96, 0xf8, 0x82, 0x06, 0xef, 0x99, 0x56, 0x6e, 0x73, 0xe0, 0x0c, 0xdb, 0x04, 0xd2,
-	0x2c, 0xb9, 0x2c, 0x34, 0x38, 0x83, 0xc3, 0xa9, 0xa4, 0x01, 0x93, 0x3c, 0xd3, 0xec, 0x56, 0x21,
-	0xef, 0x43, 0x37, 0xa1, 0x57, 0xfe, 0x3b, 0x2e, 0xca, 0x78, 0xbb, 0x09, 0xbd, 0x3a, 0xe3, 0x42,
-	0xa1, 0x21, 0x1c, 0xce, 0x3e, 0x6a, 0xa6, 0x7c, 0xc1, 0xa4, 0xcd, 0xe9, 0xb6, 0x0c, 0xe4, 0xc0,
-	0xe8, 0x2f, 0x99, 0x2c, 0xf2, 0xe2, 0xdf, 0x1d, 0x40, 0x3f, 0x33, 0xfd, 0x81, 0xcb, 0xf7, 0x53,
-	0xa6, 0x34, 0x61, 0xbf, 0x65, 0x4c, 0x69, 0xf4, 0x35, 0xb4, 0x45, 0x9c, 0x46, 0xca, 0x75, 0x06,
-	0xad, 0xe1, 0xde, 0x93, 0xcf, 0x46, 0x95, 0x61, 0x8e, 0x56, 0x4d, 0x93, 0x02, 0x85, 0x7e, 0x84,
-	0x3d, 0xbd, 0x2c, 0x3e, 0xaf, 0x26, 0x77, 0x3a, 0xa9, 0x39, 0xad, 0x37, 0x47, 0xaa, 0x1e, 0xf8,
-	0x2f, 0xa7, 0x98, 0x25, 0x61, 0x2a, 0x9b, 0xeb, 0xff, 0x39, 0x4b, 0x74, 0x04, 0x6d, 0x26, 0x25,
-	0x97, 0xa6, 0xe7, 0x1e, 0x29, 0x04, 0x34, 0x86, 0xbb, 0xd6, 0xc5, 0xd7, 0x92, 0xa6, 0x2a, 0x89,
-	0xb5, 0x66, 0xa1, 0xbb, 0x63, 0xdc, 0x91, 0x35, 0x4d, 0x57, 0x16, 0xf4, 0x25, 0x1c, 0x96, 0x0e,
-	0x92, 0x05, 0x2c, 0x5e, 0xb0, 0xd0, 0x6d, 0x1b, 0x74, 0xdf, 0xea, 0x89, 0x55, 0xa3, 0x2f, 0xa0,
-	0x4f, 0x17, 0x91, 0x2f, 0x99, 0x12, 0x3c, 0x55, 0xcc, 0x4f, 0x94, 0xdb, 0x19, 0x38, 0xc3, 0x26,
-	0xd9, 0xa7, 0x8b, 0xbc, 0x29, 0xa3, 0xbd, 0x50, 0x78, 0x0a, 0xfd, 0xca, 0x20, 0x24, 0x9f, 0x31,
-	0xe4, 0x81, 0xe9, 0x2c, 0xa5, 0x09, 0xab, 0x76, 0x9a, 0xcb, 0xe8, 0x00, 0x9a, 0xb1, 0x30, 0x0d,
-	0xf6, 0x48, 0x33, 0x16, 0xe8, 0x53, 0xe8, 0x48, 0xad, 0xf3, 0xe8, 0x2d, 0x13, 0xbd, 0x2d, 0xb5,
-	0xbe, 0x50, 0xf8, 0x57, 0xd8, 0x5f, 0x45, 0x3d, 0xe3, 0x02, 0x1d, 0x42, 0x2b, 0x0e, 0xaf, 0x4c,
-	0xb8, 0x36, 0xc9, 0x5f, 0xd1, 0x33, 0xe8, 0x88, 0x3c, 0x5d, 0xb9, 0x9c, 0xe3, 0x6d, 0xcb, 0xc9,
-	0x41, 0xc4, 0x62, 0xf1, 0xa2, 0x4a, 0x4a, 0xbb, 0x9b, 0xe5, 0x70, 0x9d, 0xea, 0x70, 0xeb, 0x1b,
-	0x6b, 0xae, 0x6d, 0x6c, 0x04, 0x3b, 0x86, 0xa6, 0x2d, 0x93, 0xdb, 0xdb, 0x92, 0xfb, 0x8c, 0x0b,
-	0x62, 0x70, 0xf8, 0x0f, 0x07, 0xee, 0xd6, 0x58, 0x59, 0x0c, 0xf0, 0x9f, 0x69, 0x59, 0xd4, 0xf8,
-	0x9f, 0x68, 0x69, 0x5d, 0x6b, 0xb4, 0xfc, 0x06, 0x8e, 0x4e, 0x99, 0x3e, 0xa5, 0x9a, 0x7d, 0xa0,
-	0x1f, 0x5f, 0x86, 0xcb, 0x3a, 0x4e, 0x00, 0xa2, 0x42, 0xe9, 0xc7, 0xa1, 0x1d, 0x44, 0x2f, 0x2a,
-	0x61, 0xf8, 0x19, 0xdc, 0x23, 0x4c, 0x69, 0x2a, 0xf5, 0x84, 0xc9, 0x45, 0x1c, 0x30, 0x55, 0x9e,
-	0x2b, 0x0f, 0xba, 0xca, 0xaa, 0x4c, 0x0f, 0x3d, 0xb2, 0x94, 0x31, 0xcd, 0x93, 0xa5, 0x4c, 0xc6,
-	0xc1, 0x0b, 0x9e, 0x24, 0x34, 0x0d, 0xed, 0x2d, 0xe0, 0xc2, 0x6e, 0x50, 0x28, 0x6c, 0xa6, 0x52,
-	0x44, 0x63, 0xe8, 0x08, 0x83, 0x31, 0x03, 0xcf, 0xe7, 0x51, 0xdc, 0x57, 0xa3, 0xf2, 0xbe, 0x1a,
-	0x4d, 0xcc, 0x7d, 0x45, 0x2c, 0x0c, 0x5f, 0xc0, 0xbd, 0x7a, 0x8a, 0x65, 0x47, 0x4f, 0xa1, 0x5b,
-	0x92, 0xd7, 0x64, 0xb9, 0x21, 0xd8, 0x12, 0x88, 0xbf, 0x82, 0xfe, 0x94, 0xc6, 0xf3, 0x73, 0x1e,
-	0x2d, 0x1b, 0x74, 0x61, 0xd7, 0x36, 0x54, 0x16, 0x6b, 0x45, 0x7c, 0x02, 0xbb, 0xe7, 0x3c, 0x3a,
-	0x8f, 0x53, 0x86, 0x10, 0xec, 0xcc, 0xe3, 0xb4, 0x44, 0x98, 0xf7, 0x27, 0x7f, 0xb6, 0xa1, 0x73,
-	0x61, 0xee, 0x6f, 0xf4, 0x1d, 0xec, 0x4f, 0xaa, 0xc3, 0x43, 0x9f, 0xd4, 0x56, 0xf6, 0x9a, 0xc7,
-	0xa1, 0x77, 0x5d, 0x85, 0x1b, 0xe8, 0x5b, 0xb8, 0x33, 0xd1, 0x5c, 0xfc, 0x6b, 0xbf, 0xc7, 0xd0,
-	0x21, 0x6c, 0xc6, 0xb9, 0xbe, 0xb5, 0xc7, 0x2b, 0xe8, 0xaf, 0x6d, 0x18, 0x3d, 0xaa, 0xe1, 0x36,
-	0xef, 0x7f, 0x73, 0xb0, 0x1f, 0x00, 0x26, 0x4c, 0xbf, 0x30, 0x1f, 0x1e, 0x85, 0x1e, 0xd4, 0x20,
-	0x96, 0x7b, 0xd6, 0xb8, 0xd5, 0xff, 0x74, 0xe5, 0xbf, 0xa1, 0x85, 0x9b, 0x42, 0xe2, 0x06, 0x7a,
-	0x0d, 0x7d, 0x92, 0xa5, 0x95, 0xf3, 0xa6, 0xd0, 0xc3, 0x9a, 0xc7, 0xf5, 0x0f, 0x84, 0x37, 0xd8,
-	0x0e, 0xb0, 0xe4, 0x68, 0xa0, 0x9f, 0xe0, 0x4e, 0xf5, 0xf4, 0x6c, 0xaa, 0xec, 0xf3, 0x7a, 0x65,
-	0x1b, 0xce, 0x1a, 0x6e, 0xa0, 0x37, 0x70, 0x50, 0x67, 0x2d, 0x5a, 0x77, 0xbb, 0x7e, 0x6a, 0xbc,
-	0x47, 0x37, 0x40, 0x2a, 0xb1, 0x9f, 0x43, 0xb7, 0xa4, 0x30, 0x5a, 0xbb, 0x13, 0xeb, 0xcc, 0xf6,
-	0x8e, 0x6a, 0x56, 0x4b, 0x65, 0xdc, 0x78, 0xec, 0x3c, 0x3f, 0x79, 0xf3, 0xc0, 0x98, 0xc6, 0xc5,
-	0x9f, 0x44, 0x30, 0xe7, 0x59, 0x38, 0x8e, 0xb8, 0xfd, 0xa5, 0x98, 0x75, 0xcc, 0xf3, 0xe9, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x57, 0x26, 0xf0, 0xd6, 0xac, 0x08, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x6f, 0x53, 0x1b, 0xb7,
+	0x13, 0xe6, 0x8c, 0x6d, 0xec, 0x05, 0x63, 0xa2, 0xf0, 0x23, 0xe6, 0x80, 0x5f, 0xa8, 0x32, 0xed,
+	0x90, 0xe9, 0xd4, 0xce, 0x24, 0xe9, 0x9f, 0x57, 0xe9, 0x34, 0x64, 0x02, 0x4c, 0xa0, 0x30, 0x32,
+	0xa1, 0x33, 0x79, 0xe3, 0x11, 0x77, 0xca, 0x71, 0xc3, 0x9d, 0x74, 0x95, 0x74, 0x40, 0x5e, 0xf4,
+	0x5d, 0xfb, 0xf9, 0xfa, 0x29, 0xfa, 0x3d, 0x3a, 0xa7, 0xd3, 0x99, 0x3b, 0x6c, 0x08, 0x6d, 0x5f,
+	0x59, 0xda, 0x7d, 0x76, 0x1f, 0xed, 0x4a, 0xfb, 0xf8, 0x60, 0x55, 0x48, 0xef, 0x07, 0x39, 0x48,
+	0xa4, 0xd0, 0x42, 0x0d, 0x62, 0x1a, 0xc4, 0xd4, 0xef, 0x9b, 0x1d, 0x9a, 0x37, 0xbb, 0xbe, 0x01,
+	0xb8, 0x55, 0x9c, 0x27, 0xe2, 0x58, 0xf0, 0x1c, 0xe7, 0xba, 0xd5, 0x14, 0x9e, 0xe0, 0x1f, 0xc3,
+	0xc0, 0xfa, 0xd6, 0x03, 0x21, 0x82, 0x88, 0xe5, 0xce, 0xd3, 0xf4, 0xe3, 0x40, 0x69, 0x99, 0x7a,
+	0x3a, 0xf7, 0xe2, 0x77, 0x00, 0x47, 0x21, 0x0f, 0x8e, 0xa8, 0xa4, 0xb1, 0x42, 0xeb, 0x00, 0x67,
+	0x42, 0xe9, 0x91, 0x90, 0xa3, 0x30, 0xe9, 0x39, 0x9b, 0xce, 0x56, 0x9b, 0xb4, 0x32, 0xcb, 0xa1,
+	0xdc, 0x4b, 0xd0, 0x63, 0x98, 0xe7, 0x69, 0x3c, 0x4a, 0xa8, 0x77, 0xce, 0xb4, 0xea, 0xd5, 0x36,
+	0x9d, 0xad, 0x06, 0x01, 0x9e, 0xc6, 0x47, 0xb9, 0x05, 0xa7, 0xb0, 0x74, 0x2c, 0xa9, 0xc7, 0xa4,
+	0x48, 0x35, 0xbb, 0x57, 0xca, 0x55, 0x68, 0xc5, 0xf4, 0x6a, 0x74, 0x26, 0x92, 0x22, 0xdf, 0x5c,
+	0x4c, 0xaf, 0x76, 0x45, 0xa2, 0xd0, 0x16, 0x2c, 0x9d, 0x7e, 0xd2, 0x4c, 0x8d, 0x12, 0x26, 0x2d,
+	0x67, 0x6f, 0xd6, 0x40, 0x16, 0x8d, 0xfd, 0x88, 0xc9, 0x9c, 0x17, 0xff, 0xee, 0x00, 0xfa, 0x99,
+	0xe9, 0x4b, 0x21, 0xcf, 0x8f, 0x99, 0xd2, 0x84, 0xfd, 0x9a, 0x32, 0xa5, 0xd1, 0x37, 0xd0, 0x48,
+	0x42, 0x1e, 0xa8, 0x9e, 0xb3, 0x39, 0xbb, 0x35, 0xff, 0xfc, 0x51, 0xbf, 0xd4, 0xcc, 0xfe, 0x75,
+	0xd1, 0x24, 0x47, 0xa1, 0x1f, 0x61, 0x5e, 0x8f, 0x0f, 0x9f, 0x9d, 0x26, 0x0b, 0xda, 0xa8, 0x04,
+	0xdd, 0x2c, 0x8e, 0x94, 0x23, 0xf0, 0x5f, 0x4e, 0xde, 0x4b, 0xc2, 0x54, 0x1a, 0xe9, 0xff, 0xd8,
+	0x4b, 0xb4, 0x0c, 0x0d, 0x26, 0xa5, 0x90, 0xa6, 0xe6, 0x36, 0xc9, 0x37, 0x68, 0x00, 0x0f, 0x6d,
+	0xc8, 0x48, 0x4b, 0xca, 0x55, 0x1c, 0x6a, 0xcd, 0xfc, 0x5e, 0xdd, 0x84, 0x23, 0xeb, 0x3a, 0xbe,
+	0xf6, 0xa0, 0xa7, 0xb0, 0x54, 0x04, 0x48, 0xe6, 0xb1, 0xf0, 0x82, 0xf9, 0xbd, 0x86, 0x41, 0x77,
+	0xad, 0x9d, 0x58, 0x33, 0xfa, 0x0a, 0xba, 0xf4, 0x22, 0x18, 0x49, 0xa6, 0x12, 0xc1, 0x15, 0x1b,
+	0xc5, 0xaa, 0xd7, 0xdc, 0x74, 0xb6, 0x6a, 0xa4, 0x43, 0x2f, 0x02, 0x62, 0xad, 0x07, 0x0a, 0x1f,
+	0x43, 0xb7, 0xd4, 0x08, 0x29, 0x4e, 0x19, 0x72, 0xc1, 0x54, 0xc6, 0x69, 0xcc, 0xca, 0x95, 0x66,
+	0x7b, 0xb4, 0x08, 0xb5, 0x30, 0x31, 0x05, 0xb6, 0x49, 0x2d, 0x4c, 0xd0, 0xff, 0xa0, 0x29, 0xb5,
+	0xce, 0xb2, 0xcf, 0x9a, 0xec, 0x0d, 0xa9, 0xf5, 0x81, 0xc2, 0xbf, 0x40, 0xe7, 0x3a, 0xeb, 0xae,
+	0x48, 0xd0, 0x12, 0xcc, 0x86, 0xfe, 0x95, 0x49, 0xd7, 0x20, 0xd9, 0x12, 0xbd, 0x84, 0x66, 0x92,
+	0xd1, 0x15, 0x97, 0xb3, 0x7e, 0xdb, 0xe5, 0x64, 0x20, 0x62, 0xb1, 0xf8, 0xa2, 0xfc, 0x28, 0xed,
+	0xdd, 0x8c, 0x9b, 0xeb, 0x94, 0x9b, 0x5b, 0xbd, 0xb1, 0xda, 0x8d, 0x1b, 0xeb, 0x43, 0xdd, 0x3c,
+	0xd3, 0x59, 0xc3, 0xed, 0xde, 0xc2, 0xbd, 0x2b, 0x12, 0x62, 0x70, 0xf8, 0x0f, 0x07, 0x1e, 0x56,
+	0x5e, 0x65, 0xde, 0xc0, 0xcf, 0x3f, 0xcb, 0xfc, 0x8c, 0xff, 0xea, 0x59, 0xda, 0xd0, 0xca, 0xb3,
+	0xfc, 0x16, 0x96, 0x77, 0x98, 0xde, 0xa1, 0x9a, 0x5d, 0xd2, 0x4f, 0x7b, 0xfe, 0xf8, 0x1c, 0x1b,
+	0x00, 0x41, 0x6e, 0x1c, 0x85, 0xbe, 0x6d, 0x44, 0x3b, 0x28, 0x60, 0xf8, 0x25, 0xac, 0x10, 0xa6,
+	0x34, 0x95, 0x7a, 0xc8, 0xe4, 0x45, 0xe8, 0x31, 0x55, 0xcc, 0x95, 0x0b, 0x2d, 0x65, 0x4d, 0xa6,
+	0x86, 0x36, 0x19, 0xef, 0x31, 0xcd, 0xc8, 0x38, 0x93, 0xa1, 0xb7, 0x2d, 0xe2, 0x98, 0x72, 0xdf,
+	0xaa, 0x40, 0x0f, 0xe6, 0xbc, 0xdc, 0x60, 0x99, 0x8a, 0x2d, 0x1a, 0x40, 0x33, 0x31, 0x18, 0xd3,
+	0xf0, 0xac, 0x1f, 0xb9, 0x5e, 0xf5, 0x0b, 0xbd, 0xea, 0x0f, 0x8d, 0x5e, 0x11, 0x0b, 0xc3, 0x07,
+	0xb0, 0x52, 0xa5, 0x18, 0x57, 0xf4, 0x02, 0x5a, 0xc5, 0xe3, 0xed, 0x39, 0x77, 0x27, 0x1b, 0x03,
+	0xf1, 0xd7, 0xd0, 0x3d, 0xa6, 0x61, 0xb4, 0x2f, 0x82, 0x71, 0x81, 0x3d, 0x98, 0xb3, 0x05, 0x15,
+	0x87, 0xb5, 0x5b, 0xbc, 0x01, 0x73, 0xfb, 0x22, 0xd8, 0x0f, 0x39, 0x43, 0x08, 0xea, 0x51, 0xc8,
+	0x0b, 0x84, 0x59, 0x63, 0x01, 0x0b, 0xc3, 0x33, 0x16, 0x45, 0x45, 0xa2, 0x0d, 0x00, 0xc5, 0x94,
+	0x0a, 0x05, 0x2f, 0xb5, 0xd8, 0x5a, 0xf6, 0xfc, 0x72, 0x53, 0x6a, 0xd5, 0xa6, 0x20, 0xa8, 0x4b,
+	0x71, 0x99, 0x4f, 0x48, 0x87, 0x98, 0x75, 0x66, 0xf3, 0x44, 0xa4, 0xcc, 0xac, 0x77, 0x88, 0x59,
+	0xe3, 0xdf, 0x00, 0x0c, 0xe1, 0x1e, 0x4f, 0xd2, 0xcf, 0xd2, 0x21, 0xa8, 0xfb, 0x54, 0x53, 0xc3,
+	0xb5, 0x40, 0xcc, 0xfa, 0xbe, 0x44, 0xd9, 0xc0, 0x78, 0x91, 0x50, 0xcc, 0x68, 0x47, 0x8b, 0xe4,
+	0x1b, 0x7c, 0x02, 0xf3, 0x86, 0xfe, 0x30, 0xd5, 0x19, 0x7f, 0x41, 0xe0, 0x94, 0x08, 0x56, 0xa0,
+	0xc9, 0xae, 0xc2, 0x4c, 0xa3, 0x6a, 0x26, 0xd2, 0xee, 0xd0, 0x1a, 0xb4, 0xb3, 0xd5, 0xc8, 0x13,
+	0x3e, 0xb3, 0xb2, 0xde, 0xca, 0x0c, 0xdb, 0xc2, 0x67, 0xf8, 0x3d, 0x3c, 0x78, 0x9f, 0x44, 0x82,
+	0xfa, 0x6f, 0xc3, 0x88, 0x15, 0xcd, 0x44, 0x50, 0x4f, 0xa8, 0x3e, 0x2b, 0x1a, 0x9e, 0xad, 0xf3,
+	0x0e, 0x72, 0xcd, 0xb8, 0xb6, 0x55, 0x15, 0xdb, 0x0c, 0x1d, 0x17, 0xa9, 0x3b, 0xc4, 0xac, 0xf1,
+	0x53, 0x78, 0xf8, 0x46, 0x5c, 0xf2, 0x7b, 0x24, 0xc6, 0x5f, 0x42, 0x3b, 0x83, 0x6c, 0x9f, 0xa5,
+	0xfc, 0xbc, 0xcc, 0xe2, 0x54, 0x58, 0x9e, 0xff, 0x39, 0x07, 0xcd, 0x03, 0xf3, 0x87, 0x8d, 0xbe,
+	0x87, 0xce, 0xb0, 0x3c, 0x2d, 0xe8, 0x41, 0x65, 0x46, 0x4f, 0x44, 0xe8, 0xbb, 0x93, 0x26, 0x3c,
+	0x83, 0xbe, 0x83, 0x85, 0xa1, 0x16, 0xc9, 0x3f, 0x8e, 0x7b, 0x06, 0x4d, 0xc2, 0x4e, 0x85, 0xd0,
+	0xf7, 0x8e, 0x78, 0x07, 0xdd, 0x1b, 0x23, 0x8d, 0x9e, 0x54, 0x70, 0xd3, 0x07, 0x7e, 0x7a, 0xb2,
+	0x57, 0x00, 0x43, 0xa6, 0xb7, 0xcd, 0x97, 0x86, 0x42, 0x6b, 0x15, 0x88, 0x15, 0x1b, 0xeb, 0xbc,
+	0x35, 0x7e, 0xe7, 0x3a, 0x7e, 0x4a, 0x09, 0x77, 0xa5, 0xc4, 0x33, 0xe8, 0x04, 0xba, 0x24, 0xe5,
+	0x25, 0x81, 0x55, 0xe8, 0x71, 0x25, 0x62, 0xf2, 0x8b, 0xc0, 0xdd, 0xbc, 0x1d, 0x60, 0xd5, 0x60,
+	0x06, 0xbd, 0x85, 0x85, 0xb2, 0x5c, 0x4e, 0x3b, 0xd9, 0x17, 0xd5, 0x93, 0x4d, 0x11, 0x57, 0x3c,
+	0x83, 0x3e, 0xc0, 0x62, 0x55, 0xa6, 0xd0, 0xcd, 0xb0, 0x49, 0x99, 0x74, 0x9f, 0xdc, 0x01, 0x29,
+	0xe5, 0x7e, 0x0d, 0xad, 0x42, 0xb3, 0xd0, 0x8d, 0x3f, 0xc1, 0xaa, 0x94, 0xb9, 0xcb, 0x15, 0xaf,
+	0xd5, 0x2e, 0x3c, 0xf3, 0xcc, 0x41, 0x6f, 0xa0, 0x7d, 0x98, 0x30, 0x6e, 0xe6, 0x17, 0xad, 0x56,
+	0x60, 0x65, 0x0d, 0x73, 0x7b, 0x93, 0xae, 0x7c, 0xdc, 0x4d, 0x96, 0x57, 0xb0, 0x38, 0x64, 0xdc,
+	0x2f, 0x89, 0xd0, 0xa3, 0x49, 0xbc, 0x71, 0x4c, 0x7f, 0x05, 0x3f, 0x01, 0x5c, 0x4f, 0x3a, 0xfa,
+	0x7f, 0x05, 0x32, 0x21, 0x01, 0xd3, 0x53, 0xec, 0xc3, 0x42, 0x79, 0xaa, 0x51, 0xf5, 0x92, 0xa7,
+	0x0c, 0xbc, 0xbb, 0x52, 0x41, 0x8c, 0xe7, 0x3c, 0x2b, 0xe8, 0xf5, 0xc6, 0x87, 0x35, 0xe3, 0x1c,
+	0xe4, 0x5f, 0xd4, 0x5e, 0x24, 0x52, 0x7f, 0x10, 0x08, 0xfb, 0x69, 0x7d, 0xda, 0x34, 0xbf, 0x2f,
+	0xfe, 0x1e, 0x00, 0xfd, 0xe6, 0xf4, 0x02, 0xb4, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -808,6 +1162,15 @@ type MagmadClient interface {
 	GenericCommand(ctx context.Context, in *GenericCommandParams, opts ...grpc.CallOption) (*GenericCommandResponse, error)
 	// Get stream of logs
 	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (Magmad_TailLogsClient, error)
+	// Start a remote shell session and get the stream of its output. Since
+	// SyncRPC can't stream requests, input is sent with SendShellInput.
+	OpenShell(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (Magmad_OpenShellClient, error)
+	// Send input to a remote shell session
+	SendShellInput(ctx context.Context, in *ShellInput, opts ...grpc.CallOption) (*Void, error)
+	// Write a file to an allowed path on the gateway
+	UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*Void, error)
+	// Get the content of a file at an allowed path on the gateway
+	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (Magmad_DownloadFileClient, error)
 }
 
 type magmadClient struct {
@@ -931,6 +1294,88 @@ func (x *magmadTailLogsClient) Recv() (*LogLine, error) {
 	return m, nil
 }
 
+func (c *magmadClient) OpenShell(ctx context.Context, in *ShellRequest, opts ...grpc.CallOption) (Magmad_OpenShellClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Magmad_serviceDesc.Streams[1], "/magma.orc8r.Magmad/OpenShell", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &magmadOpenShellClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Magmad_OpenShellClient interface {
+	Recv() (*ShellOutput, error)
+	grpc.ClientStream
+}
+
+type magmadOpenShellClient struct {
+	grpc.ClientStream
+}
+
+func (x *magmadOpenShellClient) Recv() (*ShellOutput, error) {
+	m := new(ShellOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *magmadClient) SendShellInput(ctx context.Context, in *ShellInput, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Magmad/SendShellInput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *magmadClient) UploadFile(ctx context.Context, in *UploadFileRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Magmad/UploadFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *magmadClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (Magmad_DownloadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Magmad_serviceDesc.Streams[2], "/magma.orc8r.Magmad/DownloadFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &magmadDownloadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Magmad_DownloadFileClient interface {
+	Recv() (*FileChunk, error)
+	grpc.ClientStream
+}
+
+type magmadDownloadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *magmadDownloadFileClient) Recv() (*FileChunk, error) {
+	m := new(FileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // MagmadServer is the server API for Magmad service.
 type MagmadServer interface {
 	// Starts all magma services
@@ -953,6 +1398,15 @@ type MagmadServer interface {
 	GenericCommand(context.Context, *GenericCommandParams) (*GenericCommandResponse, error)
 	// Get stream of logs
 	TailLogs(*TailLogsRequest, Magmad_TailLogsServer) error
+	// Start a remote shell session and get the stream of its output. Since
+	// SyncRPC can't stream requests, input is sent with SendShellInput.
+	OpenShell(*ShellRequest, Magmad_OpenShellServer) error
+	// Send input to a remote shell session
+	SendShellInput(context.Context, *ShellInput) (*Void, error)
+	// Write a file to an allowed path on the gateway
+	UploadFile(context.Context, *UploadFileRequest) (*Void, error)
+	// Get the content of a file at an allowed path on the gateway
+	DownloadFile(*DownloadFileRequest, Magmad_DownloadFileServer) error
 }
 
 // UnimplementedMagmadServer can be embedded to have forward compatible implementations.
@@ -989,6 +1443,18 @@ func (*UnimplementedMagmadServer) GenericCommand(ctx context.Context, req *Gener
 func (*UnimplementedMagmadServer) TailLogs(req *TailLogsRequest, srv Magmad_TailLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
 }
+func (*UnimplementedMagmadServer) OpenShell(req *ShellRequest, srv Magmad_OpenShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method OpenShell not implemented")
+}
+func (*UnimplementedMagmadServer) SendShellInput(ctx context.Context, req *ShellInput) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendShellInput not implemented")
+}
+func (*UnimplementedMagmadServer) UploadFile(ctx context.Context, req *UploadFileRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
+}
+func (*UnimplementedMagmadServer) DownloadFile(req *DownloadFileRequest, srv Magmad_DownloadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
+}
 
 func RegisterMagmadServer(s *grpc.Server, srv MagmadServer) {
 	s.RegisterService(&_Magmad_serviceDesc, srv)
@@ -1177,6 +1643,84 @@ func (x *magmadTailLogsServer) Send(m *LogLine) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Magmad_OpenShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ShellRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MagmadServer).OpenShell(m, &magmadOpenShellServer{stream})
+}
+
+type Magmad_OpenShellServer interface {
+	Send(*ShellOutput) error
+	grpc.ServerStream
+}
+
+type magmadOpenShellServer struct {
+	grpc.ServerStream
+}
+
+func (x *magmadOpenShellServer) Send(m *ShellOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Magmad_SendShellInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShellInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MagmadServer).SendShellInput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Magmad/SendShellInput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MagmadServer).SendShellInput(ctx, req.(*ShellInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Magmad_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MagmadServer).UploadFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Magmad/UploadFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MagmadServer).UploadFile(ctx, req.(*UploadFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Magmad_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MagmadServer).DownloadFile(m, &magmadDownloadFileServer{stream})
+}
+
+type Magmad_DownloadFileServer interface {
+	Send(*FileChunk) error
+	grpc.ServerStream
+}
+
+type magmadDownloadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *magmadDownloadFileServer) Send(m *FileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Magmad_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.Magmad",
 	HandlerType: (*MagmadServer)(nil),
@@ -1217,6 +1761,14 @@ var _Magmad_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenericCommand",
 			Handler:    _Magmad_GenericCommand_Handler,
 		},
+		{
+			MethodName: "SendShellInput",
+			Handler:    _Magmad_SendShellInput_Handler,
+		},
+		{
+			MethodName: "UploadFile",
+			Handler:    _Magmad_UploadFile_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1224,6 +1776,16 @@ var _Magmad_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Magmad_TailLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "OpenShell",
+			Handler:       _Magmad_OpenShell_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadFile",
+			Handler:       _Magmad_DownloadFile_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "orc8r/protos/magmad.proto",
 }
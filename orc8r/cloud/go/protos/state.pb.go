@@ -24,6 +24,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type StateChangeEvent_ChangeType int32
+
+const (
+	StateChangeEvent_CREATED StateChangeEvent_ChangeType = 0
+	StateChangeEvent_UPDATED StateChangeEvent_ChangeType = 1
+	// Deleted states include states which have outlived their TTL
+	StateChangeEvent_DELETED StateChangeEvent_ChangeType = 2
+)
+
+var StateChangeEvent_ChangeType_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+
+var StateChangeEvent_ChangeType_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x StateChangeEvent_ChangeType) String() string {
+	return proto.EnumName(StateChangeEvent_ChangeType_name, int32(x))
+}
+
+func (StateChangeEvent_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{11, 0}
+}
+
 type StateID struct {
 	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	DeviceID             string   `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
@@ -462,7 +491,139 @@ func (m *SyncStatesResponse) GetUnsyncedStates() []*IDAndVersion {
 	return nil
 }
 
+type WatchStatesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// State types to watch. All registered state types are watched if empty.
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// Device IDs (state keys) to watch. All states of the watched types are
+	// watched if empty.
+	DeviceIDs            []string `protobuf:"bytes,3,rep,name=deviceIDs,proto3" json:"deviceIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatesRequest) Reset()         { *m = WatchStatesRequest{} }
+func (m *WatchStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchStatesRequest) ProtoMessage()    {}
+func (*WatchStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{10}
+}
+
+func (m *WatchStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatesRequest.Unmarshal(m, b)
+}
+func (m *WatchStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatesRequest.Merge(m, src)
+}
+func (m *WatchStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchStatesRequest.Size(m)
+}
+func (m *WatchStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatesRequest proto.InternalMessageInfo
+
+func (m *WatchStatesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *WatchStatesRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *WatchStatesRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
+	}
+	return nil
+}
+
+type StateChangeEvent struct {
+	Change    StateChangeEvent_ChangeType `protobuf:"varint,1,opt,name=change,proto3,enum=magma.orc8r.StateChangeEvent_ChangeType" json:"change,omitempty"`
+	NetworkID string                      `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Type      string                      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	DeviceID  string                      `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
+	// Version of the state after the change, or the last known version for
+	// deleted states
+	Version              uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateChangeEvent) Reset()         { *m = StateChangeEvent{} }
+func (m *StateChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*StateChangeEvent) ProtoMessage()    {}
+func (*StateChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{11}
+}
+
+func (m *StateChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateChangeEvent.Unmarshal(m, b)
+}
+func (m *StateChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *StateChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChangeEvent.Merge(m, src)
+}
+func (m *StateChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_StateChangeEvent.Size(m)
+}
+func (m *StateChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChangeEvent proto.InternalMessageInfo
+
+func (m *StateChangeEvent) GetChange() StateChangeEvent_ChangeType {
+	if m != nil {
+		return m.Change
+	}
+	return StateChangeEvent_CREATED
+}
+
+func (m *StateChangeEvent) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *StateChangeEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StateChangeEvent) GetDeviceID() string {
+	if m != nil {
+		return m.DeviceID
+	}
+	return ""
+}
+
+func (m *StateChangeEvent) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("magma.orc8r.StateChangeEvent_ChangeType", StateChangeEvent_ChangeType_name, StateChangeEvent_ChangeType_value)
 	proto.RegisterType((*StateID)(nil), "magma.orc8r.StateID")
 	proto.RegisterType((*GetStatesRequest)(nil), "magma.orc8r.GetStatesRequest")
 	proto.RegisterType((*GetStatesResponse)(nil), "magma.orc8r.GetStatesResponse")
@@ -473,42 +634,52 @@ func init() {
 	proto.RegisterType((*SyncStatesRequest)(nil), "magma.orc8r.SyncStatesRequest")
 	proto.RegisterType((*IDAndVersion)(nil), "magma.orc8r.IDAndVersion")
 	proto.RegisterType((*SyncStatesResponse)(nil), "magma.orc8r.SyncStatesResponse")
+	proto.RegisterType((*WatchStatesRequest)(nil), "magma.orc8r.WatchStatesRequest")
+	proto.RegisterType((*StateChangeEvent)(nil), "magma.orc8r.StateChangeEvent")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_645e93724c8b4dfe) }
 
 var fileDescriptor_645e93724c8b4dfe = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x5f, 0xd3, 0xb1, 0xd1, 0xb7, 0x0a, 0xad, 0x6f, 0x95, 0xc8, 0x02, 0x85, 0x62, 0x21, 0x34,
-	0x71, 0x68, 0x61, 0xbd, 0xc0, 0x09, 0x15, 0x32, 0x50, 0x25, 0x04, 0x92, 0x2b, 0x06, 0x62, 0xa7,
-	0x12, 0x3f, 0x4d, 0x11, 0xab, 0x5d, 0x6c, 0x77, 0xa8, 0x9f, 0x91, 0x2f, 0x85, 0xea, 0x64, 0x6d,
-	0x9c, 0xac, 0x93, 0x76, 0xd8, 0xa9, 0x79, 0xfe, 0xfd, 0xe9, 0xcf, 0xcf, 0xcf, 0x86, 0x50, 0xe9,
-	0xe4, 0x8d, 0xee, 0xcf, 0xb4, 0xb2, 0xca, 0xf4, 0x8d, 0x9d, 0x58, 0xea, 0xb9, 0x02, 0xf7, 0xa6,
-	0x93, 0xf3, 0xe9, 0xa4, 0xe7, 0xf0, 0xe8, 0xd0, 0xa3, 0x25, 0x6a, 0x3a, 0x55, 0x32, 0xe3, 0x45,
-	0x1d, 0xdf, 0x81, 0xf4, 0x65, 0x9a, 0xd0, 0xe0, 0xd5, 0x20, 0x83, 0xd9, 0x5b, 0xd8, 0x1d, 0x2f,
-	0x5d, 0x47, 0x31, 0x22, 0x6c, 0xdb, 0xc5, 0x8c, 0xc2, 0x5a, 0xb7, 0x76, 0xd4, 0xe0, 0xee, 0x1b,
-	0x23, 0xb8, 0x2f, 0x68, 0xa9, 0x18, 0xc5, 0x61, 0xe0, 0xd6, 0x57, 0x35, 0xfb, 0x01, 0xfb, 0x9f,
-	0xc8, 0x3a, 0xb5, 0xe1, 0xf4, 0x67, 0x4e, 0xc6, 0xe2, 0x63, 0x68, 0x48, 0xb2, 0x7f, 0x95, 0xfe,
-	0x3d, 0x8a, 0x73, 0xa3, 0xf5, 0x02, 0xbe, 0x80, 0x7a, 0x2a, 0x4c, 0x18, 0x74, 0xeb, 0x47, 0x7b,
-	0xc7, 0xed, 0x5e, 0x61, 0x07, 0xbd, 0x3c, 0x04, 0x5f, 0x12, 0xd8, 0x3b, 0x68, 0x15, 0x9c, 0xcd,
-	0x4c, 0x49, 0x43, 0xf8, 0x12, 0x76, 0xdc, 0xfe, 0x4d, 0x58, 0x73, 0x7a, 0xac, 0xea, 0x79, 0xce,
-	0x60, 0x43, 0x38, 0xe0, 0x34, 0x53, 0xba, 0x94, 0xee, 0x36, 0x16, 0x67, 0xd0, 0xf6, 0x2d, 0xf2,
-	0x18, 0x1f, 0x60, 0x7f, 0x2e, 0xb5, 0x43, 0x48, 0x8c, 0x8b, 0x6e, 0x0f, 0x3d, 0xb7, 0x51, 0x3c,
-	0x94, 0xe2, 0x44, 0x6b, 0xa5, 0x79, 0x45, 0xc0, 0x38, 0xc0, 0x1a, 0xbf, 0x6d, 0xe3, 0xb1, 0x0d,
-	0xf7, 0x68, 0x29, 0x0c, 0xeb, 0x0e, 0xc8, 0x0a, 0x76, 0x06, 0x07, 0x31, 0x5d, 0x90, 0xa5, 0xbb,
-	0x38, 0x91, 0x8f, 0xd0, 0x1a, 0x2f, 0x64, 0xe2, 0x5b, 0xbf, 0x2e, 0xb5, 0xf3, 0xb0, 0xda, 0x80,
-	0x53, 0xd2, 0x26, 0x55, 0x72, 0xd5, 0xd5, 0x2f, 0xd0, 0x2c, 0xae, 0xe3, 0x73, 0x08, 0x52, 0xe1,
-	0x62, 0x6d, 0xfa, 0xfb, 0x20, 0x15, 0x18, 0xc2, 0xee, 0x65, 0x26, 0x70, 0xbd, 0xd8, 0xe6, 0x57,
-	0x25, 0xfb, 0x0e, 0x58, 0xcc, 0x95, 0x9f, 0xd1, 0x10, 0x1e, 0xcc, 0xa5, 0x59, 0xc8, 0xa4, 0x74,
-	0x42, 0x37, 0x04, 0x2c, 0x09, 0x8e, 0xff, 0x05, 0xd0, 0x74, 0x9f, 0xe3, 0xec, 0xc6, 0xe0, 0x67,
-	0x68, 0xac, 0x66, 0x12, 0x3b, 0x9e, 0x51, 0xf9, 0x16, 0x44, 0x4f, 0x36, 0xc1, 0x59, 0x3e, 0xb6,
-	0x85, 0xdf, 0xa0, 0x59, 0x9c, 0x2e, 0xec, 0x7a, 0x8a, 0x6b, 0x66, 0x37, 0x7a, 0x76, 0x03, 0x63,
-	0x65, 0x7b, 0x02, 0xcd, 0xe2, 0x0c, 0x94, 0x6c, 0xaf, 0x19, 0x8f, 0xa8, 0xe5, 0x31, 0x4e, 0x55,
-	0x2a, 0xd8, 0x16, 0x7e, 0x05, 0x58, 0x77, 0x15, 0xfd, 0xdd, 0x54, 0xc6, 0x20, 0x7a, 0xba, 0x11,
-	0xbf, 0xca, 0xf5, 0xbe, 0xf3, 0xf3, 0x91, 0xe3, 0xf4, 0xb3, 0xc7, 0x28, 0xb9, 0x50, 0x73, 0xd1,
-	0x3f, 0x57, 0xf9, 0xab, 0xf4, 0x6b, 0xc7, 0xfd, 0x0e, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x25,
-	0x92, 0x8a, 0xcd, 0xee, 0x04, 0x00, 0x00,
+	// 604 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5d, 0x8f, 0xd2, 0x40,
+	0x14, 0xa5, 0x2d, 0x1f, 0x72, 0x21, 0x1b, 0xb8, 0x4b, 0x62, 0xb7, 0x8a, 0xe2, 0xc4, 0x18, 0xe2,
+	0x03, 0xac, 0xf0, 0xa2, 0x4f, 0x8a, 0xb4, 0x1a, 0x92, 0x8d, 0x1f, 0x65, 0x3f, 0x8c, 0xfb, 0x84,
+	0xed, 0xc8, 0x36, 0x2e, 0x1d, 0x6c, 0x0b, 0x86, 0x3f, 0xe3, 0x7f, 0xf4, 0x1f, 0x98, 0x4e, 0x0b,
+	0x74, 0xda, 0x85, 0x84, 0x87, 0x7d, 0x82, 0x33, 0xf7, 0x9e, 0x33, 0x77, 0xce, 0xbd, 0x33, 0x05,
+	0x95, 0x79, 0xd6, 0x6b, 0xaf, 0x3b, 0xf7, 0x58, 0xc0, 0xfc, 0xae, 0x1f, 0x4c, 0x02, 0xda, 0xe1,
+	0x00, 0x2b, 0xb3, 0xc9, 0x74, 0x36, 0xe9, 0xf0, 0xb8, 0x76, 0x22, 0xa4, 0x59, 0x6c, 0x36, 0x63,
+	0x6e, 0x94, 0xa7, 0x35, 0x45, 0x05, 0xea, 0x2d, 0x1d, 0x8b, 0xf6, 0x4f, 0xfb, 0x51, 0x98, 0xbc,
+	0x81, 0xd2, 0x38, 0x54, 0x1d, 0xe9, 0x88, 0x90, 0x0f, 0x56, 0x73, 0xaa, 0x4a, 0x2d, 0xa9, 0x5d,
+	0x36, 0xf9, 0x7f, 0xd4, 0xe0, 0x81, 0x4d, 0x43, 0xc6, 0x48, 0x57, 0x65, 0xbe, 0xbe, 0xc1, 0xe4,
+	0x1b, 0xd4, 0x3e, 0xd2, 0x80, 0xb3, 0x7d, 0x93, 0xfe, 0x5e, 0x50, 0x3f, 0xc0, 0xc7, 0x50, 0x76,
+	0x69, 0xf0, 0x87, 0x79, 0xbf, 0x46, 0x7a, 0x2c, 0xb4, 0x5d, 0xc0, 0x17, 0xa0, 0x38, 0xb6, 0xaf,
+	0xca, 0x2d, 0xa5, 0x5d, 0xe9, 0x35, 0x3a, 0x89, 0x13, 0x74, 0xe2, 0x22, 0xcc, 0x30, 0x81, 0xbc,
+	0x85, 0x7a, 0x42, 0xd9, 0x9f, 0x33, 0xd7, 0xa7, 0xf8, 0x12, 0x8a, 0xfc, 0xfc, 0xbe, 0x2a, 0x71,
+	0x3e, 0x66, 0xf9, 0x66, 0x9c, 0x41, 0x06, 0x70, 0x6c, 0xd2, 0x39, 0xf3, 0x52, 0xd5, 0x1d, 0x22,
+	0x71, 0x0d, 0x0d, 0x51, 0x22, 0x2e, 0x63, 0x08, 0xb5, 0x85, 0xeb, 0xf1, 0x08, 0xb5, 0xc7, 0x49,
+	0xb5, 0x87, 0x82, 0xda, 0x48, 0x1f, 0xb8, 0xb6, 0xe1, 0x79, 0xcc, 0x33, 0x33, 0x04, 0x62, 0x02,
+	0x6c, 0xe3, 0x87, 0x1a, 0x8f, 0x0d, 0x28, 0xd0, 0x90, 0xa8, 0x2a, 0x3c, 0x10, 0x01, 0x72, 0x0d,
+	0xc7, 0x3a, 0xbd, 0xa5, 0x01, 0xbd, 0x8f, 0x8e, 0x7c, 0x80, 0xfa, 0x78, 0xe5, 0x5a, 0xa2, 0xf4,
+	0xab, 0x94, 0x9d, 0x27, 0x59, 0x03, 0x2e, 0xa9, 0xe7, 0x3b, 0xcc, 0xdd, 0xb8, 0xfa, 0x09, 0xaa,
+	0xc9, 0x75, 0x7c, 0x0e, 0xb2, 0x63, 0xf3, 0xb2, 0x76, 0x6d, 0x2f, 0x3b, 0x36, 0xaa, 0x50, 0x5a,
+	0x46, 0x04, 0xee, 0x45, 0xde, 0x5c, 0x43, 0x72, 0x05, 0x98, 0xac, 0x2b, 0xee, 0xd1, 0x00, 0x8e,
+	0x16, 0xae, 0xbf, 0x72, 0xad, 0x54, 0x87, 0xf6, 0x14, 0x98, 0x22, 0x90, 0x9f, 0x80, 0x57, 0x93,
+	0xc0, 0xba, 0x39, 0xc4, 0xcc, 0x06, 0x14, 0xc2, 0xde, 0x45, 0x76, 0x96, 0xcd, 0x08, 0x84, 0x9c,
+	0x75, 0xe7, 0x7c, 0x55, 0xe1, 0x91, 0xed, 0x02, 0xf9, 0x27, 0x41, 0x8d, 0xef, 0x31, 0xbc, 0x99,
+	0xb8, 0x53, 0x6a, 0x2c, 0xa9, 0x1b, 0xe0, 0x3b, 0x28, 0x5a, 0x1c, 0xf2, 0x3d, 0x8e, 0x7a, 0xed,
+	0xac, 0x33, 0x89, 0xf4, 0x4e, 0xf4, 0xff, 0x7c, 0x35, 0xa7, 0x66, 0xcc, 0x13, 0x0b, 0x95, 0xd3,
+	0x85, 0xae, 0x07, 0x4e, 0xd9, 0x31, 0x70, 0xf9, 0xd4, 0xc0, 0x25, 0xfc, 0x2f, 0x88, 0xfe, 0xf7,
+	0x01, 0xb6, 0xbb, 0x63, 0x05, 0x4a, 0x43, 0xd3, 0x18, 0x9c, 0x1b, 0x7a, 0x2d, 0x17, 0x82, 0x8b,
+	0x2f, 0x3a, 0x07, 0x52, 0x08, 0x74, 0xe3, 0xcc, 0x08, 0x81, 0xdc, 0xfb, 0xab, 0x40, 0x95, 0x1f,
+	0x62, 0x1c, 0xbd, 0x46, 0x78, 0x06, 0xe5, 0xcd, 0x7d, 0xc7, 0xa6, 0x70, 0xd8, 0xf4, 0x0b, 0xa3,
+	0x3d, 0xd9, 0x15, 0x8e, 0x7a, 0x4f, 0x72, 0x78, 0x01, 0xd5, 0xe4, 0xcd, 0xc5, 0x96, 0xc0, 0xb8,
+	0xe3, 0x5d, 0xd0, 0x9e, 0xed, 0xc9, 0xd8, 0xc8, 0x1a, 0x50, 0x4d, 0xde, 0xaf, 0x94, 0xec, 0x1d,
+	0x57, 0x4f, 0xab, 0x0b, 0x19, 0x97, 0xcc, 0xb1, 0x49, 0x0e, 0x3f, 0x03, 0x6c, 0x27, 0x16, 0xc5,
+	0xd3, 0x64, 0xae, 0x98, 0xf6, 0x74, 0x67, 0x7c, 0x53, 0xd7, 0x57, 0xa8, 0x24, 0x26, 0x15, 0x45,
+	0x46, 0x76, 0x86, 0xb5, 0xe6, 0xde, 0x61, 0x22, 0xb9, 0x53, 0xe9, 0x7d, 0xf3, 0xfb, 0x23, 0x9e,
+	0xd3, 0x8d, 0xbe, 0x1d, 0xd6, 0x2d, 0x5b, 0xd8, 0xdd, 0x29, 0x8b, 0x3f, 0x22, 0x3f, 0x8a, 0xfc,
+	0xb7, 0xff, 0x7f, 0x00, 0x17, 0xfd, 0xcc, 0xec, 0x9d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -527,6 +698,10 @@ type StateServiceClient interface {
 	ReportStates(ctx context.Context, in *ReportStatesRequest, opts ...grpc.CallOption) (*ReportStatesResponse, error)
 	DeleteStates(ctx context.Context, in *DeleteStatesRequest, opts ...grpc.CallOption) (*Void, error)
 	SyncStates(ctx context.Context, in *SyncStatesRequest, opts ...grpc.CallOption) (*SyncStatesResponse, error)
+	// WatchStates streams change events for the states of a network,
+	// optionally filtered by state type and device ID, until the client
+	// cancels the call.
+	WatchStates(ctx context.Context, in *WatchStatesRequest, opts ...grpc.CallOption) (StateService_WatchStatesClient, error)
 }
 
 type stateServiceClient struct {
@@ -573,12 +748,48 @@ func (c *stateServiceClient) SyncStates(ctx context.Context, in *SyncStatesReque
 	return out, nil
 }
 
+func (c *stateServiceClient) WatchStates(ctx context.Context, in *WatchStatesRequest, opts ...grpc.CallOption) (StateService_WatchStatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateService_serviceDesc.Streams[0], "/magma.orc8r.StateService/WatchStates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateServiceWatchStatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateService_WatchStatesClient interface {
+	Recv() (*StateChangeEvent, error)
+	grpc.ClientStream
+}
+
+type stateServiceWatchStatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateServiceWatchStatesClient) Recv() (*StateChangeEvent, error) {
+	m := new(StateChangeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 type StateServiceServer interface {
 	GetStates(context.Context, *GetStatesRequest) (*GetStatesResponse, error)
 	ReportStates(context.Context, *ReportStatesRequest) (*ReportStatesResponse, error)
 	DeleteStates(context.Context, *DeleteStatesRequest) (*Void, error)
 	SyncStates(context.Context, *SyncStatesRequest) (*SyncStatesResponse, error)
+	// WatchStates streams change events for the states of a network,
+	// optionally filtered by state type and device ID, until the client
+	// cancels the call.
+	WatchStates(*WatchStatesRequest, StateService_WatchStatesServer) error
 }
 
 // UnimplementedStateServiceServer can be embedded to have forward compatible implementations.
@@ -597,6 +808,9 @@ func (*UnimplementedStateServiceServer) DeleteStates(ctx context.Context, req *D
 func (*UnimplementedStateServiceServer) SyncStates(ctx context.Context, req *SyncStatesRequest) (*SyncStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncStates not implemented")
 }
+func (*UnimplementedStateServiceServer) WatchStates(req *WatchStatesRequest, srv StateService_WatchStatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStates not implemented")
+}
 
 func RegisterStateServiceServer(s *grpc.Server, srv StateServiceServer) {
 	s.RegisterService(&_StateService_serviceDesc, srv)
@@ -674,6 +888,27 @@ func _StateService_SyncStates_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_WatchStates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServiceServer).WatchStates(m, &stateServiceWatchStatesServer{stream})
+}
+
+type StateService_WatchStatesServer interface {
+	Send(*StateChangeEvent) error
+	grpc.ServerStream
+}
+
+type stateServiceWatchStatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateServiceWatchStatesServer) Send(m *StateChangeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _StateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.StateService",
 	HandlerType: (*StateServiceServer)(nil),
@@ -695,6 +930,12 @@ var _StateService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _StateService_SyncStates_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStates",
+			Handler:       _StateService_WatchStates_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "orc8r/protos/state.proto",
 }
@@ -40,7 +40,9 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 //   all the keys (the batch is guaranteed to contain only unique keys).
 // - If resync is false, then the gateway can update the keys, or add new
 //   ones if the key is not already present.
-// - Key deletions are not yet supported (#15109350)
+// - Gateways which send a StreamDigest in extra_args receive incremental
+//   batches (resync false) relative to the digest they hold. Keys removed
+//   in the cloud are sent as updates with deleted set.
 // --------------------------------------------------------------------------
 type StreamRequest struct {
 	GatewayId string `protobuf:"bytes,1,opt,name=gatewayId,proto3" json:"gatewayId,omitempty"`
@@ -104,8 +106,10 @@ type DataUpdate struct {
 	// Unique key for each item
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// value can be file contents, protobuf serialized message, etc.
-	// For key deletions, the value field would be absent.
-	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// If deleted is true, the key was removed and value is absent. Only set
+	// in incremental batches.
+	Deleted              bool     `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -150,11 +154,21 @@ func (m *DataUpdate) GetValue() []byte {
 	return nil
 }
 
+func (m *DataUpdate) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type DataUpdateBatch struct {
 	Updates []*DataUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 	// If resync is true, the updates would be a snapshot of all the
 	// contents in the cloud.
-	Resync               bool     `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	Resync bool `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	// Digest of the full stream contents after this batch is applied. Only
+	// set for requests which carry a StreamDigest.
+	Digest               string   `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -199,36 +213,110 @@ func (m *DataUpdateBatch) GetResync() bool {
 	return false
 }
 
+func (m *DataUpdateBatch) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+// StreamDigest can be sent as the extra_args of a StreamRequest by gateways
+// which support incremental updates.
+type StreamDigest struct {
+	// Digest of the stream contents held by the gateway, as received in the
+	// last DataUpdateBatch it applied. Empty if the gateway holds nothing.
+	Digest string `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// If keep_open is true, the stream is not closed after the first batch
+	// and new batches are pushed as the stream contents change.
+	KeepOpen bool `protobuf:"varint,2,opt,name=keep_open,json=keepOpen,proto3" json:"keep_open,omitempty"`
+	// Extra args passed to the stream provider, in place of the extra_args
+	// of the StreamRequest which carries this StreamDigest.
+	ExtraArgs            *any.Any `protobuf:"bytes,3,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamDigest) Reset()         { *m = StreamDigest{} }
+func (m *StreamDigest) String() string { return proto.CompactTextString(m) }
+func (*StreamDigest) ProtoMessage()    {}
+func (*StreamDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acdce76608ae0d01, []int{3}
+}
+
+func (m *StreamDigest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamDigest.Unmarshal(m, b)
+}
+func (m *StreamDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamDigest.Marshal(b, m, deterministic)
+}
+func (m *StreamDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamDigest.Merge(m, src)
+}
+func (m *StreamDigest) XXX_Size() int {
+	return xxx_messageInfo_StreamDigest.Size(m)
+}
+func (m *StreamDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamDigest proto.InternalMessageInfo
+
+func (m *StreamDigest) GetDigest() string {
+	if m != nil {
+		return m.Digest
+	}
+	return ""
+}
+
+func (m *StreamDigest) GetKeepOpen() bool {
+	if m != nil {
+		return m.KeepOpen
+	}
+	return false
+}
+
+func (m *StreamDigest) GetExtraArgs() *any.Any {
+	if m != nil {
+		return m.ExtraArgs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StreamRequest)(nil), "magma.orc8r.StreamRequest")
 	proto.RegisterType((*DataUpdate)(nil), "magma.orc8r.DataUpdate")
 	proto.RegisterType((*DataUpdateBatch)(nil), "magma.orc8r.DataUpdateBatch")
+	proto.RegisterType((*StreamDigest)(nil), "magma.orc8r.StreamDigest")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/streamer.proto", fileDescriptor_acdce76608ae0d01) }
 
 var fileDescriptor_acdce76608ae0d01 = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4f, 0xf2, 0x40,
-	0x10, 0xc5, 0xbf, 0x7e, 0x44, 0x84, 0xa9, 0x46, 0xb3, 0x21, 0x5a, 0x01, 0x23, 0xe9, 0x89, 0xd3,
-	0x56, 0xc1, 0x83, 0x57, 0x88, 0x89, 0xd1, 0x83, 0x87, 0x25, 0x78, 0x30, 0x26, 0x64, 0x28, 0xe3,
-	0x9a, 0x48, 0xbb, 0xb8, 0xbb, 0x55, 0x7b, 0xf6, 0x1f, 0x37, 0xee, 0x96, 0x20, 0x07, 0x4f, 0xed,
-	0x9b, 0xfd, 0xcd, 0xbc, 0xc9, 0x1b, 0xe8, 0x28, 0x9d, 0x5e, 0xe9, 0x64, 0xa5, 0x95, 0x55, 0x26,
-	0x31, 0x56, 0x13, 0x66, 0xa4, 0xb9, 0xd3, 0x2c, 0xcc, 0x50, 0x66, 0xc8, 0x1d, 0xd2, 0x3e, 0x91,
-	0x4a, 0xc9, 0x25, 0x79, 0x74, 0x5e, 0x3c, 0x27, 0x98, 0x97, 0x9e, 0x8b, 0xbf, 0x02, 0xd8, 0x9f,
-	0xb8, 0x56, 0x41, 0x6f, 0x05, 0x19, 0xcb, 0xba, 0xd0, 0x94, 0x68, 0xe9, 0x03, 0xcb, 0xdb, 0x45,
-	0x14, 0xf4, 0x82, 0x7e, 0x53, 0x6c, 0x0a, 0xec, 0x0c, 0x42, 0xef, 0x34, 0xcb, 0x31, 0xa3, 0xe8,
-	0xbf, 0x7b, 0x07, 0x5f, 0xba, 0xc7, 0x8c, 0xd8, 0x10, 0x80, 0x3e, 0xad, 0xc6, 0x19, 0x6a, 0x69,
-	0xa2, 0x5a, 0x2f, 0xe8, 0x87, 0x83, 0x16, 0xf7, 0x0b, 0xf0, 0xf5, 0x02, 0x7c, 0x94, 0x97, 0xa2,
-	0xe9, 0xb8, 0x91, 0x96, 0x26, 0xbe, 0x04, 0xb8, 0x46, 0x8b, 0xd3, 0xd5, 0x02, 0x2d, 0xb1, 0x43,
-	0xa8, 0xbd, 0x52, 0x59, 0x79, 0xff, 0xfc, 0xb2, 0x16, 0xec, 0xbc, 0xe3, 0xb2, 0xf0, 0x7e, 0x7b,
-	0xc2, 0x8b, 0xf8, 0x09, 0x0e, 0x36, 0x5d, 0x63, 0xb4, 0xe9, 0x0b, 0xbb, 0x80, 0xdd, 0xc2, 0x49,
-	0x13, 0x05, 0xbd, 0x5a, 0x3f, 0x1c, 0x1c, 0xf3, 0x5f, 0x41, 0xf0, 0x0d, 0x2e, 0xd6, 0x1c, 0x3b,
-	0x82, 0xba, 0x26, 0x53, 0xe6, 0xa9, 0x1b, 0xde, 0x10, 0x95, 0x1a, 0x3c, 0x40, 0x63, 0x52, 0x65,
-	0xca, 0xee, 0x00, 0x6e, 0xc8, 0x4e, 0xab, 0x8e, 0xf6, 0xd6, 0xcc, 0xad, 0xf4, 0xda, 0xdd, 0x3f,
-	0xfc, 0xdc, 0x7a, 0xf1, 0xbf, 0xf3, 0x60, 0x7c, 0xfa, 0xd8, 0x71, 0x48, 0xe2, 0xcf, 0x97, 0x2e,
-	0x55, 0xb1, 0x48, 0xa4, 0xaa, 0xee, 0x38, 0xaf, 0xbb, 0xef, 0xf0, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0x62, 0xfb, 0xc6, 0xde, 0x01, 0x00, 0x00,
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6f, 0xda, 0x30,
+	0x18, 0xc5, 0x97, 0xa1, 0x41, 0xf2, 0x85, 0x69, 0x93, 0x85, 0xb6, 0x0c, 0x98, 0x86, 0x72, 0xe2,
+	0x94, 0x6c, 0x70, 0xd9, 0x15, 0x84, 0x34, 0xad, 0x07, 0x2a, 0x19, 0xd1, 0x43, 0x2f, 0xc8, 0x24,
+	0x5f, 0xdd, 0x8a, 0x24, 0x4e, 0x6d, 0xa7, 0x25, 0xe7, 0xfe, 0xe3, 0x15, 0x76, 0x10, 0x70, 0xe8,
+	0xa5, 0xa7, 0xe4, 0xbd, 0xfc, 0xe2, 0x67, 0x3f, 0x7f, 0x30, 0x10, 0x32, 0xf9, 0x2b, 0xe3, 0x52,
+	0x0a, 0x2d, 0x54, 0xac, 0xb4, 0x44, 0x96, 0xa3, 0x8c, 0x8c, 0x26, 0x7e, 0xce, 0x78, 0xce, 0x22,
+	0x83, 0xf4, 0x7f, 0x70, 0x21, 0x78, 0x86, 0x16, 0xdd, 0x56, 0x77, 0x31, 0x2b, 0x6a, 0xcb, 0x85,
+	0x2f, 0x0e, 0x7c, 0x5e, 0x99, 0x5f, 0x29, 0x3e, 0x56, 0xa8, 0x34, 0x19, 0x82, 0xc7, 0x99, 0xc6,
+	0x67, 0x56, 0xff, 0x4f, 0x03, 0x67, 0xe4, 0x8c, 0x3d, 0x7a, 0x32, 0xc8, 0x2f, 0xf0, 0x6d, 0xd2,
+	0xa6, 0x60, 0x39, 0x06, 0x1f, 0xcd, 0x77, 0xb0, 0xd6, 0x92, 0xe5, 0x48, 0xa6, 0x00, 0xb8, 0xd7,
+	0x92, 0x6d, 0x98, 0xe4, 0x2a, 0x68, 0x8d, 0x9c, 0xb1, 0x3f, 0xe9, 0x45, 0x76, 0x03, 0xd1, 0x71,
+	0x03, 0xd1, 0xac, 0xa8, 0xa9, 0x67, 0xb8, 0x99, 0xe4, 0x2a, 0x5c, 0x02, 0x2c, 0x98, 0x66, 0xeb,
+	0x32, 0x65, 0x1a, 0xc9, 0x57, 0x68, 0xed, 0xb0, 0x6e, 0xb2, 0x0f, 0xaf, 0xa4, 0x07, 0x9f, 0x9e,
+	0x58, 0x56, 0xd9, 0xbc, 0x2e, 0xb5, 0x82, 0x04, 0xd0, 0x49, 0x31, 0x43, 0x8d, 0xa9, 0xc9, 0x71,
+	0xe9, 0x51, 0x86, 0x1a, 0xbe, 0x9c, 0xd6, 0x9b, 0x33, 0x9d, 0xdc, 0x93, 0x3f, 0xd0, 0xa9, 0x8c,
+	0x54, 0x81, 0x33, 0x6a, 0x8d, 0xfd, 0xc9, 0xf7, 0xe8, 0xac, 0xa2, 0xe8, 0x84, 0xd3, 0x23, 0x47,
+	0xbe, 0x41, 0x5b, 0xa2, 0xaa, 0x8b, 0xc4, 0xc4, 0xba, 0xb4, 0x51, 0x07, 0x3f, 0x7d, 0xe0, 0xa8,
+	0xb4, 0x89, 0xf5, 0x68, 0xa3, 0xc2, 0x3d, 0x74, 0x6d, 0x95, 0x0b, 0xa3, 0xcf, 0x38, 0xe7, 0x9c,
+	0x23, 0x03, 0xf0, 0x76, 0x88, 0xe5, 0x46, 0x94, 0x58, 0x34, 0x4b, 0xbb, 0x07, 0xe3, 0xba, 0xc4,
+	0xe2, 0x5d, 0xfd, 0x4d, 0x6e, 0xc0, 0x5d, 0x35, 0xf7, 0x4f, 0xae, 0x00, 0xfe, 0xa1, 0x5e, 0x37,
+	0x67, 0xe8, 0x5f, 0x9c, 0xf2, 0xe2, 0xa6, 0xfb, 0xc3, 0x37, 0x1a, 0x30, 0x85, 0x85, 0x1f, 0x7e,
+	0x3b, 0xf3, 0x9f, 0xb7, 0x03, 0x83, 0xc4, 0x76, 0xd4, 0x92, 0x4c, 0x54, 0x69, 0xcc, 0x45, 0x33,
+	0x73, 0xdb, 0xb6, 0x79, 0x4e, 0x5f, 0x07, 0x00, 0x65, 0x84, 0xdd, 0x0d, 0x8a, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -12,7 +12,9 @@ package cert
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"strings"
 )
@@ -36,6 +38,29 @@ func LoadCertAndPrivKey(certFile, keyFile string) (
 	return
 }
 
+// load and parse the first PEM encoded certificate in certFile
+func LoadCert(certFile string) (*x509.Certificate, error) {
+	certPEM, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to load certificate (%s): %s", certFile, err)
+	}
+	for {
+		var block *pem.Block
+		block, certPEM = pem.Decode(certPEM)
+		if block == nil {
+			return nil, fmt.Errorf("Failed to find certificate PEM block in %s", certFile)
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse cert (%s): %s", certFile, err)
+		}
+		return cert, nil
+	}
+}
+
 // SerialToString converts big.Int to hexadecimal string with uppercace letters
 // (A,B,C,D,E,F), without base prefix ("0x") and without leading zeros
 func SerialToString(certSerialNumber *big.Int) string {
@@ -74,6 +74,7 @@ func WriteKey(keyFile string, priv interface{}) error {
 
 // read and parse private key from 'keyFile', return the 'priv' key
 // in the form of either *rsa.PrivateKey or *ecdsa.PrivateKey
+// (or any key type supported by PKCS #8 for "PRIVATE KEY" blocks)
 func ReadKey(keyFile string) (priv interface{}, err error) {
 	byteKey, err := ioutil.ReadFile(keyFile)
 	if err != nil {
@@ -90,6 +91,8 @@ func ReadKey(keyFile string) (priv interface{}, err error) {
 		priv, err = x509.ParsePKCS1PrivateKey(pemKey.Bytes)
 	case "EC PRIVATE KEY":
 		priv, err = x509.ParseECPrivateKey(pemKey.Bytes)
+	case "PRIVATE KEY":
+		priv, err = x509.ParsePKCS8PrivateKey(pemKey.Bytes)
 	default:
 		err = fmt.Errorf("Key type %s is not supported.", pemKey.Type)
 		priv = nil
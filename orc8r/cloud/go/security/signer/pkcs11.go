@@ -0,0 +1,339 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+// PKCS11Scheme is the scheme of RFC 7512 PKCS#11 key URIs
+const PKCS11Scheme = "pkcs11"
+
+var (
+	// PKCS#11 modules can only be initialized once per process, so their
+	// contexts are shared by all signers
+	modulesLock sync.Mutex
+	modules     = map[string]*pkcs11.Ctx{}
+
+	// DER encoded DigestInfo prefixes of PKCS #1 v1.5 signatures (RFC 8017
+	// section 9.2), which CKM_RSA_PKCS expects to be prepended to the digest
+	rsaHashPrefixes = map[crypto.Hash][]byte{
+		crypto.SHA1:   {0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
+		crypto.SHA224: {0x30, 0x2d, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x04, 0x05, 0x00, 0x04, 0x1c},
+		crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+		crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+		crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+	}
+
+	pssHashMechanisms = map[crypto.Hash][2]uint{
+		crypto.SHA1:   {pkcs11.CKM_SHA_1, pkcs11.CKG_MGF1_SHA1},
+		crypto.SHA224: {pkcs11.CKM_SHA224, pkcs11.CKG_MGF1_SHA224},
+		crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+		crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+		crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+	}
+)
+
+// PKCS11Signer signs with an RSA or ECDSA private key held in a PKCS#11
+// token, without the key ever leaving the token
+type PKCS11Signer struct {
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+	pub     crypto.PublicKey
+
+	// PKCS#11 sessions can only run one operation at a time
+	lock sync.Mutex
+}
+
+// PKCS11URI holds the attributes of a PKCS#11 key URI used to find the
+// private key
+type PKCS11URI struct {
+	// Path attributes
+	Token  string
+	Object string
+	ID     []byte
+	SlotID *uint
+
+	// Query attributes
+	ModulePath string
+	PIN        string
+}
+
+// ParsePKCS11URI parses an RFC 7512 PKCS#11 URI of the form
+// pkcs11:token=<label>;object=<label>;id=<pct-encoded id>?module-path=<path>&pin-value=<pin>
+// The PIN can also be read from a file with pin-source=<path>.
+func ParsePKCS11URI(keyURI string) (*PKCS11URI, error) {
+	if !strings.HasPrefix(keyURI, PKCS11Scheme+":") {
+		return nil, fmt.Errorf("%s is not a PKCS#11 URI", redact(keyURI))
+	}
+	path := strings.TrimPrefix(keyURI, PKCS11Scheme+":")
+	query := ""
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, query = path[:i], path[i+1:]
+	}
+
+	ret := &PKCS11URI{}
+	err := parsePKCS11Attributes(path, ";", func(name, value string) error {
+		switch name {
+		case "token":
+			ret.Token = value
+		case "object":
+			ret.Object = value
+		case "id":
+			ret.ID = []byte(value)
+		case "slot-id":
+			slotID, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid slot-id %s", value)
+			}
+			id := uint(slotID)
+			ret.SlotID = &id
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = parsePKCS11Attributes(query, "&", func(name, value string) error {
+		switch name {
+		case "module-path":
+			ret.ModulePath = value
+		case "pin-value":
+			ret.PIN = value
+		case "pin-source":
+			pin, err := ioutil.ReadFile(strings.TrimPrefix(value, "file:"))
+			if err != nil {
+				return fmt.Errorf("failed to read PIN: %s", err)
+			}
+			ret.PIN = strings.TrimSpace(string(pin))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ret.ModulePath == "" {
+		return nil, fmt.Errorf("module-path must be set in PKCS#11 URI %s", redact(keyURI))
+	}
+	if ret.Object == "" && len(ret.ID) == 0 {
+		return nil, fmt.Errorf("object or id must be set in PKCS#11 URI %s", redact(keyURI))
+	}
+	return ret, nil
+}
+
+func parsePKCS11Attributes(attrs string, sep string, handle func(name, value string) error) error {
+	for _, attr := range strings.Split(attrs, sep) {
+		if attr == "" {
+			continue
+		}
+		nameValue := strings.SplitN(attr, "=", 2)
+		if len(nameValue) != 2 {
+			return fmt.Errorf("invalid PKCS#11 URI attribute %s", attr)
+		}
+		value, err := url.PathUnescape(nameValue[1])
+		if err != nil {
+			return fmt.Errorf("invalid PKCS#11 URI attribute %s: %s", nameValue[0], err)
+		}
+		if err = handle(nameValue[0], value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewPKCS11Signer logs into the token and finds the private key identified
+// by the PKCS#11 URI keyURI, whose public key is pub
+func NewPKCS11Signer(keyURI string, pub crypto.PublicKey) (crypto.Signer, error) {
+	switch pub.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", pub)
+	}
+	uri, err := ParsePKCS11URI(keyURI)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := getModule(uri.ModulePath)
+	if err != nil {
+		return nil, err
+	}
+	slotID, err := findSlot(ctx, uri)
+	if err != nil {
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slotID, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session: %s", err)
+	}
+	// Logins are shared by all sessions with the token
+	err = ctx.Login(session, pkcs11.CKU_USER, uri.PIN)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		ctx.CloseSession(session)
+		return nil, fmt.Errorf("failed to log into PKCS#11 token: %s", err)
+	}
+	key, err := findPrivateKey(ctx, session, uri)
+	if err != nil {
+		ctx.CloseSession(session)
+		return nil, err
+	}
+	return &PKCS11Signer{ctx: ctx, session: session, key: key, pub: pub}, nil
+}
+
+func getModule(modulePath string) (*pkcs11.Ctx, error) {
+	modulesLock.Lock()
+	defer modulesLock.Unlock()
+	if ctx, ok := modules[modulePath]; ok {
+		return ctx, nil
+	}
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", modulePath)
+	}
+	err := ctx.Initialize()
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		ctx.Destroy()
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module %s: %s", modulePath, err)
+	}
+	modules[modulePath] = ctx
+	return ctx, nil
+}
+
+func findSlot(ctx *pkcs11.Ctx, uri *PKCS11URI) (uint, error) {
+	if uri.SlotID != nil {
+		return *uri.SlotID, nil
+	}
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %s", err)
+	}
+	for _, slot := range slots {
+		tokenInfo, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get PKCS#11 token info of slot %d: %s", slot, err)
+		}
+		if uri.Token == "" || strings.TrimRight(tokenInfo.Label, " ") == uri.Token {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("PKCS#11 token %s not found", uri.Token)
+}
+
+func findPrivateKey(ctx *pkcs11.Ctx, session pkcs11.SessionHandle, uri *PKCS11URI) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY)}
+	if uri.Object != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, uri.Object))
+	}
+	if len(uri.ID) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, uri.ID))
+	}
+	if err := ctx.FindObjectsInit(session, template); err != nil {
+		return 0, fmt.Errorf("failed to find PKCS#11 private key: %s", err)
+	}
+	keys, _, err := ctx.FindObjects(session, 2)
+	ctx.FindObjectsFinal(session)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find PKCS#11 private key: %s", err)
+	}
+	switch len(keys) {
+	case 0:
+		return 0, fmt.Errorf("PKCS#11 private key %s not found", uri.Object)
+	case 1:
+		return keys[0], nil
+	default:
+		return 0, fmt.Errorf("PKCS#11 private key %s is ambiguous", uri.Object)
+	}
+}
+
+// Public returns the public key of the key pair
+func (s *PKCS11Signer) Public() crypto.PublicKey {
+	return s.pub
+}
+
+// Sign signs digest with the private key in the token. RSA keys sign with
+// PSS if opts is *rsa.PSSOptions and PKCS #1 v1.5 otherwise, ECDSA keys
+// return ASN.1 encoded signatures like ecdsa.PrivateKey.
+func (s *PKCS11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var mechanism *pkcs11.Mechanism
+	data := digest
+	switch pub := s.pub.(type) {
+	case *rsa.PublicKey:
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			params, ok := pssHashMechanisms[opts.HashFunc()]
+			if !ok {
+				return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+			}
+			saltLength := pssOpts.SaltLength
+			switch saltLength {
+			case rsa.PSSSaltLengthAuto:
+				saltLength = (pub.N.BitLen()+7)/8 - 2 - opts.HashFunc().Size()
+			case rsa.PSSSaltLengthEqualsHash:
+				saltLength = opts.HashFunc().Size()
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(params[0], params[1], uint(saltLength)))
+		} else {
+			prefix, ok := rsaHashPrefixes[opts.HashFunc()]
+			if !ok {
+				return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+			}
+			data = append(append([]byte{}, prefix...), digest...)
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+		}
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 signing: %s", err)
+	}
+	signature, err := s.ctx.Sign(s.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with PKCS#11 key: %s", err)
+	}
+	if _, ok := s.pub.(*ecdsa.PublicKey); ok {
+		return marshalECDSASignature(signature)
+	}
+	return signature, nil
+}
+
+// Close ends the signer's session with the token
+func (s *PKCS11Signer) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.ctx.CloseSession(s.session)
+}
+
+// marshalECDSASignature converts the concatenated r and s of a CKM_ECDSA
+// signature to the ASN.1 encoding used in certificates
+func marshalECDSASignature(signature []byte) ([]byte, error) {
+	if len(signature) == 0 || len(signature)%2 != 0 {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d", len(signature))
+	}
+	half := len(signature) / 2
+	return asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(signature[:half]),
+		S: new(big.Int).SetBytes(signature[half:]),
+	})
+}
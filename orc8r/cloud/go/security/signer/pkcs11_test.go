@@ -0,0 +1,196 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/security/signer"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testTokenLabel = "magma test"
+	testSOPIN      = "0000"
+	testUserPIN    = "1234"
+)
+
+var softHSMModulePaths = []string{
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// TestPKCS11Signer runs against SoftHSM, whose module path can be set with
+// SOFTHSM2_MODULE. The test is skipped if SoftHSM isn't installed.
+func TestPKCS11Signer(t *testing.T) {
+	modulePath := getSoftHSMModulePath(t)
+	dir, err := ioutil.TempDir("", "signer_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	tokenDir := filepath.Join(dir, "tokens")
+	assert.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	err = ioutil.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\n", tokenDir)), 0600)
+	assert.NoError(t, err)
+	os.Setenv("SOFTHSM2_CONF", conf)
+	defer os.Unsetenv("SOFTHSM2_CONF")
+
+	rsaPub, ecPub := initTestToken(t, modulePath)
+	keyURI := func(object, pin string) string {
+		return fmt.Sprintf("pkcs11:token=%s;object=%s?module-path=%s&pin-value=%s",
+			strings.Replace(testTokenLabel, " ", "%20", -1), object, modulePath, pin)
+	}
+
+	_, err = signer.New(keyURI("rsa", "4321"), rsaPub)
+	assert.Error(t, err)
+
+	rsaSigner, err := signer.New(keyURI("rsa", testUserPIN), rsaPub)
+	assert.NoError(t, err)
+	checkSignsCertificate(t, rsaSigner)
+	digest := sha256.Sum256([]byte("foo"))
+	pssOpts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+	signature, err := rsaSigner.Sign(rand.Reader, digest[:], pssOpts)
+	assert.NoError(t, err)
+	assert.NoError(t, rsa.VerifyPSS(rsaPub, crypto.SHA256, digest[:], signature, pssOpts))
+
+	ecSigner, err := signer.New(keyURI("ec", testUserPIN), ecPub)
+	assert.NoError(t, err)
+	checkSignsCertificate(t, ecSigner)
+
+	// mismatched and missing keys
+	_, err = signer.New(keyURI("rsa", testUserPIN), ecPub)
+	assert.Error(t, err)
+	_, err = signer.New(keyURI("missing", testUserPIN), ecPub)
+	assert.EqualError(t, err, "PKCS#11 private key missing not found")
+
+	assert.NoError(t, rsaSigner.(*signer.PKCS11Signer).Close())
+	assert.NoError(t, ecSigner.(*signer.PKCS11Signer).Close())
+}
+
+func getSoftHSMModulePath(t *testing.T) string {
+	if modulePath := os.Getenv("SOFTHSM2_MODULE"); modulePath != "" {
+		return modulePath
+	}
+	for _, modulePath := range softHSMModulePaths {
+		if _, err := os.Stat(modulePath); err == nil {
+			return modulePath
+		}
+	}
+	t.Skip("SoftHSM is not installed")
+	return ""
+}
+
+// initTestToken initializes a token with an RSA key pair labeled rsa and a
+// P-256 key pair labeled ec, and returns their public keys
+func initTestToken(t *testing.T, modulePath string) (*rsa.PublicKey, *ecdsa.PublicKey) {
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		t.Fatalf("Failed to load %s", modulePath)
+	}
+	err := ctx.Initialize()
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		t.Fatalf("Failed to initialize %s: %s", modulePath, err)
+	}
+	slots, err := ctx.GetSlotList(true)
+	assert.NoError(t, err)
+	assert.NoError(t, ctx.InitToken(slots[len(slots)-1], testSOPIN, testTokenLabel))
+
+	// SoftHSM moves initialized tokens to a new slot
+	slots, err = ctx.GetSlotList(true)
+	assert.NoError(t, err)
+	var slot uint
+	for _, s := range slots {
+		tokenInfo, err := ctx.GetTokenInfo(s)
+		assert.NoError(t, err)
+		if strings.TrimRight(tokenInfo.Label, " ") == testTokenLabel {
+			slot = s
+		}
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	assert.NoError(t, err)
+	defer ctx.CloseSession(session)
+	assert.NoError(t, ctx.Login(session, pkcs11.CKU_SO, testSOPIN))
+	assert.NoError(t, ctx.InitPIN(session, testUserPIN))
+	assert.NoError(t, ctx.Logout(session))
+	assert.NoError(t, ctx.Login(session, pkcs11.CKU_USER, testUserPIN))
+	defer ctx.Logout(session)
+
+	rsaPubHandle, _, err := ctx.GenerateKeyPair(
+		session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, "rsa"),
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, 2048),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, []byte{1, 0, 1}),
+		},
+		privateKeyTemplate("rsa"),
+	)
+	assert.NoError(t, err)
+	attrs, err := ctx.GetAttributeValue(session, rsaPubHandle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+	})
+	assert.NoError(t, err)
+	rsaPub := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(attrs[0].Value),
+		E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+	}
+
+	p256OID, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	assert.NoError(t, err)
+	ecPubHandle, _, err := ctx.GenerateKeyPair(
+		session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, "ec"),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, p256OID),
+		},
+		privateKeyTemplate("ec"),
+	)
+	assert.NoError(t, err)
+	attrs, err = ctx.GetAttributeValue(session, ecPubHandle, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	assert.NoError(t, err)
+	var point []byte
+	_, err = asn1.Unmarshal(attrs[0].Value, &point)
+	assert.NoError(t, err)
+	x, y := elliptic.Unmarshal(elliptic.P256(), point)
+	return rsaPub, &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+}
+
+func privateKeyTemplate(label string) []*pkcs11.Attribute {
+	return []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package signer provides crypto.Signer implementations for private keys held
+// in process memory, in a PKCS#11 token (e.g. an HSM or SoftHSM) or by any
+// other backend registered with RegisterBackend, such as an external KMS.
+//
+// Keys are identified by URIs whose scheme selects the backend, e.g.
+// pkcs11:token=magma;object=bootstrapper?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234
+// Key URIs without a registered scheme are read as PEM encoded key files.
+package signer
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// Factory creates a signer for the private key identified by keyURI. pub is
+// the public key of the key pair, as found in its certificate.
+type Factory func(keyURI string, pub crypto.PublicKey) (crypto.Signer, error)
+
+var (
+	backendsLock sync.RWMutex
+	backends     = map[string]Factory{}
+)
+
+func init() {
+	if err := RegisterBackend(PKCS11Scheme, NewPKCS11Signer); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterBackend registers the factory of signers for key URIs with the
+// given scheme. Returns an error if the scheme is already registered.
+func RegisterBackend(scheme string, factory Factory) error {
+	backendsLock.Lock()
+	defer backendsLock.Unlock()
+	if _, ok := backends[scheme]; ok {
+		return fmt.Errorf("signer backend for scheme %s is already registered", scheme)
+	}
+	backends[scheme] = factory
+	return nil
+}
+
+// New returns a signer for the private key identified by keyURI and checks
+// that it is the private key matching pub.
+func New(keyURI string, pub crypto.PublicKey) (crypto.Signer, error) {
+	factory := getFactory(keyURI)
+	signer, err := factory(keyURI, pub)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkKeyPair(signer, pub); err != nil {
+		return nil, fmt.Errorf("private key %s does not match public key: %s", redact(keyURI), err)
+	}
+	return signer, nil
+}
+
+func getFactory(keyURI string) Factory {
+	i := strings.Index(keyURI, ":")
+	if i <= 0 {
+		return newSoftwareSigner
+	}
+	backendsLock.RLock()
+	defer backendsLock.RUnlock()
+	if factory, ok := backends[keyURI[:i]]; ok {
+		return factory
+	}
+	return newSoftwareSigner
+}
+
+// checkKeyPair signs a test digest with signer and verifies the signature
+// with pub, so that keys which don't expose their public part (e.g. keys
+// held in a token) are checked as well
+func checkKeyPair(signer crypto.Signer, pub crypto.PublicKey) error {
+	digest := sha256.Sum256([]byte("magma signer key pair check"))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to sign: %s", err)
+	}
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature)
+	case *ecdsa.PublicKey:
+		var ecdsaSig struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(signature, &ecdsaSig); err != nil {
+			return fmt.Errorf("failed to parse ECDSA signature: %s", err)
+		}
+		if !ecdsa.Verify(pub, digest[:], ecdsaSig.R, ecdsaSig.S) {
+			return fmt.Errorf("ECDSA verification failure")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
+
+// redact strips the query attributes, which may hold secrets such as a PIN,
+// from key URIs
+func redact(keyURI string) string {
+	if i := strings.Index(keyURI, "?"); i >= 0 {
+		return keyURI[:i]
+	}
+	return keyURI
+}
@@ -0,0 +1,158 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/signer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSoftwareSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rsaKey, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	rsaKeyFile := filepath.Join(dir, "rsa.key.pem")
+	assert.NoError(t, key.WriteKey(rsaKeyFile, rsaKey))
+	ecKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	ecKeyFile := filepath.Join(dir, "ec.key.pem")
+	assert.NoError(t, key.WriteKey(ecKeyFile, ecKey))
+	pkcs8DER, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	assert.NoError(t, err)
+	pkcs8KeyFile := filepath.Join(dir, "pkcs8.key.pem")
+	err = ioutil.WriteFile(pkcs8KeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8DER}), 0600)
+	assert.NoError(t, err)
+
+	s, err := signer.New(rsaKeyFile, key.PublicKey(rsaKey))
+	assert.NoError(t, err)
+	checkSignsCertificate(t, s)
+
+	s, err = signer.New(ecKeyFile, key.PublicKey(ecKey))
+	assert.NoError(t, err)
+	checkSignsCertificate(t, s)
+
+	s, err = signer.New(pkcs8KeyFile, key.PublicKey(ecKey))
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey(ecKey), s.Public())
+
+	// wrong key
+	_, err = signer.New(rsaKeyFile, key.PublicKey(ecKey))
+	assert.Error(t, err)
+	otherKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	_, err = signer.New(ecKeyFile, key.PublicKey(otherKey))
+	assert.EqualError(t, err, "private key "+ecKeyFile+" does not match public key: ECDSA verification failure")
+
+	// missing key
+	_, err = signer.New(filepath.Join(dir, "missing.pem"), key.PublicKey(ecKey))
+	assert.Error(t, err)
+}
+
+func TestRegisterBackend(t *testing.T) {
+	ecKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	var requestedURI string
+	err = signer.RegisterBackend("test", func(keyURI string, pub crypto.PublicKey) (crypto.Signer, error) {
+		requestedURI = keyURI
+		return ecKey.(*ecdsa.PrivateKey), nil
+	})
+	assert.NoError(t, err)
+	err = signer.RegisterBackend("test", nil)
+	assert.EqualError(t, err, "signer backend for scheme test is already registered")
+	err = signer.RegisterBackend(signer.PKCS11Scheme, nil)
+	assert.Error(t, err)
+
+	s, err := signer.New("test:key1?secret=foo", key.PublicKey(ecKey))
+	assert.NoError(t, err)
+	assert.Equal(t, "test:key1?secret=foo", requestedURI)
+	checkSignsCertificate(t, s)
+
+	otherKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	_, err = signer.New("test:key1?secret=foo", key.PublicKey(otherKey))
+	assert.EqualError(t, err, "private key test:key1 does not match public key: ECDSA verification failure")
+}
+
+func TestParsePKCS11URI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	pinFile := filepath.Join(dir, "pin")
+	assert.NoError(t, ioutil.WriteFile(pinFile, []byte("5678\n"), 0600))
+
+	uri, err := signer.ParsePKCS11URI("pkcs11:token=magma%20ca;object=bootstrapper;id=%01%02?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-value=1234")
+	assert.NoError(t, err)
+	assert.Equal(t, &signer.PKCS11URI{
+		Token:      "magma ca",
+		Object:     "bootstrapper",
+		ID:         []byte{1, 2},
+		ModulePath: "/usr/lib/softhsm/libsofthsm2.so",
+		PIN:        "1234",
+	}, uri)
+
+	uri, err = signer.ParsePKCS11URI("pkcs11:slot-id=3;object=vpn?module-path=/lib/p11.so&pin-source=file:" + pinFile)
+	assert.NoError(t, err)
+	slotID := uint(3)
+	assert.Equal(t, &signer.PKCS11URI{
+		Object:     "vpn",
+		SlotID:     &slotID,
+		ModulePath: "/lib/p11.so",
+		PIN:        "5678",
+	}, uri)
+
+	_, err = signer.ParsePKCS11URI("pkcs11:object=vpn?pin-value=1234")
+	assert.EqualError(t, err, "module-path must be set in PKCS#11 URI pkcs11:object=vpn")
+	_, err = signer.ParsePKCS11URI("pkcs11:token=magma?module-path=/lib/p11.so")
+	assert.EqualError(t, err, "object or id must be set in PKCS#11 URI pkcs11:token=magma")
+	_, err = signer.ParsePKCS11URI("pkcs11:object?module-path=/lib/p11.so")
+	assert.EqualError(t, err, "invalid PKCS#11 URI attribute object")
+	_, err = signer.ParsePKCS11URI("pkcs11:slot-id=a;object=vpn?module-path=/lib/p11.so")
+	assert.EqualError(t, err, "invalid slot-id a")
+	_, err = signer.ParsePKCS11URI("/var/opt/magma/certs/vpn_ca.key")
+	assert.EqualError(t, err, "/var/opt/magma/certs/vpn_ca.key is not a PKCS#11 URI")
+}
+
+// checkSignsCertificate creates a self signed certificate with s and checks
+// its signature
+func checkSignsCertificate(t *testing.T, s crypto.Signer) {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signer test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, s.Public(), s)
+	if !assert.NoError(t, err) {
+		return
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	assert.NoError(t, err)
+	assert.NoError(t, cert.CheckSignatureFrom(cert))
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"fmt"
+
+	"magma/orc8r/cloud/go/security/key"
+)
+
+// NewSoftwareSigner returns the private key read from the PEM encoded
+// keyFile, which signs in process memory
+func NewSoftwareSigner(keyFile string) (crypto.Signer, error) {
+	priv, err := key.ReadKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key type %T of %s can not sign", priv, keyFile)
+	}
+	return signer, nil
+}
+
+func newSoftwareSigner(keyFile string, _ crypto.PublicKey) (crypto.Signer, error) {
+	return NewSoftwareSigner(keyFile)
+}
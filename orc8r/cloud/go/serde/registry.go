@@ -95,6 +95,24 @@ func Deserialize(domain string, typeVal string, data []byte) (interface{}, error
 	return subregistry.deserialize(typeVal, data)
 }
 
+// GetSerdesForDomain returns all Serde implementations registered for a
+// domain. This function is thread-safe.
+func GetSerdesForDomain(domain string) []Serde {
+	registry.RLock()
+	defer registry.RUnlock()
+	subregistry, ok := registry.serdeRegistriesByDomain[domain]
+	if !ok {
+		return []Serde{}
+	}
+	subregistry.RLock()
+	defer subregistry.RUnlock()
+	ret := make([]Serde, 0, len(subregistry.serdesByKey))
+	for _, serde := range subregistry.serdesByKey {
+		ret = append(ret, serde)
+	}
+	return ret
+}
+
 func getSerdesByDomain(serdesToGroup []Serde) map[string][]Serde {
 	ret := map[string][]Serde{}
 	for _, s := range serdesToGroup {
@@ -21,4 +21,6 @@ var identityDecoratorBypassList = map[string]struct{}{
 
 	"/magma.orc8r.Bootstrapper/GetChallenge": {},
 	"/magma.orc8r.Bootstrapper/RequestSign":  {},
+	// Renewal is authenticated by the presented certificate itself
+	"/magma.orc8r.Bootstrapper/RenewCertificate": {},
 }
@@ -68,6 +68,10 @@ func (srv *testStateServer) SyncStates(ctx context.Context, req *protos.SyncStat
 	return &protos.SyncStatesResponse{UnsyncedStates: []*protos.IDAndVersion{}}, nil
 }
 
+func (srv *testStateServer) WatchStates(req *protos.WatchStatesRequest, stream protos.StateService_WatchStatesServer) error {
+	return nil
+}
+
 func TestIdentityInjector(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
@@ -173,7 +177,7 @@ func TestIdentityInjector(t *testing.T) {
 	// Unregister GW
 	assert.NoError(
 		t,
-		configurator.DeleteEntity(networkID, orc8r.MagmadGatewayType, gwid.LogicalId))
+		configurator.DeleteEntity(context.Background(), networkID, orc8r.MagmadGatewayType, gwid.LogicalId))
 
 	ctx = metadata.NewOutgoingContext(
 		context.Background(),
@@ -61,14 +61,15 @@ func (srv *BootstrapperServer) GetChallenge(ctx context.Context, hwId *protos.Ac
 
 	// case based on the env variable whether to use magmad or configurator
 	var err error
-	keyType, _, err = getChallengeKey(hwId.Id)
+	keyType, _, _, err = getChallengeKey(hwId.Id)
 	if err != nil {
 		return nil, err
 	}
 
 	if keyType != protos.ChallengeKey_ECHO &&
 		keyType != protos.ChallengeKey_SOFTWARE_RSA_SHA256 &&
-		keyType != protos.ChallengeKey_SOFTWARE_ECDSA_SHA256 {
+		keyType != protos.ChallengeKey_SOFTWARE_ECDSA_SHA256 &&
+		keyType != protos.ChallengeKey_TPM2_QUOTE {
 		return nil, errorLogger(status.Errorf(codes.Aborted, "Unsupported key type: %s", keyType))
 	}
 
@@ -99,7 +100,7 @@ func (srv *BootstrapperServer) RequestSign(
 	ctx context.Context, resp *protos.Response) (*protos.Certificate, error) {
 
 	hwId := resp.HwId.Id
-	keyType, key, err := getChallengeKey(hwId)
+	keyType, key, pcrPolicy, err := getChallengeKey(hwId)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +119,8 @@ func (srv *BootstrapperServer) RequestSign(
 		err = verifySoftwareRSASHA256(resp, key)
 	case protos.ChallengeKey_SOFTWARE_ECDSA_SHA256:
 		err = verifySoftwareECDSASHA256(resp, key)
+	case protos.ChallengeKey_TPM2_QUOTE:
+		err = verifyTPM2Quote(resp, key, pcrPolicy)
 	default:
 		err = fmt.Errorf("Unsupported key type: %s", keyType)
 	}
@@ -141,6 +144,24 @@ func (srv *BootstrapperServer) RequestSign(
 	return cert, nil
 }
 
+// renew the certificate of a gateway presenting its current valid certificate
+// and return the rotated certificate, capped to GatewayCertificateDuration
+func (srv *BootstrapperServer) RenewCertificate(
+	ctx context.Context, req *protos.RenewCertificateRequest) (*protos.Certificate, error) {
+
+	if req.Csr != nil {
+		reqValidDuration, err := ptypes.Duration(req.Csr.ValidTime)
+		if err != nil || reqValidDuration.Nanoseconds() > GatewayCertificateDuration.Nanoseconds() {
+			req.Csr.ValidTime = ptypes.DurationProto(GatewayCertificateDuration)
+		}
+	}
+	cert, err := certifier.RenewCertificate(req)
+	if err != nil {
+		return nil, errorLogger(status.Errorf(status.Code(err), "Failed to renew certificate: %s", status.Convert(err).Message()))
+	}
+	return cert, nil
+}
+
 // return the length of signature (number of bytes)
 func (srv *BootstrapperServer) signatureLength() int {
 	keyLength := srv.privKey.N.BitLen()
@@ -254,30 +275,30 @@ func verifySoftwareECDSASHA256(resp *protos.Response, key []byte) error {
 	return nil
 }
 
-func getChallengeKey(hwID string) (protos.ChallengeKey_KeyType, []byte, error) {
+func getChallengeKey(hwID string) (protos.ChallengeKey_KeyType, []byte, *models2.TpmPcrPolicy, error) {
 	var empty protos.ChallengeKey_KeyType
 	entity, err := configurator.LoadEntityForPhysicalID(hwID, configurator.EntityLoadCriteria{})
 	if err != nil {
-		return empty, nil, errorLogger(status.Errorf(codes.NotFound, "Gateway with hwid %s is not registered: %s", hwID, err))
+		return empty, nil, nil, errorLogger(status.Errorf(codes.NotFound, "Gateway with hwid %s is not registered: %s", hwID, err))
 	}
 	iRecord, err := device.GetDevice(entity.NetworkID, orc8r.AccessGatewayRecordType, hwID)
 	if err != nil {
-		return empty, nil, errorLogger(status.Errorf(codes.NotFound, "Failed to find gateway record: %s", err))
+		return empty, nil, nil, errorLogger(status.Errorf(codes.NotFound, "Failed to find gateway record: %s", err))
 	}
 	record, ok := iRecord.(*models2.GatewayDevice)
 	if !ok {
-		return empty, nil, errorLogger(status.Errorf(codes.NotFound, "Failed to find gateway record"))
+		return empty, nil, nil, errorLogger(status.Errorf(codes.NotFound, "Failed to find gateway record"))
 	}
 
 	var key []byte
 	keyType, ok := protos.ChallengeKey_KeyType_value[record.Key.KeyType]
 	if !ok {
-		return empty, nil, errorLogger(status.Errorf(codes.Aborted, "Unsupported key type: %v", keyType))
+		return empty, nil, nil, errorLogger(status.Errorf(codes.Aborted, "Unsupported key type: %v", keyType))
 	}
 	if record.Key.Key != nil {
 		key = *record.Key.Key
 	}
-	return protos.ChallengeKey_KeyType(keyType), key, nil
+	return protos.ChallengeKey_KeyType(keyType), key, record.Key.TpmPcrPolicy, nil
 }
 
 func errorLogger(err error) error {
@@ -24,6 +24,7 @@ import (
 	"magma/orc8r/cloud/go/security/key"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
+	"magma/orc8r/cloud/go/services/certifier"
 	certifierTestInit "magma/orc8r/cloud/go/services/certifier/test_init"
 	certifierTestUtils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -280,13 +281,39 @@ func testNegative(
 	assert.Error(t, err)
 }
 
+func testRenewal(t *testing.T, srv *servicers.BootstrapperServer, ctx context.Context) {
+	gwID := protos.NewGatewayIdentity("test_ag_renewal", "", "")
+	csr, gwKey, err := certifierTestUtils.CreateCSRAndKeyForId(servicers.GatewayCertificateDuration, gwID)
+	assert.NoError(t, err)
+	currentCert, err := certifier.SignCSR(csr)
+	assert.NoError(t, err)
+
+	req, _, err := certifierTestUtils.CreateRenewalRequest(currentCert.CertDer, gwKey, time.Hour*24*10, gwID)
+	assert.NoError(t, err)
+	renewedCert, err := srv.RenewCertificate(ctx, req)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(renewedCert.CertDer)
+	assert.NoError(t, err)
+	assert.Equal(t, "test_ag_renewal", cert.Subject.CommonName)
+	// notBefore is backdated by an hour to allow for clock skew
+	assert.Equal(t, servicers.GatewayCertificateDuration+time.Hour, cert.NotAfter.Sub(cert.NotBefore))
+
+	// renewal request signed with the wrong key
+	_, otherKey, err := certifierTestUtils.CreateCSRAndKeyForId(time.Hour, gwID)
+	assert.NoError(t, err)
+	req, _, err = certifierTestUtils.CreateRenewalRequest(currentCert.CertDer, otherKey, time.Hour, gwID)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.Error(t, err)
+}
+
 func TestBootstrapperServer(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
 	_ = serde.RegisterSerdes(serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models.GatewayDevice{}))
 
 	testNetworkID := "bootstrapper_test_network"
-	err := configurator.CreateNetwork(configurator.Network{
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{
 		ID:   testNetworkID,
 		Name: "Test Network Name",
 	})
@@ -323,4 +350,5 @@ func TestBootstrapperServer(t *testing.T) {
 		context.Background(),
 		metadata.Pairs("x-magma-client-cert-cn", "bla"))
 	testNegative(t, testNetworkID, srv, ctx)
+	testRenewal(t, srv, context.Background())
 }
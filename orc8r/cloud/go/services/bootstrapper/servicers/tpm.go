@@ -0,0 +1,169 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+
+	models2 "magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/google/go-tpm/tpm2"
+)
+
+var pcrBanks = map[string]tpm2.Algorithm{
+	models2.TpmPcrPolicyHashAlgorithmSha1:   tpm2.AlgSHA1,
+	models2.TpmPcrPolicyHashAlgorithmSha256: tpm2.AlgSHA256,
+	models2.TpmPcrPolicyHashAlgorithmSha384: tpm2.AlgSHA384,
+}
+
+// verify response with a TPM 2.0 quote signed by the enrolled attestation
+// key, whose qualifying data is the sha256 digest of the challenge. If the
+// gateway has a PCR policy, the quote must attest its PCR values.
+func verifyTPM2Quote(resp *protos.Response, key []byte, pcrPolicy *models2.TpmPcrPolicy) error {
+	publicKey, err := x509.ParsePKIXPublicKey(key)
+	if err != nil {
+		return fmt.Errorf("Failed to parse attestation key: %s", err)
+	}
+
+	response := resp.GetTpm2QuoteResponse()
+	if response == nil {
+		return fmt.Errorf("Wrong type of response, expected TPM2 quote")
+	}
+
+	signature, err := tpm2.DecodeSignature(bytes.NewBuffer(response.Signature))
+	if err != nil {
+		return fmt.Errorf("Failed to decode quote signature: %s", err)
+	}
+	signatureHash, err := verifyTPM2Signature(publicKey, response.Quoted, signature)
+	if err != nil {
+		return err
+	}
+
+	attestation, err := tpm2.DecodeAttestationData(response.Quoted)
+	if err != nil {
+		return fmt.Errorf("Failed to decode quote: %s", err)
+	}
+	if attestation.Type != tpm2.TagAttestQuote || attestation.AttestedQuoteInfo == nil {
+		return fmt.Errorf("Wrong type of attestation, expected quote")
+	}
+	nonce := sha256.Sum256(resp.Challenge)
+	if !bytes.Equal(attestation.ExtraData, nonce[:]) {
+		return fmt.Errorf("Quote is not over the challenge")
+	}
+	if pcrPolicy != nil {
+		return verifyPCRPolicy(attestation.AttestedQuoteInfo, signatureHash, pcrPolicy)
+	}
+	return nil
+}
+
+// verifyTPM2Signature verifies the TPMT_SIGNATURE of the attestation data,
+// returning the signature's hash function
+func verifyTPM2Signature(publicKey crypto.PublicKey, quoted []byte, signature *tpm2.Signature) (crypto.Hash, error) {
+	var hashAlg tpm2.Algorithm
+	switch {
+	case signature.RSA != nil:
+		hashAlg = signature.RSA.HashAlg
+	case signature.ECC != nil:
+		hashAlg = signature.ECC.HashAlg
+	}
+	hash, err := hashAlg.Hash()
+	if err != nil {
+		return 0, fmt.Errorf("Unsupported quote signature hash: %s", err)
+	}
+	if hash == crypto.SHA1 {
+		return 0, fmt.Errorf("Quotes signed with SHA1 are not accepted")
+	}
+	hasher := hash.New()
+	hasher.Write(quoted)
+	digest := hasher.Sum(nil)
+
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch signature.Alg {
+		case tpm2.AlgRSASSA:
+			err = rsa.VerifyPKCS1v15(pub, hash, digest, signature.RSA.Signature)
+		case tpm2.AlgRSAPSS:
+			err = rsa.VerifyPSS(pub, hash, digest, signature.RSA.Signature, nil)
+		default:
+			err = fmt.Errorf("signature algorithm 0x%x does not match RSA attestation key", signature.Alg)
+		}
+	case *ecdsa.PublicKey:
+		if signature.Alg != tpm2.AlgECDSA {
+			err = fmt.Errorf("signature algorithm 0x%x does not match ECDSA attestation key", signature.Alg)
+		} else if !ecdsa.Verify(pub, digest, signature.ECC.R, signature.ECC.S) {
+			err = fmt.Errorf("ECDSA verification failure")
+		}
+	default:
+		err = fmt.Errorf("unsupported attestation key type %T", publicKey)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("Failed to verify quote signature: %s", err)
+	}
+	return hash, nil
+}
+
+// verifyPCRPolicy checks that the quote selects exactly the PCRs of the
+// policy and that its PCR digest, computed by the TPM with the hash of the
+// signature, matches the digest of the policy's PCR values
+func verifyPCRPolicy(quoteInfo *tpm2.QuoteInfo, signatureHash crypto.Hash, pcrPolicy *models2.TpmPcrPolicy) error {
+	bank, ok := pcrBanks[pcrPolicy.HashAlgorithm]
+	if !ok {
+		return fmt.Errorf("Unsupported PCR policy hash algorithm %s", pcrPolicy.HashAlgorithm)
+	}
+	pcrs := make([]int, 0, len(pcrPolicy.Pcrs))
+	values := map[int][]byte{}
+	for pcr, value := range pcrPolicy.Pcrs {
+		index, err := strconv.Atoi(pcr)
+		if err != nil {
+			return fmt.Errorf("Invalid PCR index %s in PCR policy", pcr)
+		}
+		values[index], err = hex.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("Invalid value of PCR %s in PCR policy", pcr)
+		}
+		pcrs = append(pcrs, index)
+	}
+	sort.Ints(pcrs)
+
+	selection := quoteInfo.PCRSelection
+	if selection.Hash != bank || !equalInts(selection.PCRs, pcrs) {
+		return fmt.Errorf("Quote selects PCRs %v of bank 0x%x, expected PCRs %v of bank %s",
+			selection.PCRs, selection.Hash, pcrs, pcrPolicy.HashAlgorithm)
+	}
+	hasher := signatureHash.New()
+	for _, index := range pcrs {
+		hasher.Write(values[index])
+	}
+	if !bytes.Equal(hasher.Sum(nil), quoteInfo.PCRDigest) {
+		return fmt.Errorf("Quoted PCR values do not match PCR policy")
+	}
+	return nil
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+// +build tpm_simulator
+
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// The TPM simulator is built with cgo against OpenSSL 1.0 or 1.1, run these
+// tests with -tags tpm_simulator where those are available.
+
+package servicers_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/google/go-tpm-tools/simulator"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+// TestTPM2QuoteBootstrap_Simulator checks quotes produced by a simulated TPM,
+// to make sure the software quotes above match the TPM's encoding
+func TestTPM2QuoteBootstrap_Simulator(t *testing.T) {
+	sim, err := simulator.Get()
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer sim.Close()
+
+	// Measure into PCRs 0 and 7
+	for _, index := range []int{0, 7} {
+		measurement := sha256.Sum256([]byte(fmt.Sprintf("measurement %d", index)))
+		err = tpm2.PCRExtend(sim, tpmutil.Handle(index), tpm2.AlgSHA256, measurement[:], "")
+		assert.NoError(t, err)
+	}
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256, PCRs: []int{0, 7}}
+	pcrs, err := tpm2.ReadPCRs(sim, sel)
+	assert.NoError(t, err)
+	pcrPolicy := &models.TpmPcrPolicy{
+		HashAlgorithm: models.TpmPcrPolicyHashAlgorithmSha256,
+		Pcrs:          map[string]string{"0": hex.EncodeToString(pcrs[0]), "7": hex.EncodeToString(pcrs[7])},
+	}
+
+	// Restricted ECDSA signing key in the endorsement hierarchy
+	akHandle, akPub, err := tpm2.CreatePrimary(sim, tpm2.HandleEndorsement, tpm2.PCRSelection{}, "", "", tpm2.Public{
+		Type:       tpm2.AlgECC,
+		NameAlg:    tpm2.AlgSHA256,
+		Attributes: tpm2.FlagSignerDefault,
+		ECCParameters: &tpm2.ECCParams{
+			Sign:    &tpm2.SigScheme{Alg: tpm2.AlgECDSA, Hash: tpm2.AlgSHA256},
+			CurveID: tpm2.CurveNISTP256,
+		},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer tpm2.FlushContext(sim, akHandle)
+
+	srv := startTPMTestServices(t, "tpm_sim_network")
+	ctx := context.Background()
+	registerTPMGateway(t, "tpm_sim_network", "test_ag_tpm_sim", akPub, pcrPolicy)
+	challenge, err := srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: "test_ag_tpm_sim"})
+	assert.NoError(t, err)
+	nonce := sha256.Sum256(challenge.Challenge)
+
+	quoted, signature, err := tpm2.QuoteRaw(sim, akHandle, "", "", nonce[:], sel, tpm2.AlgNull)
+	assert.NoError(t, err)
+	quote := &protos.Response_TPM2Quote{Quoted: quoted, Signature: signature}
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_sim", challenge.Challenge, quote))
+	assert.NoError(t, err)
+
+	// PCR 7 no longer matches the policy
+	measurement := sha256.Sum256([]byte("unexpected"))
+	err = tpm2.PCRExtend(sim, tpmutil.Handle(7), tpm2.AlgSHA256, measurement[:], "")
+	assert.NoError(t, err)
+	quoted, signature, err = tpm2.QuoteRaw(sim, akHandle, "", "", nonce[:], sel, tpm2.AlgNull)
+	assert.NoError(t, err)
+	quote = &protos.Response_TPM2Quote{Quoted: quoted, Signature: signature}
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_sim", challenge.Challenge, quote))
+	assert.Error(t, err)
+}
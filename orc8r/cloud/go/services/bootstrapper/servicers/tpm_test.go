@@ -0,0 +1,233 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
+	certifierTestInit "magma/orc8r/cloud/go/services/certifier/test_init"
+	certifierTestUtils "magma/orc8r/cloud/go/services/certifier/test_utils"
+	"magma/orc8r/cloud/go/services/configurator"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	configuratorTestUtils "magma/orc8r/cloud/go/services/configurator/test_utils"
+	"magma/orc8r/cloud/go/services/device"
+	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+const tpmType = "TPM2_QUOTE"
+
+func TestTPM2QuoteBootstrap(t *testing.T) {
+	srv := startTPMTestServices(t, "tpm_test_network")
+	ctx := context.Background()
+
+	pcr0 := sha256.Sum256([]byte("firmware"))
+	pcr7 := sha256.Sum256([]byte("secure boot"))
+	pcrs := map[int][]byte{0: pcr0[:], 7: pcr7[:]}
+	pcrPolicy := &models.TpmPcrPolicy{
+		HashAlgorithm: models.TpmPcrPolicyHashAlgorithmSha256,
+		Pcrs:          map[string]string{"0": hex.EncodeToString(pcr0[:]), "7": hex.EncodeToString(pcr7[:])},
+	}
+
+	// ECDSA attestation key with a PCR policy
+	ecdsaAK, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	registerTPMGateway(t, "tpm_test_network", "test_ag_tpm_ecdsa", key.PublicKey(ecdsaAK), pcrPolicy)
+	challenge, err := srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: "test_ag_tpm_ecdsa"})
+	assert.NoError(t, err)
+	assert.Equal(t, protos.ChallengeKey_TPM2_QUOTE, challenge.KeyType)
+	nonce := sha256.Sum256(challenge.Challenge)
+
+	quote := softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.NoError(t, err)
+
+	// quote over another nonce
+	otherNonce := sha256.Sum256([]byte("replayed"))
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, otherNonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// PCR values not matching the policy
+	badPCRs := map[int][]byte{0: pcr0[:], 7: pcr0[:]}
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, badPCRs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// PCRs missing from the quote
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, map[int][]byte{0: pcr0[:]})
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// PCRs of another bank
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA1, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// quote signed by another key
+	otherAK, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	quote = softwareTPM2Quote(t, otherAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// quote signed with SHA1
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA1, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_ecdsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+
+	// wrong type of response
+	hashed := sha256.Sum256(challenge.Challenge)
+	r, s, err := ecdsa.Sign(rand.Reader, ecdsaAK.(*ecdsa.PrivateKey), hashed[:])
+	assert.NoError(t, err)
+	csr, err := certifierTestUtils.CreateCSR(time.Duration(time.Hour*24*10), "cn", "cn")
+	assert.NoError(t, err)
+	_, err = srv.RequestSign(ctx, &protos.Response{
+		HwId:      &protos.AccessGatewayID{Id: "test_ag_tpm_ecdsa"},
+		Challenge: challenge.Challenge,
+		Response:  &protos.Response_EcdsaResponse{EcdsaResponse: &protos.Response_ECDSA{R: r.Bytes(), S: s.Bytes()}},
+		Csr:       csr,
+	})
+	assert.Error(t, err)
+
+	// RSA attestation key without a PCR policy, signing with RSASSA or RSAPSS
+	rsaAK, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	registerTPMGateway(t, "tpm_test_network", "test_ag_tpm_rsa", key.PublicKey(rsaAK), nil)
+	challenge, err = srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: "test_ag_tpm_rsa"})
+	assert.NoError(t, err)
+	nonce = sha256.Sum256(challenge.Challenge)
+
+	quote = softwareTPM2Quote(t, rsaAK.(crypto.Signer), tpm2.AlgRSASSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_rsa", challenge.Challenge, quote))
+	assert.NoError(t, err)
+	quote = softwareTPM2Quote(t, rsaAK.(crypto.Signer), tpm2.AlgRSAPSS, crypto.SHA384, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_rsa", challenge.Challenge, quote))
+	assert.NoError(t, err)
+
+	// signature algorithm not matching the attestation key
+	quote = softwareTPM2Quote(t, ecdsaAK.(crypto.Signer), tpm2.AlgECDSA, crypto.SHA256, nonce[:], tpm2.AlgSHA256, pcrs)
+	_, err = srv.RequestSign(ctx, tpmResponse(t, "test_ag_tpm_rsa", challenge.Challenge, quote))
+	assert.Error(t, err)
+}
+
+func startTPMTestServices(t *testing.T, networkID string) *servicers.BootstrapperServer {
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	certifierTestInit.StartTestService(t)
+	_ = serde.RegisterSerdes(serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models.GatewayDevice{}))
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: networkID, Name: "Test Network Name"})
+	assert.NoError(t, err)
+
+	privateKey, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	srv, err := servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey))
+	assert.NoError(t, err)
+	return srv
+}
+
+func registerTPMGateway(t *testing.T, networkID string, hwID string, akPub crypto.PublicKey, pcrPolicy *models.TpmPcrPolicy) {
+	marshaledPubKey, err := x509.MarshalPKIXPublicKey(akPub)
+	assert.NoError(t, err)
+	pubKey := strfmt.Base64(marshaledPubKey)
+	challengeKey := &models.ChallengeKey{KeyType: tpmType, Key: &pubKey, TpmPcrPolicy: pcrPolicy}
+	assert.NoError(t, challengeKey.ValidateModel())
+	configuratorTestUtils.RegisterGateway(t, networkID, hwID, &models.GatewayDevice{HardwareID: hwID, Key: challengeKey})
+}
+
+func tpmResponse(t *testing.T, hwID string, challenge []byte, quote *protos.Response_TPM2Quote) *protos.Response {
+	csr, err := certifierTestUtils.CreateCSR(time.Duration(time.Hour*24*10), "cn", "cn")
+	assert.NoError(t, err)
+	return &protos.Response{
+		HwId:      &protos.AccessGatewayID{Id: hwID},
+		Challenge: challenge,
+		Response:  &protos.Response_Tpm2QuoteResponse{Tpm2QuoteResponse: quote},
+		Csr:       csr,
+	}
+}
+
+// softwareTPM2Quote produces the TPMS_ATTEST and TPMT_SIGNATURE a TPM
+// returns from TPM2_Quote, with the attestation key held in software
+func softwareTPM2Quote(
+	t *testing.T,
+	ak crypto.Signer,
+	sigAlg tpm2.Algorithm,
+	hash crypto.Hash,
+	nonce []byte,
+	bank tpm2.Algorithm,
+	pcrs map[int][]byte,
+) *protos.Response_TPM2Quote {
+	hashAlgs := map[crypto.Hash]tpm2.Algorithm{crypto.SHA1: tpm2.AlgSHA1, crypto.SHA256: tpm2.AlgSHA256, crypto.SHA384: tpm2.AlgSHA384}
+
+	// TPMS_QUOTE_INFO with a single TPMS_PCR_SELECTION
+	pcrSelect := make([]byte, 3)
+	pcrDigest := hash.New()
+	for index := 0; index < 24; index++ {
+		if value, ok := pcrs[index]; ok {
+			pcrSelect[index/8] |= 1 << uint(index%8)
+			pcrDigest.Write(value)
+		}
+	}
+	quoted, err := tpmutil.Pack(
+		uint32(0xff544347), // TPM_GENERATED_VALUE
+		tpm2.TagAttestQuote,
+		tpmutil.U16Bytes(append([]byte{0, byte(tpm2.AlgSHA256)}, make([]byte, sha256.Size)...)), // qualified signer name
+		tpmutil.U16Bytes(nonce),
+		tpm2.ClockInfo{Clock: 1000, ResetCount: 1, RestartCount: 0, Safe: 1},
+		uint64(0x2010), // firmware version
+		uint32(1),
+		bank,
+		byte(len(pcrSelect)),
+		tpmutil.RawBytes(pcrSelect),
+		tpmutil.U16Bytes(pcrDigest.Sum(nil)),
+	)
+	assert.NoError(t, err)
+
+	hasher := hash.New()
+	hasher.Write(quoted)
+	digest := hasher.Sum(nil)
+	var signature []byte
+	switch sigAlg {
+	case tpm2.AlgRSASSA:
+		sig, err := ak.Sign(rand.Reader, digest, hash)
+		assert.NoError(t, err)
+		signature, err = tpmutil.Pack(sigAlg, hashAlgs[hash], tpmutil.U16Bytes(sig))
+		assert.NoError(t, err)
+	case tpm2.AlgRSAPSS:
+		sig, err := ak.Sign(rand.Reader, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash})
+		assert.NoError(t, err)
+		signature, err = tpmutil.Pack(sigAlg, hashAlgs[hash], tpmutil.U16Bytes(sig))
+		assert.NoError(t, err)
+	case tpm2.AlgECDSA:
+		r, s, err := ecdsa.Sign(rand.Reader, ak.(*ecdsa.PrivateKey), digest)
+		assert.NoError(t, err)
+		signature, err = tpmutil.Pack(sigAlg, hashAlgs[hash], tpmutil.U16Bytes(r.Bytes()), tpmutil.U16Bytes(s.Bytes()))
+		assert.NoError(t, err)
+	}
+	return &protos.Response_TPM2Quote{Quoted: quoted, Signature: signature}
+}
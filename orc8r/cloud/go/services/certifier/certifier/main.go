@@ -10,31 +10,46 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/security/signer"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/certifier/metrics"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+	"magma/orc8r/cloud/go/services/certifier/responder"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	"magma/orc8r/cloud/go/sqorc"
 
 	"github.com/golang/glog"
+	"github.com/labstack/echo"
 	"golang.org/x/net/context"
 )
 
 var (
 	bootstrapCACertFile = flag.String("cac", "server_cert.pem", "Signer CA's Certificate file")
-	bootstrapCAKeyFile  = flag.String("cak", "server_cert.key.pem", "Signer CA's Private Key file")
+	bootstrapCAKeyFile  = flag.String("cak", "server_cert.key.pem", "Signer CA's Private Key file or PKCS#11 URI")
 
 	vpnCertFile = flag.String("vpnc", "vpn_ca.crt", "VPN CA's Certificate file")
-	vpnKeyFile  = flag.String("vpnk", "vpn_ca.key", "VPN CA's Private Key file")
+	vpnKeyFile  = flag.String("vpnk", "vpn_ca.key", "VPN CA's Private Key file or PKCS#11 URI")
 
 	gcHours = flag.Int64("gc-hours", 12, "Garbage Collection time interval (in hours)")
+
+	crlMinutes    = flag.Int64("crl-minutes", 60, "CRL publishing time interval (in minutes)")
+	responderPort = flag.Int("responder-port", 8089, "Port of the HTTP CRL & OCSP responder, 0 disables it")
+	responderURL  = flag.String("responder-url", "", "Base URL of the CRL & OCSP responder to embed in signed certificates, e.g. http://certifier:8089")
+
+	// Gateways renew their certificates 20 hours prior to expiration, so by
+	// default only alert on gateways which are overdue for rotation
+	expiringCertWindow   = flag.Duration("expiring-cert-window", time.Hour*10, "Report identities whose latest certificate expires within this window")
+	expiringCertInterval = flag.Duration("expiring-cert-interval", time.Minute*10, "Expiring certificate reporting time interval")
 )
 
 func main() {
@@ -52,13 +67,13 @@ func main() {
 	caMap := map[protos.CertType]*servicers.CAInfo{}
 
 	// Add servicers to the service
-	bootstrapCert, bootstrapPrivKey, err := cert.LoadCertAndPrivKey(*bootstrapCACertFile, *bootstrapCAKeyFile)
+	bootstrapCA, err := loadCA(*bootstrapCACertFile, *bootstrapCAKeyFile)
 	if err != nil {
 		log.Printf("ERROR: Failed to load bootstrap CA cert and key: %v", err)
 	} else {
-		caMap[protos.CertType_DEFAULT] = &servicers.CAInfo{Cert: bootstrapCert, PrivKey: bootstrapPrivKey}
+		caMap[protos.CertType_DEFAULT] = bootstrapCA
 	}
-	vpnCert, vpnPrivKey, vpnErr := cert.LoadCertAndPrivKey(*vpnCertFile, *vpnKeyFile)
+	vpnCA, vpnErr := loadCA(*vpnCertFile, *vpnKeyFile)
 	if vpnErr != nil {
 		fmtstr := "ERROR: Failed to load VPN cert and key: %v"
 		if err != nil {
@@ -67,7 +82,12 @@ func main() {
 			log.Printf(fmtstr, vpnErr)
 		}
 	} else {
-		caMap[protos.CertType_VPN] = &servicers.CAInfo{Cert: vpnCert, PrivKey: vpnPrivKey}
+		caMap[protos.CertType_VPN] = vpnCA
+	}
+	if *responderURL != "" {
+		for certType, ca := range caMap {
+			setRevocationURLs(ca, certType, *responderURL)
+		}
 	}
 	servicer, err := servicers.NewCertifierServer(store, caMap)
 	if err != nil {
@@ -87,9 +107,57 @@ func main() {
 		}
 	}()
 
+	// Publish CRLs now and on every tick
+	if err := servicer.UpdateCRLs(); err != nil {
+		glog.Errorf("error publishing CRLs: %s", err)
+	}
+	crlTick := time.Tick(time.Minute * time.Duration(*crlMinutes))
+	go func() {
+		for range crlTick {
+			if err := servicer.UpdateCRLs(); err != nil {
+				glog.Errorf("error publishing CRLs: %s", err)
+			}
+		}
+	}()
+
+	// Report metrics on expiring certificates
+	go metrics.PeriodicallyReportExpiringCertificates(*expiringCertInterval, *expiringCertWindow)
+
+	// Start CRL & OCSP responder
+	if *responderPort > 0 {
+		e := echo.New()
+		responder.RegisterHandlers(e)
+		go func() {
+			log.Fatalf("CRL & OCSP responder stopped: %s", e.Start(fmt.Sprintf(":%d", *responderPort)))
+		}()
+	}
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// setRevocationURLs sets the URLs of the CA's CRL and of the OCSP responder
+// served at baseURL, see the responder package
+func setRevocationURLs(ca *servicers.CAInfo, certType protos.CertType, baseURL string) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	crlPath := strings.Replace(responder.CRLPath, ":cert_type", strings.ToLower(certType.String()), 1)
+	ca.CRLDistributionPoints = []string{baseURL + crlPath}
+	ca.OCSPServers = []string{baseURL + responder.OCSPPath}
+}
+
+// loadCA loads the CA certificate from certFile and the signer of its
+// private key, which is either a key file or a PKCS#11 URI
+func loadCA(certFile, keyURI string) (*servicers.CAInfo, error) {
+	caCert, err := cert.LoadCert(certFile)
+	if err != nil {
+		return nil, err
+	}
+	caSigner, err := signer.New(keyURI, caCert.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &servicers.CAInfo{Cert: caCert, Signer: caSigner}, nil
+}
@@ -11,6 +11,7 @@ package certifier
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"magma/orc8r/cloud/go/clock"
 	merrors "magma/orc8r/cloud/go/errors"
@@ -167,6 +168,38 @@ func GetAll() (map[string]*certifierprotos.CertificateInfo, error) {
 	return certMap.GetCertificates(), err
 }
 
+// GetExpiringCertificates returns the latest certificate of every identity
+// whose latest certificate expires within the given duration, keyed by SN
+func GetExpiringCertificates(within time.Duration) (map[string]*certifierprotos.CertificateInfo, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	certMap, err := client.GetExpiringCertificates(
+		context.Background(),
+		&certifierprotos.GetExpiringCertificatesRequest{Within: ptypes.DurationProto(within)},
+	)
+	if err != nil || certMap == nil {
+		return nil, err
+	}
+	return certMap.GetCertificates(), err
+}
+
+// RenewCertificate returns a rotated certificate for the gateway presenting
+// its current certificate and a CSR signed with the current private key
+func RenewCertificate(req *protos.RenewCertificateRequest) (*protos.Certificate, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	cert, err := client.RenewCertificate(context.Background(), req)
+	if err != nil {
+		glog.Errorf("Failed to renew certificate: %s", err)
+		return nil, err
+	}
+	return cert, nil
+}
+
 // Revoke Certificate and delete record of given SN
 func RevokeCertificate(sn *protos.Certificate_SN) error {
 	client, err := getCertifierClient()
@@ -188,6 +221,57 @@ func RevokeCertificateSN(sn string) error {
 	return RevokeCertificate(&protos.Certificate_SN{Sn: sn})
 }
 
+// RevokeCertificateWithReason revokes the certificate of given SN recording
+// the given revocation reason, which is published in the CRL and OCSP
+// responses of the issuing CA
+func RevokeCertificateWithReason(sn string, reason certifierprotos.RevocationReason) error {
+	client, err := getCertifierClient()
+	if err != nil {
+		return err
+	}
+
+	glog.V(2).Infof("Certifier: revoking certificate with SN: %s, reason: %s", sn, reason)
+
+	_, err = client.RevokeCertificateWithReason(
+		context.Background(),
+		&certifierprotos.RevokeCertificateRequest{Sn: &protos.Certificate_SN{Sn: sn}, Reason: reason},
+	)
+	if err != nil {
+		glog.Errorf("Failed to revoke certificate with SN: %s, %s", sn, err)
+		return err
+	}
+	return nil
+}
+
+// GetCRL returns the latest DER encoded CRL signed by the CA of given type
+func GetCRL(certType protos.CertType) ([]byte, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	crl, err := client.GetCRL(context.Background(), &certifierprotos.GetCRLRequest{CertType: certType})
+	if err != nil {
+		glog.Errorf("Failed to get CRL for %s: %s", certType, err)
+		return nil, err
+	}
+	return crl.CrlDer, nil
+}
+
+// GetOCSPResponse returns the DER encoded OCSP response to the given DER
+// encoded OCSP request
+func GetOCSPResponse(requestDER []byte) ([]byte, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.GetOCSPResponse(context.Background(), &certifierprotos.OCSPRequest{RequestDer: requestDER})
+	if err != nil {
+		glog.Errorf("Failed to get OCSP response: %s", err)
+		return nil, err
+	}
+	return res.ResponseDer, nil
+}
+
 // Let certifier to remove expired certificates
 func CollectGarbage() error {
 	client, err := getCertifierClient()
@@ -16,12 +16,14 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
 	"magma/orc8r/cloud/go/protos"
 	security_cert "magma/orc8r/cloud/go/security/cert"
 	"magma/orc8r/cloud/go/services/certifier"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	"magma/orc8r/cloud/go/services/certifier/test_init"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
@@ -76,20 +78,28 @@ func TestCertifier(t *testing.T) {
 	_, err = certifier.GetIdentity(snMsg)
 	assert.Equal(t, grpc.Code(err), codes.NotFound)
 
+	// revoked certificates are kept, so they cannot be added again
 	oper := protos.NewOperatorIdentity("testOperator")
-	assert.NoError(t,
-		certifier.AddCertificate(oper, firstCertDer),
-		"Failed to Add Existing Cert")
+	err = certifier.AddCertificate(oper, firstCertDer)
+	assert.Equal(t, codes.AlreadyExists, grpc.Code(err))
 
-	certInfoMsg, err = certifier.GetCertificateIdentity(security_cert.SerialToString(firstCertSN))
-	assert.NoError(t, err, "Error getting added cert identity")
+	csrMsg, err = certifier_test_utils.CreateCSRForId(time.Duration(time.Hour*2), oper)
+	assert.NoError(t, err)
+	certMsg, err = certifier.SignCSR(csrMsg)
+	assert.NoError(t, err, "Failed to sign CSR")
+	operCertSN := certMsg.Sn.Sn
+	operCert, err := x509.ParseCertificate(certMsg.CertDer)
+	assert.NoError(t, err)
+
+	certInfoMsg, err = certifier.GetCertificateIdentity(operCertSN)
+	assert.NoError(t, err, "Error getting operator cert identity")
 	if err == nil {
 		assert.Equal(t, oper.HashString(), certInfoMsg.Id.HashString())
 	}
 
 	sns, err := certifier.ListCertificates()
 	assert.NoError(t, err, "Error Listing Certificates")
-	assert.Equal(t, 1, len(sns))
+	assert.Equal(t, 2, len(sns))
 
 	csrMsg, err = certifier_test_utils.CreateCSR(time.Duration(time.Hour*2), "cn1", "cn1")
 	assert.NoError(t, err)
@@ -98,12 +108,33 @@ func TestCertifier(t *testing.T) {
 
 	sns, err = certifier.ListCertificates()
 	assert.NoError(t, err, "Error Listing Certificates")
-	assert.Equal(t, 2, len(sns))
+	assert.Equal(t, 3, len(sns))
 
 	operSNs, err := certifier.FindCertificates(oper)
 	assert.NoError(t, err, "Error Finding Operator Certificates")
-	assert.Equal(t, 1, len(operSNs))
-	if len(operSNs) > 0 {
-		assert.Equal(t, security_cert.SerialToString(firstCertSN), operSNs[0])
-	}
+	assert.Equal(t, []string{operCertSN}, operSNs)
+
+	// revocation status is published through CRL and OCSP
+	err = certifier.RevokeCertificateWithReason(operCertSN, certprotos.RevocationReason_SUPERSEDED)
+	assert.NoError(t, err)
+	caCert, err := certifier.GetCACert(&certprotos.GetCARequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	ca, err := x509.ParseCertificate(caCert.Cert)
+	assert.NoError(t, err)
+
+	crlDER, err := certifier.GetCRL(protos.CertType_DEFAULT)
+	assert.NoError(t, err)
+	crl, err := x509.ParseCRL(crlDER)
+	assert.NoError(t, err)
+	assert.NoError(t, ca.CheckCRLSignature(crl))
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 2)
+
+	reqDER, err := ocsp.CreateRequest(operCert, ca, nil)
+	assert.NoError(t, err)
+	resDER, err := certifier.GetOCSPResponse(reqDER)
+	assert.NoError(t, err)
+	res, err := ocsp.ParseResponseForCert(resDER, operCert, ca)
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, res.Status)
+	assert.Equal(t, ocsp.Superseded, res.RevocationReason)
 }
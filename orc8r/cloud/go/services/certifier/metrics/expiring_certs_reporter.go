@@ -0,0 +1,71 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package metrics
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// PeriodicallyReportExpiringCertificates reports metrics on the identities
+// whose latest certificate expires within the given window every dur
+func PeriodicallyReportExpiringCertificates(dur time.Duration, within time.Duration) {
+	for _ = range time.Tick(dur) {
+		err := ReportExpiringCertificates(within)
+		if err != nil {
+			glog.Errorf("err in reportExpiringCertificates: %v\n", err)
+		}
+	}
+}
+
+// ReportExpiringCertificates sets the expiring certificate metrics from the
+// certificates expiring within the given window
+func ReportExpiringCertificates(within time.Duration) error {
+	certs, err := certifier.GetExpiringCertificates(within)
+	if err != nil {
+		return err
+	}
+	// Certificate identities only hold the hardware ID of the gateway
+	gateways, err := configurator.LoadEntitiesByPhysicalID(nil, orc8r.MagmadGatewayType)
+	if err != nil {
+		return err
+	}
+	expiringCertCount.Reset()
+	gwCertExpiresIn.Reset()
+
+	now := clock.Now()
+	for sn, certInfo := range certs {
+		gw := certInfo.GetId().GetGateway()
+		if gw == nil {
+			if certInfo.GetId().GetOperator() != "" {
+				expiringCertCount.WithLabelValues("", OperatorIdentityType).Inc()
+			}
+			continue
+		}
+		// Gateways which aren't registered are reported without a network,
+		// by hardware ID
+		networkID, gatewayID := "", gw.HardwareId
+		if entity, ok := gateways[gw.HardwareId]; ok {
+			networkID, gatewayID = entity.NetworkID, entity.Key
+		} else {
+			glog.V(2).Infof("Unregistered gateway %s with expiring certificate %s", gw.HardwareId, sn)
+		}
+		expiringCertCount.WithLabelValues(networkID, GatewayIdentityType).Inc()
+		notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+		gwCertExpiresIn.WithLabelValues(networkID, gatewayID).Set(notAfter.Sub(now).Seconds())
+	}
+	return nil
+}
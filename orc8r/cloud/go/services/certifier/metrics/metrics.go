@@ -0,0 +1,46 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package metrics
+
+import (
+	"magma/orc8r/cloud/go/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	IdentityTypeLabelName = "identityType"
+
+	GatewayIdentityType  = "gateway"
+	OperatorIdentityType = "operator"
+)
+
+var (
+	expiringCertCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "certifier_expiring_certificate_count",
+			Help: "Number of identities whose latest certificate expires within the alerting window",
+		},
+		[]string{metrics.NetworkLabelName, IdentityTypeLabelName},
+	)
+	gwCertExpiresIn = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gateway_certificate_expires_in_seconds",
+			Help: "Seconds until the latest certificate of the gateway expires, for gateways within the alerting window",
+		},
+		[]string{metrics.NetworkLabelName, metrics.GatewayLabelName},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		expiringCertCount,
+		gwCertExpiresIn,
+	)
+}
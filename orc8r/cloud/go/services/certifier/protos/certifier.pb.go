@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: certifier.proto
+// source: orc8r/cloud/go/services/certifier/protos/certifier.proto
 
 package protos
 
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -26,11 +27,55 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// RevocationReason mirrors the CRLReason codes of RFC 5280 section 5.3.1
+type RevocationReason int32
+
+const (
+	RevocationReason_UNSPECIFIED            RevocationReason = 0
+	RevocationReason_KEY_COMPROMISE         RevocationReason = 1
+	RevocationReason_CA_COMPROMISE          RevocationReason = 2
+	RevocationReason_AFFILIATION_CHANGED    RevocationReason = 3
+	RevocationReason_SUPERSEDED             RevocationReason = 4
+	RevocationReason_CESSATION_OF_OPERATION RevocationReason = 5
+	RevocationReason_PRIVILEGE_WITHDRAWN    RevocationReason = 9
+)
+
+var RevocationReason_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "KEY_COMPROMISE",
+	2: "CA_COMPROMISE",
+	3: "AFFILIATION_CHANGED",
+	4: "SUPERSEDED",
+	5: "CESSATION_OF_OPERATION",
+	9: "PRIVILEGE_WITHDRAWN",
+}
+
+var RevocationReason_value = map[string]int32{
+	"UNSPECIFIED":            0,
+	"KEY_COMPROMISE":         1,
+	"CA_COMPROMISE":          2,
+	"AFFILIATION_CHANGED":    3,
+	"SUPERSEDED":             4,
+	"CESSATION_OF_OPERATION": 5,
+	"PRIVILEGE_WITHDRAWN":    9,
+}
+
+func (x RevocationReason) String() string {
+	return proto.EnumName(RevocationReason_name, int32(x))
+}
+
+func (RevocationReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{0}
+}
+
 type CertificateInfo struct {
-	Id                   *protos.Identity     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	NotBefore            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-	NotAfter             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-	CertType             protos.CertType      `protobuf:"varint,4,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	Id        *protos.Identity     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotBefore *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	CertType  protos.CertType      `protobuf:"varint,4,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	// Set once the certificate has been revoked
+	RevokedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	RevocationReason     RevocationReason     `protobuf:"varint,6,opt,name=revocation_reason,json=revocationReason,proto3,enum=magma.orc8r.certifier.RevocationReason" json:"revocation_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -40,7 +85,7 @@ func (m *CertificateInfo) Reset()         { *m = CertificateInfo{} }
 func (m *CertificateInfo) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfo) ProtoMessage()    {}
 func (*CertificateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_515f9a7ba5ef1ab9, []int{0}
+	return fileDescriptor_0037205171c15011, []int{0}
 }
 
 func (m *CertificateInfo) XXX_Unmarshal(b []byte) error {
@@ -89,6 +134,20 @@ func (m *CertificateInfo) GetCertType() protos.CertType {
 	return protos.CertType_DEFAULT
 }
 
+func (m *CertificateInfo) GetRevokedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return nil
+}
+
+func (m *CertificateInfo) GetRevocationReason() RevocationReason {
+	if m != nil {
+		return m.RevocationReason
+	}
+	return RevocationReason_UNSPECIFIED
+}
+
 type CertificateInfoMap struct {
 	Certificates         map[string]*CertificateInfo `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
@@ -100,7 +159,7 @@ func (m *CertificateInfoMap) Reset()         { *m = CertificateInfoMap{} }
 func (m *CertificateInfoMap) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfoMap) ProtoMessage()    {}
 func (*CertificateInfoMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_515f9a7ba5ef1ab9, []int{1}
+	return fileDescriptor_0037205171c15011, []int{1}
 }
 
 func (m *CertificateInfoMap) XXX_Unmarshal(b []byte) error {
@@ -141,7 +200,7 @@ func (m *AddCertRequest) Reset()         { *m = AddCertRequest{} }
 func (m *AddCertRequest) String() string { return proto.CompactTextString(m) }
 func (*AddCertRequest) ProtoMessage()    {}
 func (*AddCertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_515f9a7ba5ef1ab9, []int{2}
+	return fileDescriptor_0037205171c15011, []int{2}
 }
 
 func (m *AddCertRequest) XXX_Unmarshal(b []byte) error {
@@ -194,7 +253,7 @@ func (m *SerialNumbers) Reset()         { *m = SerialNumbers{} }
 func (m *SerialNumbers) String() string { return proto.CompactTextString(m) }
 func (*SerialNumbers) ProtoMessage()    {}
 func (*SerialNumbers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_515f9a7ba5ef1ab9, []int{3}
+	return fileDescriptor_0037205171c15011, []int{3}
 }
 
 func (m *SerialNumbers) XXX_Unmarshal(b []byte) error {
@@ -222,6 +281,46 @@ func (m *SerialNumbers) GetSns() []string {
 	return nil
 }
 
+type GetExpiringCertificatesRequest struct {
+	// Window from now in which the latest certificate of an identity expires
+	Within               *duration.Duration `protobuf:"bytes,1,opt,name=within,proto3" json:"within,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetExpiringCertificatesRequest) Reset()         { *m = GetExpiringCertificatesRequest{} }
+func (m *GetExpiringCertificatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExpiringCertificatesRequest) ProtoMessage()    {}
+func (*GetExpiringCertificatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{4}
+}
+
+func (m *GetExpiringCertificatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExpiringCertificatesRequest.Unmarshal(m, b)
+}
+func (m *GetExpiringCertificatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExpiringCertificatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExpiringCertificatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExpiringCertificatesRequest.Merge(m, src)
+}
+func (m *GetExpiringCertificatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExpiringCertificatesRequest.Size(m)
+}
+func (m *GetExpiringCertificatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExpiringCertificatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExpiringCertificatesRequest proto.InternalMessageInfo
+
+func (m *GetExpiringCertificatesRequest) GetWithin() *duration.Duration {
+	if m != nil {
+		return m.Within
+	}
+	return nil
+}
+
 type GetCARequest struct {
 	CertType             protos.CertType `protobuf:"varint,1,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -233,7 +332,7 @@ func (m *GetCARequest) Reset()         { *m = GetCARequest{} }
 func (m *GetCARequest) String() string { return proto.CompactTextString(m) }
 func (*GetCARequest) ProtoMessage()    {}
 func (*GetCARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_515f9a7ba5ef1ab9, []int{4}
+	return fileDescriptor_0037205171c15011, []int{5}
 }
 
 func (m *GetCARequest) XXX_Unmarshal(b []byte) error {
@@ -261,56 +360,294 @@ func (m *GetCARequest) GetCertType() protos.CertType {
 	return protos.CertType_DEFAULT
 }
 
+type RevokeCertificateRequest struct {
+	Sn                   *protos.Certificate_SN `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	Reason               RevocationReason       `protobuf:"varint,2,opt,name=reason,proto3,enum=magma.orc8r.certifier.RevocationReason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *RevokeCertificateRequest) Reset()         { *m = RevokeCertificateRequest{} }
+func (m *RevokeCertificateRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeCertificateRequest) ProtoMessage()    {}
+func (*RevokeCertificateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{6}
+}
+
+func (m *RevokeCertificateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeCertificateRequest.Unmarshal(m, b)
+}
+func (m *RevokeCertificateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeCertificateRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeCertificateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeCertificateRequest.Merge(m, src)
+}
+func (m *RevokeCertificateRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeCertificateRequest.Size(m)
+}
+func (m *RevokeCertificateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeCertificateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeCertificateRequest proto.InternalMessageInfo
+
+func (m *RevokeCertificateRequest) GetSn() *protos.Certificate_SN {
+	if m != nil {
+		return m.Sn
+	}
+	return nil
+}
+
+func (m *RevokeCertificateRequest) GetReason() RevocationReason {
+	if m != nil {
+		return m.Reason
+	}
+	return RevocationReason_UNSPECIFIED
+}
+
+type GetCRLRequest struct {
+	CertType             protos.CertType `protobuf:"varint,1,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCRLRequest) Reset()         { *m = GetCRLRequest{} }
+func (m *GetCRLRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCRLRequest) ProtoMessage()    {}
+func (*GetCRLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{7}
+}
+
+func (m *GetCRLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCRLRequest.Unmarshal(m, b)
+}
+func (m *GetCRLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCRLRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCRLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCRLRequest.Merge(m, src)
+}
+func (m *GetCRLRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCRLRequest.Size(m)
+}
+func (m *GetCRLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCRLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCRLRequest proto.InternalMessageInfo
+
+func (m *GetCRLRequest) GetCertType() protos.CertType {
+	if m != nil {
+		return m.CertType
+	}
+	return protos.CertType_DEFAULT
+}
+
+type CRL struct {
+	CrlDer               []byte   `protobuf:"bytes,1,opt,name=crl_der,json=crlDer,proto3" json:"crl_der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CRL) Reset()         { *m = CRL{} }
+func (m *CRL) String() string { return proto.CompactTextString(m) }
+func (*CRL) ProtoMessage()    {}
+func (*CRL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{8}
+}
+
+func (m *CRL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CRL.Unmarshal(m, b)
+}
+func (m *CRL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CRL.Marshal(b, m, deterministic)
+}
+func (m *CRL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CRL.Merge(m, src)
+}
+func (m *CRL) XXX_Size() int {
+	return xxx_messageInfo_CRL.Size(m)
+}
+func (m *CRL) XXX_DiscardUnknown() {
+	xxx_messageInfo_CRL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CRL proto.InternalMessageInfo
+
+func (m *CRL) GetCrlDer() []byte {
+	if m != nil {
+		return m.CrlDer
+	}
+	return nil
+}
+
+type OCSPRequest struct {
+	RequestDer           []byte   `protobuf:"bytes,1,opt,name=request_der,json=requestDer,proto3" json:"request_der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OCSPRequest) Reset()         { *m = OCSPRequest{} }
+func (m *OCSPRequest) String() string { return proto.CompactTextString(m) }
+func (*OCSPRequest) ProtoMessage()    {}
+func (*OCSPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{9}
+}
+
+func (m *OCSPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OCSPRequest.Unmarshal(m, b)
+}
+func (m *OCSPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OCSPRequest.Marshal(b, m, deterministic)
+}
+func (m *OCSPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCSPRequest.Merge(m, src)
+}
+func (m *OCSPRequest) XXX_Size() int {
+	return xxx_messageInfo_OCSPRequest.Size(m)
+}
+func (m *OCSPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCSPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCSPRequest proto.InternalMessageInfo
+
+func (m *OCSPRequest) GetRequestDer() []byte {
+	if m != nil {
+		return m.RequestDer
+	}
+	return nil
+}
+
+type OCSPResponse struct {
+	ResponseDer          []byte   `protobuf:"bytes,1,opt,name=response_der,json=responseDer,proto3" json:"response_der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OCSPResponse) Reset()         { *m = OCSPResponse{} }
+func (m *OCSPResponse) String() string { return proto.CompactTextString(m) }
+func (*OCSPResponse) ProtoMessage()    {}
+func (*OCSPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0037205171c15011, []int{10}
+}
+
+func (m *OCSPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OCSPResponse.Unmarshal(m, b)
+}
+func (m *OCSPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OCSPResponse.Marshal(b, m, deterministic)
+}
+func (m *OCSPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCSPResponse.Merge(m, src)
+}
+func (m *OCSPResponse) XXX_Size() int {
+	return xxx_messageInfo_OCSPResponse.Size(m)
+}
+func (m *OCSPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCSPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCSPResponse proto.InternalMessageInfo
+
+func (m *OCSPResponse) GetResponseDer() []byte {
+	if m != nil {
+		return m.ResponseDer
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("magma.orc8r.certifier.RevocationReason", RevocationReason_name, RevocationReason_value)
 	proto.RegisterType((*CertificateInfo)(nil), "magma.orc8r.certifier.CertificateInfo")
 	proto.RegisterType((*CertificateInfoMap)(nil), "magma.orc8r.certifier.CertificateInfoMap")
 	proto.RegisterMapType((map[string]*CertificateInfo)(nil), "magma.orc8r.certifier.CertificateInfoMap.CertificatesEntry")
 	proto.RegisterType((*AddCertRequest)(nil), "magma.orc8r.certifier.AddCertRequest")
 	proto.RegisterType((*SerialNumbers)(nil), "magma.orc8r.certifier.SerialNumbers")
+	proto.RegisterType((*GetExpiringCertificatesRequest)(nil), "magma.orc8r.certifier.GetExpiringCertificatesRequest")
 	proto.RegisterType((*GetCARequest)(nil), "magma.orc8r.certifier.GetCARequest")
+	proto.RegisterType((*RevokeCertificateRequest)(nil), "magma.orc8r.certifier.RevokeCertificateRequest")
+	proto.RegisterType((*GetCRLRequest)(nil), "magma.orc8r.certifier.GetCRLRequest")
+	proto.RegisterType((*CRL)(nil), "magma.orc8r.certifier.CRL")
+	proto.RegisterType((*OCSPRequest)(nil), "magma.orc8r.certifier.OCSPRequest")
+	proto.RegisterType((*OCSPResponse)(nil), "magma.orc8r.certifier.OCSPResponse")
 }
 
-func init() { proto.RegisterFile("certifier.proto", fileDescriptor_515f9a7ba5ef1ab9) }
-
-var fileDescriptor_515f9a7ba5ef1ab9 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x8e, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x90, 0x15, 0x0e, 0xc8, 0xc2, 0x98, 0x4d, 0xba, 0x5d, 0x13, 0xb1, 0xba, 0x06,
-	0x6f, 0x4a, 0x82, 0x17, 0xe2, 0x9f, 0x1b, 0xc0, 0x15, 0x37, 0xd9, 0xdd, 0xc4, 0x42, 0xbc, 0xf0,
-	0x86, 0x94, 0xf6, 0xd0, 0x4c, 0xb6, 0xed, 0xe0, 0x74, 0xd8, 0x84, 0x17, 0xf0, 0x4d, 0x7c, 0x2d,
-	0xe3, 0xa3, 0x98, 0xfe, 0x5b, 0x29, 0x14, 0xed, 0x15, 0x33, 0x73, 0xbe, 0xf9, 0x7d, 0x67, 0xbe,
-	0x19, 0x0a, 0xc7, 0x16, 0x72, 0x41, 0x97, 0x14, 0xb9, 0xbe, 0xe2, 0x4c, 0x30, 0x72, 0xe2, 0x99,
-	0x8e, 0x67, 0xea, 0x8c, 0x5b, 0x03, 0xae, 0xdf, 0x17, 0xd5, 0x27, 0xd1, 0x42, 0x2f, 0xd2, 0x04,
-	0xbd, 0x9d, 0x4d, 0xea, 0x69, 0xb6, 0xca, 0x3c, 0x8f, 0xf9, 0x49, 0xe9, 0x2c, 0x53, 0xa2, 0x36,
-	0xfa, 0x82, 0x8a, 0x4d, 0x52, 0x7c, 0xea, 0x30, 0xe6, 0xb8, 0x18, 0x57, 0x17, 0xeb, 0x65, 0x4f,
-	0x50, 0x0f, 0x03, 0x61, 0x7a, 0xab, 0x58, 0xa0, 0xfd, 0x96, 0xe1, 0x78, 0x1c, 0x9b, 0x59, 0xa6,
-	0xc0, 0x4b, 0x7f, 0xc9, 0xc8, 0x39, 0x94, 0xa8, 0xad, 0xc8, 0x1d, 0xb9, 0x5b, 0xef, 0x9f, 0xe8,
-	0xdb, 0xed, 0x5e, 0x26, 0x74, 0xa3, 0x44, 0x6d, 0xf2, 0x16, 0xc0, 0x67, 0x62, 0xbe, 0xc0, 0x25,
-	0xe3, 0xa8, 0x94, 0x22, 0xb9, 0xaa, 0xc7, 0x86, 0x7a, 0x6a, 0xa8, 0xcf, 0x52, 0x43, 0xa3, 0xe6,
-	0x33, 0x31, 0x8a, 0xc4, 0xe4, 0x0d, 0x84, 0x93, 0xb9, 0xb9, 0x14, 0xc8, 0x95, 0xf2, 0x7f, 0x77,
-	0x56, 0x7d, 0x26, 0x86, 0xa1, 0x96, 0xf4, 0xa1, 0x16, 0x46, 0x33, 0x17, 0x9b, 0x15, 0x2a, 0x0f,
-	0x3a, 0x72, 0xb7, 0xb9, 0xd3, 0x61, 0x78, 0x96, 0xd9, 0x66, 0x85, 0x46, 0xd5, 0x4a, 0x46, 0xda,
-	0x2f, 0x19, 0xc8, 0xce, 0x11, 0xaf, 0xcd, 0x15, 0x99, 0x43, 0xc3, 0xfa, 0xbb, 0x1a, 0x28, 0x72,
-	0xa7, 0xdc, 0xad, 0xf7, 0xdf, 0xeb, 0xb9, 0xd7, 0xa3, 0xef, 0x03, 0xb6, 0x97, 0x82, 0x0b, 0x5f,
-	0xf0, 0x8d, 0x91, 0x01, 0xaa, 0x0e, 0xb4, 0xf7, 0x24, 0xa4, 0x05, 0xe5, 0x5b, 0xdc, 0x44, 0xe1,
-	0xd6, 0x8c, 0x70, 0x48, 0x3e, 0x40, 0xe5, 0xce, 0x74, 0xd7, 0x69, 0x82, 0x2f, 0x8b, 0x35, 0x60,
-	0xc4, 0x9b, 0xde, 0x95, 0x06, 0xb2, 0xf6, 0x43, 0x86, 0xe6, 0xd0, 0xb6, 0x43, 0x85, 0x81, 0xdf,
-	0xd7, 0x18, 0x88, 0xa2, 0x57, 0x78, 0x0a, 0x51, 0x4c, 0x73, 0x1b, 0x79, 0x64, 0xdf, 0x30, 0x1e,
-	0x86, 0xf3, 0x8f, 0xbb, 0x49, 0x97, 0x8b, 0x25, 0xfd, 0x0c, 0x1e, 0x4d, 0x91, 0x53, 0xd3, 0xbd,
-	0x59, 0x7b, 0x0b, 0xe4, 0x41, 0x78, 0xda, 0xc0, 0x8f, 0xa3, 0xad, 0x19, 0xe1, 0x50, 0x1b, 0x41,
-	0x63, 0x82, 0x62, 0x3c, 0x4c, 0x1b, 0xcd, 0xd8, 0xc8, 0x85, 0x6c, 0xfa, 0x3f, 0x2b, 0x50, 0x1b,
-	0xa7, 0xc1, 0x90, 0x31, 0x54, 0x22, 0x22, 0x79, 0x7e, 0x20, 0xb9, 0x6d, 0x3f, 0xf5, 0x71, 0x16,
-	0x3e, 0x0c, 0x39, 0x9a, 0x44, 0x46, 0x40, 0xa6, 0xd4, 0xf1, 0x93, 0x14, 0x93, 0x9c, 0x49, 0x2b,
-	0x2b, 0x9e, 0x1a, 0xaa, 0xb2, 0xd7, 0x5b, 0xa2, 0xd5, 0x24, 0x32, 0x83, 0xfa, 0x04, 0x45, 0x9a,
-	0x2f, 0x39, 0x3b, 0x24, 0xd5, 0xa7, 0x37, 0x6a, 0xc1, 0x5b, 0xd6, 0x24, 0x72, 0x01, 0x6d, 0x03,
-	0xef, 0xd8, 0x2d, 0x6e, 0x37, 0xf6, 0x4f, 0x76, 0x3b, 0x53, 0xfc, 0xca, 0xa8, 0xad, 0x49, 0xe4,
-	0xea, 0xfe, 0x89, 0xa4, 0x8c, 0xf3, 0x03, 0x2d, 0x64, 0x5f, 0x52, 0x3e, 0xed, 0x0b, 0xb4, 0x3e,
-	0x51, 0x7f, 0x1b, 0x17, 0x90, 0xfc, 0x67, 0xa6, 0xbe, 0x38, 0x60, 0x93, 0x79, 0x28, 0x9a, 0x44,
-	0xae, 0xa1, 0x75, 0x45, 0x03, 0x91, 0x41, 0xee, 0x7b, 0x17, 0xc6, 0x7d, 0x86, 0xa3, 0x09, 0x8a,
-	0xa1, 0xeb, 0xe6, 0x41, 0x5e, 0x15, 0xfe, 0x93, 0x6b, 0x12, 0x19, 0x40, 0x73, 0xcc, 0x5c, 0x17,
-	0x2d, 0x31, 0x31, 0xf9, 0xc2, 0x74, 0x30, 0x8f, 0x98, 0x97, 0xd2, 0xa8, 0xfa, 0xed, 0x28, 0xfe,
-	0x2a, 0x2f, 0xe2, 0xdf, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xfc, 0xb5, 0x0d,
-	0x06, 0x00, 0x00,
+func init() {
+	proto.RegisterFile("orc8r/cloud/go/services/certifier/protos/certifier.proto", fileDescriptor_0037205171c15011)
+}
+
+var fileDescriptor_0037205171c15011 = []byte{
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x16, 0xa5, 0x58, 0xb1, 0x46, 0xb6, 0x4c, 0x6f, 0x90, 0xdf, 0x32, 0xfd, 0xc3, 0x71, 0x98,
+	0xa6, 0x75, 0x5b, 0x80, 0x42, 0x5c, 0x14, 0x75, 0x0f, 0x40, 0x41, 0x53, 0x94, 0x4c, 0x54, 0x96,
+	0xd4, 0xa5, 0x12, 0xa3, 0x45, 0x01, 0x82, 0x22, 0x57, 0x0a, 0x11, 0x8a, 0x54, 0x97, 0x2b, 0xa7,
+	0xba, 0xe8, 0x6d, 0x51, 0xa0, 0x2f, 0xd2, 0xa7, 0xea, 0x3b, 0xf4, 0x0d, 0x0a, 0x9e, 0x5c, 0x52,
+	0xa7, 0x28, 0xe8, 0x95, 0x76, 0x77, 0xbe, 0xf9, 0x66, 0x76, 0xbe, 0x99, 0xa5, 0xe0, 0xd2, 0xa7,
+	0xd6, 0x25, 0x6d, 0x58, 0xae, 0x3f, 0xb3, 0x1b, 0x63, 0xbf, 0x11, 0x10, 0x7a, 0xe7, 0x58, 0x24,
+	0x68, 0x58, 0x84, 0x32, 0x67, 0xe4, 0x10, 0xda, 0x98, 0x52, 0x9f, 0xf9, 0x99, 0x03, 0x29, 0x3a,
+	0x40, 0x8f, 0x27, 0xe6, 0x78, 0x62, 0x4a, 0x91, 0xbf, 0x74, 0x6f, 0x14, 0xfe, 0x1f, 0x13, 0xae,
+	0x76, 0x12, 0x8e, 0xf3, 0x56, 0x7f, 0x32, 0xf1, 0xbd, 0xc4, 0x74, 0x92, 0x33, 0x39, 0x36, 0xf1,
+	0x98, 0xc3, 0xe6, 0x89, 0xf1, 0x74, 0xec, 0xfb, 0x63, 0x97, 0xc4, 0xd6, 0xe1, 0x6c, 0xd4, 0xb0,
+	0x67, 0xd4, 0x64, 0xce, 0xbd, 0xf3, 0x93, 0x45, 0x3b, 0x73, 0x26, 0x24, 0x60, 0xe6, 0x64, 0x1a,
+	0x03, 0xc4, 0xbf, 0x8b, 0x70, 0xa0, 0xc4, 0xc9, 0x58, 0x26, 0x23, 0x9a, 0x37, 0xf2, 0xd1, 0x73,
+	0x28, 0x3a, 0x76, 0x9d, 0x3b, 0xe3, 0xce, 0xab, 0x17, 0x8f, 0xa5, 0xec, 0x75, 0xb4, 0x24, 0x3a,
+	0x2e, 0x3a, 0x36, 0xfa, 0x12, 0xc0, 0xf3, 0x99, 0x31, 0x24, 0x23, 0x9f, 0x92, 0x7a, 0x31, 0x82,
+	0x0b, 0x52, 0x1c, 0x50, 0x4a, 0x03, 0x4a, 0x83, 0x34, 0x20, 0xae, 0x78, 0x3e, 0xbb, 0x8a, 0xc0,
+	0xe8, 0x0b, 0x08, 0x37, 0x86, 0x39, 0x62, 0x84, 0xd6, 0x4b, 0xef, 0xf4, 0xdc, 0xf5, 0x7c, 0x26,
+	0x87, 0x58, 0x74, 0x01, 0x95, 0xb0, 0x74, 0x06, 0x9b, 0x4f, 0x49, 0xfd, 0xc1, 0x19, 0x77, 0x5e,
+	0x5b, 0xc8, 0x30, 0xbc, 0xcb, 0x60, 0x3e, 0x25, 0x78, 0xd7, 0x4a, 0x56, 0x61, 0x9e, 0x94, 0xdc,
+	0xf9, 0x6f, 0x88, 0x6d, 0x98, 0xac, 0xbe, 0xf3, 0xee, 0x3c, 0x13, 0xb4, 0xcc, 0xd0, 0x00, 0x0e,
+	0xc3, 0x8d, 0x15, 0x95, 0xd4, 0xa0, 0xc4, 0x0c, 0x7c, 0xaf, 0x5e, 0x8e, 0xc2, 0x7e, 0x24, 0xad,
+	0xd4, 0x59, 0xc2, 0xf7, 0x78, 0x1c, 0xc1, 0x31, 0x4f, 0x17, 0x4e, 0xc4, 0xbf, 0x38, 0x40, 0x0b,
+	0x35, 0xbf, 0x31, 0xa7, 0xc8, 0x80, 0x3d, 0xeb, 0xdf, 0xd3, 0xa0, 0xce, 0x9d, 0x95, 0xce, 0xab,
+	0x17, 0x5f, 0xaf, 0x89, 0xb3, 0x4c, 0x90, 0x3d, 0x0a, 0x54, 0x8f, 0xd1, 0x39, 0xce, 0x11, 0x0a,
+	0x63, 0x38, 0x5c, 0x82, 0x20, 0x1e, 0x4a, 0x6f, 0xc8, 0x3c, 0x52, 0xbb, 0x82, 0xc3, 0x25, 0xfa,
+	0x06, 0x76, 0xee, 0x4c, 0x77, 0x96, 0x4a, 0xfa, 0xe1, 0x76, 0x09, 0xe0, 0xd8, 0xe9, 0xab, 0xe2,
+	0x25, 0x27, 0xfe, 0xc6, 0x41, 0x4d, 0xb6, 0xed, 0x10, 0x81, 0xc9, 0xcf, 0x33, 0x12, 0xb0, 0x6d,
+	0x7b, 0xea, 0x18, 0x22, 0xdd, 0x0c, 0x9b, 0xd0, 0x28, 0xfc, 0x1e, 0x7e, 0x18, 0xee, 0x9b, 0x8b,
+	0xd2, 0x97, 0xb6, 0x92, 0x5e, 0x7c, 0x0a, 0xfb, 0x3a, 0xa1, 0x8e, 0xe9, 0x76, 0x67, 0x93, 0x21,
+	0xa1, 0x41, 0x78, 0xdb, 0xc0, 0x8b, 0x4b, 0x5b, 0xc1, 0xe1, 0x52, 0xd4, 0xe1, 0xb4, 0x4d, 0x98,
+	0xfa, 0xcb, 0xd4, 0xa1, 0x8e, 0x37, 0xce, 0xd6, 0x27, 0x4d, 0xfd, 0x05, 0x94, 0xdf, 0x3a, 0xec,
+	0xb5, 0xe3, 0x25, 0xe9, 0x1f, 0x2f, 0xf5, 0x4e, 0x33, 0x19, 0x3a, 0x9c, 0x00, 0xc5, 0x2b, 0xd8,
+	0x6b, 0x13, 0xa6, 0xc8, 0x29, 0x45, 0x2e, 0x77, 0x6e, 0xbb, 0xdc, 0x7f, 0xe7, 0xa0, 0x8e, 0xa3,
+	0x4e, 0xcc, 0x24, 0x95, 0x12, 0x7e, 0x0a, 0xc5, 0x20, 0xcd, 0xe7, 0x64, 0x89, 0x29, 0x01, 0x4b,
+	0x7a, 0x17, 0x17, 0x03, 0x0f, 0x7d, 0x0b, 0xe5, 0xa4, 0x75, 0x8b, 0xef, 0xd7, 0xba, 0x89, 0x9b,
+	0xa8, 0xc0, 0x7e, 0x78, 0x1d, 0xdc, 0xf9, 0x2f, 0xf7, 0x39, 0x85, 0x92, 0x82, 0x3b, 0xe8, 0x08,
+	0x1e, 0x5a, 0xd4, 0x8d, 0x04, 0xe6, 0x22, 0x81, 0xcb, 0x16, 0x75, 0x9b, 0x84, 0x8a, 0x12, 0x54,
+	0x7b, 0x8a, 0xde, 0x4f, 0x43, 0x3c, 0x81, 0x2a, 0x8d, 0x97, 0x19, 0x2c, 0x24, 0x47, 0x21, 0xfe,
+	0x05, 0xec, 0xc5, 0xf8, 0x60, 0xea, 0x7b, 0x01, 0x41, 0x4f, 0x61, 0x8f, 0x26, 0xeb, 0x8c, 0x47,
+	0x35, 0x3d, 0x6b, 0x12, 0xfa, 0xc9, 0x9f, 0x1c, 0xf0, 0x8b, 0x97, 0x44, 0x07, 0x50, 0x7d, 0xd9,
+	0xd5, 0xfb, 0xaa, 0xa2, 0xb5, 0x34, 0xb5, 0xc9, 0x17, 0x10, 0x82, 0xda, 0x77, 0xea, 0x0f, 0x86,
+	0xd2, 0xbb, 0xe9, 0xe3, 0xde, 0x8d, 0xa6, 0xab, 0x3c, 0x87, 0x0e, 0x61, 0x5f, 0x91, 0xb3, 0x47,
+	0x45, 0x74, 0x04, 0x8f, 0xe4, 0x56, 0x4b, 0xeb, 0x68, 0xf2, 0x40, 0xeb, 0x75, 0x0d, 0xe5, 0x5a,
+	0xee, 0xb6, 0xd5, 0x26, 0x5f, 0x42, 0x35, 0x00, 0xfd, 0x65, 0x5f, 0xc5, 0xba, 0xda, 0x54, 0x9b,
+	0xfc, 0x03, 0x24, 0xc0, 0xff, 0x14, 0x55, 0xd7, 0x63, 0x58, 0xaf, 0x65, 0xf4, 0xfa, 0x2a, 0x8e,
+	0x36, 0xfc, 0x4e, 0x48, 0xd2, 0xc7, 0xda, 0x2b, 0xad, 0xa3, 0xb6, 0x55, 0xe3, 0x56, 0x1b, 0x5c,
+	0x37, 0xb1, 0x7c, 0xdb, 0xe5, 0x2b, 0x17, 0x7f, 0x54, 0xa0, 0xa2, 0xa4, 0xca, 0x20, 0x05, 0x76,
+	0xa2, 0x7e, 0x42, 0xcf, 0xd6, 0x48, 0x97, 0xed, 0x36, 0xe1, 0x51, 0x5e, 0x0a, 0x39, 0xe4, 0x11,
+	0x0b, 0xe8, 0x0a, 0x90, 0xee, 0x8c, 0xbd, 0x64, 0x30, 0x93, 0x1e, 0x41, 0x7c, 0x1e, 0xac, 0x63,
+	0xa1, 0xbe, 0xae, 0x9f, 0xc4, 0x02, 0x1a, 0x40, 0xb5, 0x4d, 0x58, 0x3a, 0xb2, 0x68, 0x53, 0xeb,
+	0x09, 0x5b, 0x3e, 0x1c, 0x62, 0x01, 0xa9, 0x70, 0xb8, 0xd4, 0xe9, 0x9b, 0xb9, 0x0f, 0x73, 0xc6,
+	0x57, 0xbe, 0x63, 0x8b, 0x05, 0x64, 0xc1, 0xc9, 0x12, 0xcd, 0xad, 0xc3, 0x5e, 0x27, 0x42, 0x37,
+	0x36, 0xb4, 0xfd, 0xaa, 0x21, 0x5b, 0x1d, 0xa4, 0x03, 0xe5, 0x78, 0x16, 0xd0, 0x07, 0x1b, 0xb4,
+	0xb8, 0x1f, 0x15, 0x41, 0x58, 0x57, 0x05, 0xdc, 0x11, 0x0b, 0xe8, 0x27, 0x38, 0x68, 0x13, 0x96,
+	0xeb, 0x63, 0x71, 0x8d, 0x43, 0x66, 0x38, 0x84, 0x67, 0x1b, 0x31, 0x31, 0x51, 0x94, 0x6b, 0x6d,
+	0x41, 0xed, 0xe7, 0x6b, 0x1c, 0xf3, 0xaf, 0xf5, 0xea, 0x9b, 0x7f, 0x0f, 0x7c, 0xcb, 0xf1, 0xb2,
+	0x74, 0x01, 0x5a, 0xfd, 0x94, 0x0b, 0xeb, 0x4a, 0x93, 0x7b, 0x8c, 0xc5, 0x02, 0xba, 0x01, 0xbe,
+	0xe3, 0x04, 0x2c, 0x47, 0xb9, 0x1c, 0x7b, 0x6b, 0xba, 0xeb, 0x48, 0x1b, 0xd9, 0x75, 0x57, 0x91,
+	0x7c, 0xbc, 0xf5, 0x87, 0x54, 0x2c, 0xa0, 0x5f, 0xe1, 0x68, 0xcd, 0x57, 0x01, 0x7d, 0xbe, 0x5e,
+	0xf6, 0x0d, 0x5f, 0x91, 0xf7, 0x0b, 0x3f, 0x08, 0xdf, 0x29, 0x8f, 0xbc, 0xcd, 0x4a, 0x97, 0x2f,
+	0xc2, 0xa2, 0x39, 0x0d, 0xb3, 0x69, 0x78, 0x2f, 0xa1, 0xa6, 0xf8, 0xae, 0x4b, 0x2c, 0xd6, 0x36,
+	0xe9, 0xd0, 0x1c, 0x93, 0x55, 0x65, 0x5a, 0x25, 0xfd, 0xd5, 0xee, 0x8f, 0xe5, 0xf8, 0xff, 0xe7,
+	0x30, 0xfe, 0xfd, 0xec, 0x9f, 0x01, 0x00, 0x73, 0xc8, 0xc8, 0x07, 0x20, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,8 +674,20 @@ type CertifierClient interface {
 	GetIdentity(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*CertificateInfo, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
+	// Revoked certificates are kept until garbage collected so they can be
+	// published in the CRL and reported by the OCSP responder.
 	//
 	RevokeCertificate(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*protos.Void, error)
+	// Revoke an existing certificate with the given revocation reason.
+	//
+	RevokeCertificateWithReason(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the latest signed CRL for the requested CA.
+	//
+	GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error)
+	// Returns a signed OCSP response for the given OCSP request.
+	// Malformed or unknown requests are answered with an OCSP error response.
+	//
+	GetOCSPResponse(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
 	AddCertificate(ctx context.Context, in *AddCertRequest, opts ...grpc.CallOption) (*protos.Void, error)
@@ -349,6 +698,14 @@ type CertifierClient interface {
 	ListCertificates(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*CertificateInfoMap, error)
+	// Returns the latest unrevoked certificate of every identity whose latest
+	// certificate expires within the requested window
+	GetExpiringCertificates(ctx context.Context, in *GetExpiringCertificatesRequest, opts ...grpc.CallOption) (*CertificateInfoMap, error)
+	// Signs a rotated certificate for the identity of a currently valid,
+	// unrevoked gateway certificate, given proof of possession of its key.
+	// Returns signed certificate.
+	//
+	RenewCertificate(ctx context.Context, in *protos.RenewCertificateRequest, opts ...grpc.CallOption) (*protos.Certificate, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error)
@@ -398,6 +755,33 @@ func (c *certifierClient) RevokeCertificate(ctx context.Context, in *protos.Cert
 	return out, nil
 }
 
+func (c *certifierClient) RevokeCertificateWithReason(ctx context.Context, in *RevokeCertificateRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/RevokeCertificateWithReason", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certifierClient) GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error) {
+	out := new(CRL)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certifierClient) GetOCSPResponse(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error) {
+	out := new(OCSPResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetOCSPResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) AddCertificate(ctx context.Context, in *AddCertRequest, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/AddCertificate", in, out, opts...)
@@ -434,6 +818,24 @@ func (c *certifierClient) GetAll(ctx context.Context, in *protos.Void, opts ...g
 	return out, nil
 }
 
+func (c *certifierClient) GetExpiringCertificates(ctx context.Context, in *GetExpiringCertificatesRequest, opts ...grpc.CallOption) (*CertificateInfoMap, error) {
+	out := new(CertificateInfoMap)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetExpiringCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certifierClient) RenewCertificate(ctx context.Context, in *protos.RenewCertificateRequest, opts ...grpc.CallOption) (*protos.Certificate, error) {
+	out := new(protos.Certificate)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/RenewCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/CollectGarbage", in, out, opts...)
@@ -457,8 +859,20 @@ type CertifierServer interface {
 	GetIdentity(context.Context, *protos.Certificate_SN) (*CertificateInfo, error)
 	// Revoke an existing certificate.
 	// If the certificate does not exist or is expired, this request is ignored.
+	// Revoked certificates are kept until garbage collected so they can be
+	// published in the CRL and reported by the OCSP responder.
 	//
 	RevokeCertificate(context.Context, *protos.Certificate_SN) (*protos.Void, error)
+	// Revoke an existing certificate with the given revocation reason.
+	//
+	RevokeCertificateWithReason(context.Context, *RevokeCertificateRequest) (*protos.Void, error)
+	// Returns the latest signed CRL for the requested CA.
+	//
+	GetCRL(context.Context, *GetCRLRequest) (*CRL, error)
+	// Returns a signed OCSP response for the given OCSP request.
+	// Malformed or unknown requests are answered with an OCSP error response.
+	//
+	GetOCSPResponse(context.Context, *OCSPRequest) (*OCSPResponse, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
 	AddCertificate(context.Context, *AddCertRequest) (*protos.Void, error)
@@ -469,6 +883,14 @@ type CertifierServer interface {
 	ListCertificates(context.Context, *protos.Void) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(context.Context, *protos.Void) (*CertificateInfoMap, error)
+	// Returns the latest unrevoked certificate of every identity whose latest
+	// certificate expires within the requested window
+	GetExpiringCertificates(context.Context, *GetExpiringCertificatesRequest) (*CertificateInfoMap, error)
+	// Signs a rotated certificate for the identity of a currently valid,
+	// unrevoked gateway certificate, given proof of possession of its key.
+	// Returns signed certificate.
+	//
+	RenewCertificate(context.Context, *protos.RenewCertificateRequest) (*protos.Certificate, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(context.Context, *protos.Void) (*protos.Void, error)
@@ -490,6 +912,15 @@ func (*UnimplementedCertifierServer) GetIdentity(ctx context.Context, req *proto
 func (*UnimplementedCertifierServer) RevokeCertificate(ctx context.Context, req *protos.Certificate_SN) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificate not implemented")
 }
+func (*UnimplementedCertifierServer) RevokeCertificateWithReason(ctx context.Context, req *RevokeCertificateRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCertificateWithReason not implemented")
+}
+func (*UnimplementedCertifierServer) GetCRL(ctx context.Context, req *GetCRLRequest) (*CRL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCRL not implemented")
+}
+func (*UnimplementedCertifierServer) GetOCSPResponse(ctx context.Context, req *OCSPRequest) (*OCSPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOCSPResponse not implemented")
+}
 func (*UnimplementedCertifierServer) AddCertificate(ctx context.Context, req *AddCertRequest) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCertificate not implemented")
 }
@@ -502,6 +933,12 @@ func (*UnimplementedCertifierServer) ListCertificates(ctx context.Context, req *
 func (*UnimplementedCertifierServer) GetAll(ctx context.Context, req *protos.Void) (*CertificateInfoMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
+func (*UnimplementedCertifierServer) GetExpiringCertificates(ctx context.Context, req *GetExpiringCertificatesRequest) (*CertificateInfoMap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiringCertificates not implemented")
+}
+func (*UnimplementedCertifierServer) RenewCertificate(ctx context.Context, req *protos.RenewCertificateRequest) (*protos.Certificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
 func (*UnimplementedCertifierServer) CollectGarbage(ctx context.Context, req *protos.Void) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
 }
@@ -582,6 +1019,60 @@ func _Certifier_RevokeCertificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_RevokeCertificateWithReason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).RevokeCertificateWithReason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/RevokeCertificateWithReason",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).RevokeCertificateWithReason(ctx, req.(*RevokeCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certifier_GetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetCRL(ctx, req.(*GetCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certifier_GetOCSPResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OCSPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetOCSPResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetOCSPResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetOCSPResponse(ctx, req.(*OCSPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_AddCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCertRequest)
 	if err := dec(in); err != nil {
@@ -654,6 +1145,42 @@ func _Certifier_GetAll_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_GetExpiringCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpiringCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetExpiringCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetExpiringCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetExpiringCertificates(ctx, req.(*GetExpiringCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certifier_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.RenewCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).RenewCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/RenewCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).RenewCertificate(ctx, req.(*protos.RenewCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.Void)
 	if err := dec(in); err != nil {
@@ -692,6 +1219,18 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeCertificate",
 			Handler:    _Certifier_RevokeCertificate_Handler,
 		},
+		{
+			MethodName: "RevokeCertificateWithReason",
+			Handler:    _Certifier_RevokeCertificateWithReason_Handler,
+		},
+		{
+			MethodName: "GetCRL",
+			Handler:    _Certifier_GetCRL_Handler,
+		},
+		{
+			MethodName: "GetOCSPResponse",
+			Handler:    _Certifier_GetOCSPResponse_Handler,
+		},
 		{
 			MethodName: "AddCertificate",
 			Handler:    _Certifier_AddCertificate_Handler,
@@ -708,11 +1247,19 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _Certifier_GetAll_Handler,
 		},
+		{
+			MethodName: "GetExpiringCertificates",
+			Handler:    _Certifier_GetExpiringCertificates_Handler,
+		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Certifier_RenewCertificate_Handler,
+		},
 		{
 			MethodName: "CollectGarbage",
 			Handler:    _Certifier_CollectGarbage_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "certifier.proto",
+	Metadata: "orc8r/cloud/go/services/certifier/protos/certifier.proto",
 }
@@ -0,0 +1,97 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package responder serves certifier's CRLs and OCSP responder over plain
+// HTTP, so that standard TLS stacks can check the revocation status of
+// certifier issued certificates.
+package responder
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	CRLPath     = "/crl/:cert_type"
+	OCSPPath    = "/ocsp"
+	OCSPGetPath = "/ocsp/*"
+
+	MIMEApplicationPKIXCRL      = "application/pkix-crl"
+	MIMEApplicationOCSPRequest  = "application/ocsp-request"
+	MIMEApplicationOCSPResponse = "application/ocsp-response"
+
+	// maxOCSPRequestSize bounds the size of POSTed OCSP requests, which are
+	// a few hundred bytes for a single certificate
+	maxOCSPRequestSize = 64 * 1024
+)
+
+// RegisterHandlers registers the CRL and OCSP endpoints on the given server
+func RegisterHandlers(e *echo.Echo) {
+	e.GET(CRLPath, getCRL)
+	e.POST(OCSPPath, postOCSPRequest)
+	e.GET(OCSPGetPath, getOCSPRequest)
+}
+
+// getCRL serves the DER encoded CRL of the CA named by the cert_type path
+// parameter, e.g. /crl/default or /crl/vpn
+func getCRL(c echo.Context) error {
+	certTypeName := strings.TrimSuffix(strings.ToUpper(c.Param("cert_type")), ".CRL")
+	certType, ok := protos.CertType_value[certTypeName]
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("unknown cert type %s", c.Param("cert_type")))
+	}
+	crl, err := certifier.GetCRL(protos.CertType(certType))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.Blob(http.StatusOK, MIMEApplicationPKIXCRL, crl)
+}
+
+// postOCSPRequest handles OCSP requests sent as the body of a POST
+// (RFC 6960 appendix A.1)
+func postOCSPRequest(c echo.Context) error {
+	if c.Request().Header.Get(echo.HeaderContentType) != MIMEApplicationOCSPRequest {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, fmt.Sprintf("expected content type %s", MIMEApplicationOCSPRequest))
+	}
+	requestDER, err := ioutil.ReadAll(http.MaxBytesReader(c.Response(), c.Request().Body, maxOCSPRequestSize))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return respondOCSP(c, requestDER)
+}
+
+// getOCSPRequest handles OCSP requests sent as the URL-encoded base64 of the
+// DER encoded request appended to the path of a GET (RFC 6960 appendix A.1)
+func getOCSPRequest(c echo.Context) error {
+	encoded, err := url.PathUnescape(c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	requestDER, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("OCSP request must be base64 encoded: %s", err))
+	}
+	return respondOCSP(c, requestDER)
+}
+
+func respondOCSP(c echo.Context, requestDER []byte) error {
+	response, err := certifier.GetOCSPResponse(requestDER)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.Blob(http.StatusOK, MIMEApplicationOCSPResponse, response)
+}
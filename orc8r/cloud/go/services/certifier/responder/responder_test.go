@@ -0,0 +1,97 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package responder_test
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+	"magma/orc8r/cloud/go/services/certifier/responder"
+	"magma/orc8r/cloud/go/services/certifier/test_init"
+	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestResponder(t *testing.T) {
+	test_init.StartTestService(t)
+	e := echo.New()
+	responder.RegisterHandlers(e)
+
+	csrMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24), "cn", "cn")
+	assert.NoError(t, err)
+	certMsg, err := certifier.SignCSR(csrMsg)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certMsg.CertDer)
+	assert.NoError(t, err)
+	caMsg, err := certifier.GetCACert(&certprotos.GetCARequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	ca, err := x509.ParseCertificate(caMsg.Cert)
+	assert.NoError(t, err)
+	assert.NoError(t, certifier.RevokeCertificateSN(certMsg.Sn.Sn))
+
+	// CRL
+	rec := serve(e, httptest.NewRequest(http.MethodGet, "/crl/default", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, responder.MIMEApplicationPKIXCRL, rec.Header().Get(echo.HeaderContentType))
+	crl, err := x509.ParseCRL(rec.Body.Bytes())
+	assert.NoError(t, err)
+	assert.NoError(t, ca.CheckCRLSignature(crl))
+	if assert.Len(t, crl.TBSCertList.RevokedCertificates, 1) {
+		assert.Equal(t, cert.SerialNumber, crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+	}
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/crl/vpn.crl", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/crl/foo", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// OCSP over POST
+	reqDER, err := ocsp.CreateRequest(cert, ca, nil)
+	assert.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/ocsp", bytes.NewReader(reqDER))
+	req.Header.Set(echo.HeaderContentType, responder.MIMEApplicationOCSPRequest)
+	rec = serve(e, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, responder.MIMEApplicationOCSPResponse, rec.Header().Get(echo.HeaderContentType))
+	res, err := ocsp.ParseResponseForCert(rec.Body.Bytes(), cert, ca)
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, res.Status)
+
+	req = httptest.NewRequest(http.MethodPost, "/ocsp", bytes.NewReader(reqDER))
+	rec = serve(e, req)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	// OCSP over GET
+	encoded := url.PathEscape(base64.StdEncoding.EncodeToString(reqDER))
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/ocsp/"+encoded, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	res, err = ocsp.ParseResponseForCert(rec.Body.Bytes(), cert, ca)
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, res.Status)
+
+	rec = serve(e, httptest.NewRequest(http.MethodGet, "/ocsp/!!!", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func serve(e *echo.Echo, req *http.Request) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
@@ -9,11 +9,13 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/x509"
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"magma/orc8r/cloud/go/clock"
@@ -41,14 +43,28 @@ func init() {
 	CollectGarbageAfter = time.Duration(time.Hour * 24)
 }
 
+// clockSkewAllowance is how far back signed certificates' notBefore is set
+const clockSkewAllowance = time.Hour
+
+// CAInfo holds a CA certificate and the signer of its private key, which
+// may live in process memory, a PKCS#11 token or an external KMS
+// (see security/signer). CRLDistributionPoints and OCSPServers are the URLs
+// at which the revocation status of the certificates signed by the CA is
+// published, they're embedded in the signed certificates if set.
 type CAInfo struct {
-	Cert    *x509.Certificate
-	PrivKey interface{}
+	Cert   *x509.Certificate
+	Signer crypto.Signer
+
+	CRLDistributionPoints []string
+	OCSPServers           []string
 }
 
 type CertifierServer struct {
 	store datastore.Api
 	CAs   map[protos.CertType]*CAInfo
+
+	crlLock sync.RWMutex
+	crls    map[protos.CertType]*signedCRL
 }
 
 func NewCertifierServer(store datastore.Api, CAs map[protos.CertType]*CAInfo) (srv *CertifierServer, err error) {
@@ -61,6 +77,7 @@ func NewCertifierServer(store datastore.Api, CAs map[protos.CertType]*CAInfo) (s
 		return nil, fmt.Errorf("No Certificates are provided to certifier")
 	}
 	srv.CAs = CAs
+	srv.crls = map[protos.CertType]*signedCRL{}
 	return srv, nil
 }
 
@@ -109,11 +126,11 @@ func (srv *CertifierServer) signCSR(
 		return nil, time.Time{}, time.Time{}, fmt.Errorf("No CA found for given cert type: %s", certType.String())
 	}
 	signingCert := ca.Cert
-	signingKey := ca.PrivKey
+	signingKey := ca.Signer
 
 	now := clock.Now().UTC()
 	// Provide a cert from an hour ago to account for clock skews
-	notBefore := now.Add(-1 * time.Hour)
+	notBefore := now.Add(-1 * clockSkewAllowance)
 	notAfter := now.Add(validTime)
 	if notAfter.After(signingCert.NotAfter) {
 		glog.Warningln("The requested time is longer than signing certificate valid time.")
@@ -127,6 +144,8 @@ func (srv *CertifierServer) signCSR(
 		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
+		CRLDistributionPoints: ca.CRLDistributionPoints,
+		OCSPServer:            ca.OCSPServers,
 	}
 
 	clientCertDER, err := x509.CreateCertificate(
@@ -293,6 +312,10 @@ func (srv *CertifierServer) GetIdentity(
 	}
 	certInfo := &certprotos.CertificateInfo{}
 	proto.Unmarshal(marshalledCertInfo, certInfo)
+	if certInfo.RevokedAt != nil {
+		return &certprotos.CertificateInfo{}, status.Errorf(codes.PermissionDenied,
+			"Certificate with serial number '%s' has been revoked", certSN)
+	}
 
 	// check timestamp
 	notBefore, _ := ptypes.Timestamp(certInfo.NotBefore)
@@ -312,19 +335,7 @@ func (srv *CertifierServer) GetIdentity(
 func (srv *CertifierServer) RevokeCertificate(
 	ctx context.Context, snMsg *protos.Certificate_SN) (*protos.Void, error) {
 
-	var certSN string
-	if snMsg != nil {
-		certSN = strings.TrimLeft(snMsg.Sn, "0")
-	}
-	_, _, err := srv.store.Get(CERTIFICATE_INFO_TABLE, certSN)
-	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
-	}
-	err = srv.store.Delete(CERTIFICATE_INFO_TABLE, certSN)
-	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "Failed to delete certificate: %s", err)
-	}
-	return &protos.Void{}, nil
+	return srv.RevokeCertificateWithReason(ctx, &certprotos.RevokeCertificateRequest{Sn: snMsg})
 }
 
 func (srv *CertifierServer) AddCertificate(ctx context.Context, req *certprotos.AddCertRequest) (*protos.Void, error) {
@@ -374,8 +385,8 @@ func (srv *CertifierServer) AddCertificate(ctx context.Context, req *certprotos.
 	return res, nil
 }
 
-// Finds & returns Serial Numbers of all Certificates associated with the
-// given Identity
+// Finds & returns Serial Numbers of all unrevoked Certificates associated
+// with the given Identity
 func (srv *CertifierServer) FindCertificates(ctx context.Context, id *protos.Identity) (*certprotos.SerialNumbers, error) {
 
 	res := &certprotos.SerialNumbers{}
@@ -390,7 +401,7 @@ func (srv *CertifierServer) FindCertificates(ctx context.Context, id *protos.Ide
 			if err != nil {
 				return res, err
 			}
-			if certInfo != nil && certInfo.Id.HashString() == idKey {
+			if certInfo != nil && certInfo.RevokedAt == nil && certInfo.Id.HashString() == idKey {
 				res.Sns = append(res.Sns, sn)
 			}
 		}
@@ -10,10 +10,14 @@ package servicers_test
 
 import (
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
 	"testing"
 	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/protos"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/test_utils"
@@ -21,7 +25,10 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCertifier(t *testing.T) {
@@ -34,7 +41,7 @@ func TestCertifier(t *testing.T) {
 
 	// just test with default
 	caMap := map[protos.CertType]*servicers.CAInfo{
-		protos.CertType_DEFAULT: {caCert, caKey},
+		protos.CertType_DEFAULT: {Cert: caCert, Signer: caKey},
 	}
 	srv, err := servicers.NewCertifierServer(ds, caMap)
 	assert.NoError(t, err)
@@ -85,11 +92,12 @@ func TestCertifier(t *testing.T) {
 		_, err = srv.SignAddCertificate(ctx, csrMsg)
 		assert.NoError(t, err)
 	}
+	// revoked certificates are kept until they expire
 	allSns, _ := ds.ListKeys(servicers.CERTIFICATE_INFO_TABLE)
-	assert.Equal(t, 3, len(allSns))
+	assert.Equal(t, 5, len(allSns))
 	srv.CollectGarbage(ctx, nil)
 	allSns, _ = ds.ListKeys(servicers.CERTIFICATE_INFO_TABLE)
-	assert.Equal(t, 0, len(allSns))
+	assert.Equal(t, 1, len(allSns))
 
 	// test csr longer than cert
 	csrMsg, err = certifier_test_utils.CreateCSR(time.Duration(time.Hour*24*100), "cn", "cn")
@@ -116,3 +124,281 @@ func TestCertifier(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, cert.Subject.CommonName, *csrMsg.Id.ToCommonName())
 }
+
+func TestRevocation(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	ctx := context.Background()
+
+	defaultCert, defaultKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	vpnCert, vpnKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {
+			Cert:                  defaultCert,
+			Signer:                defaultKey,
+			CRLDistributionPoints: []string{"http://certifier:8089/crl/default"},
+			OCSPServers:           []string{"http://certifier:8089/ocsp"},
+		},
+		protos.CertType_VPN: {Cert: vpnCert, Signer: vpnKey},
+	}
+	srv, err := servicers.NewCertifierServer(ds, caMap)
+	assert.NoError(t, err)
+
+	operator := protos.NewOperatorIdentity("cn")
+	csrMsg, err := certifier_test_utils.CreateCSRForId(time.Duration(time.Hour*24), operator)
+	assert.NoError(t, err)
+	revokedMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	goodMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	revokedCert, err := x509.ParseCertificate(revokedMsg.CertDer)
+	assert.NoError(t, err)
+	goodCert, err := x509.ParseCertificate(goodMsg.CertDer)
+	assert.NoError(t, err)
+	// signed certificates point to the CRL and OCSP responder of their CA
+	assert.Equal(t, []string{"http://certifier:8089/crl/default"}, goodCert.CRLDistributionPoints)
+	assert.Equal(t, []string{"http://certifier:8089/ocsp"}, goodCert.OCSPServer)
+
+	// empty CRL before any revocation, on this and on another replica
+	clock.SetAndFreezeClock(t, time.Now())
+	crl := getCRL(t, srv, protos.CertType_DEFAULT, defaultCert)
+	assert.Empty(t, crl.TBSCertList.RevokedCertificates)
+	replica, err := servicers.NewCertifierServer(ds, caMap)
+	assert.NoError(t, err)
+	crl = getCRL(t, replica, protos.CertType_DEFAULT, defaultCert)
+	assert.Empty(t, crl.TBSCertList.RevokedCertificates)
+
+	// revoke with reason
+	_, err = srv.RevokeCertificateWithReason(ctx, &certprotos.RevokeCertificateRequest{
+		Sn:     revokedMsg.Sn,
+		Reason: certprotos.RevocationReason_KEY_COMPROMISE,
+	})
+	assert.NoError(t, err)
+	_, err = srv.GetIdentity(ctx, revokedMsg.Sn)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.GetIdentity(ctx, goodMsg.Sn)
+	assert.NoError(t, err)
+
+	// revoked certificates are kept, but not associated with the identity
+	allSns, _ := ds.ListKeys(servicers.CERTIFICATE_INFO_TABLE)
+	assert.Equal(t, 2, len(allSns))
+	sns, err := srv.FindCertificates(ctx, operator)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{goodMsg.Sn.Sn}, sns.Sns)
+
+	// revoking again keeps the original reason
+	_, err = srv.RevokeCertificate(ctx, revokedMsg.Sn)
+	assert.NoError(t, err)
+	_, err = srv.RevokeCertificate(ctx, &protos.Certificate_SN{Sn: "1234"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// CRL of the issuing CA lists the revoked certificate
+	crl = getCRL(t, srv, protos.CertType_DEFAULT, defaultCert)
+	if assert.Len(t, crl.TBSCertList.RevokedCertificates, 1) {
+		entry := crl.TBSCertList.RevokedCertificates[0]
+		assert.Equal(t, revokedCert.SerialNumber, entry.SerialNumber)
+		if assert.Len(t, entry.Extensions, 1) {
+			var reason asn1.Enumerated
+			_, err = asn1.Unmarshal(entry.Extensions[0].Value, &reason)
+			assert.NoError(t, err)
+			assert.Equal(t, asn1.Enumerated(ocsp.KeyCompromise), reason)
+		}
+	}
+	crl = getCRL(t, srv, protos.CertType_VPN, vpnCert)
+	assert.Empty(t, crl.TBSCertList.RevokedCertificates)
+
+	// the other replica publishes the revocation once its cached CRL expires
+	crl = getCRL(t, replica, protos.CertType_DEFAULT, defaultCert)
+	assert.Empty(t, crl.TBSCertList.RevokedCertificates)
+	clock.SetAndFreezeClock(t, clock.Now().Add(servicers.CRLCacheTTL))
+	crl = getCRL(t, replica, protos.CertType_DEFAULT, defaultCert)
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+	clock.UnfreezeClock(t)
+
+	_, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType(42)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// OCSP
+	res := getOCSPResponse(t, srv, goodCert, defaultCert)
+	assert.Equal(t, ocsp.Good, res.Status)
+	res = getOCSPResponse(t, srv, revokedCert, defaultCert)
+	assert.Equal(t, ocsp.Revoked, res.Status)
+	assert.Equal(t, ocsp.KeyCompromise, res.RevocationReason)
+	// certificate not issued by the VPN CA
+	res = getOCSPResponse(t, srv, goodCert, vpnCert)
+	assert.Equal(t, ocsp.Unknown, res.Status)
+
+	ocspRes, err := srv.GetOCSPResponse(ctx, &certprotos.OCSPRequest{RequestDer: []byte("foo")})
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.MalformedRequestErrorResponse, ocspRes.ResponseDer)
+	unknownCA, _, err := certifier_test_utils.CreateSignedCertAndPrivKey(time.Hour)
+	assert.NoError(t, err)
+	reqDER, err := ocsp.CreateRequest(goodCert, unknownCA, nil)
+	assert.NoError(t, err)
+	ocspRes, err = srv.GetOCSPResponse(ctx, &certprotos.OCSPRequest{RequestDer: reqDER})
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.UnauthorizedErrorResponse, ocspRes.ResponseDer)
+
+	// garbage collection drops expired revoked certificates from the CRL
+	csrMsg, err = certifier_test_utils.CreateCSRForId(0, operator)
+	assert.NoError(t, err)
+	expiredMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	_, err = srv.RevokeCertificate(ctx, expiredMsg.Sn)
+	assert.NoError(t, err)
+	crl = getCRL(t, srv, protos.CertType_DEFAULT, defaultCert)
+	assert.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+	servicers.CollectGarbageAfter = time.Duration(0)
+	_, err = srv.CollectGarbage(ctx, &protos.Void{})
+	assert.NoError(t, err)
+	allSns, _ = ds.ListKeys(servicers.CERTIFICATE_INFO_TABLE)
+	assert.Equal(t, 2, len(allSns))
+}
+
+func getCRL(t *testing.T, srv *servicers.CertifierServer, certType protos.CertType, ca *x509.Certificate) *pkix.CertificateList {
+	crlMsg, err := srv.GetCRL(context.Background(), &certprotos.GetCRLRequest{CertType: certType})
+	assert.NoError(t, err)
+	crl, err := x509.ParseCRL(crlMsg.CrlDer)
+	assert.NoError(t, err)
+	assert.NoError(t, ca.CheckCRLSignature(crl))
+	return crl
+}
+
+func getOCSPResponse(t *testing.T, srv *servicers.CertifierServer, cert *x509.Certificate, issuer *x509.Certificate) *ocsp.Response {
+	reqDER, err := ocsp.CreateRequest(cert, issuer, nil)
+	assert.NoError(t, err)
+	resMsg, err := srv.GetOCSPResponse(context.Background(), &certprotos.OCSPRequest{RequestDer: reqDER})
+	assert.NoError(t, err)
+	res, err := ocsp.ParseResponseForCert(resMsg.ResponseDer, cert, issuer)
+	assert.NoError(t, err)
+	return res
+}
+
+func TestRenewCertificate(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	ctx := context.Background()
+
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	srv, err := servicers.NewCertifierServer(ds, map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {Cert: caCert, Signer: caKey},
+	})
+	assert.NoError(t, err)
+
+	gw := protos.NewGatewayIdentity("hw1", "", "")
+	csrMsg, gwKey, err := certifier_test_utils.CreateCSRAndKeyForId(time.Hour*4, gw)
+	assert.NoError(t, err)
+	currentMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+
+	// renew, requested lifetime is capped to the current one
+	req, newKey, err := certifier_test_utils.CreateRenewalRequest(currentMsg.CertDer, gwKey, time.Hour*24, gw)
+	assert.NoError(t, err)
+	renewedMsg, err := srv.RenewCertificate(ctx, req)
+	assert.NoError(t, err)
+	renewedCert, err := x509.ParseCertificate(renewedMsg.CertDer)
+	assert.NoError(t, err)
+	assert.Equal(t, "hw1", renewedCert.Subject.CommonName)
+	assert.Equal(t, time.Hour*5, renewedCert.NotAfter.Sub(renewedCert.NotBefore))
+	certInfo, err := srv.GetIdentity(ctx, renewedMsg.Sn)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(gw, certInfo.Id))
+	// the current certificate stays valid until it expires
+	_, err = srv.GetIdentity(ctx, currentMsg.Sn)
+	assert.NoError(t, err)
+
+	// the renewed certificate can be renewed in turn
+	req, _, err = certifier_test_utils.CreateRenewalRequest(renewedMsg.CertDer, newKey, time.Hour, gw)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.NoError(t, err)
+
+	// CSR not signed by the current certificate's key
+	req, _, err = certifier_test_utils.CreateRenewalRequest(currentMsg.CertDer, newKey, time.Hour, gw)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// CSR for another identity
+	req, _, err = certifier_test_utils.CreateRenewalRequest(currentMsg.CertDer, gwKey, time.Hour, protos.NewGatewayIdentity("hw2", "", ""))
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// revoked certificate
+	_, err = srv.RevokeCertificate(ctx, currentMsg.Sn)
+	assert.NoError(t, err)
+	req, _, err = certifier_test_utils.CreateRenewalRequest(currentMsg.CertDer, gwKey, time.Hour, gw)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// operator certificate
+	operator := protos.NewOperatorIdentity("op1")
+	csrMsg, operKey, err := certifier_test_utils.CreateCSRAndKeyForId(time.Hour*4, operator)
+	assert.NoError(t, err)
+	operMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	req, _, err = certifier_test_utils.CreateRenewalRequest(operMsg.CertDer, operKey, time.Hour, operator)
+	assert.NoError(t, err)
+	_, err = srv.RenewCertificate(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// certificate not issued by certifier
+	_, err = srv.RenewCertificate(ctx, &protos.RenewCertificateRequest{CertDer: caCert.Raw, Csr: csrMsg})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestGetExpiringCertificates(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	ctx := context.Background()
+
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	srv, err := servicers.NewCertifierServer(ds, map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {Cert: caCert, Signer: caKey},
+	})
+	assert.NoError(t, err)
+
+	signCert := func(validTime time.Duration, id *protos.Identity) string {
+		csrMsg, err := certifier_test_utils.CreateCSRForId(validTime, id)
+		assert.NoError(t, err)
+		certMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+		assert.NoError(t, err)
+		return certMsg.Sn.Sn
+	}
+	// gw1 was rotated, only its previous certificate expires soon
+	signCert(time.Hour, protos.NewGatewayIdentity("gw1", "", ""))
+	signCert(time.Hour*48, protos.NewGatewayIdentity("gw1", "", ""))
+	// gw2 has not been rotated
+	signCert(time.Hour, protos.NewGatewayIdentity("gw2", "", ""))
+	gw2SN := signCert(time.Hour*2, protos.NewGatewayIdentity("gw2", "", ""))
+	// gw3's latest certificate is revoked
+	gw3SN := signCert(time.Hour, protos.NewGatewayIdentity("gw3", "", ""))
+	revokedSN := signCert(time.Hour*2, protos.NewGatewayIdentity("gw3", "", ""))
+	_, err = srv.RevokeCertificate(ctx, &protos.Certificate_SN{Sn: revokedSN})
+	assert.NoError(t, err)
+	// op1 has a long lived certificate
+	opSN := signCert(time.Hour*24*5, protos.NewOperatorIdentity("op1"))
+	// gw4's certificate has already expired
+	signCert(0, protos.NewGatewayIdentity("gw4", "", ""))
+
+	actual, err := srv.GetExpiringCertificates(ctx, &certprotos.GetExpiringCertificatesRequest{Within: ptypes.DurationProto(time.Hour * 24)})
+	assert.NoError(t, err)
+	assert.Len(t, actual.Certificates, 2)
+	assert.Contains(t, actual.Certificates, gw2SN)
+	assert.Contains(t, actual.Certificates, gw3SN)
+
+	actual, err = srv.GetExpiringCertificates(ctx, &certprotos.GetExpiringCertificatesRequest{Within: ptypes.DurationProto(time.Hour * 24 * 7)})
+	assert.NoError(t, err)
+	assert.Len(t, actual.Certificates, 4)
+	assert.Contains(t, actual.Certificates, opSN)
+
+	_, err = srv.GetExpiringCertificates(ctx, &certprotos.GetExpiringCertificatesRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,266 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	CRLValidity  time.Duration // nextUpdate of published CRLs
+	OCSPValidity time.Duration // nextUpdate of OCSP responses
+	// CRLCacheTTL is how long a CRL is served from memory before it's
+	// regenerated. Revocations only drop the cached CRL of the replica which
+	// handled them, so this bounds how stale the other replicas' CRLs are.
+	CRLCacheTTL time.Duration
+)
+
+func init() {
+	CRLValidity = time.Duration(time.Hour * 24)
+	OCSPValidity = time.Duration(time.Hour)
+	CRLCacheTTL = time.Duration(time.Minute)
+}
+
+// oidExtensionReasonCode is the CRL entry extension holding the
+// revocation reason (RFC 5280 section 5.3.1)
+var oidExtensionReasonCode = asn1.ObjectIdentifier{2, 5, 29, 21}
+
+type signedCRL struct {
+	der         []byte
+	cachedUntil time.Time
+}
+
+func (srv *CertifierServer) RevokeCertificateWithReason(
+	ctx context.Context, req *certprotos.RevokeCertificateRequest) (*protos.Void, error) {
+
+	certSN := strings.TrimLeft(req.GetSn().GetSn(), "0")
+	certInfo, err := srv.getCertInfo(certSN)
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if certInfo.RevokedAt != nil {
+		return &protos.Void{}, nil
+	}
+	certInfo.RevokedAt, err = ptypes.TimestampProto(clock.Now().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create revocation timestamp: %s", err)
+	}
+	certInfo.RevocationReason = req.GetReason()
+	marshaledCertInfo, err := proto.Marshal(certInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Marshalling error in CertificateInfo: %s", err)
+	}
+	err = srv.store.Put(CERTIFICATE_INFO_TABLE, certSN, marshaledCertInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Failed to revoke certificate: %s", err)
+	}
+
+	// Drop the cached CRL so the revocation is published on the next fetch
+	// from this replica, other replicas pick it up within CRLCacheTTL
+	srv.crlLock.Lock()
+	delete(srv.crls, certInfo.CertType)
+	srv.crlLock.Unlock()
+	return &protos.Void{}, nil
+}
+
+// GetCRL returns the cached CRL of the requested CA, regenerating it if it
+// is missing or was cached more than CRLCacheTTL ago
+func (srv *CertifierServer) GetCRL(ctx context.Context, req *certprotos.GetCRLRequest) (*certprotos.CRL, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid CRL request")
+	}
+	srv.crlLock.RLock()
+	crl, ok := srv.crls[req.CertType]
+	srv.crlLock.RUnlock()
+	if ok && clock.Now().Before(crl.cachedUntil) {
+		return &certprotos.CRL{CrlDer: crl.der}, nil
+	}
+
+	crl, err := srv.updateCRL(req.CertType)
+	if err != nil {
+		return nil, err
+	}
+	return &certprotos.CRL{CrlDer: crl.der}, nil
+}
+
+// UpdateCRLs regenerates and caches the CRLs of all configured CAs
+func (srv *CertifierServer) UpdateCRLs() error {
+	var errs []string
+	for certType := range srv.CAs {
+		if _, err := srv.updateCRL(certType); err != nil {
+			errs = append(errs, fmt.Sprintf("%s -> %v", certType.String(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("Failed to update CRL[s]: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (srv *CertifierServer) updateCRL(certType protos.CertType) (*signedCRL, error) {
+	ca, ok := srv.CAs[certType]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "No CA found for given cert type: %s", certType.String())
+	}
+	revoked, err := srv.getRevokedCertificates(certType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now().UTC()
+	nextUpdate := now.Add(CRLValidity)
+	der, err := ca.Cert.CreateCRL(rand.Reader, ca.Signer, revoked, now, nextUpdate)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to sign CRL: %s", err)
+	}
+	cachedUntil := now.Add(CRLCacheTTL)
+	if cachedUntil.After(nextUpdate) {
+		cachedUntil = nextUpdate
+	}
+	crl := &signedCRL{der: der, cachedUntil: cachedUntil}
+
+	srv.crlLock.Lock()
+	srv.crls[certType] = crl
+	srv.crlLock.Unlock()
+	glog.V(2).Infof("Published CRL for %s with %d revoked certificates", certType.String(), len(revoked))
+	return crl, nil
+}
+
+// getRevokedCertificates returns CRL entries for all revoked, unexpired
+// certificates issued by the CA of the given cert type
+func (srv *CertifierServer) getRevokedCertificates(certType protos.CertType) ([]pkix.RevokedCertificate, error) {
+	allCerts, err := srv.GetAll(context.Background(), &protos.Void{})
+	if err != nil {
+		return nil, err
+	}
+	now := clock.Now().UTC()
+	revoked := []pkix.RevokedCertificate{}
+	for sn, certInfo := range allCerts.Certificates {
+		if certInfo.RevokedAt == nil || certInfo.CertType != certType {
+			continue
+		}
+		notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+		if now.After(notAfter) {
+			continue
+		}
+		serial, ok := new(big.Int).SetString(sn, 16)
+		if !ok {
+			glog.Errorf("Skipping certificate with malformed serial number %s in CRL", sn)
+			continue
+		}
+		revokedAt, _ := ptypes.Timestamp(certInfo.RevokedAt)
+		entry := pkix.RevokedCertificate{SerialNumber: serial, RevocationTime: revokedAt}
+		// RFC 5280 recommends omitting the reason code rather than using unspecified
+		if certInfo.RevocationReason != certprotos.RevocationReason_UNSPECIFIED {
+			reason, err := asn1.Marshal(asn1.Enumerated(certInfo.RevocationReason))
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Failed to marshal revocation reason: %s", err)
+			}
+			entry.Extensions = []pkix.Extension{{Id: oidExtensionReasonCode, Value: reason}}
+		}
+		revoked = append(revoked, entry)
+	}
+	return revoked, nil
+}
+
+// GetOCSPResponse answers an OCSP request with a response signed by the CA
+// which issued the requested certificate. Protocol level failures are
+// reported as OCSP error responses rather than RPC errors, so the result can
+// be relayed to the OCSP client as is.
+func (srv *CertifierServer) GetOCSPResponse(ctx context.Context, req *certprotos.OCSPRequest) (*certprotos.OCSPResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid OCSP request")
+	}
+	ocspReq, err := ocsp.ParseRequest(req.RequestDer)
+	if err != nil {
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.MalformedRequestErrorResponse}, nil
+	}
+	certType, ca, err := srv.findIssuer(ocspReq)
+	if err != nil {
+		glog.V(2).Infof("Rejecting OCSP request: %s", err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.UnauthorizedErrorResponse}, nil
+	}
+
+	now := clock.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: ocspReq.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(OCSPValidity),
+	}
+	certInfo, err := srv.getCertInfo(cert.SerialToString(ocspReq.SerialNumber))
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		glog.Errorf("Failed to load certificate for OCSP request: %s", err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.InternalErrorErrorResponse}, nil
+	case certInfo.CertType != certType:
+	case certInfo.RevokedAt != nil:
+		template.Status = ocsp.Revoked
+		template.RevokedAt, _ = ptypes.Timestamp(certInfo.RevokedAt)
+		template.RevocationReason = int(certInfo.RevocationReason)
+	default:
+		template.Status = ocsp.Good
+	}
+
+	der, err := ocsp.CreateResponse(ca.Cert, ca.Cert, template, ca.Signer)
+	if err != nil {
+		glog.Errorf("Failed to sign OCSP response: %s", err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.InternalErrorErrorResponse}, nil
+	}
+	return &certprotos.OCSPResponse{ResponseDer: der}, nil
+}
+
+// findIssuer returns the CA matching the issuer name and key hashes of the
+// OCSP request
+func (srv *CertifierServer) findIssuer(ocspReq *ocsp.Request) (protos.CertType, *CAInfo, error) {
+	if !ocspReq.HashAlgorithm.Available() {
+		return 0, nil, fmt.Errorf("unsupported hash algorithm %v", ocspReq.HashAlgorithm)
+	}
+	for certType, ca := range srv.CAs {
+		var spki struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}
+		if _, err := asn1.Unmarshal(ca.Cert.RawSubjectPublicKeyInfo, &spki); err != nil {
+			return 0, nil, fmt.Errorf("failed to parse public key of %s CA: %s", certType.String(), err)
+		}
+		nameHash := ocspReq.HashAlgorithm.New()
+		nameHash.Write(ca.Cert.RawSubject)
+		keyHash := ocspReq.HashAlgorithm.New()
+		keyHash.Write(spki.PublicKey.RightAlign())
+		if string(nameHash.Sum(nil)) == string(ocspReq.IssuerNameHash) &&
+			string(keyHash.Sum(nil)) == string(ocspReq.IssuerKeyHash) {
+			return certType, ca, nil
+		}
+	}
+	return 0, nil, fmt.Errorf("no CA matches issuer of certificate %s", cert.SerialToString(ocspReq.SerialNumber))
+}
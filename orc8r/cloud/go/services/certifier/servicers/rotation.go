@@ -0,0 +1,131 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetExpiringCertificates returns the latest unrevoked, unexpired certificate
+// of every identity for which it expires within the requested window.
+// Identities which have already been issued a rotated certificate are not
+// reported even though their previous certificate is about to expire.
+func (srv *CertifierServer) GetExpiringCertificates(
+	ctx context.Context, req *certprotos.GetExpiringCertificatesRequest) (*certprotos.CertificateInfoMap, error) {
+
+	if req == nil || req.Within == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Expiration window must be provided")
+	}
+	within, err := ptypes.Duration(req.Within)
+	if err != nil || within < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid expiration window: %v", req.Within)
+	}
+	allCerts, err := srv.GetAll(ctx, &protos.Void{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now().UTC()
+	latestSNs := map[string]string{}
+	latestNotAfters := map[string]time.Time{}
+	for sn, certInfo := range allCerts.Certificates {
+		if certInfo.RevokedAt != nil || certInfo.Id == nil {
+			continue
+		}
+		notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+		if !notAfter.After(now) {
+			continue
+		}
+		idKey := certInfo.CertType.String() + certInfo.Id.HashString()
+		if latest, ok := latestNotAfters[idKey]; !ok || notAfter.After(latest) {
+			latestSNs[idKey] = sn
+			latestNotAfters[idKey] = notAfter
+		}
+	}
+
+	res := &certprotos.CertificateInfoMap{Certificates: map[string]*certprotos.CertificateInfo{}}
+	deadline := now.Add(within)
+	for idKey, sn := range latestSNs {
+		if latestNotAfters[idKey].Before(deadline) {
+			res.Certificates[sn] = allCerts.Certificates[sn]
+		}
+	}
+	return res, nil
+}
+
+// RenewCertificate signs a rotated certificate for the gateway holding a
+// currently valid certificate. The gateway proves possession of the current
+// certificate's private key by signing the new CSR with it, so the renewal
+// can bypass the bootstrap challenge. The rotated certificate's lifetime
+// can not exceed the lifetime of the current one.
+func (srv *CertifierServer) RenewCertificate(
+	ctx context.Context, req *protos.RenewCertificateRequest) (*protos.Certificate, error) {
+
+	if req == nil || req.Csr == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Current certificate and CSR must be provided")
+	}
+	currentCert, err := x509.ParseCertificate(req.CertDer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to parse current certificate: %s", err)
+	}
+	if err = srv.verifyCert(currentCert, protos.CertType_DEFAULT); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "%s", err)
+	}
+	// GetIdentity rejects unknown, revoked and expired certificates
+	certInfo, err := srv.GetIdentity(ctx, &protos.Certificate_SN{Sn: cert.SerialToString(currentCert.SerialNumber)})
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "Current certificate is not valid: %s", status.Convert(err).Message())
+	}
+	if !identity.IsGateway(certInfo.Id) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only gateway certificates can be renewed")
+	}
+	if err = checkProofOfPossession(currentCert, req.Csr.CsrDer, req.Signature); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "Failed to verify CSR signature: %s", err)
+	}
+	if req.Csr.Id != nil && !proto.Equal(req.Csr.Id, certInfo.Id) {
+		return nil, status.Errorf(codes.PermissionDenied, "CSR identity does not match current certificate")
+	}
+
+	csrMsg := proto.Clone(req.Csr).(*protos.CSR)
+	csrMsg.Id = certInfo.Id
+	csrMsg.CertType = protos.CertType_DEFAULT
+	maxValidTime := currentCert.NotAfter.Sub(currentCert.NotBefore) - clockSkewAllowance
+	validTime, err := ptypes.Duration(csrMsg.ValidTime)
+	if err != nil || validTime > maxValidTime {
+		csrMsg.ValidTime = ptypes.DurationProto(maxValidTime)
+	}
+	return srv.SignAddCertificate(ctx, csrMsg)
+}
+
+func checkProofOfPossession(currentCert *x509.Certificate, csrDER []byte, signature []byte) error {
+	var algo x509.SignatureAlgorithm
+	switch currentCert.PublicKeyAlgorithm {
+	case x509.RSA:
+		algo = x509.SHA256WithRSA
+	case x509.ECDSA:
+		algo = x509.ECDSAWithSHA256
+	default:
+		return fmt.Errorf("unsupported public key algorithm %s", currentCert.PublicKeyAlgorithm)
+	}
+	return currentCert.CheckSignature(algo, csrDER, signature)
+}
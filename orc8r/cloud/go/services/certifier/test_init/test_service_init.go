@@ -29,7 +29,7 @@ func StartTestService(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create bootstrap certifier certificate: %s", err)
 	} else {
-		caMap[protos.CertType_DEFAULT] = &servicers.CAInfo{bootstrapCert, bootstrapKey}
+		caMap[protos.CertType_DEFAULT] = &servicers.CAInfo{Cert: bootstrapCert, Signer: bootstrapKey}
 	}
 
 	vpnCert, vpnKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
@@ -37,7 +37,7 @@ func StartTestService(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Failed to create VPN certifier certificate: %s", err)
 	} else {
-		caMap[protos.CertType_VPN] = &servicers.CAInfo{vpnCert, vpnKey}
+		caMap[protos.CertType_VPN] = &servicers.CAInfo{Cert: vpnCert, Signer: vpnKey}
 	}
 	certServer, err := servicers.NewCertifierServer(test_utils.GetMockDatastoreInstance(), caMap)
 	if err != nil {
@@ -9,7 +9,9 @@ LICENSE file in the root directory of this source tree.
 package test_utils
 
 import (
+	"crypto"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
@@ -39,11 +41,47 @@ func CreateCSRForId(validTime time.Duration, id *protos.Identity) (*protos.CSR,
 	return createCSR(validTime, *cn, id)
 }
 
+// CreateCSRAndKeyForId returns a CSR for the given identity along with the
+// private key it was created with
+func CreateCSRAndKeyForId(validTime time.Duration, id *protos.Identity) (*protos.CSR, interface{}, error) {
+	priv, err := key.GenerateKey("", 2048)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to create key: %s", err)
+	}
+	csr, err := createCSRWithKey(validTime, *id.ToCommonName(), id, priv)
+	return csr, priv, err
+}
+
+// CreateRenewalRequest returns a request to renew the certificate certDER,
+// signed with its private key certKey, along with the private key of the
+// rotated certificate
+func CreateRenewalRequest(
+	certDER []byte,
+	certKey interface{},
+	validTime time.Duration,
+	id *protos.Identity,
+) (*protos.RenewCertificateRequest, interface{}, error) {
+	csr, priv, err := CreateCSRAndKeyForId(validTime, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	digest := sha256.Sum256(csr.CsrDer)
+	signature, err := certKey.(crypto.Signer).Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to sign CSR: %s", err)
+	}
+	return &protos.RenewCertificateRequest{CertDer: certDER, Csr: csr, Signature: signature}, priv, nil
+}
+
 func createCSR(validTime time.Duration, cn string, id *protos.Identity) (*protos.CSR, error) {
 	priv, err := key.GenerateKey("", 2048)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to create key: %s", err)
 	}
+	return createCSRWithKey(validTime, cn, id, priv)
+}
+
+func createCSRWithKey(validTime time.Duration, cn string, id *protos.Identity, priv interface{}) (*protos.CSR, error) {
 
 	template := x509.CertificateRequest{
 		Subject: pkix.Name{
@@ -54,6 +92,9 @@ func createCSR(validTime time.Duration, cn string, id *protos.Identity) (*protos
 		},
 	}
 	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &template, priv)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create CSR: %s", err)
+	}
 	csr := &protos.CSR{
 		Id:        id,
 		ValidTime: ptypes.DurationProto(validTime),
@@ -63,7 +104,7 @@ func createCSR(validTime time.Duration, cn string, id *protos.Identity) (*protos
 	return csr, nil
 }
 
-func CreateSignedCertAndPrivKey(validTime time.Duration) (*x509.Certificate, interface{}, error) {
+func CreateSignedCertAndPrivKey(validTime time.Duration) (*x509.Certificate, crypto.Signer, error) {
 	priv, err := key.GenerateKey("", 2048)
 	notBefore := clock.Now().UTC()
 	notAfter := notBefore.Add(validTime)
@@ -89,7 +130,7 @@ func CreateSignedCertAndPrivKey(validTime time.Duration) (*x509.Certificate, int
 			CommonName:         "",
 		},
 		KeyUsage: x509.KeyUsageKeyEncipherment |
-			x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		ExtKeyUsage: []x509.ExtKeyUsage{
 			x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 	}
@@ -103,5 +144,5 @@ func CreateSignedCertAndPrivKey(validTime time.Duration) (*x509.Certificate, int
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed to parse certificate: %s", err)
 	}
-	return cert, priv, nil
+	return cert, priv.(crypto.Signer), nil
 }
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package handlers_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -37,7 +38,7 @@ func TestCheckind(t *testing.T) {
 
 	// create a test network with a single GW
 	networkID := "checkind_obsidian_test_network"
-	err := configurator.CreateNetwork(
+	err := configurator.CreateNetwork(context.Background(),
 		configurator.Network{
 			Name: "Test Network 1",
 			ID:   networkID,
@@ -45,7 +46,7 @@ func TestCheckind(t *testing.T) {
 	)
 	assert.NoError(t, err)
 
-	_, err = configurator.CreateEntity(networkID, configurator.NetworkEntity{
+	_, err = configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
 		Key:        testAgHwId,
 		Type:       "magmad_gateway",
 		PhysicalID: testAgHwId,
@@ -60,7 +61,7 @@ func TestCheckind(t *testing.T) {
 	getGWStatusNoError(t, restPort, networkID, testAgHwId)
 	getGWStatusNotFoundError(t, restPort, networkID)
 
-	err = configurator.DeleteNetwork(networkID)
+	err = configurator.DeleteNetwork(context.Background(), networkID)
 	assert.NoError(t, err)
 }
 
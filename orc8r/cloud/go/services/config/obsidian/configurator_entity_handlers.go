@@ -32,7 +32,7 @@ func configuratorCreateEntityConfig(c echo.Context, networkID string, entityType
 		return obsidian.HttpError(errors.Wrap(err, fmt.Sprintf("Entity %s,%s does not exist in %s", entityType, entityKey, networkID)), http.StatusInternalServerError)
 	}
 	if !entityExists {
-		_, err = configurator.CreateEntity(networkID, configurator.NetworkEntity{
+		_, err = configurator.CreateEntity(c.Request().Context(), networkID, configurator.NetworkEntity{
 			Key:    entityKey,
 			Type:   entityType,
 			Config: config,
@@ -41,7 +41,7 @@ func configuratorCreateEntityConfig(c echo.Context, networkID string, entityType
 			return obsidian.HttpError(errors.Wrap(err, "Failed to create entity"), http.StatusInternalServerError)
 		}
 	} else {
-		err := configurator.CreateOrUpdateEntityConfig(networkID, entityType, entityKey, config)
+		err := configurator.CreateOrUpdateEntityConfig(c.Request().Context(), networkID, entityType, entityKey, config)
 		if err != nil {
 			return obsidian.HttpError(errors.Wrap(err, "Failed to create entity config"), http.StatusInternalServerError)
 		}
@@ -68,7 +68,7 @@ func configuratorUpdateEntityConfig(c echo.Context, networkID string, entityType
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.CreateOrUpdateEntityConfig(networkID, entityType, entityKey, config)
+	err := configurator.CreateOrUpdateEntityConfig(c.Request().Context(), networkID, entityType, entityKey, config)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -76,7 +76,7 @@ func configuratorUpdateEntityConfig(c echo.Context, networkID string, entityType
 }
 
 func configuratorDeleteEntityConfig(c echo.Context, networkID string, entityType string, entityKey string) error {
-	err := configurator.DeleteEntityConfig(networkID, entityType, entityKey)
+	err := configurator.DeleteEntityConfig(c.Request().Context(), networkID, entityType, entityKey)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -9,6 +9,7 @@
 package obsidian_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -32,7 +33,7 @@ func commonSetupEntities(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	test_init.StartTestService(t)
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 }
 
@@ -55,7 +56,7 @@ func TestConfiguratorCreateEntityConfig(t *testing.T) {
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	_, err := configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type: "cfg_entity",
 		Key:  "key",
 	})
@@ -92,7 +93,7 @@ func TestConfiguratorUpdateEntityConfig(t *testing.T) {
 
 func TestConfiguratorDeleteEntityConfig(t *testing.T) {
 	commonSetupEntities(t)
-	_, err := configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type:   "cfg_entity",
 		Key:    "key",
 		Config: &configType{Foo: "foo"},
@@ -130,7 +131,7 @@ func testEntityUpdate(t *testing.T, succConfigType string, errConfigType string)
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	_, err := configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type:   succConfigType,
 		Key:    "key",
 		Config: &configType{Foo: "foo", Bar: "bar"},
@@ -188,7 +189,7 @@ func testGetEntityConfig(t *testing.T, succConfigType string) {
 
 	// Happy path
 	expected := &configType{Foo: "foo", Bar: "bar"}
-	_, err = configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err = configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type:   succConfigType,
 		Key:    "key",
 		Config: expected,
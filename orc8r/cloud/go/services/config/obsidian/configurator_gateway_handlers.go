@@ -40,7 +40,7 @@ func configuratorCreateGatewayConfig(c echo.Context, networkID string, configTyp
 	// access gateway to it
 	// note that this operation is not atomic, so there is a very slim but
 	// nonzero chance that the entity is created without the proper assoc
-	_, err := configurator.CreateEntity(networkID, configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, configurator.NetworkEntity{
 		Type:   configType,
 		Key:    configKey,
 		Config: config,
@@ -49,7 +49,7 @@ func configuratorCreateGatewayConfig(c echo.Context, networkID string, configTyp
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
-	_, err = configurator.UpdateEntity(networkID, configurator.EntityUpdateCriteria{
+	_, err = configurator.UpdateEntity(c.Request().Context(), networkID, configurator.EntityUpdateCriteria{
 		Type:              orc8r.MagmadGatewayType,
 		Key:               configKey,
 		AssociationsToAdd: []storage.TypeAndKey{{Type: configType, Key: configKey}},
@@ -69,7 +69,7 @@ func configuratorDeleteGatewayConfig(c echo.Context, networkID string, configTyp
 		return configuratorDeleteMagmadGatewayConfig(c, networkID, configKey)
 	}
 
-	err := configurator.DeleteEntity(networkID, configType, configKey)
+	err := configurator.DeleteEntity(c.Request().Context(), networkID, configType, configKey)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -88,7 +88,7 @@ func configuratorCreateMagmadGatewayConfig(c echo.Context, networkID string, gat
 		Key:       gatewayID,
 		NewConfig: requestedConfig,
 	}
-	_, err := configurator.UpdateEntities(networkID, []configurator.EntityUpdateCriteria{gwUpdate})
+	_, err := configurator.UpdateEntities(c.Request().Context(), networkID, []configurator.EntityUpdateCriteria{gwUpdate})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -101,7 +101,7 @@ func configuratorDeleteMagmadGatewayConfig(c echo.Context, networkID, gatewayID
 		Key:          gatewayID,
 		DeleteConfig: true,
 	}
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
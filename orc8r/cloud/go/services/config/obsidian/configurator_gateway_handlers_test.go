@@ -9,6 +9,7 @@
 package obsidian_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -32,7 +33,7 @@ func commonSetupGateways(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	test_init.StartTestService(t)
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 
 }
@@ -56,7 +57,7 @@ func TestConfiguratorCreateGatewayConfig(t *testing.T) {
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	_, err := configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type: "magmad_gateway",
 		Key:  "key",
 	})
@@ -93,7 +94,7 @@ func TestConfiguratorUpdateGatewayConfig(t *testing.T) {
 
 func TestConfiguratorDeleteGatewayConfig(t *testing.T) {
 	commonSetupGateways(t)
-	_, err := configurator.CreateEntity("network1", configurator.NetworkEntity{
+	_, err := configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{
 		Type:   "cfg_gateway",
 		Key:    "key",
 		Config: &configType{Foo: "foo"},
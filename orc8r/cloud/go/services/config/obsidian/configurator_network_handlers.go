@@ -43,7 +43,7 @@ func configuratorCreateNetworkConfig(c echo.Context, networkID string, configTyp
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.UpdateNetworkConfig(networkID, configType, config)
+	err := configurator.UpdateNetworkConfig(c.Request().Context(), networkID, configType, config)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -66,7 +66,7 @@ func configuratorUpdateNetworkConfig(c echo.Context, networkID string, configTyp
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.UpdateNetworkConfig(networkID, configType, config)
+	err := configurator.UpdateNetworkConfig(c.Request().Context(), networkID, configType, config)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -74,7 +74,7 @@ func configuratorUpdateNetworkConfig(c echo.Context, networkID string, configTyp
 }
 
 func configuratorDeleteNetworkConfig(c echo.Context, networkID string, configType string) error {
-	err := configurator.DeleteNetworkConfig(networkID, configType)
+	err := configurator.DeleteNetworkConfig(c.Request().Context(), networkID, configType)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -9,6 +9,7 @@
 package obsidian_test
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -32,7 +33,7 @@ func commonSetupNetworks(t *testing.T) {
 	)
 	assert.NoError(t, err)
 	test_init.StartTestService(t)
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 }
 
@@ -55,7 +56,7 @@ func TestConfiguratorGetNetworkConfig(t *testing.T) {
 
 	// Happy path
 	expected := &configType{Foo: "foo", Bar: "bar"}
-	err = configurator.UpdateNetworkConfig("network1", "cfg_network", expected)
+	err = configurator.UpdateNetworkConfig(context.Background(), "network1", "cfg_network", expected)
 	assert.NoError(t, err)
 	err = handler.HandlerFunc(c)
 	assert.NoError(t, err)
@@ -162,7 +163,7 @@ func TestConfiguratorUpdateNetworkConfig(t *testing.T) {
 
 func TestConfiguratorDeleteNetworkConfig(t *testing.T) {
 	commonSetupNetworks(t)
-	err := configurator.UpdateNetworkConfig("network1", "cfg_network", &configType{Foo: "foo", Bar: "bar"})
+	err := configurator.UpdateNetworkConfig(context.Background(), "network1", "cfg_network", &configType{Foo: "foo", Bar: "bar"})
 	assert.NoError(t, err)
 
 	e := echo.New()
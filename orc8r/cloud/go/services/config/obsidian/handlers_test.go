@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package obsidian_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -78,7 +79,7 @@ func TestReadAllKeysConfigHandler(t *testing.T) {
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 
 	// 404
@@ -89,7 +90,7 @@ func TestReadAllKeysConfigHandler(t *testing.T) {
 
 	// Happy path
 	a_config := &fooConfig{Foo: "foo", Bar: "bar"}
-	_, err = configurator.CreateEntity("network1", configurator.NetworkEntity{Key: "key", Type: "foo", Config: a_config})
+	_, err = configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{Key: "key", Type: "foo", Config: a_config})
 	assert.NoError(t, err)
 
 	actual_keys := &[]string{}
@@ -121,7 +122,7 @@ func TestGetConfigHandler(t *testing.T) {
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 
 	// 404
@@ -132,7 +133,7 @@ func TestGetConfigHandler(t *testing.T) {
 
 	// Happy path
 	expected := &fooConfig{Foo: "foo", Bar: "bar"}
-	_, err = configurator.CreateEntity("network1", configurator.NetworkEntity{Key: "key", Type: "foo", Config: expected})
+	_, err = configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{Key: "key", Type: "foo", Config: expected})
 	assert.NoError(t, err)
 
 	err = cfgObsidian.GetReadConfigHandler("google.com", "foo", mockKeyGetter, actual).HandlerFunc(c)
@@ -144,7 +145,7 @@ func TestGetConfigHandler(t *testing.T) {
 
 	// Config service error
 	expectedUnmarshalErrCfg := &errConfig{ShouldErrorOnMarshal: "N", ShouldErrorOnUnmarshal: "Y"}
-	_, err = configurator.CreateEntity("network1", configurator.NetworkEntity{Key: "key", Type: "err", Config: expectedUnmarshalErrCfg})
+	_, err = configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{Key: "key", Type: "err", Config: expectedUnmarshalErrCfg})
 	assert.Error(t, err)
 
 	actualUnmarshalErr := &errConfig{}
@@ -176,7 +177,7 @@ func TestCreateConfigHandler(t *testing.T) {
 	c.SetParamNames("network_id")
 	c.SetParamValues("network1")
 
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
 
 	err = cfgObsidian.GetCreateConfigHandler("google.com", "foo", mockKeyGetter, &fooConfig{}).HandlerFunc(c)
@@ -199,7 +200,7 @@ func TestUpdateConfigHandler(t *testing.T) {
 	obsidian.TLS = false // To bypass access control
 
 	configurator_test_init.StartTestService(t)
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1", Configs: map[string]interface{}{"foo_network": &fooConfig{Foo: "foo", Bar: "bar"}}})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1", Configs: map[string]interface{}{"foo_network": &fooConfig{Foo: "foo", Bar: "bar"}}})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -262,9 +263,9 @@ func TestDeleteConfigHandler(t *testing.T) {
 
 	configurator_test_init.StartTestService(t)
 
-	err = configurator.CreateNetwork(configurator.Network{ID: "network1"})
+	err = configurator.CreateNetwork(context.Background(), configurator.Network{ID: "network1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("network1", configurator.NetworkEntity{Type: "foo", Key: "key", Config: &fooConfig{Foo: "foo", Bar: "bar"}})
+	_, err = configurator.CreateEntity(context.Background(), "network1", configurator.NetworkEntity{Type: "foo", Key: "key", Config: &fooConfig{Foo: "foo", Bar: "bar"}})
 	assert.NoError(t, err)
 
 	e := echo.New()
@@ -23,6 +23,9 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func getNBConfiguratorClient() (protos.NorthboundConfiguratorClient, error) {
@@ -70,13 +73,13 @@ func ListNetworksOfType(networkType string) ([]string, error) {
 	return funk.Map(networks.Networks, func(n *storage.Network) string { return n.ID }).([]string), nil
 }
 
-func CreateNetwork(network Network) error {
-	_, err := CreateNetworks([]Network{network})
+func CreateNetwork(ctx context.Context, network Network) error {
+	_, err := CreateNetworks(ctx, []Network{network})
 	return err
 }
 
 // CreateNetworks registers the given list of Networks and returns the created networks
-func CreateNetworks(networks []Network) ([]Network, error) {
+func CreateNetworks(ctx context.Context, networks []Network) ([]Network, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -90,7 +93,7 @@ func CreateNetworks(networks []Network) ([]Network, error) {
 		}
 		req.Networks = append(req.Networks, pNet)
 	}
-	result, err := client.CreateNetworks(context.Background(), req)
+	result, err := client.CreateNetworks(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +110,7 @@ func CreateNetworks(networks []Network) ([]Network, error) {
 }
 
 // UpdateNetworks updates the specified networks and returns the updated networks
-func UpdateNetworks(updates []NetworkUpdateCriteria) error {
+func UpdateNetworks(ctx context.Context, updates []NetworkUpdateCriteria) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
@@ -121,28 +124,28 @@ func UpdateNetworks(updates []NetworkUpdateCriteria) error {
 		}
 		request.Updates = append(request.Updates, protoUpdate)
 	}
-	_, err = client.UpdateNetworks(context.Background(), request)
+	_, err = client.UpdateNetworks(ctx, request)
 	return err
 }
 
 // DeleteNetworks deletes the network specified by networkID
-func DeleteNetworks(networkIDs []string) error {
+func DeleteNetworks(ctx context.Context, networkIDs []string) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
-	_, err = client.DeleteNetworks(context.Background(), &protos.DeleteNetworksRequest{NetworkIDs: networkIDs})
+	_, err = client.DeleteNetworks(ctx, &protos.DeleteNetworksRequest{NetworkIDs: networkIDs})
 	return err
 }
 
 // DeleteNetwork deletes a network.
-func DeleteNetwork(networkID string) error {
+func DeleteNetwork(ctx context.Context, networkID string) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 	_, err = client.DeleteNetworks(
-		context.Background(),
+		ctx,
 		&protos.DeleteNetworksRequest{NetworkIDs: []string{networkID}},
 	)
 	return err
@@ -248,20 +251,20 @@ func LoadNetworkConfig(networkID, configType string) (interface{}, error) {
 	return network.Configs[configType], nil
 }
 
-func UpdateNetworkConfig(networkID, configType string, config interface{}) error {
+func UpdateNetworkConfig(ctx context.Context, networkID, configType string, config interface{}) error {
 	updateCriteria := NetworkUpdateCriteria{
 		ID:                   networkID,
 		ConfigsToAddOrUpdate: map[string]interface{}{configType: config},
 	}
-	return UpdateNetworks([]NetworkUpdateCriteria{updateCriteria})
+	return UpdateNetworks(ctx, []NetworkUpdateCriteria{updateCriteria})
 }
 
-func DeleteNetworkConfig(networkID, configType string) error {
+func DeleteNetworkConfig(ctx context.Context, networkID, configType string) error {
 	updateCriteria := NetworkUpdateCriteria{
 		ID:              networkID,
 		ConfigsToDelete: []string{configType},
 	}
-	return UpdateNetworks([]NetworkUpdateCriteria{updateCriteria})
+	return UpdateNetworks(ctx, []NetworkUpdateCriteria{updateCriteria})
 }
 
 func GetNetworkConfigsByType(networkID string, configType string) (interface{}, error) {
@@ -279,7 +282,7 @@ func GetNetworkConfigsByType(networkID string, configType string) (interface{},
 // executed in order within a single transaction.
 // This function is all-or-nothing - any failure or error encountered during
 // any operation will rollback the entire batch.
-func WriteEntities(networkID string, writes ...EntityWriteOperation) error {
+func WriteEntities(ctx context.Context, networkID string, writes ...EntityWriteOperation) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
@@ -305,15 +308,15 @@ func WriteEntities(networkID string, writes ...EntityWriteOperation) error {
 		}
 	}
 
-	_, err = client.WriteEntities(context.Background(), req)
+	_, err = client.WriteEntities(ctx, req)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
-	ret, err := CreateEntities(networkID, []NetworkEntity{entity})
+func CreateEntity(ctx context.Context, networkID string, entity NetworkEntity) (NetworkEntity, error) {
+	ret, err := CreateEntities(ctx, networkID, []NetworkEntity{entity})
 	if err != nil {
 		return NetworkEntity{}, err
 	}
@@ -321,7 +324,7 @@ func CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error)
 }
 
 // CreateEntities registers the given entities and returns the created network entities
-func CreateEntities(networkID string, entities []NetworkEntity) ([]NetworkEntity, error) {
+func CreateEntities(ctx context.Context, networkID string, entities []NetworkEntity) ([]NetworkEntity, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -335,7 +338,7 @@ func CreateEntities(networkID string, entities []NetworkEntity) ([]NetworkEntity
 		}
 		request.Entities = append(request.Entities, protoEnt)
 	}
-	response, err := client.CreateEntities(context.Background(), request)
+	response, err := client.CreateEntities(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -353,12 +356,12 @@ func CreateEntities(networkID string, entities []NetworkEntity) ([]NetworkEntity
 
 // CreateInternalEntity is a loose wrapper around CreateEntity to create an
 // entity in the internal network structure
-func CreateInternalEntity(entity NetworkEntity) (NetworkEntity, error) {
-	return CreateEntity(storage.InternalNetworkID, entity)
+func CreateInternalEntity(ctx context.Context, entity NetworkEntity) (NetworkEntity, error) {
+	return CreateEntity(ctx, storage.InternalNetworkID, entity)
 }
 
-func UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
-	retMap, err := UpdateEntities(networkID, []EntityUpdateCriteria{update})
+func UpdateEntity(ctx context.Context, networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
+	retMap, err := UpdateEntities(ctx, networkID, []EntityUpdateCriteria{update})
 	if err != nil {
 		return NetworkEntity{}, err
 	}
@@ -369,7 +372,7 @@ func UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity,
 }
 
 // UpdateEntities updates the registered entities and returns the updated entities
-func UpdateEntities(networkID string, updates []EntityUpdateCriteria) (map[string]NetworkEntity, error) {
+func UpdateEntities(ctx context.Context, networkID string, updates []EntityUpdateCriteria) (map[string]NetworkEntity, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
@@ -383,7 +386,7 @@ func UpdateEntities(networkID string, updates []EntityUpdateCriteria) (map[strin
 		}
 		request.Updates = append(request.Updates, upProto)
 	}
-	response, err := client.UpdateEntities(context.Background(), request)
+	response, err := client.UpdateEntities(ctx, request)
 	if err != nil {
 		return nil, err
 	}
@@ -401,43 +404,43 @@ func UpdateEntities(networkID string, updates []EntityUpdateCriteria) (map[strin
 
 // UpdateInternalEntity is a loose wrapper around UpdateEntity to update an
 // entity in the internal network structure
-func UpdateInternalEntity(update EntityUpdateCriteria) (NetworkEntity, error) {
-	return UpdateEntity(storage.InternalNetworkID, update)
+func UpdateInternalEntity(ctx context.Context, update EntityUpdateCriteria) (NetworkEntity, error) {
+	return UpdateEntity(ctx, storage.InternalNetworkID, update)
 }
 
-func CreateOrUpdateEntityConfig(networkID string, entityType string, entityKey string, config interface{}) error {
+func CreateOrUpdateEntityConfig(ctx context.Context, networkID string, entityType string, entityKey string, config interface{}) error {
 	updateCriteria := EntityUpdateCriteria{
 		Key:       entityKey,
 		Type:      entityType,
 		NewConfig: config,
 	}
-	_, err := UpdateEntities(networkID, []EntityUpdateCriteria{updateCriteria})
+	_, err := UpdateEntities(ctx, networkID, []EntityUpdateCriteria{updateCriteria})
 	return err
 }
 
-func DeleteEntityConfig(networkID, entityType, entityKey string) error {
+func DeleteEntityConfig(ctx context.Context, networkID, entityType, entityKey string) error {
 	updateCriteria := EntityUpdateCriteria{
 		Key:          entityKey,
 		Type:         entityType,
 		DeleteConfig: true,
 	}
-	_, err := UpdateEntities(networkID, []EntityUpdateCriteria{updateCriteria})
+	_, err := UpdateEntities(ctx, networkID, []EntityUpdateCriteria{updateCriteria})
 	return err
 }
 
-func DeleteEntity(networkID string, entityType string, entityKey string) error {
-	return DeleteEntities(networkID, []storage2.TypeAndKey{{Type: entityType, Key: entityKey}})
+func DeleteEntity(ctx context.Context, networkID string, entityType string, entityKey string) error {
+	return DeleteEntities(ctx, networkID, []storage2.TypeAndKey{{Type: entityType, Key: entityKey}})
 }
 
 // DeleteEntity deletes the entity specified by networkID, type, key
 // We also have cascading deletes to delete foreign keys for assocs
-func DeleteEntities(networkID string, ids []storage2.TypeAndKey) error {
+func DeleteEntities(ctx context.Context, networkID string, ids []storage2.TypeAndKey) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 	_, err = client.DeleteEntities(
-		context.Background(),
+		ctx,
 		&protos.DeleteEntitiesRequest{
 			NetworkID: networkID,
 			ID:        tksToEntIDs(ids),
@@ -448,8 +451,8 @@ func DeleteEntities(networkID string, ids []storage2.TypeAndKey) error {
 
 // DeleteInternalEntity is a loose wrapper around DeleteEntities to delete an
 // entity in the internal network structure
-func DeleteInternalEntity(entityType, entityKey string) error {
-	return DeleteEntity(storage.InternalNetworkID, entityType, entityKey)
+func DeleteInternalEntity(ctx context.Context, entityType, entityKey string) error {
+	return DeleteEntity(ctx, storage.InternalNetworkID, entityType, entityKey)
 }
 
 // GetPhysicalIDOfEntity gets the physicalID associated with the entity
@@ -549,6 +552,33 @@ func LoadEntityForPhysicalID(physicalID string, criteria EntityLoadCriteria) (Ne
 	return loaded[0], nil
 }
 
+// LoadEntitiesByPhysicalID loads the entities of the given type in the given
+// networks which correspond to a physical device, keyed by physical ID. If
+// networkIDs is empty, the entities of all networks are loaded. This takes
+// one request per network, rather than one per physical ID.
+func LoadEntitiesByPhysicalID(networkIDs []string, entityType string) (map[string]NetworkEntity, error) {
+	if len(networkIDs) == 0 {
+		var err error
+		networkIDs, err = ListNetworkIDs()
+		if err != nil {
+			return nil, err
+		}
+	}
+	ret := map[string]NetworkEntity{}
+	for _, networkID := range networkIDs {
+		loaded, _, err := LoadEntities(networkID, &entityType, nil, nil, nil, EntityLoadCriteria{})
+		if err != nil {
+			return nil, err
+		}
+		for _, entity := range loaded {
+			if entity.PhysicalID != "" {
+				ret[entity.PhysicalID] = entity
+			}
+		}
+	}
+	return ret, nil
+}
+
 func GetNetworkAndEntityIDForPhysicalID(physicalID string) (string, string, error) {
 	if len(physicalID) == 0 {
 		return "", "", errors.New("Empty Hardware ID")
@@ -682,6 +712,120 @@ func LoadAllEntitiesInNetwork(networkID string, entityType string, criteria Enti
 	return ret, nil
 }
 
+// SearchEntitiesInNetwork fetches all entities of specified type in a network
+// which match the search filter
+func SearchEntitiesInNetwork(networkID string, entityType string, filter EntitySearchFilter, criteria EntityLoadCriteria) ([]NetworkEntity, error) {
+	ret, _, err := LoadEntitiesPage(networkID, entityType, &filter, criteria)
+	return ret, err
+}
+
+// ValidatePageToken returns an error if token isn't a token returned by
+// LoadEntitiesPage.
+func ValidatePageToken(token string) error {
+	return storage.ValidatePageToken(token)
+}
+
+// LoadEntitiesPage fetches the page of entities of specified type in a
+// network which is specified by the page size and token of the load
+// criteria. If filter is non-nil, only entities matching the search filter
+// are returned. Entities are ordered by key. The token of the next page is
+// returned, or an empty string if this is the last page.
+func LoadEntitiesPage(networkID string, entityType string, filter *EntitySearchFilter, criteria EntityLoadCriteria) ([]NetworkEntity, string, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, "", err
+	}
+
+	loadFilter := &storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: entityType}}
+	if filter != nil {
+		loadFilter.SearchFilter = filter.toStorageProto()
+	}
+	resp, err := client.LoadEntities(
+		context.Background(),
+		&protos.LoadEntitiesRequest{
+			NetworkID: networkID,
+			Filter:    loadFilter,
+			Criteria:  criteria.toStorageProto(),
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret := make([]NetworkEntity, len(resp.Entities))
+	for i, protoEnt := range resp.Entities {
+		ent, err := ret[i].fromStorageProto(protoEnt)
+		if err != nil {
+			return nil, "", errors.Wrapf(err, "request succeeded but deserialization failed")
+		}
+		ret[i] = ent
+	}
+	return ret, resp.NextPageToken, nil
+}
+
+// ListRevisions fetches the recorded revisions of a network in the order
+// they were made. If entity is non-nil, only revisions of that entity are
+// returned. If networkOnly is true, only revisions of the network itself
+// are returned.
+func ListRevisions(networkID string, entity *storage2.TypeAndKey, networkOnly bool) ([]Revision, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &storage.RevisionLoadFilter{NetworkOnly: networkOnly}
+	if entity != nil {
+		filter.Entity = &storage.EntityID{Type: entity.Type, Key: entity.Key}
+	}
+	resp, err := client.ListRevisions(
+		context.Background(),
+		&protos.ListRevisionsRequest{NetworkID: networkID, Filter: filter},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]Revision, len(resp.Revisions))
+	for i, protoRev := range resp.Revisions {
+		rev, err := ret[i].fromStorageProto(protoRev)
+		if err != nil {
+			return nil, errors.Wrapf(err, "request succeeded but deserialization failed")
+		}
+		ret[i] = rev
+	}
+	return ret, nil
+}
+
+// WithRevisionAuthor returns a context which attributes the mutations made
+// through the write functions of this package to author. The author is
+// recorded on the revisions of those mutations.
+func WithRevisionAuthor(ctx context.Context, author string) context.Context {
+	if author == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, storage.RevisionAuthorMetadataKey, author)
+}
+
+// RollbackToRevision restores the network or entity which was mutated by
+// the specified revision to its state right after the revision. The rollback
+// is itself recorded as a new revision. Returns ErrNotFound if the revision
+// doesn't exist, and a storage.RollbackError if it can't be rolled back.
+func RollbackToRevision(ctx context.Context, networkID string, revisionID uint64) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.RollbackToRevision(ctx, &protos.RollbackToRevisionRequest{NetworkID: networkID, RevisionID: revisionID})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return merrors.ErrNotFound
+	case codes.FailedPrecondition:
+		return storage.RollbackError{Reason: status.Convert(err).Message()}
+	}
+	return err
+}
+
 func getSBConfiguratorClient() (protos.SouthboundConfiguratorClient, error) {
 	conn, err := registry.GetConnection(ServiceName)
 	if err != nil {
@@ -8,6 +8,8 @@ LICENSE file in the root directory of this source tree.
 package configurator_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -47,7 +49,7 @@ func TestConfiguratorService(t *testing.T) {
 		Description: "description",
 		Configs:     config,
 	}
-	_, err = configurator.CreateNetworks([]configurator.Network{network1})
+	_, err = configurator.CreateNetworks(context.Background(), []configurator.Network{network1})
 	assert.NoError(t, err)
 
 	networks, notFound, err := configurator.LoadNetworks([]string{networkID1}, true, true)
@@ -67,7 +69,7 @@ func TestConfiguratorService(t *testing.T) {
 		ConfigsToDelete:      toDelete,
 	}
 
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria1})
+	err = configurator.UpdateNetworks(context.Background(), []configurator.NetworkUpdateCriteria{updateCriteria1})
 	assert.NoError(t, err)
 	networks, notFound, err = configurator.LoadNetworks([]string{networkID1}, true, true)
 	assert.NoError(t, err)
@@ -84,7 +86,7 @@ func TestConfiguratorService(t *testing.T) {
 		Name:        "test_network2",
 		Description: "description2",
 	}
-	_, err = configurator.CreateNetworks([]configurator.Network{network2})
+	_, err = configurator.CreateNetworks(context.Background(), []configurator.Network{network2})
 	assert.NoError(t, err)
 
 	networkIDs, err := configurator.ListNetworkIDs()
@@ -93,7 +95,7 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, networkID2, networkIDs[1])
 
 	// Delete, Load
-	err = configurator.DeleteNetworks([]string{network2.ID})
+	err = configurator.DeleteNetworks(context.Background(), []string{network2.ID})
 	assert.NoError(t, err)
 
 	networks, notFound, err = configurator.LoadNetworks([]string{networkID2}, true, true)
@@ -102,7 +104,7 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, 1, len(notFound))
 
 	// Create Networks With Type
-	createdTypedLteNetworks, err := configurator.CreateNetworks([]configurator.Network{
+	createdTypedLteNetworks, err := configurator.CreateNetworks(context.Background(), []configurator.Network{
 		{
 			Name: "lte network 1",
 			Type: "lte",
@@ -150,7 +152,7 @@ func TestConfiguratorService(t *testing.T) {
 	}
 
 	// Create, Load
-	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{entity1, entity2})
+	_, err = configurator.CreateEntities(context.Background(), networkID1, []configurator.NetworkEntity{entity1, entity2})
 	assert.NoError(t, err)
 
 	entities, entitiesNotFound, err := configurator.LoadEntities(
@@ -181,7 +183,7 @@ func TestConfiguratorService(t *testing.T) {
 		AssociationsToAdd: []storage.TypeAndKey{entityID2},
 	}
 
-	_, err = configurator.UpdateEntities(networkID1, []configurator.EntityUpdateCriteria{entityUpdateCriteria})
+	_, err = configurator.UpdateEntities(context.Background(), networkID1, []configurator.EntityUpdateCriteria{entityUpdateCriteria})
 	assert.NoError(t, err)
 	entities, entitiesNotFound, err = configurator.LoadEntities(
 		networkID1,
@@ -200,9 +202,19 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, entityID2.Key, entities[0].Associations[0].Key)
 	assert.Equal(t, entityID1.Key, entities[1].ParentAssociations[0].Key)
 
+	// Load by physical ID
+	byPhysicalID, err := configurator.LoadEntitiesByPhysicalID([]string{networkID1}, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(byPhysicalID))
+	assert.Equal(t, entityID1.Key, byPhysicalID["4321"].Key)
+	assert.Equal(t, entityID2.Key, byPhysicalID["5678"].Key)
+	byPhysicalID, err = configurator.LoadEntitiesByPhysicalID(nil, "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(byPhysicalID))
+
 	// Update foobar, create foobaz, add association fooboo -> foobaz  in 1
 	// client call
-	err = configurator.WriteEntities(
+	err = configurator.WriteEntities(context.Background(),
 		networkID1,
 		configurator.EntityUpdateCriteria{Type: entityID1.Type, Key: entityID1.Key, NewDescription: swag.String("newnewnew")},
 		configurator.NetworkEntity{Type: "foo", Key: "baz"},
@@ -249,7 +261,7 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, expected, entities)
 
 	// Delete, Load
-	err = configurator.DeleteEntities(networkID1, []storage.TypeAndKey{entityID2})
+	err = configurator.DeleteEntities(context.Background(), networkID1, []storage.TypeAndKey{entityID2})
 	assert.NoError(t, err)
 	entities, entitiesNotFound, err = configurator.LoadEntities(
 		networkID1,
@@ -261,6 +273,80 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, 2, len(entities))
 	assert.Equal(t, 0, len(entitiesNotFound))
 	assert.Equal(t, "foobar", entities[0].Name)
+
+	// Search
+	entities, err = configurator.SearchEntitiesInNetwork(
+		networkID1, "foo",
+		configurator.EntitySearchFilter{NameContains: swag.String("BAR")},
+		configurator.EntityLoadCriteria{LoadMetadata: true},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entities))
+	assert.Equal(t, "foobar", entities[0].Name)
+	assert.Nil(t, entities[0].Config)
+
+	entities, err = configurator.SearchEntitiesInNetwork(
+		networkID1, "foo",
+		configurator.EntitySearchFilter{ConfigPredicates: []configurator.ConfigPredicate{{Value: "ELL", Contains: true}}},
+		configurator.EntityLoadCriteria{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entities))
+	assert.Equal(t, "bar", entities[0].Key)
+	assert.Nil(t, entities[0].Config)
+
+	err = serde.RegisterSerdes(serde.NewBinarySerde(configurator.NetworkEntitySerdeDomain, "search", &searchConfig{}))
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(context.Background(), networkID1, []configurator.NetworkEntity{
+		{Type: "search", Key: "s1", Config: &searchConfig{Name: "site-1", Apns: []searchApn{{Name: "internet"}, {Name: "ims"}}}},
+		{Type: "search", Key: "s2", Config: &searchConfig{Name: "site-2", Apns: []searchApn{{Name: "internet"}}, Priority: 2}},
+	})
+	assert.NoError(t, err)
+
+	filter, err := configurator.ParseEntitySearchFilter([]string{"config.apns.name=ims"})
+	assert.NoError(t, err)
+	entities, err = configurator.SearchEntitiesInNetwork(networkID1, "search", filter, configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entities))
+	assert.Equal(t, "s1", entities[0].Key)
+	assert.Equal(t, "site-1", entities[0].Config.(*searchConfig).Name)
+
+	filter, err = configurator.ParseEntitySearchFilter([]string{"config.apns.name=internet", "config.priority=2"})
+	assert.NoError(t, err)
+	entities, err = configurator.SearchEntitiesInNetwork(networkID1, "search", filter, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(entities))
+	assert.Equal(t, "s2", entities[0].Key)
+
+	filter, err = configurator.ParseEntitySearchFilter([]string{"config.name~SITE", "config.missing=1"})
+	assert.NoError(t, err)
+	entities, err = configurator.SearchEntitiesInNetwork(networkID1, "search", filter, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(entities))
+}
+
+func TestParseEntitySearchFilter(t *testing.T) {
+	filter, err := configurator.ParseEntitySearchFilter([]string{"name~site-12", "description~a=b", "config.apn.name=internet", "config~foo"})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		configurator.EntitySearchFilter{
+			NameContains:        swag.String("site-12"),
+			DescriptionContains: swag.String("a=b"),
+			ConfigPredicates: []configurator.ConfigPredicate{
+				{Path: "apn.name", Value: "internet"},
+				{Value: "foo", Contains: true},
+			},
+		},
+		filter,
+	)
+
+	_, err = configurator.ParseEntitySearchFilter([]string{"name=foo"})
+	assert.EqualError(t, err, "invalid search term \"name=foo\", name only supports ~")
+	_, err = configurator.ParseEntitySearchFilter([]string{"foo"})
+	assert.EqualError(t, err, "invalid search term \"foo\", expected <field>=<value> or <field>~<value>")
+	_, err = configurator.ParseEntitySearchFilter([]string{"physical_id=foo"})
+	assert.EqualError(t, err, "invalid search term \"physical_id=foo\", unsupported field physical_id")
 }
 
 func strPointer(str string) *string {
@@ -290,3 +376,21 @@ func (m *mockSerde) Serialize(in interface{}) ([]byte, error) {
 func (m *mockSerde) Deserialize(in []byte) (interface{}, error) {
 	return string(in), nil
 }
+
+type searchConfig struct {
+	Name     string      `json:"name"`
+	Apns     []searchApn `json:"apns"`
+	Priority int         `json:"priority,omitempty"`
+}
+
+type searchApn struct {
+	Name string `json:"name"`
+}
+
+func (m *searchConfig) MarshalBinary() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *searchConfig) UnmarshalBinary(b []byte) error {
+	return json.Unmarshal(b, m)
+}
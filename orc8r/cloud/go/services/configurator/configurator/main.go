@@ -37,7 +37,13 @@ func main() {
 		glog.Fatalf("Failed to connect to database: %s", err)
 	}
 
-	factory := storage.NewSQLConfiguratorStorageFactory(db, &storage.DefaultIDGenerator{}, sqorc.GetSqlBuilder())
+	maxRevisions := uint64(storage.DefaultMaxRevisions)
+	if srv.Config != nil {
+		if configured, err := srv.Config.GetIntParam("max_revisions_per_network"); err == nil && configured >= 0 {
+			maxRevisions = uint64(configured)
+		}
+	}
+	factory := storage.NewSQLConfiguratorStorageFactoryWithHistory(db, &storage.DefaultIDGenerator{}, sqorc.GetSqlBuilder(), maxRevisions)
 	err = factory.InitializeServiceStorage()
 	if err != nil {
 		glog.Fatalf("Failed to initialize configurator database: %s", err)
@@ -78,7 +78,7 @@ func GetDeleteNetworkConfigHandler(path string, configType string) obsidian.Hand
 			if nerr != nil {
 				return nerr
 			}
-			err := configurator.DeleteNetworkConfig(networkID, configType)
+			err := configurator.DeleteNetworkConfig(c.Request().Context(), networkID, configType)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
@@ -101,7 +101,7 @@ func createOrUpdateNetworkConfig(c echo.Context, configType string, modelPtr ser
 		ID:                   networkID,
 		ConfigsToAddOrUpdate: map[string]interface{}{configType: config},
 	}
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria})
+	err = configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{updateCriteria})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
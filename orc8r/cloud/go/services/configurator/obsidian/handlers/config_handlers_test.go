@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -45,7 +46,7 @@ func TestGetNetworkConfigCRUDHandlers(t *testing.T) {
 	e := echo.New()
 
 	// Test GetCreateNetworkConfigHandler
-	_, err = configurator.CreateNetworks(
+	_, err = configurator.CreateNetworks(context.Background(),
 		[]configurator.Network{{
 			ID: networkID,
 		}})
@@ -716,6 +716,139 @@ func (m *DeleteEntitiesRequest) GetID() []*storage.EntityID {
 	return nil
 }
 
+type ListRevisionsRequest struct {
+	NetworkID            string                      `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Filter               *storage.RevisionLoadFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListRevisionsRequest) Reset()         { *m = ListRevisionsRequest{} }
+func (m *ListRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsRequest) ProtoMessage()    {}
+func (*ListRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{15}
+}
+
+func (m *ListRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsRequest.Merge(m, src)
+}
+func (m *ListRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsRequest.Size(m)
+}
+func (m *ListRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListRevisionsRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ListRevisionsRequest) GetFilter() *storage.RevisionLoadFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ListRevisionsResponse struct {
+	Revisions            []*storage.Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ListRevisionsResponse) Reset()         { *m = ListRevisionsResponse{} }
+func (m *ListRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRevisionsResponse) ProtoMessage()    {}
+func (*ListRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{16}
+}
+
+func (m *ListRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRevisionsResponse.Merge(m, src)
+}
+func (m *ListRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRevisionsResponse.Size(m)
+}
+func (m *ListRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListRevisionsResponse) GetRevisions() []*storage.Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type RollbackToRevisionRequest struct {
+	NetworkID            string   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	RevisionID           uint64   `protobuf:"varint,2,opt,name=revisionID,proto3" json:"revisionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackToRevisionRequest) Reset()         { *m = RollbackToRevisionRequest{} }
+func (m *RollbackToRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackToRevisionRequest) ProtoMessage()    {}
+func (*RollbackToRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{17}
+}
+
+func (m *RollbackToRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackToRevisionRequest.Unmarshal(m, b)
+}
+func (m *RollbackToRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackToRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackToRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackToRevisionRequest.Merge(m, src)
+}
+func (m *RollbackToRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackToRevisionRequest.Size(m)
+}
+func (m *RollbackToRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackToRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackToRevisionRequest proto.InternalMessageInfo
+
+func (m *RollbackToRevisionRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *RollbackToRevisionRequest) GetRevisionID() uint64 {
+	if m != nil {
+		return m.RevisionID
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ListNetworkIDsResponse)(nil), "magma.orc8r.configurator.ListNetworkIDsResponse")
 	proto.RegisterType((*LoadNetworksRequest)(nil), "magma.orc8r.configurator.LoadNetworksRequest")
@@ -734,63 +867,72 @@ func init() {
 	proto.RegisterType((*UpdateEntitiesResponse)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse")
 	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse.UpdatedEntitiesEntry")
 	proto.RegisterType((*DeleteEntitiesRequest)(nil), "magma.orc8r.configurator.DeleteEntitiesRequest")
+	proto.RegisterType((*ListRevisionsRequest)(nil), "magma.orc8r.configurator.ListRevisionsRequest")
+	proto.RegisterType((*ListRevisionsResponse)(nil), "magma.orc8r.configurator.ListRevisionsResponse")
+	proto.RegisterType((*RollbackToRevisionRequest)(nil), "magma.orc8r.configurator.RollbackToRevisionRequest")
 }
 
 func init() { proto.RegisterFile("northbound.proto", fileDescriptor_90b042c70967f647) }
 
 var fileDescriptor_90b042c70967f647 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x53, 0x7d, 0x69, 0x72, 0xfb, 0xd1, 0x86, 0xa1, 0x89, 0xa2, 0x08, 0x41, 0x35, 0xab,
-	0x82, 0x20, 0xae, 0x52, 0xa0, 0x55, 0x57, 0xa8, 0x75, 0x10, 0xa1, 0x55, 0xd5, 0x5a, 0xd0, 0x4a,
-	0x5d, 0x80, 0x5c, 0x67, 0x1a, 0x4c, 0x13, 0x4f, 0x3a, 0x1e, 0xb7, 0x0a, 0x4b, 0x36, 0x3c, 0x11,
-	0x3b, 0x78, 0x08, 0x96, 0x3c, 0x0d, 0x28, 0x9e, 0xb1, 0x63, 0x3b, 0x4e, 0x62, 0x77, 0x81, 0x58,
-	0xc5, 0x9a, 0x99, 0x7b, 0xce, 0xfd, 0x39, 0x73, 0xe7, 0x06, 0xca, 0x36, 0x65, 0xfc, 0xe3, 0x39,
-	0x75, 0xed, 0x4e, 0x63, 0xc0, 0x28, 0xa7, 0xa8, 0xd6, 0x37, 0xba, 0x7d, 0xa3, 0x41, 0x99, 0xb9,
-	0xcd, 0x1a, 0x26, 0xb5, 0x2f, 0xac, 0xae, 0xcb, 0x0c, 0x4e, 0x59, 0xfd, 0xa1, 0xb7, 0xa3, 0x7a,
-	0x3b, 0xaa, 0x77, 0xd8, 0x51, 0x4d, 0xda, 0xef, 0x53, 0x5b, 0x98, 0xd6, 0x5f, 0x86, 0x0f, 0x98,
-	0x3d, 0xea, 0x76, 0xd4, 0x2e, 0x55, 0x1d, 0xc2, 0xae, 0x2d, 0x93, 0x8c, 0xce, 0x8e, 0xc1, 0x54,
-	0x87, 0x53, 0x66, 0x74, 0x89, 0xff, 0x2b, 0x10, 0xf0, 0x36, 0x54, 0x0f, 0x2c, 0x87, 0x1f, 0x12,
-	0x7e, 0x43, 0xd9, 0x65, 0x5b, 0x73, 0x74, 0xe2, 0x0c, 0xa8, 0xed, 0x10, 0xf4, 0x00, 0xc0, 0x0e,
-	0x56, 0x6b, 0xca, 0xda, 0xc2, 0x7a, 0x49, 0x0f, 0xad, 0xe0, 0x6f, 0x0a, 0xdc, 0x3b, 0xa0, 0x46,
-	0x47, 0x9a, 0x3a, 0x3a, 0xb9, 0x72, 0x89, 0xc3, 0xd1, 0x31, 0x14, 0x4d, 0x66, 0x71, 0xc2, 0x2c,
-	0xa3, 0x96, 0x5f, 0x53, 0xd6, 0x97, 0x9a, 0xcf, 0x1b, 0xd3, 0x22, 0x6c, 0xf8, 0xce, 0x48, 0x90,
-	0x11, 0xde, 0x9e, 0x34, 0xd6, 0x03, 0x18, 0xb4, 0x0f, 0x85, 0x0b, 0xab, 0xc7, 0x09, 0xab, 0x2d,
-	0x78, 0x80, 0x9b, 0x99, 0x00, 0x5f, 0x79, 0xa6, 0xba, 0x84, 0xc0, 0xef, 0xa1, 0xb2, 0xc7, 0x88,
-	0xc1, 0x49, 0xdc, 0xf1, 0x16, 0x14, 0x65, 0x78, 0x22, 0xdc, 0xa5, 0xe6, 0xa3, 0xd4, 0x3c, 0x7a,
-	0x60, 0x8a, 0x6d, 0xa8, 0xc6, 0xf1, 0x65, 0x46, 0xdf, 0x42, 0xd9, 0xf4, 0x76, 0x3a, 0x1f, 0x6e,
-	0x4f, 0xb4, 0x22, 0x21, 0x7c, 0x74, 0xfc, 0x09, 0x2a, 0xef, 0x06, 0x9d, 0x84, 0x78, 0x8e, 0x61,
-	0xd1, 0xf5, 0x36, 0x7c, 0x96, 0xad, 0xd4, 0x2c, 0x02, 0x30, 0xa8, 0x84, 0x8f, 0x83, 0xb7, 0xa0,
-	0xa2, 0x91, 0x1e, 0x99, 0xe4, 0x9a, 0x27, 0x96, 0x9f, 0x52, 0x2c, 0x2d, 0x9b, 0x5b, 0xdc, 0x22,
-	0x81, 0xdd, 0x7d, 0x28, 0x05, 0xa7, 0x6a, 0xca, 0x9a, 0xb2, 0x5e, 0xd2, 0xc7, 0x0b, 0xe8, 0x4d,
-	0x50, 0x77, 0x21, 0xa4, 0xe6, 0xfc, 0x00, 0x3c, 0x82, 0xe1, 0x64, 0xd9, 0xd1, 0x51, 0x48, 0x96,
-	0x42, 0x45, 0xcf, 0xb2, 0xa0, 0x4d, 0xaa, 0x12, 0x7f, 0x86, 0xd5, 0xd3, 0xd1, 0x77, 0xb6, 0x98,
-	0x34, 0x28, 0xdc, 0x8c, 0xac, 0x9c, 0x5a, 0xde, 0x2b, 0xca, 0x93, 0xe9, 0x5e, 0x8c, 0xd1, 0x87,
-	0x12, 0x5b, 0x97, 0xb6, 0xf8, 0xbb, 0x02, 0x68, 0x72, 0x1b, 0xb5, 0xa1, 0x20, 0xe4, 0xe1, 0xf1,
-	0x2e, 0x35, 0xd5, 0xd4, 0x15, 0x17, 0x38, 0xaf, 0x73, 0xba, 0x04, 0x40, 0x47, 0x50, 0x10, 0x55,
-	0x97, 0xb9, 0x7f, 0x91, 0x36, 0x5b, 0x51, 0xed, 0x8c, 0x10, 0x05, 0xce, 0x6e, 0x09, 0x16, 0x99,
-	0xf0, 0x13, 0xff, 0xca, 0x43, 0x25, 0x96, 0x3b, 0x79, 0x47, 0xce, 0xc6, 0x77, 0x84, 0xc8, 0x3d,
-	0xa9, 0xde, 0xac, 0xb1, 0x04, 0x37, 0xc5, 0xe7, 0x40, 0x14, 0xca, 0xc2, 0x95, 0x10, 0xb6, 0x28,
-	0x82, 0x96, 0xa6, 0x08, 0x21, 0x37, 0x1b, 0x22, 0xc8, 0x00, 0xba, 0x65, 0x73, 0x36, 0xd4, 0x57,
-	0xdc, 0xe8, 0x6a, 0xdd, 0x81, 0xd5, 0xa4, 0x83, 0xa8, 0x0c, 0x0b, 0x97, 0x64, 0x28, 0xb5, 0x31,
-	0xfa, 0x44, 0x2d, 0xf8, 0xef, 0xda, 0xe8, 0xb9, 0x7e, 0xb2, 0x33, 0xc7, 0x2a, 0xac, 0x77, 0xf2,
-	0xdb, 0x0a, 0xfe, 0xa2, 0xf8, 0x0d, 0x2e, 0x9b, 0x30, 0xf7, 0xa1, 0x18, 0xcb, 0x4a, 0x66, 0x2f,
-	0x02, 0x00, 0xcc, 0xfd, 0x26, 0xf8, 0x37, 0x0b, 0x8c, 0xbf, 0x2a, 0x7e, 0x2f, 0xcc, 0x16, 0xfa,
-	0xd1, 0xb8, 0x53, 0x8a, 0xc8, 0x6f, 0x29, 0xf6, 0x71, 0xa3, 0xfc, 0xad, 0x40, 0x35, 0xee, 0x89,
-	0x4c, 0xc0, 0x20, 0x41, 0x85, 0x22, 0x01, 0xad, 0xe9, 0xac, 0xc9, 0x58, 0xff, 0xb2, 0x0c, 0xaf,
-	0xfc, 0xa7, 0x22, 0x5b, 0x29, 0x76, 0x20, 0xdf, 0xd6, 0x64, 0x15, 0x1e, 0xa7, 0xad, 0x42, 0x5b,
-	0xd3, 0xf3, 0x6d, 0xad, 0xf9, 0xa3, 0x08, 0xd5, 0xc3, 0x60, 0xba, 0xda, 0x0b, 0x9d, 0x47, 0xa7,
-	0xb0, 0x1c, 0x1d, 0x73, 0xd0, 0xdd, 0x08, 0xf8, 0x09, 0xb5, 0x3a, 0xf5, 0x8d, 0xe9, 0x7c, 0xc9,
-	0x33, 0x12, 0xce, 0x21, 0x17, 0x96, 0xa3, 0xaf, 0x3d, 0x9a, 0x91, 0xb4, 0xc4, 0xb9, 0x63, 0x16,
-	0x6d, 0xf2, 0x20, 0x81, 0x73, 0xe8, 0x04, 0x96, 0xa3, 0x8f, 0xfe, 0x2c, 0xda, 0xc4, 0xf1, 0xa0,
-	0x3e, 0x99, 0x00, 0x81, 0x1b, 0x7d, 0xe0, 0x67, 0xe1, 0x26, 0x8e, 0x02, 0xc9, 0xb8, 0x1c, 0xfe,
-	0x0f, 0xcf, 0x8a, 0xe8, 0xe9, 0x8c, 0x54, 0x4f, 0xce, 0x94, 0xf5, 0x6c, 0x03, 0x9f, 0x4e, 0x1c,
-	0xb7, 0xc7, 0x71, 0x0e, 0x31, 0xb8, 0x13, 0x69, 0xdf, 0xa8, 0x91, 0xba, 0xcf, 0x0b, 0x5e, 0x35,
-	0xe3, 0xbb, 0x10, 0x16, 0x44, 0x40, 0x3a, 0x57, 0x10, 0x71, 0xd6, 0x8d, 0xf4, 0x06, 0x61, 0xda,
-	0x68, 0x8f, 0x98, 0x2f, 0x88, 0x0c, 0xb4, 0xc9, 0xed, 0x27, 0xac, 0x97, 0x34, 0xb4, 0x89, 0xfd,
-	0x20, 0x59, 0x2f, 0x8e, 0xd0, 0x4b, 0x80, 0x3a, 0x47, 0x2f, 0x71, 0xcc, 0x4c, 0x83, 0xa2, 0x2f,
-	0x97, 0xdd, 0xe2, 0x59, 0x41, 0xfc, 0xc9, 0x3a, 0x17, 0xbf, 0x9b, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xda, 0x6f, 0xc2, 0x9d, 0xad, 0x0d, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc6, 0xa1, 0x0d, 0xc9, 0xa1, 0x85, 0x74, 0x4a, 0xa2, 0xd4, 0xaa, 0x28, 0xf2, 0x2a, 0xad,
+	0xda, 0x18, 0x85, 0xb6, 0x20, 0x56, 0x15, 0x24, 0x15, 0x29, 0x08, 0xc1, 0x88, 0x82, 0xca, 0x82,
+	0xca, 0x38, 0x43, 0xea, 0x92, 0x78, 0xc2, 0x78, 0x0c, 0x4a, 0x97, 0xdd, 0x74, 0xdd, 0x87, 0xe9,
+	0xae, 0x2f, 0xd1, 0x65, 0x9f, 0xe6, 0x5e, 0xc5, 0x33, 0x9e, 0xd8, 0x8e, 0x93, 0xd8, 0x2c, 0xae,
+	0xee, 0x2a, 0xd6, 0xcc, 0x9c, 0xef, 0x3b, 0x3f, 0xdf, 0x9c, 0x39, 0x81, 0x8a, 0x4b, 0x19, 0xff,
+	0xed, 0x9e, 0xfa, 0x6e, 0xaf, 0x39, 0x62, 0x94, 0x53, 0x54, 0x1f, 0x5a, 0xfd, 0xa1, 0xd5, 0xa4,
+	0xcc, 0x3e, 0x60, 0x4d, 0x9b, 0xba, 0x0f, 0x4e, 0xdf, 0x67, 0x16, 0xa7, 0x4c, 0xff, 0x22, 0xd8,
+	0x31, 0x83, 0x1d, 0x33, 0x38, 0xec, 0x99, 0x36, 0x1d, 0x0e, 0xa9, 0x2b, 0x4c, 0xf5, 0x1f, 0xa2,
+	0x07, 0xec, 0x01, 0xf5, 0x7b, 0x66, 0x9f, 0x9a, 0x1e, 0x61, 0xcf, 0x8e, 0x4d, 0x3c, 0x33, 0x0a,
+	0x66, 0x7a, 0x9c, 0x32, 0xab, 0x4f, 0xc2, 0x5f, 0x81, 0x60, 0x1c, 0x40, 0xed, 0xcc, 0xf1, 0xf8,
+	0x39, 0xe1, 0x2f, 0x94, 0x3d, 0x76, 0xdb, 0x1e, 0x26, 0xde, 0x88, 0xba, 0x1e, 0x41, 0xdb, 0x00,
+	0xae, 0x5a, 0xad, 0x6b, 0x3b, 0xab, 0x8d, 0x32, 0x8e, 0xac, 0x18, 0xff, 0x68, 0xf0, 0xe9, 0x19,
+	0xb5, 0x7a, 0xd2, 0xd4, 0xc3, 0xe4, 0xc9, 0x27, 0x1e, 0x47, 0x97, 0x50, 0xb2, 0x99, 0xc3, 0x09,
+	0x73, 0xac, 0x7a, 0x61, 0x47, 0x6b, 0xac, 0xb7, 0xbe, 0x6b, 0xce, 0x8b, 0xb0, 0x19, 0x3a, 0x23,
+	0x41, 0x26, 0x78, 0xc7, 0xd2, 0x18, 0x2b, 0x18, 0x74, 0x0a, 0xc5, 0x07, 0x67, 0xc0, 0x09, 0xab,
+	0xaf, 0x06, 0x80, 0x7b, 0xb9, 0x00, 0x7f, 0x0c, 0x4c, 0xb1, 0x84, 0x30, 0xee, 0xa0, 0x7a, 0xcc,
+	0x88, 0xc5, 0x49, 0xd2, 0xf1, 0x0e, 0x94, 0x64, 0x78, 0x22, 0xdc, 0xf5, 0xd6, 0x97, 0x99, 0x79,
+	0xb0, 0x32, 0x35, 0x5c, 0xa8, 0x25, 0xf1, 0x65, 0x46, 0xaf, 0xa0, 0x62, 0x07, 0x3b, 0xbd, 0x5f,
+	0x5f, 0x4f, 0xb4, 0x29, 0x21, 0x42, 0x74, 0xe3, 0x77, 0xa8, 0xfe, 0x3c, 0xea, 0xa5, 0xc4, 0x73,
+	0x09, 0x6b, 0x7e, 0xb0, 0x11, 0xb2, 0xec, 0x67, 0x66, 0x11, 0x80, 0xaa, 0x12, 0x21, 0x8e, 0xb1,
+	0x0f, 0xd5, 0x36, 0x19, 0x90, 0x59, 0xae, 0x65, 0x62, 0xf9, 0x4f, 0x8a, 0xa5, 0xe3, 0x72, 0x87,
+	0x3b, 0x44, 0xd9, 0x7d, 0x0e, 0x65, 0x75, 0xaa, 0xae, 0xed, 0x68, 0x8d, 0x32, 0x9e, 0x2e, 0xa0,
+	0x9f, 0x54, 0xdd, 0x85, 0x90, 0x5a, 0xcb, 0x03, 0x08, 0x08, 0xc6, 0xb3, 0x65, 0x47, 0x17, 0x11,
+	0x59, 0x0a, 0x15, 0x7d, 0x9b, 0x07, 0x6d, 0x56, 0x95, 0xc6, 0x1f, 0xb0, 0x75, 0x33, 0xf9, 0xce,
+	0x17, 0x53, 0x1b, 0x8a, 0x2f, 0x13, 0x2b, 0xaf, 0x5e, 0x08, 0x8a, 0xf2, 0xf5, 0x7c, 0x2f, 0xa6,
+	0xe8, 0x63, 0x89, 0x8d, 0xa5, 0xad, 0xf1, 0xaf, 0x06, 0x68, 0x76, 0x1b, 0x75, 0xa1, 0x28, 0xe4,
+	0x11, 0xf0, 0xae, 0xb7, 0xcc, 0xcc, 0x15, 0x17, 0x38, 0x27, 0x2b, 0x58, 0x02, 0xa0, 0x0b, 0x28,
+	0x8a, 0xaa, 0xcb, 0xdc, 0x7f, 0x9f, 0x35, 0x5b, 0x71, 0xed, 0x4c, 0x10, 0x05, 0xce, 0x51, 0x19,
+	0xd6, 0x98, 0xf0, 0xd3, 0xf8, 0xbf, 0x00, 0xd5, 0x44, 0xee, 0xe4, 0x1d, 0xb9, 0x9d, 0xde, 0x11,
+	0x22, 0xf7, 0xa4, 0x7a, 0xf3, 0xc6, 0xa2, 0x6e, 0x4a, 0xc8, 0x81, 0x28, 0x54, 0x84, 0x2b, 0x11,
+	0x6c, 0x51, 0x84, 0x76, 0x96, 0x22, 0x44, 0xdc, 0x6c, 0x8a, 0x20, 0x15, 0x74, 0xc7, 0xe5, 0x6c,
+	0x8c, 0x37, 0xfd, 0xf8, 0xaa, 0xee, 0xc1, 0x56, 0xda, 0x41, 0x54, 0x81, 0xd5, 0x47, 0x32, 0x96,
+	0xda, 0x98, 0x7c, 0xa2, 0x0e, 0x7c, 0xf8, 0x6c, 0x0d, 0xfc, 0x30, 0xd9, 0xb9, 0x63, 0x15, 0xd6,
+	0x87, 0x85, 0x03, 0xcd, 0xf8, 0x53, 0x0b, 0x1b, 0x5c, 0x3e, 0x61, 0x9e, 0x42, 0x29, 0x91, 0x95,
+	0xdc, 0x5e, 0x28, 0x00, 0x83, 0x87, 0x4d, 0xf0, 0x5d, 0x16, 0xd8, 0xf8, 0x4b, 0x0b, 0x7b, 0x61,
+	0xbe, 0xd0, 0x2f, 0xa6, 0x9d, 0x52, 0x44, 0xfe, 0x4a, 0xb1, 0x4f, 0x1b, 0xe5, 0x1b, 0x0d, 0x6a,
+	0x49, 0x4f, 0x64, 0x02, 0x46, 0x29, 0x2a, 0x14, 0x09, 0xe8, 0xcc, 0x67, 0x4d, 0xc7, 0x7a, 0x9f,
+	0x65, 0xf8, 0x14, 0x3e, 0x15, 0xf9, 0x4a, 0x71, 0x08, 0x85, 0x6e, 0x5b, 0x56, 0xe1, 0xab, 0xac,
+	0x55, 0xe8, 0xb6, 0x71, 0xa1, 0xdb, 0x9e, 0x28, 0x7f, 0x6b, 0x32, 0xcc, 0x60, 0xf2, 0xec, 0x78,
+	0x0e, 0x75, 0x33, 0x52, 0x9e, 0x25, 0x5e, 0x99, 0x0c, 0xef, 0x42, 0xc8, 0x90, 0x32, 0x5e, 0x58,
+	0x50, 0x4d, 0xf8, 0x20, 0xeb, 0x7e, 0x02, 0x65, 0x16, 0x2e, 0xd6, 0xb5, 0xac, 0x01, 0x86, 0x38,
+	0x78, 0x6a, 0x6c, 0xfc, 0x02, 0x9f, 0x61, 0x3a, 0x18, 0xdc, 0x5b, 0xf6, 0xe3, 0x15, 0x55, 0x07,
+	0x32, 0xc5, 0xba, 0x0d, 0x10, 0xe2, 0x04, 0x69, 0xd6, 0x1a, 0x1f, 0xe0, 0xc8, 0x4a, 0xeb, 0x6f,
+	0x80, 0xda, 0xb9, 0x1a, 0x50, 0x8f, 0x23, 0x1e, 0xa1, 0x1b, 0xd8, 0x88, 0x4f, 0x8a, 0xe8, 0x93,
+	0x98, 0xfb, 0xd7, 0xd4, 0xe9, 0xe9, 0xbb, 0xf3, 0x23, 0x4a, 0x1f, 0x33, 0x8d, 0x15, 0xe4, 0xc3,
+	0x46, 0x7c, 0x60, 0x42, 0x0b, 0x74, 0x97, 0x3a, 0xba, 0xe9, 0xbb, 0xd9, 0x0d, 0x14, 0xed, 0x35,
+	0x6c, 0xc4, 0xe7, 0xa6, 0x45, 0xb4, 0xa9, 0x13, 0x96, 0x3e, 0x9b, 0x00, 0x81, 0x1b, 0x9f, 0x91,
+	0x16, 0xe1, 0xa6, 0x4e, 0x53, 0xe9, 0xb8, 0x1c, 0x3e, 0x8a, 0x8e, 0xdb, 0xe8, 0x9b, 0x05, 0xa9,
+	0x9e, 0x1d, 0xcb, 0xf5, 0x7c, 0x33, 0x33, 0x26, 0x9e, 0x3f, 0xe0, 0xc6, 0x0a, 0x62, 0xf0, 0x71,
+	0xec, 0x05, 0x44, 0xcd, 0xcc, 0x4f, 0xa5, 0xe0, 0x35, 0x73, 0x3e, 0xad, 0x51, 0x41, 0x28, 0xd2,
+	0xa5, 0x82, 0x48, 0xb2, 0xee, 0x66, 0x37, 0x88, 0xd2, 0xc6, 0xdb, 0xec, 0x72, 0x41, 0xe4, 0xa0,
+	0x4d, 0xef, 0xe0, 0x51, 0xbd, 0x64, 0xa1, 0x4d, 0x6d, 0xa9, 0xe9, 0x7a, 0xf1, 0x84, 0x5e, 0x14,
+	0xea, 0x12, 0xbd, 0x24, 0x31, 0x73, 0xcd, 0xda, 0x51, 0xb9, 0xc4, 0xba, 0xdf, 0x22, 0xb9, 0xa4,
+	0xb5, 0x6a, 0xdd, 0xcc, 0x7c, 0x5e, 0x25, 0xf0, 0x0e, 0xd0, 0x6c, 0x3b, 0x44, 0x0b, 0xf4, 0x3e,
+	0xb7, 0x79, 0xa6, 0x26, 0xf2, 0xa8, 0x74, 0x5b, 0x14, 0xff, 0xbd, 0xef, 0xc5, 0xef, 0xde, 0xdb,
+	0x01, 0x00, 0xa1, 0xea, 0x01, 0x2c, 0xc4, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -826,6 +968,11 @@ type NorthboundConfiguratorClient interface {
 	DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error)
 	// LoadEntities fetches the set of Entities specified by the request
 	LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error)
+	// ListRevisions fetches the revisions of a network specified by the request
+	ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	// RollbackToRevision restores the network or entity mutated by a revision
+	// to its state right after the revision
+	RollbackToRevision(ctx context.Context, in *RollbackToRevisionRequest, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type northboundConfiguratorClient struct {
@@ -926,6 +1073,24 @@ func (c *northboundConfiguratorClient) LoadEntities(ctx context.Context, in *Loa
 	return out, nil
 }
 
+func (c *northboundConfiguratorClient) ListRevisions(ctx context.Context, in *ListRevisionsRequest, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) RollbackToRevision(ctx context.Context, in *RollbackToRevisionRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/RollbackToRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NorthboundConfiguratorServer is the server API for NorthboundConfigurator service.
 type NorthboundConfiguratorServer interface {
 	// ListNetworkIDs fetches the list of networkIDs registered
@@ -949,6 +1114,11 @@ type NorthboundConfiguratorServer interface {
 	DeleteEntities(context.Context, *DeleteEntitiesRequest) (*protos.Void, error)
 	// LoadEntities fetches the set of Entities specified by the request
 	LoadEntities(context.Context, *LoadEntitiesRequest) (*storage.EntityLoadResult, error)
+	// ListRevisions fetches the revisions of a network specified by the request
+	ListRevisions(context.Context, *ListRevisionsRequest) (*ListRevisionsResponse, error)
+	// RollbackToRevision restores the network or entity mutated by a revision
+	// to its state right after the revision
+	RollbackToRevision(context.Context, *RollbackToRevisionRequest) (*protos.Void, error)
 }
 
 // UnimplementedNorthboundConfiguratorServer can be embedded to have forward compatible implementations.
@@ -985,6 +1155,12 @@ func (*UnimplementedNorthboundConfiguratorServer) DeleteEntities(ctx context.Con
 func (*UnimplementedNorthboundConfiguratorServer) LoadEntities(ctx context.Context, req *LoadEntitiesRequest) (*storage.EntityLoadResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadEntities not implemented")
 }
+func (*UnimplementedNorthboundConfiguratorServer) ListRevisions(ctx context.Context, req *ListRevisionsRequest) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) RollbackToRevision(ctx context.Context, req *RollbackToRevisionRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackToRevision not implemented")
+}
 
 func RegisterNorthboundConfiguratorServer(s *grpc.Server, srv NorthboundConfiguratorServer) {
 	s.RegisterService(&_NorthboundConfigurator_serviceDesc, srv)
@@ -1170,6 +1346,42 @@ func _NorthboundConfigurator_LoadEntities_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NorthboundConfigurator_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ListRevisions(ctx, req.(*ListRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_RollbackToRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackToRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).RollbackToRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/RollbackToRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).RollbackToRevision(ctx, req.(*RollbackToRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.configurator.NorthboundConfigurator",
 	HandlerType: (*NorthboundConfiguratorServer)(nil),
@@ -1214,6 +1426,14 @@ var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadEntities",
 			Handler:    _NorthboundConfigurator_LoadEntities_Handler,
 		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _NorthboundConfigurator_ListRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackToRevision",
+			Handler:    _NorthboundConfigurator_RollbackToRevision_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "northbound.proto",
@@ -12,6 +12,7 @@ import (
 	"context"
 	"fmt"
 
+	merrors "magma/orc8r/cloud/go/errors"
 	commonProtos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -141,7 +142,19 @@ func (srv *nbConfiguratorServicer) LoadEntities(context context.Context, req *pr
 		return emptyRes, err
 	}
 
-	loadResult, err := store.LoadEntities(req.NetworkID, *req.Filter, *req.Criteria)
+	// Storage doesn't evaluate config predicates, so load configs to
+	// evaluate them here
+	criteria := *req.Criteria
+	configPredicates := req.Filter.GetSearchFilter().GetConfigPredicates()
+	if len(configPredicates) > 0 {
+		criteria.LoadConfig = true
+	}
+	var loadResult storage.EntityLoadResult
+	if len(configPredicates) > 0 {
+		loadResult, err = loadEntitiesMatchingConfig(store, req.NetworkID, *req.Filter, criteria, configPredicates, req.Criteria.LoadConfig)
+	} else {
+		loadResult, err = store.LoadEntities(req.NetworkID, *req.Filter, criteria)
+	}
 	if err != nil {
 		storage.RollbackLogOnError(store)
 		return emptyRes, err
@@ -243,6 +256,49 @@ func (srv *nbConfiguratorServicer) DeleteEntities(context context.Context, req *
 	return void, store.Commit()
 }
 
+func (srv *nbConfiguratorServicer) ListRevisions(context context.Context, req *protos.ListRevisionsRequest) (*protos.ListRevisionsResponse, error) {
+	res := &protos.ListRevisionsResponse{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return res, err
+	}
+
+	filter := storage.RevisionLoadFilter{}
+	if req.Filter != nil {
+		filter = *req.Filter
+	}
+	revisions, err := store.LoadRevisions(req.NetworkID, filter)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return res, err
+	}
+	res.Revisions = revisions
+	return res, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) RollbackToRevision(context context.Context, req *protos.RollbackToRevisionRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return void, err
+	}
+
+	err = store.RollbackToRevision(req.NetworkID, req.RevisionID)
+	if err == merrors.ErrNotFound {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.NotFound, "revision %d of network %s does not exist", req.RevisionID, req.NetworkID)
+	}
+	if rollbackErr, ok := err.(storage.RollbackError); ok {
+		storage.RollbackLogOnError(store)
+		return void, status.Error(codes.FailedPrecondition, rollbackErr.Reason)
+	}
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	return void, store.Commit()
+}
+
 func networkConfigsAreValid(configs map[string][]byte) error {
 	for typeVal, config := range configs {
 		_, err := serde.Deserialize(configurator.NetworkConfigSerdeDomain, typeVal, config)
@@ -0,0 +1,181 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/golang/glog"
+)
+
+// loadEntitiesMatchingConfig loads the entities matching the filter whose
+// configs match the predicates. Storage applies the page size before the
+// predicates are evaluated, so further pages are loaded until the page is
+// full or there are no more entities. Each further load only asks for the
+// number of entities still missing from the page, so the token of the last
+// load points right after the last returned entity.
+func loadEntitiesMatchingConfig(
+	store storage.ConfiguratorStorage,
+	networkID string,
+	filter storage.EntityLoadFilter,
+	criteria storage.EntityLoadCriteria,
+	predicates []*storage.ConfigPredicate,
+	keepConfig bool,
+) (storage.EntityLoadResult, error) {
+	pageSize := criteria.PageSize
+	ret := storage.EntityLoadResult{Entities: []*storage.NetworkEntity{}}
+	for {
+		result, err := store.LoadEntities(networkID, filter, criteria)
+		if err != nil {
+			return storage.EntityLoadResult{}, err
+		}
+		result = filterEntitiesByConfig(result, predicates, filter.IDs, keepConfig)
+		ret.Entities = append(ret.Entities, result.Entities...)
+		ret.NextPageToken = result.NextPageToken
+
+		loaded := uint32(len(ret.Entities))
+		if pageSize == 0 || ret.NextPageToken == "" || loaded >= pageSize {
+			break
+		}
+		criteria.PageSize = pageSize - loaded
+		criteria.PageToken = ret.NextPageToken
+	}
+	ret.EntitiesNotFound = getEntitiesNotFound(ret.Entities, filter.IDs)
+	return ret, nil
+}
+
+// getEntitiesNotFound returns the requested IDs which aren't among the loaded
+// entities
+func getEntitiesNotFound(loaded []*storage.NetworkEntity, requestedIDs []*storage.EntityID) []*storage.EntityID {
+	loadedIDs := map[string]bool{}
+	for _, ent := range loaded {
+		loadedIDs[ent.GetTypeAndKey().String()] = true
+	}
+	ret := []*storage.EntityID{}
+	for _, id := range requestedIDs {
+		if !loadedIDs[id.ToTypeAndKey().String()] {
+			ret = append(ret, id)
+		}
+	}
+	return ret
+}
+
+// filterEntitiesByConfig removes the entities whose deserialized config
+// doesn't match all of the predicates from a load result. Removed entities
+// which were explicitly requested by ID are reported as not found. If
+// keepConfig is false, configs are cleared from the returned entities.
+func filterEntitiesByConfig(result storage.EntityLoadResult, predicates []*storage.ConfigPredicate, requestedIDs []*storage.EntityID, keepConfig bool) storage.EntityLoadResult {
+	ret := storage.EntityLoadResult{Entities: []*storage.NetworkEntity{}, EntitiesNotFound: result.EntitiesNotFound, NextPageToken: result.NextPageToken}
+	for _, ent := range result.Entities {
+		if !entityConfigMatches(ent, predicates) {
+			if len(requestedIDs) > 0 {
+				ret.EntitiesNotFound = append(ret.EntitiesNotFound, ent.GetID())
+			}
+			continue
+		}
+		if !keepConfig {
+			ent.Config = nil
+		}
+		ret.Entities = append(ret.Entities, ent)
+	}
+	return ret
+}
+
+func entityConfigMatches(ent *storage.NetworkEntity, predicates []*storage.ConfigPredicate) bool {
+	if len(ent.Config) == 0 {
+		return false
+	}
+	iConfig, err := serde.Deserialize(configurator.NetworkEntitySerdeDomain, ent.Type, ent.Config)
+	if err != nil {
+		glog.Warningf("failed to deserialize config of entity %s for search: %s", ent.GetTypeAndKey(), err)
+		return false
+	}
+	// Predicates address the config by its JSON representation, which is
+	// also what API clients see
+	marshaledConfig, err := json.Marshal(iConfig)
+	if err != nil {
+		glog.Warningf("failed to marshal config of entity %s for search: %s", ent.GetTypeAndKey(), err)
+		return false
+	}
+	var config interface{}
+	decoder := json.NewDecoder(bytes.NewReader(marshaledConfig))
+	decoder.UseNumber()
+	if err := decoder.Decode(&config); err != nil {
+		glog.Warningf("failed to unmarshal config of entity %s for search: %s", ent.GetTypeAndKey(), err)
+		return false
+	}
+
+	for _, predicate := range predicates {
+		var path []string
+		if predicate.Path != "" {
+			path = strings.Split(predicate.Path, ".")
+		}
+		if !configValueMatches(config, path, predicate) {
+			return false
+		}
+	}
+	return true
+}
+
+func configValueMatches(value interface{}, path []string, predicate *storage.ConfigPredicate) bool {
+	// Lists match if any of their elements match
+	if list, ok := value.([]interface{}); ok {
+		for _, elem := range list {
+			if configValueMatches(elem, path, predicate) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return leafValueMatches(value, predicate)
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	child, ok := obj[path[0]]
+	if !ok {
+		return false
+	}
+	return configValueMatches(child, path[1:], predicate)
+}
+
+func leafValueMatches(value interface{}, predicate *storage.ConfigPredicate) bool {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case json.Number:
+		str = v.String()
+	case bool:
+		str = strconv.FormatBool(v)
+	case nil:
+		str = "null"
+	default:
+		marshaled, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		str = string(marshaled)
+	}
+
+	if predicate.Contains {
+		return strings.Contains(strings.ToLower(str), strings.ToLower(predicate.Value))
+	}
+	return str == predicate.Value
+}
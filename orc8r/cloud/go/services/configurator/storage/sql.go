@@ -31,6 +31,9 @@ const (
 	entityTable      = "cfg_entities"
 	entityAssocTable = "cfg_assocs"
 	entityAclTable   = "cfg_acls"
+
+	revisionTable        = "cfg_revisions"
+	revisionCounterTable = "cfg_revision_counters"
 )
 
 const (
@@ -65,6 +68,15 @@ const (
 	aclTypeCol     = "type"
 	aclIdFilterCol = "id_filter"
 	aclVerCol      = "version"
+
+	revNidCol     = "network_id"
+	revIDCol      = "id"
+	revEntTypeCol = "entity_type"
+	revEntKeyCol  = "entity_key"
+	revValCol     = "value"
+
+	revCounterNidCol    = "network_id"
+	revCounterLastIDCol = "last_id"
 )
 
 type IDGenerator interface {
@@ -83,10 +95,21 @@ func NewSQLConfiguratorStorageFactory(db *sql.DB, generator IDGenerator, sqlBuil
 	return &sqlConfiguratorStorageFactory{db: db, idGenerator: generator, builder: sqlBuilder}
 }
 
+// NewSQLConfiguratorStorageFactoryWithHistory returns a
+// ConfiguratorStorageFactory like NewSQLConfiguratorStorageFactory, whose
+// storage also records a revision for every mutation of a network or entity.
+// Only the latest maxRevisions revisions of each network are kept, all
+// revisions are kept if maxRevisions is 0.
+func NewSQLConfiguratorStorageFactoryWithHistory(db *sql.DB, generator IDGenerator, sqlBuilder sqorc.StatementBuilder, maxRevisions uint64) ConfiguratorStorageFactory {
+	return &sqlConfiguratorStorageFactory{db: db, idGenerator: generator, builder: sqlBuilder, recordHistory: true, maxRevisions: maxRevisions}
+}
+
 type sqlConfiguratorStorageFactory struct {
-	db          *sql.DB
-	idGenerator IDGenerator
-	builder     sqorc.StatementBuilder
+	db            *sql.DB
+	idGenerator   IDGenerator
+	builder       sqorc.StatementBuilder
+	recordHistory bool
+	maxRevisions  uint64
 }
 
 func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error) {
@@ -238,6 +261,11 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	err = fact.initializeRevisionTable(tx)
+	if err != nil {
+		return
+	}
+
 	// Create internal network(s)
 	_, err = fact.builder.Insert(networksTable).
 		Columns(nwIDCol, nwTypeCol, nwNameCol, nwDescCol).
@@ -258,7 +286,14 @@ func (fact *sqlConfiguratorStorageFactory) StartTransaction(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return &sqlConfiguratorStorage{tx: tx, idGenerator: fact.idGenerator, builder: fact.builder}, nil
+	return &sqlConfiguratorStorage{
+		tx:            tx,
+		idGenerator:   fact.idGenerator,
+		builder:       fact.builder,
+		recordHistory: fact.recordHistory,
+		maxRevisions:  fact.maxRevisions,
+		author:        getRevisionAuthor(ctx),
+	}, nil
 }
 
 func getSqlOpts(opts *storage.TxOptions) *sql.TxOptions {
@@ -275,6 +310,13 @@ type sqlConfiguratorStorage struct {
 	tx          *sql.Tx
 	idGenerator IDGenerator
 	builder     sqorc.StatementBuilder
+
+	// recordHistory is true if mutations are recorded as revisions, which
+	// are attributed to author. Only the latest maxRevisions revisions of a
+	// network are kept, unless it is 0.
+	recordHistory bool
+	maxRevisions  uint64
+	author        string
 }
 
 func (store *sqlConfiguratorStorage) Commit() error {
@@ -357,6 +399,14 @@ func (store *sqlConfiguratorStorage) LoadAllNetworks(loadCriteria NetworkLoadCri
 }
 
 func (store *sqlConfiguratorStorage) CreateNetwork(network Network) (Network, error) {
+	createdNetwork, err := store.createNetwork(network)
+	if err != nil || !store.recordHistory {
+		return createdNetwork, err
+	}
+	return createdNetwork, store.recordNetworkRevision(network.ID, nil)
+}
+
+func (store *sqlConfiguratorStorage) createNetwork(network Network) (Network, error) {
 	exists, err := store.doesNetworkExist(network.ID)
 	if err != nil {
 		return network, err
@@ -398,6 +448,30 @@ func (store *sqlConfiguratorStorage) UpdateNetworks(updates []NetworkUpdateCrite
 	if err := validateNetworkUpdates(updates); err != nil {
 		return err
 	}
+	if !store.recordHistory {
+		return store.updateNetworks(updates)
+	}
+
+	networksBefore := make([]*Network, 0, len(updates))
+	for _, update := range updates {
+		before, err := store.loadNetworkForRevision(update.ID)
+		if err != nil {
+			return err
+		}
+		networksBefore = append(networksBefore, before)
+	}
+	if err := store.updateNetworks(updates); err != nil {
+		return err
+	}
+	for i, update := range updates {
+		if err := store.recordNetworkRevision(update.ID, networksBefore[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (store *sqlConfiguratorStorage) updateNetworks(updates []NetworkUpdateCriteria) error {
 
 	networksToDelete := []string{}
 	networksToUpdate := []NetworkUpdateCriteria{}
@@ -449,7 +523,13 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 	// be smart here and only load (type, key) for PKs which we don't know.
 	// Finally, we will update the entity objects to return with their edges.
 
-	entsByPk, err := store.loadFromEntitiesTable(networkID, filter, loadCriteria)
+	var entsByPk map[string]*NetworkEntity
+	var err error
+	if loadCriteria.PageSize > 0 {
+		entsByPk, ret.NextPageToken, err = store.loadEntityPage(networkID, filter, loadCriteria)
+	} else {
+		entsByPk, err = store.loadFromEntitiesTable(networkID, filter, loadCriteria)
+	}
 	if err != nil {
 		return ret, err
 	}
@@ -482,6 +562,14 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 }
 
 func (store *sqlConfiguratorStorage) CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
+	createdEntity, err := store.createEntity(networkID, entity)
+	if err != nil || !store.recordHistory {
+		return createdEntity, err
+	}
+	return createdEntity, store.recordEntityRevision(networkID, *entity.GetID(), nil)
+}
+
+func (store *sqlConfiguratorStorage) createEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
 	exists, err := store.doesEntExist(networkID, entity.GetTypeAndKey())
 	if err != nil {
 		return NetworkEntity{}, err
@@ -536,6 +624,23 @@ func (store *sqlConfiguratorStorage) CreateEntity(networkID string, entity Netwo
 }
 
 func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
+	if !store.recordHistory {
+		return store.updateEntity(networkID, update)
+	}
+
+	id := EntityID{Type: update.Type, Key: update.Key}
+	before, err := store.loadEntityForRevision(networkID, id)
+	if err != nil {
+		return NetworkEntity{Type: update.Type, Key: update.Key}, err
+	}
+	updatedEntity, err := store.updateEntity(networkID, update)
+	if err != nil {
+		return updatedEntity, err
+	}
+	return updatedEntity, store.recordEntityRevision(networkID, id, before)
+}
+
+func (store *sqlConfiguratorStorage) updateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
 	emptyRet := NetworkEntity{Type: update.Type, Key: update.Key}
 	entToUpdate, err := store.loadEntToUpdate(networkID, update)
 	if err != nil && !update.DeleteEntity {
@@ -10,6 +10,7 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/base64"
 	"fmt"
 	"sort"
 	"strings"
@@ -19,15 +20,98 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
 )
 
 func (store *sqlConfiguratorStorage) loadFromEntitiesTable(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) (map[string]*NetworkEntity, error) {
+	selectBuilder := store.getLoadEntitiesSelectBuilder(networkID, filter, criteria)
+	return store.loadEntitiesWithSelectBuilder(selectBuilder, criteria)
+}
+
+// loadEntityPage loads the page of entities matching the filter which is
+// specified by the page size and token of the load criteria. The token of
+// the next page is returned, or an empty string if this is the last page.
+func (store *sqlConfiguratorStorage) loadEntityPage(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) (map[string]*NetworkEntity, string, error) {
+	// Entities are joined with their ACLs, so we can't LIMIT the query which
+	// loads them. Instead, we first load the PKs of the entities on the page.
+	// SELECT ent.pk, ent.type, ent."key" FROM cfg_entities AS ent
+	// WHERE ... [[ AND (ent.type > $1 OR (ent.type = $2 AND ent."key" > $3)) ]]
+	// ORDER BY ent.type, ent."key" LIMIT {page_size + 1}
+	pageBuilder := store.builder.Select(
+		fmt.Sprintf("ent.%s", entPkCol),
+		fmt.Sprintf("ent.%s", entTypeCol),
+		fmt.Sprintf("ent.%s", entKeyCol),
+	).From(fmt.Sprintf("%s AS ent", entityTable))
+	pageBuilder = addLoadEntitiesFilter(pageBuilder, networkID, filter)
+	if criteria.PageToken != "" {
+		lastID, err := decodePageToken(criteria.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		pageBuilder = pageBuilder.Where(sq.Or{
+			sq.Gt{fmt.Sprintf("ent.%s", entTypeCol): lastID.Type},
+			sq.And{
+				sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): lastID.Type},
+				sq.Gt{fmt.Sprintf("ent.%s", entKeyCol): lastID.Key},
+			},
+		})
+	}
+	// Load one more entity than requested to find out if there's a next page
+	rows, err := pageBuilder.
+		OrderBy(fmt.Sprintf("ent.%s", entTypeCol), fmt.Sprintf("ent.%s", entKeyCol)).
+		Limit(uint64(criteria.PageSize) + 1).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error querying for entity page")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "loadEntityPage")
+
+	pks := []string{}
+	var lastID *EntityID
+	hasNextPage := false
+	for rows.Next() {
+		if uint32(len(pks)) == criteria.PageSize {
+			hasNextPage = true
+			break
+		}
+		var pk string
+		id := &EntityID{}
+		if err := rows.Scan(&pk, &id.Type, &id.Key); err != nil {
+			return nil, "", errors.Wrap(err, "failed to scan entity page row")
+		}
+		pks = append(pks, pk)
+		lastID = id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", errors.Wrap(err, "error iterating over entity page rows")
+	}
+	// Close the rows before issuing the next query on the same tx
+	sqorc.CloseRowsLogOnError(rows, "loadEntityPage")
+
+	nextPageToken := ""
+	if hasNextPage {
+		nextPageToken, err = encodePageToken(lastID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if len(pks) == 0 {
+		return map[string]*NetworkEntity{}, nextPageToken, nil
+	}
+
+	selectBuilder := store.getLoadEntitiesBaseSelectBuilder(criteria).
+		Where(sq.Eq{fmt.Sprintf("ent.%s", entPkCol): pks})
+	entsByPk, err := store.loadEntitiesWithSelectBuilder(selectBuilder, criteria)
+	return entsByPk, nextPageToken, err
+}
+
+func (store *sqlConfiguratorStorage) loadEntitiesWithSelectBuilder(selectBuilder sq.SelectBuilder, criteria EntityLoadCriteria) (map[string]*NetworkEntity, error) {
 	// Pointer values because we're modifying entities in-place with ACLs (LEFT JOIN)
 	entsByPk := map[string]*NetworkEntity{}
 
-	selectBuilder := store.getLoadEntitiesSelectBuilder(networkID, filter, criteria)
 	rows, err := selectBuilder.RunWith(store.tx).Query()
 	if err != nil {
 		return entsByPk, errors.Wrap(err, "error querying for entities")
@@ -53,12 +137,22 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 	// FROM cfg_entities AS ent
 	// [[ LEFT JOIN cfg_acls AS acl ON acl.entity_pk = ent.pk ]]
 	// [[ WHERE (ent.network_id = $1 AND ent.key = $2 AND ent.type = $3) OR (ent.network_id ...) ... ]]
+	selectBuilder := store.getLoadEntitiesBaseSelectBuilder(criteria)
+	return addLoadEntitiesFilter(selectBuilder, networkID, filter)
+}
+
+func (store *sqlConfiguratorStorage) getLoadEntitiesBaseSelectBuilder(criteria EntityLoadCriteria) sq.SelectBuilder {
 	selectBuilder := store.builder.Select(getLoadEntitiesColumns(criteria)...).
 		From(fmt.Sprintf("%s AS ent", entityTable))
 	if criteria.LoadPermissions {
 		selectBuilder = selectBuilder.LeftJoin(fmt.Sprintf("%s AS acl ON acl.%s = ent.%s", entityAclTable, aclEntCol, entPkCol))
 	}
+	return selectBuilder
+}
 
+// addLoadEntitiesFilter adds the WHERE clauses matching the load filter to a
+// query on the entities table aliased as ent
+func addLoadEntitiesFilter(selectBuilder sq.SelectBuilder, networkID string, filter EntityLoadFilter) sq.SelectBuilder {
 	// The WHERE has ORs if specific IDs are provided
 	if !funk.IsEmpty(filter.IDs) {
 		orClause := make(sq.Or, 0, len(filter.IDs))
@@ -86,10 +180,29 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 			selectBuilder = selectBuilder.Where(andClause)
 		}
 	}
+	if filter.SearchFilter != nil {
+		// [[ AND LOWER(ent.name) LIKE $4 ESCAPE '!' AND LOWER(ent.description) LIKE $5 ESCAPE '!' ]]
+		if filter.SearchFilter.NameContains != nil {
+			selectBuilder = selectBuilder.Where(getContainsClause(fmt.Sprintf("ent.%s", entNameCol), filter.SearchFilter.NameContains.Value))
+		}
+		if filter.SearchFilter.DescriptionContains != nil {
+			selectBuilder = selectBuilder.Where(getContainsClause(fmt.Sprintf("ent.%s", entDescCol), filter.SearchFilter.DescriptionContains.Value))
+		}
+	}
 
 	return selectBuilder
 }
 
+// getContainsClause returns a case-insensitive substring match on a column.
+// '!' is used as the LIKE escape character since backslash isn't treated
+// the same way across SQL dialects.
+func getContainsClause(column string, value string) sq.Sqlizer {
+	escaped := likeEscaper.Replace(strings.ToLower(value))
+	return sq.Expr(fmt.Sprintf("LOWER(%s) LIKE ? ESCAPE '!'", column), "%"+escaped+"%")
+}
+
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
 func getLoadEntitiesColumns(criteria EntityLoadCriteria) []string {
 	fields := []string{
 		fmt.Sprintf("ent.%s", entNidCol),
@@ -242,7 +355,7 @@ func (store *sqlConfiguratorStorage) loadFromAssocsTable(filter EntityLoadFilter
 	}
 	// if we loaded all entities, save some network traffic and just load the
 	// entire assocs table
-	if filter.IsLoadAllEntities() {
+	if filter.IsLoadAllEntities() && criteria.PageSize == 0 {
 		orClause = sq.Or{sq.Eq{"1": 1}}
 	}
 
@@ -378,3 +491,30 @@ func calculateEntitiesNotFound(entsByPk map[string]*NetworkEntity, requestedIDs
 	}
 	return ret
 }
+
+func encodePageToken(lastID *EntityID) (string, error) {
+	marshaled, err := proto.Marshal(lastID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(marshaled), nil
+}
+
+// ValidatePageToken returns an error if token isn't a page token returned by
+// a paginated entity load.
+func ValidatePageToken(token string) error {
+	_, err := decodePageToken(token)
+	return err
+}
+
+func decodePageToken(token string) (*EntityID, error) {
+	marshaled, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid page token")
+	}
+	ret := &EntityID{}
+	if err := proto.Unmarshal(marshaled, ret); err != nil {
+		return nil, errors.Wrap(err, "invalid page token")
+	}
+	return ret, nil
+}
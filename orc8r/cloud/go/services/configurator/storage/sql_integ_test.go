@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"testing"
 
+	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/sqorc"
 	orc8rStorage "magma/orc8r/cloud/go/storage"
@@ -21,6 +22,7 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
 )
 
 type mockIDGenerator struct {
@@ -339,6 +341,76 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 	)
 	assert.NoError(t, store.Commit())
 
+	// Search on name and description
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	actualEntityLoad, err = store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{SearchFilter: &storage.EntitySearchFilter{NameContains: stringPointer("BAZ")}},
+		storage.EntityLoadCriteria{},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, actualEntityLoad.Entities, 2)
+	assert.Equal(
+		t,
+		[]string{"baz", "quz"},
+		[]string{actualEntityLoad.Entities[0].Key, actualEntityLoad.Entities[1].Key},
+	)
+	actualEntityLoad, err = store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{
+			TypeFilter:   stringPointer("bar"),
+			SearchFilter: &storage.EntitySearchFilter{NameContains: stringPointer("baz"), DescriptionContains: stringPointer("z e")},
+		},
+		storage.EntityLoadCriteria{},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, actualEntityLoad.Entities, 1)
+	assert.Equal(t, "baz", actualEntityLoad.Entities[0].Key)
+	// LIKE wildcards in the search term are matched literally
+	actualEntityLoad, err = store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{SearchFilter: &storage.EntitySearchFilter{NameContains: stringPointer("%")}},
+		storage.EntityLoadCriteria{},
+	)
+	assert.NoError(t, err)
+	assert.Empty(t, actualEntityLoad.Entities)
+	assert.NoError(t, store.Commit())
+
+	// Load in pages
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	pagedCriteria := storage.FullEntityLoadCriteria
+	pagedCriteria.PageSize = 2
+	actualEntityLoad, err = store.LoadEntities("n1", storage.EntityLoadFilter{}, pagedCriteria)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*storage.NetworkEntity{&expectedBarbazEnt, &expectedBazquzEnt},
+		actualEntityLoad.Entities,
+	)
+	assert.NotEmpty(t, actualEntityLoad.NextPageToken)
+	pagedCriteria.PageToken = actualEntityLoad.NextPageToken
+	actualEntityLoad, err = store.LoadEntities("n1", storage.EntityLoadFilter{}, pagedCriteria)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		storage.EntityLoadResult{
+			Entities:         []*storage.NetworkEntity{&expectedFoobarEnt},
+			EntitiesNotFound: []*storage.EntityID{},
+		},
+		actualEntityLoad,
+	)
+	// Pages are only as full as the filter allows
+	actualEntityLoad, err = store.LoadEntities("n1", storage.EntityLoadFilter{TypeFilter: stringPointer("baz")}, storage.EntityLoadCriteria{PageSize: 1})
+	assert.NoError(t, err)
+	assert.Len(t, actualEntityLoad.Entities, 1)
+	assert.Equal(t, "quz", actualEntityLoad.Entities[0].Key)
+	assert.Empty(t, actualEntityLoad.NextPageToken)
+	_, err = store.LoadEntities("n1", storage.EntityLoadFilter{}, storage.EntityLoadCriteria{PageSize: 1, PageToken: "not a token"})
+	assert.Error(t, err)
+	assert.NoError(t, store.Rollback())
+
 	// At this point, our graph looks like this:
 	//                (baz, quz)
 	//                 /      \
@@ -897,3 +969,260 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 		allEnts,
 	)
 }
+
+func TestSqlConfiguratorStorage_Revisions(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactoryWithHistory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 0)
+	err = factory.InitializeServiceStorage()
+	assert.NoError(t, err)
+
+	aliceCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(storage.RevisionAuthorMetadataKey, "alice"))
+	bobCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(storage.RevisionAuthorMetadataKey, "bob"))
+
+	// Make some changes as alice
+	store, err := factory.StartTransaction(aliceCtx, nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Type: "t1", Name: "net1"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "bar", Name: "bar", Config: []byte("cfg1")})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "baz", Key: "quz"})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type:              "foo",
+		Key:               "bar",
+		NewName:           &wrappers.StringValue{Value: "bar2"},
+		AssociationsToAdd: []*storage.EntityID{{Type: "baz", Key: "quz"}},
+	})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", DeleteEntity: true})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	revisions, err := store.LoadRevisions("n1", storage.RevisionLoadFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 5)
+	for i, rev := range revisions {
+		assert.Equal(t, uint64(i+1), rev.ID)
+		assert.Equal(t, "n1", rev.NetworkID)
+		assert.Equal(t, "alice", rev.Author)
+	}
+
+	assert.Nil(t, revisions[0].Entity)
+	assert.Equal(t, storage.Revision_CREATE, revisions[0].Operation)
+	assert.Nil(t, revisions[0].NetworkBefore)
+	assert.Equal(t, "net1", revisions[0].NetworkAfter.Name)
+
+	assert.Equal(t, &storage.EntityID{Type: "foo", Key: "bar"}, revisions[1].Entity)
+	assert.Equal(t, storage.Revision_CREATE, revisions[1].Operation)
+	assert.Nil(t, revisions[1].EntityBefore)
+	assert.Equal(t, []byte("cfg1"), revisions[1].EntityAfter.Config)
+
+	assert.Equal(t, storage.Revision_UPDATE, revisions[3].Operation)
+	assert.Equal(t, "bar", revisions[3].EntityBefore.Name)
+	assert.Equal(t, "bar2", revisions[3].EntityAfter.Name)
+	assert.Equal(
+		t,
+		[]*storage.GraphEdge{{From: &storage.EntityID{Type: "foo", Key: "bar"}, To: &storage.EntityID{Type: "baz", Key: "quz"}}},
+		revisions[3].EdgesAdded,
+	)
+	assert.Empty(t, revisions[3].EdgesRemoved)
+
+	assert.Equal(t, storage.Revision_DELETE, revisions[4].Operation)
+	assert.Nil(t, revisions[4].EntityAfter)
+	assert.Equal(
+		t,
+		[]*storage.GraphEdge{{From: &storage.EntityID{Type: "foo", Key: "bar"}, To: &storage.EntityID{Type: "baz", Key: "quz"}}},
+		revisions[4].EdgesRemoved,
+	)
+
+	// Filters
+	revisions, err = store.LoadRevisions("n1", storage.RevisionLoadFilter{Entity: &storage.EntityID{Type: "foo", Key: "bar"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2, 4, 5}, getRevisionIDs(revisions))
+	revisions, err = store.LoadRevisions("n1", storage.RevisionLoadFilter{NetworkOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1}, getRevisionIDs(revisions))
+	revisions, err = store.LoadRevisions("n2", storage.RevisionLoadFilter{})
+	assert.NoError(t, err)
+	assert.Empty(t, revisions)
+	assert.NoError(t, store.Commit())
+
+	// Roll the deleted entity back to its state after the update as bob
+	store, err = factory.StartTransaction(bobCtx, nil)
+	assert.NoError(t, err)
+	err = store.RollbackToRevision("n1", 4)
+	assert.NoError(t, err)
+	err = store.RollbackToRevision("n1", 42)
+	assert.Equal(t, merrors.ErrNotFound, err)
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	loadResult, err := store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{IDs: []*storage.EntityID{{Type: "foo", Key: "bar"}}},
+		storage.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsFromThis: true},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, loadResult.Entities, 1)
+	assert.Equal(t, "bar2", loadResult.Entities[0].Name)
+	assert.Equal(t, []byte("cfg1"), loadResult.Entities[0].Config)
+	assert.Equal(t, []*storage.EntityID{{Type: "baz", Key: "quz"}}, loadResult.Entities[0].Associations)
+
+	revisions, err = store.LoadRevisions("n1", storage.RevisionLoadFilter{Entity: &storage.EntityID{Type: "foo", Key: "bar"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2, 4, 5, 6}, getRevisionIDs(revisions))
+	assert.Equal(t, storage.Revision_CREATE, revisions[3].Operation)
+	assert.Equal(t, "bob", revisions[3].Author)
+	assert.NoError(t, store.Commit())
+
+	// Update the network, then roll it back to its creation
+	store, err = factory.StartTransaction(bobCtx, nil)
+	assert.NoError(t, err)
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: "net1-renamed"}}})
+	assert.NoError(t, err)
+	err = store.RollbackToRevision("n1", 1)
+	assert.NoError(t, err)
+	networks, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{"n1"}}, storage.FullNetworkLoadCriteria)
+	assert.NoError(t, err)
+	assert.Len(t, networks.Networks, 1)
+	assert.Equal(t, "net1", networks.Networks[0].Name)
+	revisions, err = store.LoadRevisions("n1", storage.RevisionLoadFilter{NetworkOnly: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 7, 8}, getRevisionIDs(revisions))
+	assert.Equal(t, "net1-renamed", revisions[2].NetworkBefore.Name)
+	assert.Equal(t, "net1", revisions[2].NetworkAfter.Name)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSqlConfiguratorStorage_RevisionRetention(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactoryWithHistory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 3)
+	err = factory.InitializeServiceStorage()
+	assert.NoError(t, err)
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Type: "t1", Name: "net1"})
+	assert.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: fmt.Sprintf("net1-%d", i)}}})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, store.Commit())
+
+	// Only the latest 3 revisions are kept, IDs keep increasing
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	revisions, err := store.LoadRevisions("n1", storage.RevisionLoadFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 4, 5}, getRevisionIDs(revisions))
+	err = store.RollbackToRevision("n1", 1)
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Deleting and recreating a network doesn't reuse revision IDs
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true}})
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Type: "t1", Name: "net1"})
+	assert.NoError(t, err)
+	revisions, err = store.LoadRevisions("n1", storage.RevisionLoadFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{5, 6, 7}, getRevisionIDs(revisions))
+	assert.NoError(t, store.Commit())
+}
+
+func TestSqlConfiguratorStorage_RollbackDeletes(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactoryWithHistory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 0)
+	err = factory.InitializeServiceStorage()
+	assert.NoError(t, err)
+
+	// tier -> gw -> device, then rename and delete gw
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Type: "t1", Name: "net1"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "device", Key: "d1"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type:         "gw",
+		Key:          "g1",
+		Associations: []*storage.EntityID{{Type: "device", Key: "d1"}},
+	})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type:         "tier",
+		Key:          "t1",
+		Associations: []*storage.EntityID{{Type: "gw", Key: "g1"}},
+	})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewName: &wrappers.StringValue{Value: "gw1"}})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", DeleteEntity: true})
+	assert.NoError(t, err)
+
+	// The delete records the removed edges to and from the entity
+	revisions, err := store.LoadRevisions("n1", storage.RevisionLoadFilter{Entity: &storage.EntityID{Type: "gw", Key: "g1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{3, 5, 6}, getRevisionIDs(revisions))
+	assert.Equal(
+		t,
+		[]*storage.GraphEdge{
+			{From: &storage.EntityID{Type: "gw", Key: "g1"}, To: &storage.EntityID{Type: "device", Key: "d1"}},
+			{From: &storage.EntityID{Type: "tier", Key: "t1"}, To: &storage.EntityID{Type: "gw", Key: "g1"}},
+		},
+		revisions[2].EdgesRemoved,
+	)
+
+	// Rolling back to the rename recreates the gateway and its edges
+	err = store.RollbackToRevision("n1", 5)
+	assert.NoError(t, err)
+	loadResult, err := store.LoadEntities(
+		"n1",
+		storage.EntityLoadFilter{IDs: []*storage.EntityID{{Type: "gw", Key: "g1"}}},
+		storage.EntityLoadCriteria{LoadMetadata: true, LoadAssocsToThis: true, LoadAssocsFromThis: true},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, loadResult.Entities, 1)
+	assert.Equal(t, "gw1", loadResult.Entities[0].Name)
+	assert.Equal(t, []*storage.EntityID{{Type: "device", Key: "d1"}}, loadResult.Entities[0].Associations)
+	assert.Equal(t, []*storage.EntityID{{Type: "tier", Key: "t1"}}, loadResult.Entities[0].ParentAssociations)
+	assert.NoError(t, store.Commit())
+
+	// The gateway can't be recreated without its parent
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", DeleteEntity: true})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "tier", Key: "t1", DeleteEntity: true})
+	assert.NoError(t, err)
+	err = store.RollbackToRevision("n1", 5)
+	assert.Equal(t, storage.RollbackError{Reason: "entity gw-g1 can't be recreated, since its associated entity tier-t1 no longer exists"}, err)
+
+	// Entities deleted along with their network can't be restored
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true}})
+	assert.NoError(t, err)
+	err = store.RollbackToRevision("n1", 1)
+	assert.Equal(t, storage.RollbackError{Reason: "network n1 was deleted along with its entities, which a rollback can't restore"}, err)
+	assert.NoError(t, store.Rollback())
+}
+
+func getRevisionIDs(revisions []*storage.Revision) []uint64 {
+	ret := make([]uint64, 0, len(revisions))
+	for _, rev := range revisions {
+		ret = append(ret, rev.ID)
+	}
+	return ret
+}
@@ -0,0 +1,486 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+// RevisionAuthorMetadataKey is the gRPC metadata key under which callers of
+// the configurator service pass the author of the mutations they request.
+// The author is recorded on the revisions of those mutations.
+const RevisionAuthorMetadataKey = "x-magma-revision-author"
+
+// DefaultMaxRevisions is the default number of revisions kept per network
+const DefaultMaxRevisions = 1000
+
+// revisionEntityLoadCriteria specifies the fields of entities which are
+// recorded on revisions
+var revisionEntityLoadCriteria = EntityLoadCriteria{
+	LoadMetadata:       true,
+	LoadConfig:         true,
+	LoadAssocsToThis:   true,
+	LoadAssocsFromThis: true,
+}
+
+func getRevisionAuthor(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	authors := md.Get(RevisionAuthorMetadataKey)
+	if len(authors) == 0 {
+		return ""
+	}
+	return authors[0]
+}
+
+func (fact *sqlConfiguratorStorageFactory) initializeRevisionTable(tx *sql.Tx) error {
+	_, err := fact.builder.CreateTable(revisionTable).
+		IfNotExists().
+		Column(revNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revIDCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(revEntTypeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revEntKeyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(revValCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		PrimaryKey(revNidCol, revIDCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create revisions table")
+	}
+
+	// Revision IDs are assigned from a counter per network, so concurrent
+	// mutations of a network serialize on the counter's row
+	_, err = fact.builder.CreateTable(revisionCounterTable).
+		IfNotExists().
+		Column(revCounterNidCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(revCounterLastIDCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create revision counters table")
+	}
+
+	_, err = fact.builder.CreateIndex("revision_ent_idx").
+		IfNotExists().
+		On(revisionTable).
+		Columns(revNidCol, revEntTypeCol, revEntKeyCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create revision entity index")
+	}
+	return nil
+}
+
+func (store *sqlConfiguratorStorage) LoadRevisions(networkID string, filter RevisionLoadFilter) ([]*Revision, error) {
+	ret := []*Revision{}
+	if !store.recordHistory {
+		return ret, nil
+	}
+
+	// SELECT value FROM cfg_revisions WHERE network_id = $1
+	// [[ AND entity_type = $2 AND entity_key = $3 ]]
+	// ORDER BY id
+	whereClause := sq.And{sq.Eq{revNidCol: networkID}}
+	if filter.Entity != nil {
+		whereClause = append(whereClause, sq.Eq{revEntTypeCol: filter.Entity.Type, revEntKeyCol: filter.Entity.Key})
+	} else if filter.NetworkOnly {
+		whereClause = append(whereClause, sq.Eq{revEntTypeCol: "", revEntKeyCol: ""})
+	}
+	rows, err := store.builder.Select(revValCol).
+		From(revisionTable).
+		Where(whereClause).
+		OrderBy(revIDCol).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return ret, errors.Wrap(err, "failed to query for revisions")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadRevisions")
+
+	for rows.Next() {
+		var value []byte
+		if err := rows.Scan(&value); err != nil {
+			return []*Revision{}, errors.Wrap(err, "failed to scan revision")
+		}
+		rev := &Revision{}
+		if err := proto.Unmarshal(value, rev); err != nil {
+			return []*Revision{}, errors.Wrap(err, "failed to unmarshal revision")
+		}
+		ret = append(ret, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return []*Revision{}, errors.Wrap(err, "error iterating over revision rows")
+	}
+	return ret, nil
+}
+
+func (store *sqlConfiguratorStorage) RollbackToRevision(networkID string, revisionID uint64) error {
+	rev, err := store.loadRevision(networkID, revisionID)
+	if err != nil {
+		return err
+	}
+	if rev.Entity == nil {
+		return store.restoreNetwork(networkID, rev.NetworkAfter)
+	}
+	return store.restoreEntity(networkID, *rev.Entity, rev.EntityAfter)
+}
+
+func (store *sqlConfiguratorStorage) loadRevision(networkID string, revisionID uint64) (*Revision, error) {
+	var value []byte
+	err := store.builder.Select(revValCol).
+		From(revisionTable).
+		Where(sq.Eq{revNidCol: networkID, revIDCol: revisionID}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, merrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load revision")
+	}
+	rev := &Revision{}
+	if err := proto.Unmarshal(value, rev); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal revision")
+	}
+	return rev, nil
+}
+
+func (store *sqlConfiguratorStorage) restoreNetwork(networkID string, target *Network) error {
+	current, err := store.loadNetworkForRevision(networkID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case target == nil && current == nil:
+		return nil
+	case target == nil:
+		return store.UpdateNetworks([]NetworkUpdateCriteria{{ID: networkID, DeleteNetwork: true}})
+	case current == nil:
+		// Deleting a network deletes its entities without recording them
+		return RollbackError{Reason: fmt.Sprintf("network %s was deleted along with its entities, which a rollback can't restore", networkID)}
+	}
+
+	update := NetworkUpdateCriteria{
+		ID:                   networkID,
+		NewName:              &wrappers.StringValue{Value: target.Name},
+		NewDescription:       &wrappers.StringValue{Value: target.Description},
+		NewType:              &wrappers.StringValue{Value: target.Type},
+		ConfigsToAddOrUpdate: target.Configs,
+	}
+	for configType := range current.Configs {
+		if _, ok := target.Configs[configType]; !ok {
+			update.ConfigsToDelete = append(update.ConfigsToDelete, configType)
+		}
+	}
+	return store.UpdateNetworks([]NetworkUpdateCriteria{update})
+}
+
+func (store *sqlConfiguratorStorage) restoreEntity(networkID string, id EntityID, target *NetworkEntity) error {
+	current, err := store.loadEntityForRevision(networkID, id)
+	if err != nil {
+		return err
+	}
+	switch {
+	case target == nil && current == nil:
+		return nil
+	case target == nil:
+		_, err := store.UpdateEntity(networkID, EntityUpdateCriteria{Type: id.Type, Key: id.Key, DeleteEntity: true})
+		return err
+	case current == nil:
+		return store.recreateEntity(networkID, id, target)
+	}
+
+	update := EntityUpdateCriteria{
+		Type:              id.Type,
+		Key:               id.Key,
+		NewName:           &wrappers.StringValue{Value: target.Name},
+		NewDescription:    &wrappers.StringValue{Value: target.Description},
+		NewConfig:         &wrappers.BytesValue{Value: target.Config},
+		AssociationsToSet: &EntityAssociationsToSet{AssociationsToSet: target.Associations},
+	}
+	// Physical IDs are unique, so only touch them if they changed
+	if target.PhysicalID != current.PhysicalID {
+		update.NewPhysicalID = &wrappers.StringValue{Value: target.PhysicalID}
+	}
+	_, err = store.UpdateEntity(networkID, update)
+	return err
+}
+
+// recreateEntity recreates a deleted entity along with its associations to
+// and from other entities
+func (store *sqlConfiguratorStorage) recreateEntity(networkID string, id EntityID, target *NetworkEntity) error {
+	assocs := append([]*EntityID{}, target.Associations...)
+	for _, assoc := range append(assocs, target.ParentAssociations...) {
+		exists, err := store.doesEntExist(networkID, assoc.ToTypeAndKey())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return RollbackError{Reason: fmt.Sprintf("entity %s can't be recreated, since its associated entity %s no longer exists", id.ToTypeAndKey(), assoc.ToTypeAndKey())}
+		}
+	}
+
+	_, err := store.CreateEntity(networkID, NetworkEntity{
+		Type:         id.Type,
+		Key:          id.Key,
+		Name:         target.Name,
+		Description:  target.Description,
+		PhysicalID:   target.PhysicalID,
+		Config:       target.Config,
+		Associations: target.Associations,
+	})
+	if err != nil {
+		return err
+	}
+	for _, parent := range target.ParentAssociations {
+		_, err := store.UpdateEntity(networkID, EntityUpdateCriteria{
+			Type:              parent.Type,
+			Key:               parent.Key,
+			AssociationsToAdd: []*EntityID{{Type: id.Type, Key: id.Key}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (store *sqlConfiguratorStorage) loadNetworkForRevision(networkID string) (*Network, error) {
+	loaded, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{networkID}}, FullNetworkLoadCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load network for revision")
+	}
+	if len(loaded.Networks) == 0 {
+		return nil, nil
+	}
+	return loaded.Networks[0], nil
+}
+
+func (store *sqlConfiguratorStorage) loadEntityForRevision(networkID string, id EntityID) (*NetworkEntity, error) {
+	loaded, err := store.LoadEntities(networkID, EntityLoadFilter{IDs: []*EntityID{&id}}, revisionEntityLoadCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load entity for revision")
+	}
+	if len(loaded.Entities) == 0 {
+		return nil, nil
+	}
+	return loaded.Entities[0], nil
+}
+
+// recordNetworkRevision records the mutation of a network given its state
+// before the mutation
+func (store *sqlConfiguratorStorage) recordNetworkRevision(networkID string, before *Network) error {
+	after, err := store.loadNetworkForRevision(networkID)
+	if err != nil {
+		return err
+	}
+	if before == nil && after == nil {
+		return nil
+	}
+	return store.appendRevision(&Revision{
+		NetworkID:     networkID,
+		Operation:     getRevisionOperation(before == nil, after == nil),
+		NetworkBefore: before,
+		NetworkAfter:  after,
+	})
+}
+
+// recordEntityRevision records the mutation of an entity given its state
+// before the mutation
+func (store *sqlConfiguratorStorage) recordEntityRevision(networkID string, id EntityID, before *NetworkEntity) error {
+	after, err := store.loadEntityForRevision(networkID, id)
+	if err != nil {
+		return err
+	}
+	if before == nil && after == nil {
+		return nil
+	}
+	edgesAdded, edgesRemoved := getEdgeChanges(id, before, after)
+	return store.appendRevision(&Revision{
+		NetworkID:    networkID,
+		Entity:       &id,
+		Operation:    getRevisionOperation(before == nil, after == nil),
+		EntityBefore: before,
+		EntityAfter:  after,
+		EdgesAdded:   edgesAdded,
+		EdgesRemoved: edgesRemoved,
+	})
+}
+
+func getRevisionOperation(created bool, deleted bool) Revision_Operation {
+	switch {
+	case created:
+		return Revision_CREATE
+	case deleted:
+		return Revision_DELETE
+	default:
+		return Revision_UPDATE
+	}
+}
+
+// getEdgeChanges returns the edges from and to an entity which were added
+// and removed between its states before and after a mutation
+func getEdgeChanges(id EntityID, before *NetworkEntity, after *NetworkEntity) ([]*GraphEdge, []*GraphEdge) {
+	var beforeAssocs, afterAssocs, beforeParents, afterParents []*EntityID
+	if before != nil {
+		beforeAssocs, beforeParents = before.Associations, before.ParentAssociations
+	}
+	if after != nil {
+		afterAssocs, afterParents = after.Associations, after.ParentAssociations
+	}
+	added := append(getMissingEdges(id, afterAssocs, beforeAssocs, false), getMissingEdges(id, afterParents, beforeParents, true)...)
+	removed := append(getMissingEdges(id, beforeAssocs, afterAssocs, false), getMissingEdges(id, beforeParents, afterParents, true)...)
+	return added, removed
+}
+
+// getMissingEdges returns the edges between an entity and the associations in
+// assocs which aren't in otherAssocs. The edges point to the entity if
+// incoming is true, and from it otherwise.
+func getMissingEdges(id EntityID, assocs []*EntityID, otherAssocs []*EntityID, incoming bool) []*GraphEdge {
+	otherTKs := map[storage.TypeAndKey]bool{}
+	for _, assoc := range otherAssocs {
+		otherTKs[assoc.ToTypeAndKey()] = true
+	}
+	ret := []*GraphEdge{}
+	for _, assoc := range assocs {
+		if otherTKs[assoc.ToTypeAndKey()] {
+			continue
+		}
+		edge := &GraphEdge{From: &EntityID{Type: id.Type, Key: id.Key}, To: assoc}
+		if incoming {
+			edge.From, edge.To = assoc, edge.From
+		}
+		ret = append(ret, edge)
+	}
+	return ret
+}
+
+// appendRevision assigns the next revision ID of the network to a revision
+// and inserts it. Revisions beyond the retention limit are deleted.
+func (store *sqlConfiguratorStorage) appendRevision(rev *Revision) error {
+	revisionID, err := store.nextRevisionID(rev.NetworkID)
+	if err != nil {
+		return err
+	}
+
+	rev.ID = revisionID
+	rev.Author = store.author
+	rev.Timestamp = clock.Now().UnixNano() / int64(time.Millisecond)
+	value, err := proto.Marshal(rev)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal revision")
+	}
+
+	entType, entKey := "", ""
+	if rev.Entity != nil {
+		entType, entKey = rev.Entity.Type, rev.Entity.Key
+	}
+	_, err = store.builder.Insert(revisionTable).
+		Columns(revNidCol, revIDCol, revEntTypeCol, revEntKeyCol, revValCol).
+		Values(rev.NetworkID, rev.ID, entType, entKey, value).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to insert revision")
+	}
+
+	if store.maxRevisions == 0 || rev.ID <= store.maxRevisions {
+		return nil
+	}
+	// DELETE FROM cfg_revisions WHERE network_id = $1 AND id <= $2
+	_, err = store.builder.Delete(revisionTable).
+		Where(sq.And{
+			sq.Eq{revNidCol: rev.NetworkID},
+			sq.LtOrEq{revIDCol: rev.ID - store.maxRevisions},
+		}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired revisions")
+	}
+	return nil
+}
+
+// nextRevisionID increments the revision counter of a network and returns
+// its new value. The counter's row stays locked until the transaction ends,
+// so concurrent transactions can't assign the same ID.
+func (store *sqlConfiguratorStorage) nextRevisionID(networkID string) (uint64, error) {
+	// UPDATE cfg_revision_counters SET last_id = last_id + 1
+	// WHERE network_id = $1
+	res, err := store.builder.Update(revisionCounterTable).
+		Set(revCounterLastIDCol, sq.Expr(fmt.Sprintf("%s + 1", revCounterLastIDCol))).
+		Where(sq.Eq{revCounterNidCol: networkID}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to increment revision counter")
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to increment revision counter")
+	}
+
+	if affected == 0 {
+		// First revision of the network. Revisions recorded before the
+		// counters table existed are accounted for.
+		var lastID sql.NullInt64
+		err = store.builder.Select(fmt.Sprintf("MAX(%s)", revIDCol)).
+			From(revisionTable).
+			Where(sq.Eq{revNidCol: networkID}).
+			RunWith(store.tx).
+			QueryRow().
+			Scan(&lastID)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to query for last revision ID")
+		}
+		revisionID := uint64(lastID.Int64) + 1
+		_, err = store.builder.Insert(revisionCounterTable).
+			Columns(revCounterNidCol, revCounterLastIDCol).
+			Values(networkID, revisionID).
+			RunWith(store.tx).
+			Exec()
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to insert revision counter")
+		}
+		return revisionID, nil
+	}
+
+	var revisionID uint64
+	err = store.builder.Select(revCounterLastIDCol).
+		From(revisionCounterTable).
+		Where(sq.Eq{revCounterNidCol: networkID}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&revisionID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to query for revision counter")
+	}
+	return revisionID, nil
+}
@@ -87,6 +87,34 @@ type ConfiguratorStorage interface {
 	// entity. The load criteria fields on associations are ignored, and the
 	// returned entities will always have both association fields filled out.
 	LoadGraphForEntity(networkID string, entityID EntityID, loadCriteria EntityLoadCriteria) (EntityGraph, error)
+
+	// =======================================================================
+	// Revision Operations
+	// =======================================================================
+
+	// LoadRevisions returns the revisions of a network matching the filter,
+	// in increasing order of revision ID. Storage which doesn't record
+	// revisions returns no revisions.
+	LoadRevisions(networkID string, filter RevisionLoadFilter) ([]*Revision, error)
+
+	// RollbackToRevision restores the network or entity which a revision
+	// mutated to its state right after the revision. If the revision deleted
+	// it, it is deleted again. The restore is itself recorded as a revision.
+	// A deleted entity is recreated along with its associations to and from
+	// other entities, all of which must exist.
+	// Returns ErrNotFound from magma/orc8r/cloud/go/errors if the revision
+	// doesn't exist, and a RollbackError if the rollback isn't possible.
+	RollbackToRevision(networkID string, revisionID uint64) error
+}
+
+// RollbackError is returned by RollbackToRevision when a revision can't be
+// rolled back in the current state of the network.
+type RollbackError struct {
+	Reason string
+}
+
+func (e RollbackError) Error() string {
+	return e.Reason
 }
 
 // RollbackLogOnError calls Rollback on the provided ConfiguratorStorage and
@@ -153,7 +181,7 @@ func (m NetworkEntity) GetGraphEdges() []*GraphEdge {
 // IsLoadAllEntities return true if the EntityLoadFilter is specifying to load
 // all entities in a network, false if there are any filter conditions.
 func (m *EntityLoadFilter) IsLoadAllEntities() bool {
-	return m.TypeFilter == nil && m.KeyFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs)
+	return m.TypeFilter == nil && m.KeyFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs) && m.SearchFilter == nil
 }
 
 // FullEntityLoadCriteria is an EntityLoadCriteria which loads everything
@@ -78,6 +78,34 @@ func (ACL_Wildcard) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0d2c4ccf1453ffdb, []int{7, 1}
 }
 
+type Revision_Operation int32
+
+const (
+	Revision_CREATE Revision_Operation = 0
+	Revision_UPDATE Revision_Operation = 1
+	Revision_DELETE Revision_Operation = 2
+)
+
+var Revision_Operation_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+}
+
+var Revision_Operation_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+}
+
+func (x Revision_Operation) String() string {
+	return proto.EnumName(Revision_Operation_name, int32(x))
+}
+
+func (Revision_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17, 0}
+}
+
 // A network represents a tenant. Networks can be configured in a hierarchical
 // manner - network-level configurations are assumed to apply across multiple
 // entities within the network.
@@ -816,10 +844,14 @@ type EntityLoadFilter struct {
 	GraphID *wrappers.StringValue `protobuf:"bytes,4,opt,name=graphID,proto3" json:"graphID,omitempty"`
 	// If PhysicalID is provided, the query will return all entities matching
 	// the provided ID. All other fields are ignored if this is set.
-	PhysicalID           *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	PhysicalID *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	// If SearchFilter is provided, the query will only return entities which
+	// also match the search filter. Unlike the other fields, SearchFilter
+	// is applied on top of IDs, GraphID and PhysicalID.
+	SearchFilter         *EntitySearchFilter `protobuf:"bytes,6,opt,name=search_filter,json=searchFilter,proto3" json:"search_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *EntityLoadFilter) Reset()         { *m = EntityLoadFilter{} }
@@ -882,14 +914,154 @@ func (m *EntityLoadFilter) GetPhysicalID() *wrappers.StringValue {
 	return nil
 }
 
+func (m *EntityLoadFilter) GetSearchFilter() *EntitySearchFilter {
+	if m != nil {
+		return m.SearchFilter
+	}
+	return nil
+}
+
+// EntitySearchFilter matches entities on their metadata and on the contents
+// of their config. An entity matches if it matches every field set.
+type EntitySearchFilter struct {
+	// Match entities whose name contains this value, ignoring case
+	NameContains *wrappers.StringValue `protobuf:"bytes,1,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	// Match entities whose description contains this value, ignoring case
+	DescriptionContains *wrappers.StringValue `protobuf:"bytes,2,opt,name=description_contains,json=descriptionContains,proto3" json:"description_contains,omitempty"`
+	// Match entities whose config matches all of these predicates.
+	// Configs are only meaningful once deserialized, so config predicates are
+	// evaluated by the configurator service using the registered entity
+	// config serdes. ConfiguratorStorage implementations ignore them.
+	ConfigPredicates     []*ConfigPredicate `protobuf:"bytes,3,rep,name=config_predicates,json=configPredicates,proto3" json:"config_predicates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EntitySearchFilter) Reset()         { *m = EntitySearchFilter{} }
+func (m *EntitySearchFilter) String() string { return proto.CompactTextString(m) }
+func (*EntitySearchFilter) ProtoMessage()    {}
+func (*EntitySearchFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+}
+
+func (m *EntitySearchFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntitySearchFilter.Unmarshal(m, b)
+}
+func (m *EntitySearchFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntitySearchFilter.Marshal(b, m, deterministic)
+}
+func (m *EntitySearchFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntitySearchFilter.Merge(m, src)
+}
+func (m *EntitySearchFilter) XXX_Size() int {
+	return xxx_messageInfo_EntitySearchFilter.Size(m)
+}
+func (m *EntitySearchFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntitySearchFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntitySearchFilter proto.InternalMessageInfo
+
+func (m *EntitySearchFilter) GetNameContains() *wrappers.StringValue {
+	if m != nil {
+		return m.NameContains
+	}
+	return nil
+}
+
+func (m *EntitySearchFilter) GetDescriptionContains() *wrappers.StringValue {
+	if m != nil {
+		return m.DescriptionContains
+	}
+	return nil
+}
+
+func (m *EntitySearchFilter) GetConfigPredicates() []*ConfigPredicate {
+	if m != nil {
+		return m.ConfigPredicates
+	}
+	return nil
+}
+
+// ConfigPredicate matches a value inside the JSON representation of a
+// deserialized entity config.
+type ConfigPredicate struct {
+	// Dot-separated path to the value inside the config, e.g.
+	// "lte.apn_name". If the path goes through a list, the predicate matches
+	// if any element of the list matches.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Value to compare against. Values which aren't JSON strings are compared
+	// using their JSON encoding.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Set contains to true to match values which contain value, ignoring
+	// case, instead of values equal to it
+	Contains             bool     `protobuf:"varint,3,opt,name=contains,proto3" json:"contains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigPredicate) Reset()         { *m = ConfigPredicate{} }
+func (m *ConfigPredicate) String() string { return proto.CompactTextString(m) }
+func (*ConfigPredicate) ProtoMessage()    {}
+func (*ConfigPredicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+}
+
+func (m *ConfigPredicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigPredicate.Unmarshal(m, b)
+}
+func (m *ConfigPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigPredicate.Marshal(b, m, deterministic)
+}
+func (m *ConfigPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigPredicate.Merge(m, src)
+}
+func (m *ConfigPredicate) XXX_Size() int {
+	return xxx_messageInfo_ConfigPredicate.Size(m)
+}
+func (m *ConfigPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigPredicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigPredicate proto.InternalMessageInfo
+
+func (m *ConfigPredicate) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ConfigPredicate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ConfigPredicate) GetContains() bool {
+	if m != nil {
+		return m.Contains
+	}
+	return false
+}
+
 // EntityLoadCriteria specifies how much of an entity to load
 type EntityLoadCriteria struct {
 	// Set LoadMetadata to true to load the metadata fields (name, description)
-	LoadMetadata         bool     `protobuf:"varint,1,opt,name=load_metadata,json=loadMetadata,proto3" json:"load_metadata,omitempty"`
-	LoadConfig           bool     `protobuf:"varint,2,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
-	LoadAssocsToThis     bool     `protobuf:"varint,3,opt,name=load_assocs_to_this,json=loadAssocsToThis,proto3" json:"load_assocs_to_this,omitempty"`
-	LoadAssocsFromThis   bool     `protobuf:"varint,4,opt,name=load_assocs_from_this,json=loadAssocsFromThis,proto3" json:"load_assocs_from_this,omitempty"`
-	LoadPermissions      bool     `protobuf:"varint,5,opt,name=load_permissions,json=loadPermissions,proto3" json:"load_permissions,omitempty"`
+	LoadMetadata       bool `protobuf:"varint,1,opt,name=load_metadata,json=loadMetadata,proto3" json:"load_metadata,omitempty"`
+	LoadConfig         bool `protobuf:"varint,2,opt,name=load_config,json=loadConfig,proto3" json:"load_config,omitempty"`
+	LoadAssocsToThis   bool `protobuf:"varint,3,opt,name=load_assocs_to_this,json=loadAssocsToThis,proto3" json:"load_assocs_to_this,omitempty"`
+	LoadAssocsFromThis bool `protobuf:"varint,4,opt,name=load_assocs_from_this,json=loadAssocsFromThis,proto3" json:"load_assocs_from_this,omitempty"`
+	LoadPermissions    bool `protobuf:"varint,5,opt,name=load_permissions,json=loadPermissions,proto3" json:"load_permissions,omitempty"`
+	// Set PageSize to a non-zero value to load at most that many entities.
+	// Entities are paged through in (type, key) order.
+	PageSize uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// PageToken is the NextPageToken of the previous page. Leave empty to load
+	// the first page.
+	PageToken            string   `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -899,7 +1071,7 @@ func (m *EntityLoadCriteria) Reset()         { *m = EntityLoadCriteria{} }
 func (m *EntityLoadCriteria) String() string { return proto.CompactTextString(m) }
 func (*EntityLoadCriteria) ProtoMessage()    {}
 func (*EntityLoadCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{11}
 }
 
 func (m *EntityLoadCriteria) XXX_Unmarshal(b []byte) error {
@@ -955,19 +1127,37 @@ func (m *EntityLoadCriteria) GetLoadPermissions() bool {
 	return false
 }
 
+func (m *EntityLoadCriteria) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *EntityLoadCriteria) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type EntityLoadResult struct {
-	Entities             []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
-	EntitiesNotFound     []*EntityID      `protobuf:"bytes,2,rep,name=entities_not_found,json=entitiesNotFound,proto3" json:"entities_not_found,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Entities         []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	EntitiesNotFound []*EntityID      `protobuf:"bytes,2,rep,name=entities_not_found,json=entitiesNotFound,proto3" json:"entities_not_found,omitempty"`
+	// NextPageToken is set if PageSize was set in the load criteria and there
+	// are more entities to load. Pass it as the PageToken of the load criteria
+	// to load the next page.
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EntityLoadResult) Reset()         { *m = EntityLoadResult{} }
 func (m *EntityLoadResult) String() string { return proto.CompactTextString(m) }
 func (*EntityLoadResult) ProtoMessage()    {}
 func (*EntityLoadResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{12}
 }
 
 func (m *EntityLoadResult) XXX_Unmarshal(b []byte) error {
@@ -1002,6 +1192,13 @@ func (m *EntityLoadResult) GetEntitiesNotFound() []*EntityID {
 	return nil
 }
 
+func (m *EntityLoadResult) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 // EntityUpdateCriteria specifies a patch operation on a network entity.
 type EntityUpdateCriteria struct {
 	// (Type, Key) of the entity to update
@@ -1032,7 +1229,7 @@ func (m *EntityUpdateCriteria) Reset()         { *m = EntityUpdateCriteria{} }
 func (m *EntityUpdateCriteria) String() string { return proto.CompactTextString(m) }
 func (*EntityUpdateCriteria) ProtoMessage()    {}
 func (*EntityUpdateCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{11}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{13}
 }
 
 func (m *EntityUpdateCriteria) XXX_Unmarshal(b []byte) error {
@@ -1155,7 +1352,7 @@ func (m *EntityAssociationsToSet) Reset()         { *m = EntityAssociationsToSet
 func (m *EntityAssociationsToSet) String() string { return proto.CompactTextString(m) }
 func (*EntityAssociationsToSet) ProtoMessage()    {}
 func (*EntityAssociationsToSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{12}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{14}
 }
 
 func (m *EntityAssociationsToSet) XXX_Unmarshal(b []byte) error {
@@ -1200,7 +1397,7 @@ func (m *EntityGraph) Reset()         { *m = EntityGraph{} }
 func (m *EntityGraph) String() string { return proto.CompactTextString(m) }
 func (*EntityGraph) ProtoMessage()    {}
 func (*EntityGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{13}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{15}
 }
 
 func (m *EntityGraph) XXX_Unmarshal(b []byte) error {
@@ -1255,7 +1452,7 @@ func (m *GraphEdge) Reset()         { *m = GraphEdge{} }
 func (m *GraphEdge) String() string { return proto.CompactTextString(m) }
 func (*GraphEdge) ProtoMessage()    {}
 func (*GraphEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{14}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
 }
 
 func (m *GraphEdge) XXX_Unmarshal(b []byte) error {
@@ -1290,9 +1487,200 @@ func (m *GraphEdge) GetFrom() *EntityID {
 	return nil
 }
 
+// Revision records a mutation of a network or of a network entity.
+type Revision struct {
+	// ID of the revision. IDs increase with each revision in a network.
+	ID        uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	NetworkID string `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Entity which was mutated. Unset if the network itself was mutated.
+	Entity    *EntityID          `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	Operation Revision_Operation `protobuf:"varint,4,opt,name=operation,proto3,enum=magma.orc8r.configurator.storage.Revision_Operation" json:"operation,omitempty"`
+	// Author of the mutation, if known
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// Time of the mutation in unix milliseconds
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// State of the network before and after the mutation. These are only set
+	// on revisions of the network itself, and are unset if the network
+	// didn't exist before or after the mutation.
+	NetworkBefore *Network `protobuf:"bytes,10,opt,name=network_before,json=networkBefore,proto3" json:"network_before,omitempty"`
+	NetworkAfter  *Network `protobuf:"bytes,11,opt,name=network_after,json=networkAfter,proto3" json:"network_after,omitempty"`
+	// State of the entity before and after the mutation. These are unset on
+	// revisions of the network itself, and if the entity didn't exist before
+	// or after the mutation. Permissions are not recorded.
+	EntityBefore *NetworkEntity `protobuf:"bytes,20,opt,name=entity_before,json=entityBefore,proto3" json:"entity_before,omitempty"`
+	EntityAfter  *NetworkEntity `protobuf:"bytes,21,opt,name=entity_after,json=entityAfter,proto3" json:"entity_after,omitempty"`
+	// Graph edges from and to the entity which the mutation added and removed
+	EdgesAdded           []*GraphEdge `protobuf:"bytes,30,rep,name=edges_added,json=edgesAdded,proto3" json:"edges_added,omitempty"`
+	EdgesRemoved         []*GraphEdge `protobuf:"bytes,31,rep,name=edges_removed,json=edgesRemoved,proto3" json:"edges_removed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Revision) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *Revision) GetEntity() *EntityID {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *Revision) GetOperation() Revision_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return Revision_CREATE
+}
+
+func (m *Revision) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Revision) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Revision) GetNetworkBefore() *Network {
+	if m != nil {
+		return m.NetworkBefore
+	}
+	return nil
+}
+
+func (m *Revision) GetNetworkAfter() *Network {
+	if m != nil {
+		return m.NetworkAfter
+	}
+	return nil
+}
+
+func (m *Revision) GetEntityBefore() *NetworkEntity {
+	if m != nil {
+		return m.EntityBefore
+	}
+	return nil
+}
+
+func (m *Revision) GetEntityAfter() *NetworkEntity {
+	if m != nil {
+		return m.EntityAfter
+	}
+	return nil
+}
+
+func (m *Revision) GetEdgesAdded() []*GraphEdge {
+	if m != nil {
+		return m.EdgesAdded
+	}
+	return nil
+}
+
+func (m *Revision) GetEdgesRemoved() []*GraphEdge {
+	if m != nil {
+		return m.EdgesRemoved
+	}
+	return nil
+}
+
+// RevisionLoadFilter specifies which revisions of a network to load.
+// An empty filter loads all revisions in the network.
+type RevisionLoadFilter struct {
+	// If set, only load revisions of this entity
+	Entity *EntityID `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// If true, only load revisions of the network itself
+	NetworkOnly          bool     `protobuf:"varint,2,opt,name=network_only,json=networkOnly,proto3" json:"network_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionLoadFilter) Reset()         { *m = RevisionLoadFilter{} }
+func (m *RevisionLoadFilter) String() string { return proto.CompactTextString(m) }
+func (*RevisionLoadFilter) ProtoMessage()    {}
+func (*RevisionLoadFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
+}
+
+func (m *RevisionLoadFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionLoadFilter.Unmarshal(m, b)
+}
+func (m *RevisionLoadFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionLoadFilter.Marshal(b, m, deterministic)
+}
+func (m *RevisionLoadFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionLoadFilter.Merge(m, src)
+}
+func (m *RevisionLoadFilter) XXX_Size() int {
+	return xxx_messageInfo_RevisionLoadFilter.Size(m)
+}
+func (m *RevisionLoadFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionLoadFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionLoadFilter proto.InternalMessageInfo
+
+func (m *RevisionLoadFilter) GetEntity() *EntityID {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *RevisionLoadFilter) GetNetworkOnly() bool {
+	if m != nil {
+		return m.NetworkOnly
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.configurator.storage.ACL_Permission", ACL_Permission_name, ACL_Permission_value)
 	proto.RegisterEnum("magma.orc8r.configurator.storage.ACL_Wildcard", ACL_Wildcard_name, ACL_Wildcard_value)
+	proto.RegisterEnum("magma.orc8r.configurator.storage.Revision_Operation", Revision_Operation_name, Revision_Operation_value)
 	proto.RegisterType((*Network)(nil), "magma.orc8r.configurator.storage.Network")
 	proto.RegisterMapType((map[string][]byte)(nil), "magma.orc8r.configurator.storage.Network.ConfigsEntry")
 	proto.RegisterType((*NetworkLoadFilter)(nil), "magma.orc8r.configurator.storage.NetworkLoadFilter")
@@ -1305,105 +1693,135 @@ func init() {
 	proto.RegisterType((*ACL)(nil), "magma.orc8r.configurator.storage.ACL")
 	proto.RegisterType((*ACL_NetworkIDs)(nil), "magma.orc8r.configurator.storage.ACL.NetworkIDs")
 	proto.RegisterType((*EntityLoadFilter)(nil), "magma.orc8r.configurator.storage.EntityLoadFilter")
+	proto.RegisterType((*EntitySearchFilter)(nil), "magma.orc8r.configurator.storage.EntitySearchFilter")
+	proto.RegisterType((*ConfigPredicate)(nil), "magma.orc8r.configurator.storage.ConfigPredicate")
 	proto.RegisterType((*EntityLoadCriteria)(nil), "magma.orc8r.configurator.storage.EntityLoadCriteria")
 	proto.RegisterType((*EntityLoadResult)(nil), "magma.orc8r.configurator.storage.EntityLoadResult")
 	proto.RegisterType((*EntityUpdateCriteria)(nil), "magma.orc8r.configurator.storage.EntityUpdateCriteria")
 	proto.RegisterType((*EntityAssociationsToSet)(nil), "magma.orc8r.configurator.storage.EntityAssociationsToSet")
 	proto.RegisterType((*EntityGraph)(nil), "magma.orc8r.configurator.storage.EntityGraph")
 	proto.RegisterType((*GraphEdge)(nil), "magma.orc8r.configurator.storage.GraphEdge")
+	proto.RegisterType((*Revision)(nil), "magma.orc8r.configurator.storage.Revision")
+	proto.RegisterType((*RevisionLoadFilter)(nil), "magma.orc8r.configurator.storage.RevisionLoadFilter")
 }
 
 func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 1421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x36, 0x25, 0xd9, 0x92, 0x86, 0x92, 0xad, 0xac, 0xe5, 0x84, 0xc7, 0x27, 0x70, 0x14, 0x1e,
-	0x04, 0x70, 0x72, 0x10, 0x25, 0x55, 0x80, 0x24, 0x75, 0xd3, 0x02, 0xb2, 0x28, 0x27, 0x42, 0x1d,
-	0xd9, 0x65, 0x94, 0xba, 0x4d, 0x11, 0xb0, 0x8c, 0xb8, 0x96, 0x09, 0x4b, 0x5c, 0x81, 0x5c, 0x45,
-	0xd0, 0x13, 0xb4, 0x45, 0xfb, 0x42, 0xbd, 0xed, 0xcb, 0xb4, 0x40, 0x81, 0xde, 0xf5, 0xbe, 0xd8,
-	0x1f, 0x52, 0x94, 0x9c, 0xc0, 0x64, 0x5a, 0xa0, 0x77, 0xe4, 0xec, 0x7e, 0xdf, 0xee, 0xfc, 0xec,
-	0x37, 0x03, 0xe5, 0x80, 0x12, 0xdf, 0x1e, 0xe0, 0xfa, 0xd8, 0x27, 0x94, 0xa0, 0xda, 0xc8, 0x1e,
-	0x8c, 0xec, 0x3a, 0xf1, 0xfb, 0x8f, 0xfd, 0x7a, 0x9f, 0x78, 0xa7, 0xee, 0x60, 0xe2, 0xdb, 0x94,
-	0xf8, 0x75, 0xb9, 0x6f, 0x7b, 0x67, 0x40, 0xc8, 0x60, 0x88, 0xef, 0xf1, 0xfd, 0x6f, 0x26, 0xa7,
-	0xf7, 0xa6, 0xbe, 0x3d, 0x1e, 0x63, 0x3f, 0x10, 0x0c, 0xfa, 0x8f, 0x19, 0xc8, 0x77, 0x31, 0x9d,
-	0x12, 0xff, 0x1c, 0xad, 0x43, 0xa6, 0x63, 0x68, 0x4a, 0x4d, 0xd9, 0x2d, 0x9a, 0x99, 0x8e, 0x81,
-	0x10, 0xe4, 0x7a, 0xb3, 0x31, 0xd6, 0x32, 0xdc, 0xc2, 0xbf, 0x99, 0xcd, 0xb3, 0x47, 0x58, 0x03,
-	0x61, 0x63, 0xdf, 0xa8, 0x06, 0xaa, 0x83, 0x83, 0xbe, 0xef, 0x8e, 0xa9, 0x4b, 0x3c, 0x4d, 0xe5,
-	0x4b, 0x71, 0x13, 0x3a, 0x86, 0xbc, 0xb8, 0x5d, 0xa0, 0x55, 0x6b, 0xd9, 0x5d, 0xb5, 0xf1, 0xb0,
-	0x7e, 0xd9, 0xcd, 0xeb, 0xf2, 0x56, 0xf5, 0x96, 0x00, 0xb6, 0x3d, 0xea, 0xcf, 0xcc, 0x90, 0x06,
-	0x69, 0x90, 0x7f, 0x8b, 0xfd, 0x80, 0x9d, 0xb7, 0x53, 0x53, 0x76, 0x73, 0x66, 0xf8, 0xbb, 0xbd,
-	0x07, 0xa5, 0x38, 0x04, 0x55, 0x20, 0x7b, 0x8e, 0x67, 0xd2, 0x2d, 0xf6, 0x89, 0xaa, 0xb0, 0xfa,
-	0xd6, 0x1e, 0x4e, 0x84, 0x63, 0x25, 0x53, 0xfc, 0xec, 0x65, 0x1e, 0x2b, 0xba, 0x03, 0x57, 0xe4,
-	0xb1, 0x87, 0xc4, 0x76, 0x0e, 0xdc, 0x21, 0xc5, 0x3e, 0x23, 0x70, 0x9d, 0x40, 0x53, 0x6a, 0x59,
-	0x46, 0xe0, 0x3a, 0x01, 0xfa, 0x14, 0x54, 0x3a, 0x1b, 0x63, 0xeb, 0x94, 0x6f, 0xe0, 0x34, 0x6a,
-	0xe3, 0x7a, 0x5d, 0x84, 0xba, 0x1e, 0x86, 0xba, 0xfe, 0x82, 0xfa, 0xae, 0x37, 0xf8, 0x92, 0xb1,
-	0x9b, 0xc0, 0x00, 0x82, 0x50, 0x7f, 0x0d, 0x9b, 0xb1, 0x53, 0x5a, 0xbe, 0x4b, 0xb1, 0xef, 0xda,
-	0xe8, 0x7f, 0x50, 0x1e, 0x12, 0xdb, 0xb1, 0x46, 0x98, 0xda, 0x8e, 0x4d, 0x6d, 0x7e, 0xe5, 0x82,
-	0x59, 0x62, 0xc6, 0xe7, 0xd2, 0x86, 0x6e, 0x02, 0xff, 0xb7, 0xc2, 0x70, 0x66, 0xf8, 0x1e, 0x95,
-	0xd9, 0xa4, 0xd7, 0xfa, 0x4f, 0xca, 0x82, 0x17, 0x26, 0x0e, 0x26, 0x43, 0x8a, 0xda, 0x50, 0xf0,
-	0x84, 0x51, 0xb8, 0xa2, 0x36, 0x6e, 0x27, 0xce, 0x81, 0x19, 0x41, 0xd1, 0x7d, 0xa8, 0xca, 0xef,
-	0x8e, 0x11, 0x58, 0x1e, 0xa1, 0xd6, 0x29, 0x99, 0x78, 0x8e, 0x96, 0xe1, 0xd1, 0x41, 0xf3, 0xb5,
-	0x2e, 0xa1, 0x07, 0x6c, 0x45, 0xff, 0x3e, 0x07, 0x5b, 0x92, 0xe7, 0xe5, 0xd8, 0xb1, 0x29, 0x8e,
-	0x1c, 0x5e, 0xae, 0xb7, 0x5b, 0xb0, 0xee, 0xe0, 0x21, 0xa6, 0xd8, 0x92, 0x34, 0xbc, 0xca, 0x0a,
-	0x66, 0x59, 0x58, 0xc3, 0x32, 0x7d, 0xc4, 0x3c, 0x99, 0x5a, 0xbc, 0x0c, 0xab, 0x09, 0x42, 0x9f,
-	0xf7, 0xf0, 0xb4, 0xcb, 0xea, 0xb4, 0x0d, 0x1b, 0x0c, 0x18, 0xaf, 0xd5, 0xad, 0x04, 0xf8, 0x75,
-	0x0f, 0x4f, 0x8d, 0x58, 0x31, 0xcb, 0xf3, 0x59, 0x42, 0xb5, 0xab, 0x09, 0xcf, 0xe7, 0x6f, 0xe7,
-	0x07, 0x05, 0x34, 0x99, 0x37, 0x8b, 0x12, 0xcb, 0x76, 0x1c, 0x8b, 0xf8, 0xd6, 0x84, 0x07, 0x45,
-	0xdb, 0xe1, 0x39, 0xf9, 0x22, 0x71, 0x4e, 0x16, 0x63, 0x19, 0xbe, 0x92, 0x1e, 0x69, 0x3a, 0xce,
-	0x91, 0x2f, 0x16, 0xc5, 0x93, 0xa9, 0xf6, 0xdf, 0xb1, 0x84, 0xee, 0xc0, 0x95, 0xd8, 0x55, 0x44,
-	0x80, 0xb5, 0x1b, 0x3c, 0x89, 0x1b, 0x11, 0xc0, 0xe0, 0xe6, 0xed, 0xa7, 0xf0, 0x9f, 0xf7, 0xd2,
-	0xa7, 0x7a, 0x5e, 0xf7, 0xa1, 0xd0, 0xf6, 0xa8, 0x4b, 0x67, 0x42, 0x5c, 0x78, 0x04, 0x05, 0x90,
-	0x7f, 0x87, 0x5c, 0x99, 0x88, 0x4b, 0xff, 0x3d, 0x0b, 0x65, 0xe9, 0xb0, 0x40, 0xa2, 0xeb, 0x50,
-	0x8c, 0x8a, 0x4c, 0x82, 0xe7, 0x86, 0x88, 0x35, 0x73, 0x91, 0x35, 0x3b, 0xbf, 0xe1, 0x87, 0x89,
-	0xd8, 0x0e, 0xc0, 0xf8, 0x6c, 0x16, 0xb8, 0x7d, 0x7b, 0xd8, 0x31, 0x78, 0xe5, 0x15, 0xcd, 0x98,
-	0x05, 0x5d, 0x85, 0x35, 0x11, 0x39, 0xae, 0x48, 0x25, 0x53, 0xfe, 0x31, 0xa9, 0x1a, 0xf8, 0xf6,
-	0xf8, 0xac, 0x63, 0x68, 0xbb, 0x1c, 0x14, 0xfe, 0xa2, 0x2e, 0x94, 0xec, 0x20, 0x20, 0x7d, 0xd7,
-	0x66, 0x07, 0x04, 0x5a, 0x83, 0xd7, 0xc0, 0x9d, 0xcb, 0x6b, 0x20, 0x8c, 0xa2, 0xb9, 0x80, 0x47,
-	0xdf, 0xc0, 0xe6, 0xd8, 0xf6, 0xb1, 0x47, 0xad, 0x05, 0xda, 0x07, 0xa9, 0x69, 0x91, 0xa0, 0x69,
-	0xc6, 0xc9, 0x9f, 0x82, 0x3a, 0xc6, 0xfe, 0xc8, 0x0d, 0x02, 0x4e, 0xfa, 0x84, 0x93, 0xde, 0xba,
-	0x9c, 0xb4, 0xd9, 0x3a, 0x34, 0xe3, 0xc8, 0xb8, 0x74, 0x1f, 0x2c, 0x48, 0xb7, 0xfe, 0x5b, 0x0e,
-	0xb2, 0xcd, 0xd6, 0xe1, 0x05, 0x61, 0x78, 0x0d, 0x95, 0xa0, 0x4f, 0xc6, 0x91, 0x2e, 0x74, 0x8c,
-	0x80, 0xe7, 0x4e, 0x6d, 0xdc, 0x4f, 0x74, 0x7e, 0xf8, 0x66, 0x3a, 0x46, 0xf0, 0x6c, 0xc5, 0xdc,
-	0xe0, 0x5c, 0x73, 0x13, 0x3a, 0x81, 0x75, 0x41, 0x3f, 0x75, 0x87, 0x4e, 0xdf, 0xf6, 0x1d, 0x9e,
-	0xfd, 0xf5, 0x46, 0x3d, 0x19, 0xf9, 0x89, 0x44, 0x3d, 0x5b, 0x31, 0xcb, 0x9c, 0x27, 0x34, 0xa0,
-	0x63, 0x80, 0xb9, 0xe3, 0xbc, 0x62, 0xd6, 0x93, 0xde, 0xf8, 0x38, 0xc2, 0x99, 0x31, 0x0e, 0x74,
-	0x13, 0x54, 0xcc, 0x93, 0x24, 0xe4, 0x87, 0x15, 0x5a, 0xf1, 0x99, 0x62, 0x82, 0x30, 0x72, 0x95,
-	0x79, 0x09, 0x65, 0xde, 0x9c, 0x22, 0x67, 0x6e, 0x7c, 0x90, 0x33, 0x8a, 0x59, 0x62, 0x34, 0x91,
-	0x2f, 0xdb, 0x50, 0xe8, 0x18, 0xa2, 0x81, 0x69, 0xbb, 0x5c, 0x27, 0xa2, 0xff, 0x78, 0x46, 0x1b,
-	0x8b, 0xcd, 0x78, 0x07, 0x20, 0x16, 0xe8, 0x0a, 0x64, 0x59, 0xea, 0x64, 0x27, 0xed, 0x18, 0x81,
-	0xfe, 0x08, 0x60, 0xee, 0x29, 0x52, 0x21, 0xdf, 0x3d, 0xb2, 0x8e, 0xdb, 0xe6, 0xf3, 0xca, 0x0a,
-	0x2a, 0x40, 0xce, 0x6c, 0x37, 0x8d, 0x8a, 0x82, 0x8a, 0xb0, 0x7a, 0x62, 0x76, 0x7a, 0xed, 0x4a,
-	0x06, 0xe5, 0x21, 0x7b, 0x74, 0xd2, 0xad, 0x64, 0xf5, 0xbb, 0x50, 0x88, 0xae, 0xb6, 0x01, 0x6a,
-	0xf7, 0xc8, 0x3a, 0xe9, 0x1c, 0x1a, 0xad, 0xa6, 0x69, 0x54, 0x56, 0x50, 0x05, 0x4a, 0xe1, 0x9f,
-	0xd5, 0x3c, 0x3c, 0xac, 0x28, 0xfb, 0x79, 0x58, 0xe5, 0xa9, 0xd9, 0x5f, 0x13, 0x02, 0xa1, 0xff,
-	0x92, 0x81, 0x8a, 0x28, 0xf7, 0x58, 0xa7, 0x5f, 0xea, 0xeb, 0x4a, 0xba, 0xbe, 0x8e, 0x3e, 0x01,
-	0x38, 0xc7, 0xb3, 0x34, 0x53, 0x41, 0xf1, 0x1c, 0xcf, 0x24, 0xf8, 0x89, 0x88, 0x4d, 0x36, 0xf5,
-	0x5b, 0x65, 0x30, 0xf4, 0x70, 0xae, 0x31, 0xb9, 0x24, 0x2d, 0x29, 0x54, 0xa0, 0x27, 0x0b, 0x9a,
-	0xb6, 0x9a, 0xc4, 0xe1, 0xf9, 0x7e, 0xfd, 0x57, 0x05, 0xd0, 0x3c, 0x88, 0xe9, 0x06, 0x99, 0x1b,
-	0xa0, 0xc6, 0x06, 0x19, 0x39, 0xc7, 0xc0, 0x7c, 0x8e, 0x41, 0x77, 0x61, 0x93, 0x6f, 0xe0, 0x52,
-	0xc6, 0xbb, 0x14, 0x3d, 0x73, 0x03, 0x2e, 0xe3, 0x05, 0xb3, 0xc2, 0x96, 0xb8, 0x3c, 0x05, 0x3d,
-	0xd2, 0x3b, 0x73, 0x03, 0xf4, 0x11, 0x6c, 0xc5, 0xb7, 0x9f, 0xfa, 0x64, 0x24, 0x00, 0x39, 0x0e,
-	0x40, 0x73, 0xc0, 0x81, 0x4f, 0x46, 0x1c, 0x72, 0x1b, 0x38, 0x8d, 0x15, 0x97, 0xb5, 0x55, 0xbe,
-	0x7b, 0x83, 0xd9, 0xe7, 0x85, 0x19, 0xe8, 0x3f, 0x2b, 0xf1, 0x72, 0x91, 0x23, 0xd5, 0xe7, 0x50,
-	0xe0, 0xef, 0xce, 0xc5, 0xe1, 0x48, 0x75, 0x2f, 0x71, 0xfb, 0x16, 0x64, 0x66, 0x44, 0x80, 0xbe,
-	0x02, 0x14, 0x7e, 0x2f, 0x8d, 0x55, 0xe9, 0xca, 0xa1, 0x12, 0xb2, 0x44, 0x03, 0xd8, 0x9f, 0x6b,
-	0x50, 0x15, 0xcb, 0x4b, 0xf3, 0x57, 0xa2, 0x16, 0xcc, 0xb2, 0x29, 0xa7, 0x32, 0x21, 0x32, 0x72,
-	0x28, 0x2b, 0x09, 0xa3, 0xec, 0xca, 0xff, 0xf6, 0x4c, 0xd6, 0x02, 0x66, 0xb1, 0x62, 0xb5, 0x9c,
-	0x64, 0x32, 0x2b, 0x7b, 0x78, 0x7a, 0x3c, 0x6f, 0xe0, 0x7b, 0x00, 0x8c, 0x44, 0x56, 0xe4, 0x35,
-	0x4e, 0xf0, 0xdf, 0x0b, 0x04, 0xfb, 0x33, 0x8a, 0x03, 0xf9, 0x7c, 0x3d, 0x3c, 0x95, 0xd5, 0xea,
-	0xc2, 0x66, 0xbc, 0xe7, 0xb2, 0x72, 0x0d, 0x30, 0xe5, 0x02, 0xad, 0x36, 0x3e, 0x4e, 0x9a, 0xbf,
-	0x78, 0xc3, 0xed, 0x91, 0x17, 0x98, 0x9a, 0x57, 0xec, 0x65, 0x13, 0x7a, 0x75, 0xf1, 0x28, 0xdb,
-	0x71, 0xf8, 0xf0, 0x96, 0xae, 0x54, 0x96, 0xb8, 0x9b, 0x8e, 0x83, 0xbe, 0x85, 0xab, 0xcb, 0xdc,
-	0x72, 0x36, 0xac, 0xa5, 0xa6, 0xaf, 0x2e, 0xd2, 0x8b, 0x61, 0x12, 0x7d, 0x0d, 0x5b, 0xb1, 0xf7,
-	0xc6, 0x0e, 0xe8, 0xfb, 0x98, 0x0d, 0xc0, 0xbb, 0x69, 0x06, 0x8a, 0xcd, 0x18, 0x47, 0x8f, 0xb4,
-	0x38, 0xc3, 0x3b, 0xa8, 0xe5, 0x6c, 0x7d, 0xfb, 0xc3, 0xa9, 0xe5, 0xb8, 0xdc, 0xb8, 0x40, 0x2d,
-	0xc3, 0x72, 0x87, 0xf7, 0xb2, 0x45, 0x8c, 0xf0, 0x54, 0x9f, 0xc0, 0xb5, 0xf7, 0x64, 0xf5, 0x5d,
-	0x29, 0x64, 0xd5, 0xa2, 0xfc, 0xdd, 0x14, 0xbe, 0xc0, 0x54, 0xff, 0x43, 0x01, 0x55, 0xac, 0x3f,
-	0x65, 0x22, 0xff, 0xcf, 0xaa, 0xd4, 0x11, 0x94, 0x7d, 0x42, 0xa8, 0x15, 0x31, 0xa6, 0x17, 0xa8,
-	0x12, 0x23, 0x68, 0x87, 0x84, 0x4d, 0x58, 0xc5, 0xce, 0x00, 0x87, 0x8d, 0xef, 0xff, 0x97, 0x13,
-	0x71, 0xaf, 0xda, 0xce, 0x00, 0x9b, 0x02, 0xa9, 0x7f, 0xa7, 0x40, 0x31, 0x32, 0xa2, 0x3d, 0xc8,
-	0x50, 0x22, 0x5b, 0x77, 0x9a, 0x6b, 0x65, 0x28, 0x41, 0x9f, 0x41, 0x8e, 0xf5, 0x0d, 0xd9, 0xba,
-	0xd3, 0xa0, 0x39, 0x6e, 0xbf, 0xf8, 0x2a, 0x2f, 0x57, 0xde, 0xac, 0x71, 0xbd, 0x78, 0xf0, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xe9, 0x2f, 0xc4, 0xb1, 0x11, 0x00, 0x00,
+	// 1837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x94, 0x48, 0x9e, 0x25, 0x25, 0x7a, 0x24, 0x39, 0x5b, 0x27, 0x95, 0xe5, 0x2d,
+	0x52, 0xc8, 0x2e, 0x42, 0x3b, 0x4c, 0x91, 0xa4, 0xae, 0x5b, 0x80, 0xe2, 0xd2, 0x36, 0x51, 0x45,
+	0x62, 0x47, 0x74, 0xd5, 0xb8, 0x48, 0xb7, 0x6b, 0xee, 0x88, 0x5a, 0x88, 0xdc, 0x59, 0xec, 0x8e,
+	0xcc, 0x32, 0x7d, 0x80, 0xa6, 0x68, 0x5f, 0xa1, 0x57, 0x79, 0xb2, 0xde, 0x15, 0x28, 0xd0, 0xbb,
+	0xde, 0x17, 0xf3, 0xb3, 0x3f, 0xa4, 0x1c, 0x68, 0x57, 0x2d, 0x90, 0xbb, 0x99, 0xb3, 0xf3, 0x7d,
+	0x33, 0xe7, 0x67, 0xce, 0x39, 0xb3, 0xd0, 0x8c, 0x18, 0x0d, 0x9d, 0x09, 0x69, 0x07, 0x21, 0x65,
+	0x14, 0xed, 0xcf, 0x9c, 0xc9, 0xcc, 0x69, 0xd3, 0x70, 0xfc, 0x79, 0xd8, 0x1e, 0x53, 0xff, 0xdc,
+	0x9b, 0x5c, 0x85, 0x0e, 0xa3, 0x61, 0x5b, 0xad, 0xbb, 0xb7, 0x37, 0xa1, 0x74, 0x32, 0x25, 0x8f,
+	0xc5, 0xfa, 0x37, 0x57, 0xe7, 0x8f, 0xe7, 0xa1, 0x13, 0x04, 0x24, 0x8c, 0x24, 0x83, 0xf9, 0xd7,
+	0x12, 0x54, 0x8f, 0x09, 0x9b, 0xd3, 0xf0, 0x12, 0x6d, 0x42, 0x69, 0x60, 0x19, 0xda, 0xbe, 0x76,
+	0x50, 0xc7, 0xa5, 0x81, 0x85, 0x10, 0x54, 0x46, 0x8b, 0x80, 0x18, 0x25, 0x21, 0x11, 0x63, 0x2e,
+	0xf3, 0x9d, 0x19, 0x31, 0x40, 0xca, 0xf8, 0x18, 0xed, 0x83, 0xee, 0x92, 0x68, 0x1c, 0x7a, 0x01,
+	0xf3, 0xa8, 0x6f, 0xe8, 0xe2, 0x53, 0x56, 0x84, 0x86, 0x50, 0x95, 0xa7, 0x8b, 0x8c, 0x9d, 0xfd,
+	0xf2, 0x81, 0xde, 0xf9, 0xb4, 0x7d, 0xd3, 0xc9, 0xdb, 0xea, 0x54, 0xed, 0x9e, 0x04, 0xf6, 0x7d,
+	0x16, 0x2e, 0x70, 0x4c, 0x83, 0x0c, 0xa8, 0xbe, 0x25, 0x61, 0xc4, 0xf7, 0xdb, 0xdb, 0xd7, 0x0e,
+	0x2a, 0x38, 0x9e, 0xde, 0x7b, 0x0a, 0x8d, 0x2c, 0x04, 0xb5, 0xa0, 0x7c, 0x49, 0x16, 0x4a, 0x2d,
+	0x3e, 0x44, 0x3b, 0xb0, 0xfe, 0xd6, 0x99, 0x5e, 0x49, 0xc5, 0x1a, 0x58, 0x4e, 0x9e, 0x96, 0x3e,
+	0xd7, 0x4c, 0x17, 0xee, 0xa8, 0x6d, 0x8f, 0xa8, 0xe3, 0x3e, 0xf7, 0xa6, 0x8c, 0x84, 0x9c, 0xc0,
+	0x73, 0x23, 0x43, 0xdb, 0x2f, 0x73, 0x02, 0xcf, 0x8d, 0xd0, 0x2f, 0x40, 0x67, 0x8b, 0x80, 0xd8,
+	0xe7, 0x62, 0x81, 0xa0, 0xd1, 0x3b, 0x1f, 0xb4, 0xa5, 0xa9, 0xdb, 0xb1, 0xa9, 0xdb, 0xa7, 0x2c,
+	0xf4, 0xfc, 0xc9, 0x6f, 0x38, 0x3b, 0x06, 0x0e, 0x90, 0x84, 0xe6, 0x57, 0xb0, 0x9d, 0xd9, 0xa5,
+	0x17, 0x7a, 0x8c, 0x84, 0x9e, 0x83, 0x7e, 0x04, 0xcd, 0x29, 0x75, 0x5c, 0x7b, 0x46, 0x98, 0xe3,
+	0x3a, 0xcc, 0x11, 0x47, 0xae, 0xe1, 0x06, 0x17, 0x7e, 0xa1, 0x64, 0xe8, 0x01, 0x88, 0xb9, 0x1d,
+	0x9b, 0xb3, 0x24, 0xd6, 0xe8, 0x5c, 0xa6, 0xb4, 0x36, 0xff, 0xa6, 0x2d, 0x69, 0x81, 0x49, 0x74,
+	0x35, 0x65, 0xa8, 0x0f, 0x35, 0x5f, 0x0a, 0xa5, 0x2a, 0x7a, 0xe7, 0x61, 0x6e, 0x1f, 0xe0, 0x04,
+	0x8a, 0x9e, 0xc0, 0x8e, 0x1a, 0x0f, 0xac, 0xc8, 0xf6, 0x29, 0xb3, 0xcf, 0xe9, 0x95, 0xef, 0x1a,
+	0x25, 0x61, 0x1d, 0x94, 0x7e, 0x3b, 0xa6, 0xec, 0x39, 0xff, 0x62, 0x7e, 0x53, 0x81, 0x5d, 0xc5,
+	0xf3, 0x2a, 0x70, 0x1d, 0x46, 0x12, 0x85, 0x57, 0xe3, 0xed, 0x43, 0xd8, 0x74, 0xc9, 0x94, 0x30,
+	0x62, 0x2b, 0x1a, 0x11, 0x65, 0x35, 0xdc, 0x94, 0xd2, 0x38, 0x4c, 0x3f, 0xe3, 0x9a, 0xcc, 0x6d,
+	0x11, 0x86, 0x3b, 0x39, 0x4c, 0x5f, 0xf5, 0xc9, 0xfc, 0x98, 0xc7, 0x69, 0x1f, 0xb6, 0x38, 0x30,
+	0x1b, 0xab, 0xbb, 0x39, 0xf0, 0x9b, 0x3e, 0x99, 0x5b, 0x99, 0x60, 0x56, 0xfb, 0x73, 0x87, 0x1a,
+	0x77, 0x73, 0xee, 0x2f, 0xee, 0xce, 0x5f, 0x34, 0x30, 0x94, 0xdf, 0x6c, 0x46, 0x6d, 0xc7, 0x75,
+	0x6d, 0x1a, 0xda, 0x57, 0xc2, 0x28, 0xc6, 0x9e, 0xf0, 0xc9, 0xaf, 0x73, 0xfb, 0x64, 0xd9, 0x96,
+	0xf1, 0x2d, 0x19, 0xd1, 0xae, 0xeb, 0x9e, 0x84, 0xf2, 0xa3, 0xbc, 0x32, 0x3b, 0xe3, 0x77, 0x7c,
+	0x42, 0x8f, 0xe0, 0x4e, 0xe6, 0x28, 0xd2, 0xc0, 0xc6, 0x7d, 0xe1, 0xc4, 0xad, 0x04, 0x60, 0x09,
+	0xf1, 0xbd, 0x17, 0xf0, 0x83, 0xef, 0xa4, 0x2f, 0x74, 0xbd, 0x9e, 0x40, 0xad, 0xef, 0x33, 0x8f,
+	0x2d, 0x64, 0x72, 0x11, 0x16, 0x94, 0x40, 0x31, 0x8e, 0xb9, 0x4a, 0x09, 0x97, 0xf9, 0xaf, 0x32,
+	0x34, 0x95, 0xc2, 0x12, 0x89, 0x3e, 0x80, 0x7a, 0x12, 0x64, 0x0a, 0x9c, 0x0a, 0x12, 0xd6, 0xd2,
+	0x75, 0xd6, 0x72, 0x7a, 0xc2, 0xdb, 0x25, 0xb1, 0x3d, 0x80, 0xe0, 0x62, 0x11, 0x79, 0x63, 0x67,
+	0x3a, 0xb0, 0x44, 0xe4, 0xd5, 0x71, 0x46, 0x82, 0xee, 0xc2, 0x86, 0xb4, 0x9c, 0xc8, 0x48, 0x0d,
+	0xac, 0x66, 0x3c, 0x55, 0x4d, 0x42, 0x27, 0xb8, 0x18, 0x58, 0xc6, 0x81, 0x00, 0xc5, 0x53, 0x74,
+	0x0c, 0x0d, 0x27, 0x8a, 0xe8, 0xd8, 0x73, 0xf8, 0x06, 0x91, 0xd1, 0x11, 0x31, 0xf0, 0xe8, 0xe6,
+	0x18, 0x88, 0xad, 0x88, 0x97, 0xf0, 0xe8, 0x77, 0xb0, 0x1d, 0x38, 0x21, 0xf1, 0x99, 0xbd, 0x44,
+	0xfb, 0x49, 0x61, 0x5a, 0x24, 0x69, 0xba, 0x59, 0xf2, 0x17, 0xa0, 0x07, 0x24, 0x9c, 0x79, 0x51,
+	0x24, 0x48, 0x9f, 0x09, 0xd2, 0x0f, 0x6f, 0x26, 0xed, 0xf6, 0x8e, 0x70, 0x16, 0x99, 0x4d, 0xdd,
+	0xcf, 0x97, 0x52, 0xb7, 0xf9, 0xcf, 0x0a, 0x94, 0xbb, 0xbd, 0xa3, 0x6b, 0x89, 0xe1, 0x2b, 0x68,
+	0x45, 0x63, 0x1a, 0x24, 0x79, 0x61, 0x60, 0x45, 0xc2, 0x77, 0x7a, 0xe7, 0x49, 0xae, 0xfd, 0xe3,
+	0x3b, 0x33, 0xb0, 0xa2, 0x97, 0x6b, 0x78, 0x4b, 0x70, 0xa5, 0x22, 0x74, 0x06, 0x9b, 0x92, 0x7e,
+	0xee, 0x4d, 0xdd, 0xb1, 0x13, 0xba, 0xc2, 0xfb, 0x9b, 0x9d, 0x76, 0x3e, 0xf2, 0x33, 0x85, 0x7a,
+	0xb9, 0x86, 0x9b, 0x82, 0x27, 0x16, 0xa0, 0x21, 0x40, 0xaa, 0xb8, 0x88, 0x98, 0xcd, 0xbc, 0x27,
+	0x1e, 0x26, 0x38, 0x9c, 0xe1, 0x40, 0x0f, 0x40, 0x27, 0xc2, 0x49, 0x32, 0xfd, 0xf0, 0x40, 0xab,
+	0xbf, 0xd4, 0x30, 0x48, 0xa1, 0xc8, 0x32, 0xaf, 0xa0, 0xc9, 0x16, 0x59, 0x65, 0xee, 0xdf, 0x4a,
+	0x19, 0x0d, 0x37, 0x38, 0x4d, 0xa2, 0xcb, 0x3d, 0xa8, 0x0d, 0x2c, 0x59, 0xc0, 0x8c, 0x03, 0x91,
+	0x27, 0x92, 0x79, 0xd6, 0xa3, 0x9d, 0xe5, 0x62, 0xbc, 0x07, 0x90, 0x31, 0x74, 0x0b, 0xca, 0x03,
+	0x4b, 0x96, 0x9f, 0x3a, 0xe6, 0x43, 0xf3, 0x33, 0x80, 0x54, 0x53, 0xa4, 0x43, 0xf5, 0xf8, 0xc4,
+	0x1e, 0xf6, 0xf1, 0x17, 0xad, 0x35, 0x54, 0x83, 0x0a, 0xee, 0x77, 0xad, 0x96, 0x86, 0xea, 0xb0,
+	0x7e, 0x86, 0x07, 0xa3, 0x7e, 0xab, 0x84, 0xaa, 0x50, 0x3e, 0x39, 0x3b, 0x6e, 0x95, 0xcd, 0x8f,
+	0xa0, 0x96, 0x1c, 0x6d, 0x0b, 0xf4, 0xe3, 0x13, 0xfb, 0x6c, 0x70, 0x64, 0xf5, 0xba, 0xd8, 0x6a,
+	0xad, 0xa1, 0x16, 0x34, 0xe2, 0x99, 0xdd, 0x3d, 0x3a, 0x6a, 0x69, 0x87, 0x55, 0x58, 0x17, 0xae,
+	0x39, 0xdc, 0x90, 0x09, 0xc2, 0xfc, 0x7b, 0x19, 0x5a, 0x32, 0xdc, 0x33, 0x95, 0x7e, 0xa5, 0xae,
+	0x6b, 0xc5, 0xea, 0x3a, 0xfa, 0x39, 0xc0, 0x25, 0x59, 0x14, 0xe9, 0x0a, 0xea, 0x97, 0x64, 0xa1,
+	0xc0, 0xcf, 0xa4, 0x6d, 0xca, 0x85, 0xef, 0x2a, 0x87, 0xa1, 0x4f, 0xd3, 0x1c, 0x53, 0xc9, 0x53,
+	0x92, 0xe2, 0x0c, 0xf4, 0x6c, 0x29, 0xa7, 0xad, 0xe7, 0x51, 0x38, 0x5d, 0x8f, 0xbe, 0x84, 0x66,
+	0x44, 0x9c, 0x70, 0x7c, 0x11, 0xeb, 0xbc, 0x21, 0x08, 0x7e, 0x9a, 0xf7, 0xf4, 0xa7, 0x02, 0x2c,
+	0x0d, 0x80, 0x1b, 0x51, 0x66, 0x66, 0x7e, 0x53, 0x02, 0x74, 0x7d, 0x11, 0xea, 0x42, 0x93, 0x67,
+	0x6b, 0xde, 0xfe, 0x30, 0xc7, 0xf3, 0xa3, 0x5c, 0x3e, 0x6a, 0x70, 0x48, 0x4f, 0x21, 0xd0, 0x09,
+	0xec, 0x64, 0xb2, 0x7a, 0xca, 0x94, 0xc7, 0x5f, 0xdb, 0x19, 0x64, 0x42, 0xf8, 0xfb, 0xb8, 0x94,
+	0xda, 0x41, 0x48, 0x5c, 0x6f, 0xec, 0x30, 0x12, 0xfb, 0xf1, 0xe3, 0x9b, 0x2d, 0x21, 0x2b, 0xeb,
+	0x30, 0x46, 0xe2, 0xd6, 0x78, 0x59, 0x10, 0x99, 0x67, 0xb0, 0xb5, 0xb2, 0x88, 0x17, 0xb0, 0xc0,
+	0x61, 0x17, 0x71, 0xf1, 0xe4, 0xe3, 0xe5, 0xb2, 0x5b, 0x57, 0x65, 0x97, 0x5f, 0xdb, 0x44, 0xc3,
+	0xb2, 0xe8, 0xa6, 0x92, 0xb9, 0xf9, 0x6d, 0x62, 0xe3, 0xe2, 0x7d, 0xe8, 0x7d, 0xd0, 0x33, 0x7d,
+	0xa8, 0x6a, 0x43, 0x21, 0x6d, 0x43, 0xd1, 0x47, 0xb0, 0x2d, 0x16, 0x88, 0x4a, 0x24, 0x9a, 0x0c,
+	0x76, 0xe1, 0xc5, 0x67, 0x68, 0xf1, 0x4f, 0xa2, 0xba, 0x44, 0x23, 0x3a, 0xba, 0xf0, 0x22, 0xf4,
+	0x31, 0xec, 0x66, 0x97, 0x9f, 0x87, 0x74, 0x26, 0x01, 0x15, 0x01, 0x40, 0x29, 0xe0, 0x79, 0x48,
+	0x67, 0x02, 0xf2, 0x10, 0x04, 0x8d, 0x9d, 0xad, 0x4a, 0xeb, 0x62, 0xf5, 0x16, 0x97, 0xa7, 0x79,
+	0x25, 0x42, 0xef, 0x43, 0x3d, 0x70, 0x26, 0xc4, 0x8e, 0xbc, 0xaf, 0x89, 0x08, 0xd2, 0x26, 0xae,
+	0x71, 0xc1, 0xa9, 0xf7, 0x35, 0x41, 0x3f, 0x04, 0x10, 0x1f, 0x19, 0xbd, 0x24, 0xbe, 0x51, 0x95,
+	0x2d, 0x05, 0x97, 0x8c, 0xb8, 0xc0, 0xfc, 0x87, 0x96, 0xcd, 0x14, 0xaa, 0x9b, 0xfe, 0x15, 0xd4,
+	0x44, 0xca, 0xf5, 0x48, 0xdc, 0x4d, 0x3f, 0xce, 0xdd, 0xb9, 0x49, 0x32, 0x9c, 0x10, 0xa0, 0xdf,
+	0x02, 0x8a, 0xc7, 0x2b, 0x1d, 0x75, 0xb1, 0x4c, 0xd0, 0x8a, 0x59, 0xe2, 0xde, 0x1b, 0xfd, 0x98,
+	0x77, 0xbc, 0x7f, 0x64, 0x76, 0x46, 0x3f, 0xd9, 0x06, 0x35, 0xb9, 0x78, 0x98, 0xe8, 0xf8, 0x9f,
+	0x0d, 0xd8, 0x91, 0x34, 0x2b, 0x2d, 0x7a, 0xae, 0x2e, 0x8d, 0x47, 0x8c, 0x6a, 0xdc, 0x65, 0x1d,
+	0x52, 0x7d, 0x7b, 0x43, 0x0a, 0x55, 0xe3, 0xf6, 0x7d, 0xb7, 0xed, 0x3d, 0xe0, 0x12, 0x3b, 0x93,
+	0xee, 0xf2, 0x34, 0xef, 0x4d, 0x9f, 0xcc, 0x87, 0x69, 0xc6, 0x7b, 0x0a, 0xc0, 0x49, 0x54, 0xd4,
+	0xbf, 0x27, 0x08, 0xde, 0xbf, 0x46, 0x70, 0xb8, 0x60, 0x24, 0x52, 0x19, 0xde, 0x27, 0x73, 0x75,
+	0x23, 0x3c, 0xd8, 0xce, 0xb6, 0x65, 0xfc, 0x4a, 0x44, 0x84, 0x89, 0x1a, 0xae, 0x77, 0x7e, 0x96,
+	0xd7, 0xcf, 0xd9, 0x9e, 0x6c, 0x44, 0x4f, 0x09, 0xc3, 0x77, 0x9c, 0x55, 0x11, 0x7a, 0x7d, 0x7d,
+	0x2b, 0xc7, 0x75, 0x8d, 0xfb, 0x85, 0x43, 0x6a, 0x85, 0xbb, 0xeb, 0xba, 0xe8, 0x0f, 0x70, 0x77,
+	0x95, 0x5b, 0x3d, 0x1f, 0xf6, 0x0b, 0xd3, 0xef, 0x2c, 0xd3, 0xcb, 0xf7, 0x06, 0xfa, 0x12, 0x76,
+	0x33, 0x77, 0x9a, 0x6f, 0x30, 0x0e, 0x09, 0x7f, 0x23, 0x1d, 0x14, 0xe9, 0x39, 0xb7, 0x33, 0x1c,
+	0x23, 0xda, 0x13, 0x0c, 0xef, 0xa0, 0x56, 0xcf, 0xaf, 0x87, 0xb7, 0xa7, 0x56, 0x2f, 0xaa, 0xce,
+	0x35, 0x6a, 0x65, 0x96, 0x47, 0xa2, 0xdd, 0x59, 0xc6, 0x48, 0x4d, 0xcd, 0x2b, 0x78, 0xef, 0x3b,
+	0xbc, 0x8a, 0x5e, 0xbf, 0x3b, 0x5a, 0xb4, 0xff, 0xd5, 0x85, 0xa7, 0x84, 0x99, 0xff, 0xd6, 0x40,
+	0x97, 0xdf, 0x5f, 0xf0, 0x3e, 0xe0, 0xff, 0x9b, 0xcd, 0x4e, 0xa0, 0x19, 0x52, 0xca, 0xec, 0x84,
+	0xb1, 0x78, 0x22, 0x6b, 0x70, 0x82, 0x7e, 0x4c, 0xd8, 0x85, 0x75, 0xe2, 0x4e, 0x92, 0x9a, 0xfa,
+	0x93, 0x9b, 0x89, 0x84, 0x56, 0x7d, 0x77, 0x42, 0xb0, 0x44, 0x9a, 0x7f, 0xd6, 0xa0, 0x9e, 0x08,
+	0xd1, 0x53, 0x28, 0x31, 0xaa, 0x3a, 0x87, 0x22, 0xc7, 0x2a, 0x31, 0x8a, 0x7e, 0x09, 0x15, 0x5e,
+	0x9b, 0x8c, 0x52, 0x61, 0xb4, 0xc0, 0x99, 0xdf, 0x6e, 0x40, 0x0d, 0x93, 0xb7, 0x9e, 0xe8, 0x77,
+	0xd3, 0x77, 0x4e, 0x45, 0xbc, 0x73, 0x96, 0xde, 0xb6, 0xa5, 0xd5, 0xb7, 0xed, 0x21, 0x6c, 0xa8,
+	0xf4, 0x5a, 0x2e, 0xbc, 0xb9, 0x42, 0x22, 0x0c, 0x75, 0x1a, 0x90, 0x50, 0x04, 0x83, 0x28, 0xad,
+	0x9b, 0x79, 0xba, 0xb5, 0xf8, 0xc0, 0xed, 0x93, 0x18, 0x8b, 0x53, 0x1a, 0xfe, 0xee, 0x75, 0xae,
+	0xd8, 0x05, 0x0d, 0x45, 0xf5, 0xad, 0x63, 0x35, 0xe3, 0xda, 0x30, 0x6f, 0x46, 0x22, 0xe6, 0xcc,
+	0x02, 0x51, 0x74, 0xcb, 0x38, 0x15, 0xa0, 0x21, 0x6c, 0x2a, 0xd5, 0xec, 0x37, 0xe4, 0x9c, 0x86,
+	0x44, 0xbd, 0xe8, 0x0a, 0xfc, 0x95, 0x6a, 0x2a, 0x82, 0x43, 0x81, 0x47, 0xc7, 0x10, 0x0b, 0x6c,
+	0xe7, 0x9c, 0x77, 0xa3, 0x7a, 0x51, 0xc2, 0x86, 0xc2, 0x77, 0x39, 0x1c, 0x8d, 0xa0, 0xa9, 0xde,
+	0x5a, 0xea, 0x80, 0xb2, 0x6a, 0x15, 0xbe, 0x1a, 0x0d, 0xc9, 0xa2, 0x4e, 0x89, 0x41, 0xcd, 0xd5,
+	0x21, 0x77, 0x6f, 0x47, 0xaa, 0x9e, 0x81, 0xf2, 0xa4, 0x47, 0xa0, 0x8b, 0x38, 0xe7, 0x49, 0x9e,
+	0xb8, 0xc6, 0x5e, 0xf1, 0x7b, 0x02, 0x02, 0xdf, 0xe5, 0x70, 0x34, 0x84, 0xa6, 0x64, 0x0b, 0xc9,
+	0x8c, 0xbe, 0x25, 0x71, 0xd9, 0x28, 0xc4, 0xd7, 0x10, 0x0c, 0x58, 0x12, 0x98, 0x8f, 0xa1, 0x9e,
+	0x44, 0x0e, 0x02, 0xd8, 0xe8, 0xe1, 0x7e, 0x77, 0xd4, 0x6f, 0xad, 0xf1, 0xf1, 0xab, 0xa1, 0xc5,
+	0xc7, 0x1a, 0x1f, 0x5b, 0xfd, 0xa3, 0x3e, 0x7f, 0xe6, 0x99, 0x7f, 0x02, 0x14, 0xc7, 0x5c, 0xe6,
+	0x79, 0x96, 0x5e, 0x00, 0xed, 0xd6, 0x17, 0xe0, 0x01, 0xc4, 0x4e, 0xb6, 0xa9, 0x3f, 0x5d, 0xc4,
+	0xff, 0x4f, 0x95, 0xec, 0xc4, 0x9f, 0x2e, 0x0e, 0xeb, 0xaf, 0xab, 0x0a, 0xfe, 0x66, 0x43, 0x94,
+	0xf4, 0x4f, 0xfe, 0x3b, 0x00, 0xfb, 0x28, 0xf3, 0x25, 0x77, 0x17, 0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func StartTestService(t *testing.T) {
 		t.Fatalf("Could not initialize sqlite DB: %s", err)
 	}
 	idGenerator := sequentialIDGenerator{nextID: 1}
-	storageFactory := storage.NewSQLConfiguratorStorageFactory(db, &idGenerator, sqorc.GetSqlBuilder())
+	storageFactory := storage.NewSQLConfiguratorStorageFactoryWithHistory(db, &idGenerator, sqorc.GetSqlBuilder(), 0)
 	err = storageFactory.InitializeServiceStorage()
 	if err != nil {
 		t.Fatalf("Could not initialize storage: %s", err)
@@ -9,6 +9,7 @@
 package test_utils
 
 import (
+	"context"
 	"testing"
 
 	"magma/orc8r/cloud/go/orc8r"
@@ -20,7 +21,7 @@ import (
 )
 
 func RegisterNetwork(t *testing.T, networkID string, networkName string) {
-	err := configurator.CreateNetwork(
+	err := configurator.CreateNetwork(context.Background(),
 		configurator.Network{
 			ID:   networkID,
 			Name: networkName,
@@ -55,7 +56,7 @@ func RegisterGatewayWithName(t *testing.T, networkID string, gatewayID string, n
 			Name: name,
 		}
 	}
-	_, err := configurator.CreateEntity(networkID, gwEntity)
+	_, err := configurator.CreateEntity(context.Background(), networkID, gwEntity)
 	assert.NoError(t, err)
 
 }
@@ -66,5 +67,5 @@ func RemoveGateway(t *testing.T, networkID, gatewayID string) {
 	physicalID, err := configurator.GetPhysicalIDOfEntity(networkID, orc8r.MagmadGatewayType, gatewayID)
 	assert.NoError(t, err)
 	assert.NoError(t, device.DeleteDevice(networkID, orc8r.AccessGatewayRecordType, physicalID))
-	assert.NoError(t, configurator.DeleteEntity(networkID, orc8r.MagmadGatewayType, gatewayID))
+	assert.NoError(t, configurator.DeleteEntity(context.Background(), networkID, orc8r.MagmadGatewayType, gatewayID))
 }
@@ -9,7 +9,10 @@
 package configurator
 
 import (
+	"strings"
+
 	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	storage2 "magma/orc8r/cloud/go/storage"
 
@@ -257,6 +260,87 @@ func (ge GraphEdge) fromStorageProto(protoEdge *storage.GraphEdge) GraphEdge {
 	return ge
 }
 
+// Revision is a recorded mutation of a network or of a network entity
+type Revision struct {
+	// ID of the revision. IDs increase with each revision in a network.
+	ID        uint64
+	NetworkID string
+	// Entity which was mutated. nil if the network itself was mutated.
+	Entity *storage2.TypeAndKey
+
+	// One of CREATE, UPDATE, or DELETE
+	Operation string
+	// Author of the mutation, if known
+	Author string
+	// Time of the mutation in unix milliseconds
+	Timestamp int64
+
+	// State of the network before and after the mutation. These are only set
+	// on revisions of the network itself, and are nil if the network didn't
+	// exist before or after the mutation.
+	NetworkBefore *Network
+	NetworkAfter  *Network
+
+	// State of the entity before and after the mutation. These are nil on
+	// revisions of the network itself, and if the entity didn't exist before
+	// or after the mutation.
+	EntityBefore *NetworkEntity
+	EntityAfter  *NetworkEntity
+
+	// Graph edges from the entity which the mutation added and removed
+	EdgesAdded   []GraphEdge
+	EdgesRemoved []GraphEdge
+}
+
+func (r Revision) fromStorageProto(protoRev *storage.Revision) (Revision, error) {
+	r.ID = protoRev.ID
+	r.NetworkID = protoRev.NetworkID
+	r.Operation = protoRev.Operation.String()
+	r.Author = protoRev.Author
+	r.Timestamp = protoRev.Timestamp
+	if protoRev.Entity != nil {
+		tk := protoRev.Entity.ToTypeAndKey()
+		r.Entity = &tk
+	}
+
+	if protoRev.NetworkBefore != nil {
+		network, err := (Network{}).fromStorageProto(protoRev.NetworkBefore)
+		if err != nil {
+			return r, errors.Wrapf(err, "failed to deserialize revision %d", r.ID)
+		}
+		r.NetworkBefore = &network
+	}
+	if protoRev.NetworkAfter != nil {
+		network, err := (Network{}).fromStorageProto(protoRev.NetworkAfter)
+		if err != nil {
+			return r, errors.Wrapf(err, "failed to deserialize revision %d", r.ID)
+		}
+		r.NetworkAfter = &network
+	}
+	if protoRev.EntityBefore != nil {
+		ent, err := (NetworkEntity{}).fromStorageProto(protoRev.EntityBefore)
+		if err != nil {
+			return r, errors.Wrapf(err, "failed to deserialize revision %d", r.ID)
+		}
+		r.EntityBefore = &ent
+	}
+	if protoRev.EntityAfter != nil {
+		ent, err := (NetworkEntity{}).fromStorageProto(protoRev.EntityAfter)
+		if err != nil {
+			return r, errors.Wrapf(err, "failed to deserialize revision %d", r.ID)
+		}
+		r.EntityAfter = &ent
+	}
+
+	for _, protoEdge := range protoRev.EdgesAdded {
+		r.EdgesAdded = append(r.EdgesAdded, (GraphEdge{}).fromStorageProto(protoEdge))
+	}
+	for _, protoEdge := range protoRev.EdgesRemoved {
+		r.EdgesRemoved = append(r.EdgesRemoved, (GraphEdge{}).fromStorageProto(protoEdge))
+	}
+	return r, nil
+}
+
 // EntityLoadFilter specifies which entities to load from storage
 type EntityLoadFilter struct {
 	// If TypeFilter is provided, the query will return all entities matching
@@ -286,6 +370,12 @@ type EntityLoadCriteria struct {
 
 	LoadAssocsToThis   bool
 	LoadAssocsFromThis bool
+
+	// Set PageSize to a non-zero value to load at most PageSize entities.
+	// PageToken is the opaque token returned by the load of the previous
+	// page, or empty to load the first page.
+	PageSize  uint32
+	PageToken string
 }
 
 func (elc EntityLoadCriteria) toStorageProto() *storage.EntityLoadCriteria {
@@ -294,6 +384,8 @@ func (elc EntityLoadCriteria) toStorageProto() *storage.EntityLoadCriteria {
 		LoadConfig:         elc.LoadConfig,
 		LoadAssocsToThis:   elc.LoadAssocsToThis,
 		LoadAssocsFromThis: elc.LoadAssocsFromThis,
+		PageSize:           elc.PageSize,
+		PageToken:          elc.PageToken,
 	}
 }
 
@@ -308,6 +400,85 @@ func FullEntityLoadCriteria() EntityLoadCriteria {
 	}
 }
 
+// EntitySearchFilter matches entities on their metadata and on the contents
+// of their config. An entity matches if it matches every field set.
+type EntitySearchFilter struct {
+	// Match entities whose name contains NameContains, ignoring case
+	NameContains *string
+	// Match entities whose description contains DescriptionContains,
+	// ignoring case
+	DescriptionContains *string
+
+	// Match entities whose config matches all of ConfigPredicates
+	ConfigPredicates []ConfigPredicate
+}
+
+// ConfigPredicate matches a value inside the JSON representation of an
+// entity's config.
+type ConfigPredicate struct {
+	// Dot-separated path to the value inside the config. If the path goes
+	// through a list, the predicate matches if any element of the list
+	// matches.
+	Path string
+	// Value to compare against. Values which aren't JSON strings are
+	// compared using their JSON encoding.
+	Value string
+	// Set Contains to true to match values which contain Value, ignoring
+	// case, instead of values equal to Value
+	Contains bool
+}
+
+// ParseEntitySearchFilter parses search terms of the form <field><op><value>
+// into an EntitySearchFilter. field is one of "name", "description" or
+// "config.<path>", and op is either "=" to match values equal to value or "~"
+// to match values containing value, ignoring case. Name and description only
+// support "~". For example, "name~site-12" or "config.apn_name=internet".
+func ParseEntitySearchFilter(terms []string) (EntitySearchFilter, error) {
+	ret := EntitySearchFilter{}
+	for _, term := range terms {
+		opIdx := strings.IndexAny(term, "=~")
+		if opIdx <= 0 {
+			return ret, errors.Errorf("invalid search term %q, expected <field>=<value> or <field>~<value>", term)
+		}
+		field, op, value := term[:opIdx], term[opIdx], term[opIdx+1:]
+
+		switch {
+		case field == "name" || field == "description":
+			if op != '~' {
+				return ret, errors.Errorf("invalid search term %q, %s only supports ~", term, field)
+			}
+			if field == "name" {
+				ret.NameContains = &value
+			} else {
+				ret.DescriptionContains = &value
+			}
+		case field == "config":
+			ret.ConfigPredicates = append(ret.ConfigPredicates, ConfigPredicate{Value: value, Contains: op == '~'})
+		case strings.HasPrefix(field, "config."):
+			path := strings.TrimPrefix(field, "config.")
+			ret.ConfigPredicates = append(ret.ConfigPredicates, ConfigPredicate{Path: path, Value: value, Contains: op == '~'})
+		default:
+			return ret, errors.Errorf("invalid search term %q, unsupported field %s", term, field)
+		}
+	}
+	return ret, nil
+}
+
+func (esf EntitySearchFilter) toStorageProto() *storage.EntitySearchFilter {
+	ret := &storage.EntitySearchFilter{
+		NameContains:        protos.GetStringWrapper(esf.NameContains),
+		DescriptionContains: protos.GetStringWrapper(esf.DescriptionContains),
+	}
+	for _, predicate := range esf.ConfigPredicates {
+		ret.ConfigPredicates = append(ret.ConfigPredicates, &storage.ConfigPredicate{
+			Path:     predicate.Path,
+			Value:    predicate.Value,
+			Contains: predicate.Contains,
+		})
+	}
+	return ret
+}
+
 // EntityLoadResult encapsulates the result of a LoadEntities call
 type EntityLoadResult struct {
 	// Loaded entities
@@ -0,0 +1,621 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/broker/memstore"
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	ownersTableName    = "dispatcher_gateway_owners"
+	requestsTableName  = "dispatcher_requests"
+	responsesTableName = "dispatcher_responses"
+
+	gwIDCol       = "gateway_id"
+	ownerCol      = "owner"
+	reqIDCol      = "req_id"
+	requesterCol  = "requester"
+	connClosedCol = "conn_closed"
+	bodyCol       = "body"
+	createdAtCol  = "created_at"
+
+	// defaultPollInterval is how often the SQL broker checks for requests to
+	// gateways it owns and for responses to requests it sent.
+	defaultPollInterval = time.Millisecond * 100
+	// pendingRowTTL is how long requests and responses are kept around for
+	// a replica to pick them up. Callers time out well before this.
+	pendingRowTTL = time.Minute
+)
+
+// SQLGatewayRPCBroker is a GatewayRPCBroker which shares state between
+// dispatcher replicas through SQL tables, so that a request can be sent to a
+// gateway from any replica, not only from the replica which holds the
+// gateway's SyncRPC stream.
+//
+// Each replica records ownership of the gateways connected to it in the
+// owners table. Requests for gateways owned by another replica are written to
+// the requests table, addressed to the owner found in the owners table, and
+// each replica polls for the requests addressed to it. Responses are written
+// back to a responses table which the requesting replica polls. Requests for
+// locally owned gateways bypass the tables entirely. Requests addressed to a
+// replica which no longer owns the gateway are readdressed to the current
+// owner, and requests which are pending when the gateway is disconnected are
+// picked up by the replica the gateway reconnects to.
+//
+// Request IDs are only unique per requesting replica, so the owner hands
+// each request to the gateway under an ID of its own and translates it back
+// to the requester's ID when the gateway responds.
+type SQLGatewayRPCBroker struct {
+	replicaID    string
+	db           *sql.DB
+	builder      sqorc.StatementBuilder
+	pollInterval time.Duration
+
+	requests memstore.RequestQueue
+
+	sync.Mutex
+	// respChanByReqID holds the response channels of requests sent from this
+	// replica
+	respChanByReqID map[uint32]chan *protos.GatewayResponse
+	// forwardedByGwReqID holds the requests sent to gateways owned by this
+	// replica, keyed by the ID the gateway sees
+	forwardedByGwReqID map[uint32]forwardedRequest
+	// gwReqIDByRequest is the reverse mapping of forwardedByGwReqID
+	gwReqIDByRequest map[requestKey]uint32
+	// ownedGateways is the set of gateways connected to this replica
+	ownedGateways map[string]struct{}
+}
+
+// requestKey identifies a request across replicas
+type requestKey struct {
+	requester string
+	reqID     uint32
+}
+
+type forwardedRequest struct {
+	requestKey
+	gwID string
+}
+
+// NewSQLGatewayRPCBroker returns a SQLGatewayRPCBroker identified by
+// replicaID, which must be unique across all dispatcher replicas sharing db.
+// Initialize must be called before the broker is used.
+func NewSQLGatewayRPCBroker(replicaID string, db *sql.DB, builder sqorc.StatementBuilder) *SQLGatewayRPCBroker {
+	return &SQLGatewayRPCBroker{
+		replicaID:          replicaID,
+		db:                 db,
+		builder:            builder,
+		pollInterval:       defaultPollInterval,
+		requests:           memstore.NewRequestQueue(queueLen),
+		respChanByReqID:    map[uint32]chan *protos.GatewayResponse{},
+		forwardedByGwReqID: map[uint32]forwardedRequest{},
+		gwReqIDByRequest:   map[requestKey]uint32{},
+		ownedGateways:      map[string]struct{}{},
+	}
+}
+
+// Initialize creates the broker's tables if they don't exist and starts
+// polling for requests and responses in the background.
+func (broker *SQLGatewayRPCBroker) Initialize() error {
+	_, err := sqorc.ExecInTx(broker.db, func(*sql.Tx) error { return nil }, broker.initTables)
+	if err != nil {
+		return err
+	}
+	go broker.pollForever()
+	return nil
+}
+
+func (broker *SQLGatewayRPCBroker) initTables(tx *sql.Tx) (interface{}, error) {
+	_, err := broker.builder.CreateTable(ownersTableName).
+		IfNotExists().
+		Column(gwIDCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(ownerCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gateway owners table: %s", err)
+	}
+	// Request IDs are uint32, which doesn't fit in a signed 32-bit INT
+	_, err = broker.builder.CreateTable(requestsTableName).
+		IfNotExists().
+		Column(requesterCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(reqIDCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(connClosedCol).Type(sqorc.ColumnTypeBool).NotNull().EndColumn().
+		Column(gwIDCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(ownerCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(bodyCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(createdAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		PrimaryKey(requesterCol, reqIDCol, connClosedCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create requests table: %s", err)
+	}
+	_, err = broker.builder.CreateIndex("dispatcher_requests_owner_idx").
+		IfNotExists().
+		On(requestsTableName).
+		Columns(ownerCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create requests index: %s", err)
+	}
+	_, err = broker.builder.CreateIndex("dispatcher_requests_gw_idx").
+		IfNotExists().
+		On(requestsTableName).
+		Columns(gwIDCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create requests index: %s", err)
+	}
+	_, err = broker.builder.CreateTable(responsesTableName).
+		IfNotExists().
+		Column(requesterCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(reqIDCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(bodyCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(createdAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		PrimaryKey(requesterCol, reqIDCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create responses table: %s", err)
+	}
+	return nil, nil
+}
+
+func (broker *SQLGatewayRPCBroker) SendRequestToGateway(gwReq *protos.GatewayRequest) (*GatewayResponseChannel, error) {
+	if gwReq == nil || len(gwReq.GwId) == 0 {
+		return nil, errors.New("gwReq cannot be nil and gwId cannot be empty string")
+	}
+	respChan, reqID := broker.initializeResponse()
+	syncRPCReq := &protos.SyncRPCRequest{ReqId: reqID, ReqBody: gwReq}
+	if err := broker.sendRequest(syncRPCReq); err != nil {
+		broker.deleteResponseChan(reqID)
+		return nil, err
+	}
+	return &GatewayResponseChannel{RespChan: respChan, ReqId: reqID}, nil
+}
+
+func (broker *SQLGatewayRPCBroker) ProcessGatewayResponse(response *protos.SyncRPCResponse) error {
+	if response == nil {
+		return errors.New("cannot send nil SyncRPCResponse")
+	}
+	broker.Lock()
+	forwarded, ok := broker.forwardedByGwReqID[response.ReqId]
+	broker.deleteForwardedLocked(response.ReqId)
+	broker.Unlock()
+	if !ok {
+		return fmt.Errorf("No requester found for reqId %v\n", response.ReqId)
+	}
+	if forwarded.requester == broker.replicaID {
+		return broker.deliverResponse(forwarded.reqID, response.RespBody)
+	}
+
+	body, err := proto.Marshal(response.RespBody)
+	if err != nil {
+		return err
+	}
+	_, err = broker.builder.Insert(responsesTableName).
+		Columns(requesterCol, reqIDCol, bodyCol, createdAtCol).
+		Values(forwarded.requester, forwarded.reqID, body, clock.Now().Unix()).
+		RunWith(broker.db).
+		Exec()
+	return err
+}
+
+func (broker *SQLGatewayRPCBroker) InitializeGateway(gwId string) chan *protos.SyncRPCRequest {
+	initializedQueue := broker.requests.InitializeQueue(gwId)
+	broker.Lock()
+	broker.ownedGateways[gwId] = struct{}{}
+	broker.Unlock()
+
+	_, err := sqorc.ExecInTx(broker.db, func(*sql.Tx) error { return nil }, func(tx *sql.Tx) (interface{}, error) {
+		_, err := broker.builder.Insert(ownersTableName).
+			Columns(gwIDCol, ownerCol).
+			Values(gwId, broker.replicaID).
+			OnConflict(
+				[]sqorc.UpsertValue{{Column: ownerCol, Value: broker.replicaID}},
+				gwIDCol,
+			).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, err
+		}
+		// Take over the requests sent while the gateway was disconnected or
+		// connected to another replica
+		_, err = broker.builder.Update(requestsTableName).
+			Set(ownerCol, broker.replicaID).
+			Where(sq.Eq{gwIDCol: gwId}).
+			RunWith(tx).
+			Exec()
+		return nil, err
+	})
+	if err != nil {
+		// Requests sent from this replica still reach the gateway, and
+		// pending requests in the table are readdressed by their owner
+		glog.Errorf("failed to record ownership of gateway %s: %s", gwId, err)
+	}
+	return initializedQueue.NewQueue
+}
+
+func (broker *SQLGatewayRPCBroker) CleanupGateway(gwId string) error {
+	broker.requests.CleanupQueue(gwId)
+	broker.Lock()
+	delete(broker.ownedGateways, gwId)
+	for gwReqID, forwarded := range broker.forwardedByGwReqID {
+		if forwarded.gwID == gwId {
+			broker.deleteForwardedLocked(gwReqID)
+		}
+	}
+	broker.Unlock()
+
+	// Only release ownership if the gateway hasn't reconnected elsewhere
+	_, err := broker.builder.Delete(ownersTableName).
+		Where(sq.Eq{gwIDCol: gwId, ownerCol: broker.replicaID}).
+		RunWith(broker.db).
+		Exec()
+	return err
+}
+
+func (broker *SQLGatewayRPCBroker) CancelGatewayRequest(gwId string, reqId uint32) error {
+	broker.deleteResponseChan(reqId)
+	syncRPCRequest := &protos.SyncRPCRequest{ReqId: reqId, ReqBody: &protos.GatewayRequest{GwId: gwId}, ConnClosed: true}
+	return broker.sendRequest(syncRPCRequest)
+}
+
+// sendRequest enqueues a request directly if the target gateway is connected
+// to this replica, and writes it to the requests table addressed to the
+// gateway's owner otherwise.
+func (broker *SQLGatewayRPCBroker) sendRequest(req *protos.SyncRPCRequest) error {
+	gwID := req.ReqBody.GwId
+	broker.Lock()
+	_, ownedLocally := broker.ownedGateways[gwID]
+	broker.Unlock()
+	if ownedLocally {
+		return broker.enqueue(broker.replicaID, req)
+	}
+
+	owner, err := broker.getOwner(broker.db, gwID)
+	if err != nil {
+		return err
+	}
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	_, err = broker.builder.Insert(requestsTableName).
+		Columns(requesterCol, reqIDCol, connClosedCol, gwIDCol, ownerCol, bodyCol, createdAtCol).
+		Values(broker.replicaID, req.ReqId, req.ConnClosed, gwID, owner, body, clock.Now().Unix()).
+		RunWith(broker.db).
+		Exec()
+	return err
+}
+
+// getOwner returns the replica which owns a gateway, or an empty string if
+// the gateway isn't connected to any replica.
+func (broker *SQLGatewayRPCBroker) getOwner(runner sq.BaseRunner, gwID string) (string, error) {
+	var owner string
+	err := broker.builder.Select(ownerCol).
+		From(ownersTableName).
+		Where(sq.Eq{gwIDCol: gwID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&owner)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get owner of gateway %s: %s", gwID, err)
+	}
+	return owner, nil
+}
+
+// enqueue hands a request from requester to a gateway owned by this replica
+// under a gateway request ID which is unique on this replica. Cancellations
+// of requests which already completed are dropped.
+func (broker *SQLGatewayRPCBroker) enqueue(requester string, req *protos.SyncRPCRequest) error {
+	key := requestKey{requester: requester, reqID: req.ReqId}
+	gwID := req.ReqBody.GetGwId()
+	broker.Lock()
+	var gwReqID uint32
+	if req.ConnClosed {
+		var ok bool
+		gwReqID, ok = broker.gwReqIDByRequest[key]
+		if !ok {
+			broker.Unlock()
+			return nil
+		}
+		broker.deleteForwardedLocked(gwReqID)
+	} else {
+		gwReqID = newReqID(func(id uint32) bool {
+			_, exists := broker.forwardedByGwReqID[id]
+			return exists
+		})
+		broker.forwardedByGwReqID[gwReqID] = forwardedRequest{requestKey: key, gwID: gwID}
+		broker.gwReqIDByRequest[key] = gwReqID
+	}
+	broker.Unlock()
+
+	err := broker.requests.Enqueue(&protos.SyncRPCRequest{ReqId: gwReqID, ReqBody: req.ReqBody, ConnClosed: req.ConnClosed})
+	if err != nil && !req.ConnClosed {
+		broker.Lock()
+		broker.deleteForwardedLocked(gwReqID)
+		broker.Unlock()
+	}
+	return err
+}
+
+// deleteForwardedLocked forgets a request sent to a gateway owned by this
+// replica. The caller must hold the broker lock.
+func (broker *SQLGatewayRPCBroker) deleteForwardedLocked(gwReqID uint32) {
+	if forwarded, ok := broker.forwardedByGwReqID[gwReqID]; ok {
+		delete(broker.gwReqIDByRequest, forwarded.requestKey)
+		delete(broker.forwardedByGwReqID, gwReqID)
+	}
+}
+
+func (broker *SQLGatewayRPCBroker) initializeResponse() (chan *protos.GatewayResponse, uint32) {
+	broker.Lock()
+	defer broker.Unlock()
+	respChan := make(chan *protos.GatewayResponse)
+	reqID := newReqID(func(id uint32) bool {
+		_, exists := broker.respChanByReqID[id]
+		return exists
+	})
+	broker.respChanByReqID[reqID] = respChan
+	return respChan, reqID
+}
+
+// newReqID returns a random non-zero request ID which isn't in use.
+func newReqID(inUse func(uint32) bool) uint32 {
+	for {
+		reqID := rand.Uint32()
+		if reqID != 0 && !inUse(reqID) {
+			return reqID
+		}
+	}
+}
+
+func (broker *SQLGatewayRPCBroker) deleteResponseChan(reqID uint32) {
+	broker.Lock()
+	delete(broker.respChanByReqID, reqID)
+	broker.Unlock()
+}
+
+func (broker *SQLGatewayRPCBroker) deliverResponse(reqID uint32, resp *protos.GatewayResponse) error {
+	broker.Lock()
+	respChan, ok := broker.respChanByReqID[reqID]
+	delete(broker.respChanByReqID, reqID)
+	broker.Unlock()
+	if !ok {
+		return fmt.Errorf("No response channel found for reqId %v\n", reqID)
+	}
+	if resp == nil {
+		glog.Errorf("Nil response body received, forward to httpServer anyways\n")
+	}
+	select {
+	case respChan <- resp:
+		return nil
+	case <-time.After(processResponseTimeout):
+		close(respChan)
+		return errors.New("sendResponse timed out as respChan is not being actively waited on")
+	}
+}
+
+func (broker *SQLGatewayRPCBroker) pollForever() {
+	for range time.Tick(broker.pollInterval) {
+		if err := broker.claimRequests(); err != nil {
+			glog.Errorf("failed to claim pending gateway requests: %s", err)
+		}
+		if err := broker.claimResponses(); err != nil {
+			glog.Errorf("failed to claim pending gateway responses: %s", err)
+		}
+		if err := broker.deleteStaleRows(); err != nil {
+			glog.Errorf("failed to delete stale gateway requests: %s", err)
+		}
+	}
+}
+
+type pendingRequest struct {
+	requester string
+	req       *protos.SyncRPCRequest
+}
+
+// claimRequests moves the pending requests addressed to this replica from
+// the requests table to the gateways' local queues. Requests for gateways
+// which aren't connected to this replica anymore are readdressed to their
+// current owner. Requests sent while the gateway was disconnected are claimed
+// as well if the gateway is now connected to this replica.
+func (broker *SQLGatewayRPCBroker) claimRequests() error {
+	broker.Lock()
+	gwIDs := make([]string, 0, len(broker.ownedGateways))
+	for gwID := range broker.ownedGateways {
+		gwIDs = append(gwIDs, gwID)
+	}
+	broker.Unlock()
+	addressedToReplica := sq.Or{sq.Eq{ownerCol: broker.replicaID}}
+	if len(gwIDs) > 0 {
+		addressedToReplica = append(addressedToReplica, sq.Eq{ownerCol: "", gwIDCol: gwIDs})
+	}
+
+	ret, err := sqorc.ExecInTx(broker.db, func(*sql.Tx) error { return nil }, func(tx *sql.Tx) (interface{}, error) {
+		rows, err := broker.builder.Select(requesterCol, bodyCol).
+			From(requestsTableName).
+			Where(addressedToReplica).
+			OrderBy(createdAtCol).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, err
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "claimRequests")
+
+		var pending []pendingRequest
+		for rows.Next() {
+			var requester string
+			var body []byte
+			if err := rows.Scan(&requester, &body); err != nil {
+				return nil, err
+			}
+			req := &protos.SyncRPCRequest{}
+			if err := proto.Unmarshal(body, req); err != nil {
+				return nil, err
+			}
+			pending = append(pending, pendingRequest{requester: requester, req: req})
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		rows.Close()
+
+		// Only keep requests this replica managed to delete so that a request
+		// is never handed to a gateway by two replicas
+		var claimed []pendingRequest
+		for _, p := range pending {
+			gwID := p.req.ReqBody.GetGwId()
+			rowKey := sq.Eq{requesterCol: p.requester, reqIDCol: p.req.ReqId, connClosedCol: p.req.ConnClosed}
+			broker.Lock()
+			_, ownedLocally := broker.ownedGateways[gwID]
+			broker.Unlock()
+			if !ownedLocally {
+				owner, err := broker.getOwner(tx, gwID)
+				if err != nil {
+					return nil, err
+				}
+				if owner == broker.replicaID {
+					// Stale ownership record, wait for the gateway to reconnect
+					owner = ""
+				}
+				_, err = broker.builder.Update(requestsTableName).
+					Set(ownerCol, owner).
+					Where(rowKey).
+					RunWith(tx).
+					Exec()
+				if err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			res, err := broker.builder.Delete(requestsTableName).
+				Where(rowKey).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return nil, err
+			}
+			if affected, err := res.RowsAffected(); err == nil && affected == 1 {
+				claimed = append(claimed, p)
+			}
+		}
+		return claimed, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ret.([]pendingRequest) {
+		if err := broker.enqueue(p.requester, p.req); err != nil {
+			glog.Errorf("failed to enqueue request %d from %s for gateway %s: %s", p.req.ReqId, p.requester, p.req.ReqBody.GetGwId(), err)
+		}
+	}
+	return nil
+}
+
+type pendingResponse struct {
+	reqID uint32
+	resp  *protos.GatewayResponse
+}
+
+// claimResponses delivers responses written by other replicas for requests
+// sent from this replica.
+func (broker *SQLGatewayRPCBroker) claimResponses() error {
+	ret, err := sqorc.ExecInTx(broker.db, func(*sql.Tx) error { return nil }, func(tx *sql.Tx) (interface{}, error) {
+		rows, err := broker.builder.Select(reqIDCol, bodyCol).
+			From(responsesTableName).
+			Where(sq.Eq{requesterCol: broker.replicaID}).
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, err
+		}
+		defer sqorc.CloseRowsLogOnError(rows, "claimResponses")
+
+		var pending []pendingResponse
+		for rows.Next() {
+			var reqID uint32
+			var body []byte
+			if err := rows.Scan(&reqID, &body); err != nil {
+				return nil, err
+			}
+			resp := &protos.GatewayResponse{}
+			if err := proto.Unmarshal(body, resp); err != nil {
+				return nil, err
+			}
+			pending = append(pending, pendingResponse{reqID: reqID, resp: resp})
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		rows.Close()
+		if len(pending) == 0 {
+			return pending, nil
+		}
+
+		_, err = broker.builder.Delete(responsesTableName).
+			Where(sq.Eq{requesterCol: broker.replicaID}).
+			RunWith(tx).
+			Exec()
+		return pending, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range ret.([]pendingResponse) {
+		go func(p pendingResponse) {
+			if err := broker.deliverResponse(p.reqID, p.resp); err != nil {
+				glog.Errorf("err processing gateway response: %v\n", err)
+			}
+		}(p)
+	}
+	return nil
+}
+
+func (broker *SQLGatewayRPCBroker) deleteStaleRows() error {
+	cutoff := clock.Now().Add(-pendingRowTTL).Unix()
+	_, err := broker.builder.Delete(requestsTableName).
+		Where(sq.Lt{createdAtCol: cutoff}).
+		RunWith(broker.db).
+		Exec()
+	if err != nil {
+		return err
+	}
+	_, err = broker.builder.Delete(responsesTableName).
+		Where(sq.Lt{createdAtCol: cutoff}).
+		RunWith(broker.db).
+		Exec()
+	return err
+}
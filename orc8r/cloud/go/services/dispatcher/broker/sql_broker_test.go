@@ -0,0 +1,219 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTimeout = 5 * time.Second
+
+func TestSQLGatewayRPCBroker_RemoteGateway(t *testing.T) {
+	_, brokers := newTestBrokers(t, "requester", "owner")
+	requester, owner := brokers[0], brokers[1]
+	gwQueue := owner.InitializeGateway("gw1")
+
+	// Request sent from a replica which doesn't hold the gateway's stream
+	gwReq := &protos.GatewayRequest{GwId: "gw1", Authority: "mme", Path: "/magma.MME/Foo"}
+	respChan, err := requester.SendRequestToGateway(gwReq)
+	assert.NoError(t, err)
+	syncReq := expectRequest(t, gwQueue)
+	assert.NotZero(t, syncReq.ReqId)
+	assert.Equal(t, gwReq.Path, syncReq.ReqBody.Path)
+	assert.False(t, syncReq.ConnClosed)
+
+	// Response is routed back to the requesting replica
+	gwResp := &protos.GatewayResponse{Status: "200", Payload: []byte("bar")}
+	err = owner.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: syncReq.ReqId, RespBody: gwResp})
+	assert.NoError(t, err)
+	select {
+	case resp := <-respChan.RespChan:
+		assert.Equal(t, "200", resp.Status)
+		assert.Equal(t, []byte("bar"), resp.Payload)
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for gateway response")
+	}
+
+	// Cancellations are routed to the owning replica as well
+	respChan, err = requester.SendRequestToGateway(gwReq)
+	assert.NoError(t, err)
+	gwReqID := expectRequest(t, gwQueue).ReqId
+	err = requester.CancelGatewayRequest("gw1", respChan.ReqId)
+	assert.NoError(t, err)
+	syncReq = expectRequest(t, gwQueue)
+	assert.Equal(t, gwReqID, syncReq.ReqId)
+	assert.True(t, syncReq.ConnClosed)
+
+	// Responses to cancelled requests are rejected
+	err = owner.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: gwReqID})
+	assert.Error(t, err)
+}
+
+func TestSQLGatewayRPCBroker_LocalGateway(t *testing.T) {
+	_, brokers := newTestBrokers(t, "replicaA")
+	brokerA := brokers[0]
+	gwQueue := brokerA.InitializeGateway("gw1")
+
+	respChan, err := brokerA.SendRequestToGateway(&protos.GatewayRequest{GwId: "gw1"})
+	assert.NoError(t, err)
+	syncReq := expectRequest(t, gwQueue)
+
+	go func() {
+		err := brokerA.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: syncReq.ReqId, RespBody: &protos.GatewayResponse{Status: "200"}})
+		assert.NoError(t, err)
+	}()
+	select {
+	case resp := <-respChan.RespChan:
+		assert.Equal(t, "200", resp.Status)
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for gateway response")
+	}
+
+	_, err = brokerA.SendRequestToGateway(nil)
+	assert.Error(t, err)
+}
+
+func TestSQLGatewayRPCBroker_Reconnect(t *testing.T) {
+	_, brokers := newTestBrokers(t, "replicaA", "replicaB")
+	brokerA, brokerB := brokers[0], brokers[1]
+	brokerA.InitializeGateway("gw1")
+	assert.NoError(t, brokerA.CleanupGateway("gw1"))
+
+	// Requests sent while the gateway is disconnected are delivered once it
+	// reconnects to any replica
+	respChan, err := brokerA.SendRequestToGateway(&protos.GatewayRequest{GwId: "gw1", Path: "/magma.MME/Foo"})
+	assert.NoError(t, err)
+	gwQueue := brokerB.InitializeGateway("gw1")
+	syncReq := expectRequest(t, gwQueue)
+	assert.Equal(t, "/magma.MME/Foo", syncReq.ReqBody.Path)
+
+	// The response is routed back to the replica the request was sent from
+	err = brokerB.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: syncReq.ReqId, RespBody: &protos.GatewayResponse{Status: "200"}})
+	assert.NoError(t, err)
+	select {
+	case resp := <-respChan.RespChan:
+		assert.Equal(t, "200", resp.Status)
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for gateway response")
+	}
+}
+
+func TestSQLGatewayRPCBroker_MovedGateway(t *testing.T) {
+	_, brokers := newTestBrokers(t, "replicaA", "replicaB")
+	brokerA, brokerB := brokers[0], brokers[1]
+	brokerA.InitializeGateway("gw1")
+
+	// The gateway reconnects to B before A notices the old stream is gone,
+	// requests sent from A are addressed to B
+	gwQueue := brokerB.InitializeGateway("gw1")
+	assert.NoError(t, brokerA.CleanupGateway("gw1"))
+	_, err := brokerA.SendRequestToGateway(&protos.GatewayRequest{GwId: "gw1", Path: "/magma.MME/Foo"})
+	assert.NoError(t, err)
+	syncReq := expectRequest(t, gwQueue)
+	assert.Equal(t, "/magma.MME/Foo", syncReq.ReqBody.Path)
+}
+
+func TestSQLGatewayRPCBroker_SameReqIDFromReplicas(t *testing.T) {
+	_, brokers := newTestBrokers(t, "replicaA", "replicaB", "owner")
+	requesterA, requesterB, owner := brokers[0], brokers[1], brokers[2]
+	gwQueue := owner.InitializeGateway("gw1")
+
+	// Both requesters picked the same request ID
+	respChans := map[string]chan *protos.GatewayResponse{}
+	for _, requester := range []*SQLGatewayRPCBroker{requesterA, requesterB} {
+		respChans[requester.replicaID] = make(chan *protos.GatewayResponse, 1)
+		requester.Lock()
+		requester.respChanByReqID[42] = respChans[requester.replicaID]
+		requester.Unlock()
+		err := requester.sendRequest(&protos.SyncRPCRequest{ReqId: 42, ReqBody: &protos.GatewayRequest{GwId: "gw1", Path: requester.replicaID}})
+		assert.NoError(t, err)
+	}
+	gwReqIDs := map[string]uint32{}
+	for i := 0; i < 2; i++ {
+		syncReq := expectRequest(t, gwQueue)
+		gwReqIDs[syncReq.ReqBody.Path] = syncReq.ReqId
+	}
+	assert.Len(t, gwReqIDs, 2)
+	assert.NotEqual(t, gwReqIDs["replicaA"], gwReqIDs["replicaB"])
+
+	// Each response goes back to its requester
+	for _, requester := range []*SQLGatewayRPCBroker{requesterA, requesterB} {
+		err := owner.ProcessGatewayResponse(&protos.SyncRPCResponse{
+			ReqId:    gwReqIDs[requester.replicaID],
+			RespBody: &protos.GatewayResponse{Status: requester.replicaID},
+		})
+		assert.NoError(t, err)
+	}
+	for _, requester := range []*SQLGatewayRPCBroker{requesterA, requesterB} {
+		select {
+		case resp := <-respChans[requester.replicaID]:
+			assert.Equal(t, requester.replicaID, resp.Status)
+		case <-time.After(testTimeout):
+			t.Fatal("timed out waiting for gateway response")
+		}
+	}
+	owner.Lock()
+	assert.Empty(t, owner.forwardedByGwReqID)
+	assert.Empty(t, owner.gwReqIDByRequest)
+	owner.Unlock()
+}
+
+func TestSQLGatewayRPCBroker_StaleOwner(t *testing.T) {
+	db, brokers := newTestBrokers(t, "replicaA", "replicaB", "requester")
+	brokerA, brokerB, requester := brokers[0], brokers[1], brokers[2]
+	gwQueue := brokerB.InitializeGateway("gw1")
+
+	// The ownership record points to A, which doesn't hold the gateway's
+	// stream. A readdresses the request and B picks it up.
+	_, err := sqorc.GetSqlBuilder().Update(ownersTableName).
+		Set(ownerCol, brokerA.replicaID).
+		Where(sq.Eq{gwIDCol: "gw1"}).
+		RunWith(db).
+		Exec()
+	assert.NoError(t, err)
+	_, err = requester.SendRequestToGateway(&protos.GatewayRequest{GwId: "gw1", Path: "/magma.MME/Foo"})
+	assert.NoError(t, err)
+	syncReq := expectRequest(t, gwQueue)
+	assert.Equal(t, "/magma.MME/Foo", syncReq.ReqBody.Path)
+}
+
+func newTestBrokers(t *testing.T, replicaIDs ...string) (*sql.DB, []*SQLGatewayRPCBroker) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	// Each connection to :memory: gets its own database
+	db.SetMaxOpenConns(1)
+
+	var brokers []*SQLGatewayRPCBroker
+	for _, replicaID := range replicaIDs {
+		broker := NewSQLGatewayRPCBroker(replicaID, db, sqorc.GetSqlBuilder())
+		broker.pollInterval = 10 * time.Millisecond
+		assert.NoError(t, broker.Initialize())
+		brokers = append(brokers, broker)
+	}
+	return db, brokers
+}
+
+func expectRequest(t *testing.T, queue chan *protos.SyncRPCRequest) *protos.SyncRPCRequest {
+	select {
+	case req := <-queue:
+		return req
+	case <-time.After(testTimeout):
+		t.Fatal("timed out waiting for gateway request")
+		return nil
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
@@ -21,12 +22,23 @@ import (
 	sync_rpc_broker "magma/orc8r/cloud/go/services/dispatcher/broker"
 	"magma/orc8r/cloud/go/services/dispatcher/httpserver"
 	"magma/orc8r/cloud/go/services/dispatcher/servicers"
+	"magma/orc8r/cloud/go/sqorc"
 
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
 )
 
-const HTTP_SERVER_PORT = 9080
+const (
+	HTTP_SERVER_PORT = 9080
+
+	// brokerBackendParam selects where the SyncRPC broker keeps its state.
+	// "memory" only supports a single dispatcher replica, "sql" shares the
+	// broker's state through the datastore so dispatcher can be scaled out.
+	brokerBackendParam = "broker_backend"
+	memoryBackend      = "memory"
+	sqlBackend         = "sql"
+)
 
 func main() {
 	// Set MaxConnectionAge to infinity so Sync RPC stream doesn't restart
@@ -44,12 +56,12 @@ func main() {
 		glog.Fatalf("Error creating service: %s", err)
 	}
 
-	// create a broker
-	broker := sync_rpc_broker.NewGatewayReqRespBroker()
-
 	// get ec2 public host name
 	hostName := getHostName()
 	glog.V(2).Infof("hostName is: %v\n", hostName)
+
+	// create a broker
+	broker := newBroker(srv, hostName)
 	// create servicer
 	syncRpcServicer, err := servicers.NewSyncRPCService(hostName, broker)
 	if err != nil {
@@ -71,6 +83,35 @@ func main() {
 	}
 }
 
+func newBroker(srv *service.Service, hostName string) sync_rpc_broker.GatewayRPCBroker {
+	backend := memoryBackend
+	if srv.Config != nil {
+		if configured, err := srv.Config.GetStringParam(brokerBackendParam); err == nil {
+			backend = configured
+		}
+	}
+
+	switch backend {
+	case memoryBackend:
+		return sync_rpc_broker.NewGatewayReqRespBroker()
+	case sqlBackend:
+		db, err := sqorc.Open(datastore.SQL_DRIVER, datastore.DATABASE_SOURCE)
+		if err != nil {
+			glog.Fatalf("Failed to connect to database: %s", err)
+		}
+		// Replicas may share a host name, so make the replica ID unique
+		replicaID := fmt.Sprintf("%s-%s", hostName, uuid.New().String())
+		broker := sync_rpc_broker.NewSQLGatewayRPCBroker(replicaID, db, sqorc.GetSqlBuilder())
+		if err := broker.Initialize(); err != nil {
+			glog.Fatalf("Failed to initialize SQL broker: %s", err)
+		}
+		return broker
+	default:
+		glog.Fatalf("Unsupported broker backend %s", backend)
+		return nil
+	}
+}
+
 // getHostName of the current SyncRPCService instance
 func getHostName() string {
 	// If there is env variable override, use the env variable
@@ -0,0 +1,177 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/metrics"
+
+	"github.com/golang/glog"
+)
+
+// BatchWriter writes a batch of log entries to a sink. Errors created with
+// NewRejectedError drop the batch, other errors keep it queued to be written
+// again at the next export.
+type BatchWriter func(logEntries []*protos.LogEntry) error
+
+type rejectedError struct {
+	error
+	// rejected is the number of rejected entries, or 0 if the whole batch
+	// was rejected
+	rejected int
+}
+
+// NewRejectedError wraps the error of a sink refusing entries, which writing
+// them again won't fix
+func NewRejectedError(err error) error {
+	return rejectedError{error: err}
+}
+
+// NewPartlyRejectedError wraps the error of a sink which wrote a batch
+// except for rejected entries
+func NewPartlyRejectedError(err error, rejected int) error {
+	return rejectedError{error: err, rejected: rejected}
+}
+
+// BatchExporter queues submitted log entries and writes them in batches of
+// at most batchSize entries every export interval, or as soon as a batch is
+// full. Entries which don't fit in the queue are dropped.
+type BatchExporter struct {
+	name           string
+	write          BatchWriter
+	batchSize      int
+	queueLen       int
+	exportInterval time.Duration
+
+	queue      []*protos.LogEntry
+	queueMutex sync.Mutex
+	full       chan struct{}
+	// exportMutex serializes exports
+	exportMutex sync.Mutex
+}
+
+// NewBatchExporter creates a BatchExporter writing with write. name labels
+// the exporter's metrics.
+func NewBatchExporter(
+	name string,
+	write BatchWriter,
+	batchSize int,
+	queueLen int,
+	exportInterval time.Duration,
+) *BatchExporter {
+	if queueLen < batchSize {
+		queueLen = batchSize
+	}
+	return &BatchExporter{
+		name:           name,
+		write:          write,
+		batchSize:      batchSize,
+		queueLen:       queueLen,
+		exportInterval: exportInterval,
+		full:           make(chan struct{}, 1),
+	}
+}
+
+func (e *BatchExporter) Submit(logEntries []*protos.LogEntry) error {
+	for _, entry := range logEntries {
+		if entry.Time == 0 {
+			return fmt.Errorf("LogEntry %v doesn't have time field set", entry)
+		}
+	}
+	e.queueMutex.Lock()
+	defer e.queueMutex.Unlock()
+	dropped := 0
+	if free := e.queueLen - len(e.queue); len(logEntries) > free {
+		dropped = len(logEntries) - free
+		logEntries = logEntries[:free]
+	}
+	e.queue = append(e.queue, logEntries...)
+	if len(e.queue) >= e.batchSize {
+		select {
+		case e.full <- struct{}{}:
+		default:
+		}
+	}
+	if dropped > 0 {
+		metrics.ReportDroppedEntries(e.name, metrics.DropReasonQueueFull, dropped)
+		return fmt.Errorf("dropping %v logEntries as the %s exporter queue is full", dropped, e.name)
+	}
+	return nil
+}
+
+// QueueLen returns the number of queued entries
+func (e *BatchExporter) QueueLen() int {
+	e.queueMutex.Lock()
+	defer e.queueMutex.Unlock()
+	return len(e.queue)
+}
+
+func (e *BatchExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *BatchExporter) exportEvery() {
+	ticker := time.NewTicker(e.exportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.full:
+		}
+		if err := e.Export(); err != nil {
+			glog.Errorf("Error in exporting to %s: %v", e.name, err)
+		}
+	}
+}
+
+// Export writes all queued entries in batches. It stops at the first batch
+// which fails to be written, keeping it queued.
+func (e *BatchExporter) Export() error {
+	e.exportMutex.Lock()
+	defer e.exportMutex.Unlock()
+	for {
+		e.queueMutex.Lock()
+		batch := e.queue
+		if len(batch) > e.batchSize {
+			batch = batch[:e.batchSize]
+		}
+		e.queueMutex.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := e.write(batch)
+		rejectedErr, rejected := err.(rejectedError)
+		if err != nil && !rejected {
+			return fmt.Errorf("Failed to export to %s: %v", e.name, err)
+		}
+		exported := len(batch)
+		if rejected {
+			dropped := rejectedErr.rejected
+			if dropped <= 0 || dropped > len(batch) {
+				dropped = len(batch)
+			}
+			glog.Errorf("Dropping %d log entries rejected by %s: %v", dropped, e.name, err)
+			metrics.ReportDroppedEntries(e.name, metrics.DropReasonRejected, dropped)
+			exported -= dropped
+		}
+		if exported > 0 {
+			metrics.ReportExportedEntries(e.name, exported)
+		}
+		// only this goroutine removes entries, so the batch is still at the
+		// front of the queue
+		e.queueMutex.Lock()
+		e.queue = e.queue[len(batch):]
+		e.queueMutex.Unlock()
+	}
+}
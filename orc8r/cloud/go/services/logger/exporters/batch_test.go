@@ -0,0 +1,140 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+	"magma/orc8r/cloud/go/services/logger/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+type testWriter struct {
+	sync.Mutex
+	batches [][]*protos.LogEntry
+	errs    []error
+}
+
+func (w *testWriter) write(logEntries []*protos.LogEntry) error {
+	w.Lock()
+	defer w.Unlock()
+	var err error
+	if len(w.errs) > 0 {
+		err, w.errs = w.errs[0], w.errs[1:]
+	}
+	if err == nil {
+		w.batches = append(w.batches, logEntries)
+	}
+	return err
+}
+
+func (w *testWriter) batchCount() int {
+	w.Lock()
+	defer w.Unlock()
+	return len(w.batches)
+}
+
+func TestBatchExporter(t *testing.T) {
+	writer := &testWriter{}
+	exporter := exporters.NewBatchExporter("test_batch", writer.write, 2, 4, time.Second*10)
+
+	err := exporter.Submit([]*protos.LogEntry{{Category: "test"}})
+	assert.EqualError(t, err, "LogEntry category:\"test\"  doesn't have time field set")
+	assert.NoError(t, exporter.Submit(makeLogEntries(3)))
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, [][]*protos.LogEntry{makeLogEntries(3)[:2], makeLogEntries(3)[2:]}, writer.batches)
+	assert.Equal(t, 0, exporter.QueueLen())
+	assert.Equal(t, float64(3), getCounterValue(t, "logger_exported_entries", "test_batch", ""))
+
+	// entries which don't fit in the queue are dropped
+	err = exporter.Submit(makeLogEntries(6))
+	assert.EqualError(t, err, "dropping 2 logEntries as the test_batch exporter queue is full")
+	assert.Equal(t, 4, exporter.QueueLen())
+	assert.Equal(t, float64(2), getCounterValue(t, "logger_dropped_entries", "test_batch", metrics.DropReasonQueueFull))
+
+	// failed batches stay queued
+	writer.errs = []error{nil, errors.New("unavailable")}
+	assert.EqualError(t, exporter.Export(), "Failed to export to test_batch: unavailable")
+	assert.Equal(t, 2, exporter.QueueLen())
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, 4, len(writer.batches))
+
+	// rejected batches are dropped
+	writer.errs = []error{exporters.NewRejectedError(errors.New("bad request")), exporters.NewPartlyRejectedError(errors.New("mapping error"), 1)}
+	assert.NoError(t, exporter.Submit(makeLogEntries(4)))
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, 0, exporter.QueueLen())
+	assert.Equal(t, float64(3), getCounterValue(t, "logger_dropped_entries", "test_batch", metrics.DropReasonRejected))
+	assert.Equal(t, float64(8), getCounterValue(t, "logger_exported_entries", "test_batch", ""))
+}
+
+func TestBatchExporter_Start(t *testing.T) {
+	writer := &testWriter{}
+	exporter := exporters.NewBatchExporter("test_start", writer.write, 2, 10, time.Hour)
+	exporter.Start()
+
+	// a full batch is exported before the export interval
+	assert.NoError(t, exporter.Submit(makeLogEntries(1)))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 0, writer.batchCount())
+	assert.NoError(t, exporter.Submit(makeLogEntries(1)))
+	assert.Eventually(t, func() bool { return writer.batchCount() == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestMultiExporter(t *testing.T) {
+	writer1, writer2 := &testWriter{}, &testWriter{}
+	exporter1 := exporters.NewBatchExporter("test_multi1", writer1.write, 2, 2, time.Hour)
+	exporter2 := exporters.NewBatchExporter("test_multi2", writer2.write, 2, 4, time.Hour)
+	exporter := exporters.NewMultiExporter(exporter1, exporter2)
+
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	// the second exporter gets entries even if the first one is full
+	assert.EqualError(t, exporter.Submit(makeLogEntries(2)), "dropping 2 logEntries as the test_multi1 exporter queue is full")
+	assert.Equal(t, 2, exporter1.QueueLen())
+	assert.Equal(t, 4, exporter2.QueueLen())
+}
+
+func makeLogEntries(count int) []*protos.LogEntry {
+	entries := make([]*protos.LogEntry, 0, count)
+	for i := 0; i < count; i++ {
+		entries = append(entries, &protos.LogEntry{
+			Category:  "test",
+			Time:      int64(1500000000 + i),
+			NormalMap: map[string]string{"status": "ACTIVE"},
+		})
+	}
+	return entries
+}
+
+func getCounterValue(t *testing.T, name string, exporter string, reason string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.Metric {
+			labels := map[string]string{}
+			for _, label := range metric.Label {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels[metrics.ExporterLabelName] == exporter && labels[metrics.ReasonLabelName] == reason {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
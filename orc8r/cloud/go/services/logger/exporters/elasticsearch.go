@@ -0,0 +1,82 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	ElasticsearchExporterName = "elasticsearch"
+
+	elasticsearchTimeout = time.Second * 30
+	indexDateLayout      = "2006.01.02"
+)
+
+// ElasticsearchExporter writes log entries as LogRecords with the
+// Elasticsearch bulk API, to one index per day named
+// <indexPrefix>-YYYY.MM.DD after the entry's time
+type ElasticsearchExporter struct {
+	*BatchExporter
+	client      *elastic.Client
+	indexPrefix string
+}
+
+func NewElasticsearchExporter(
+	url string,
+	indexPrefix string,
+	batchSize int,
+	queueLen int,
+	exportInterval time.Duration,
+) (*ElasticsearchExporter, error) {
+	if !strings.HasPrefix(url, "http") {
+		url = fmt.Sprintf("http://%s", url)
+	}
+	client, err := elastic.NewSimpleClient(elastic.SetURL(url))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create Elasticsearch client: %v", err)
+	}
+	e := &ElasticsearchExporter{client: client, indexPrefix: indexPrefix}
+	e.BatchExporter = NewBatchExporter(ElasticsearchExporterName, e.write, batchSize, queueLen, exportInterval)
+	return e, nil
+}
+
+func (e *ElasticsearchExporter) write(logEntries []*protos.LogEntry) error {
+	bulk := e.client.Bulk()
+	for _, record := range ConvertToLogRecords(logEntries) {
+		timestamp, _ := time.Parse(time.RFC3339, record.Timestamp)
+		index := fmt.Sprintf("%s-%s", e.indexPrefix, timestamp.Format(indexDateLayout))
+		bulk.Add(elastic.NewBulkIndexRequest().Index(index).Doc(record))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), elasticsearchTimeout)
+	defer cancel()
+	resp, err := bulk.Do(ctx)
+	if err != nil {
+		if elasticErr, ok := err.(*elastic.Error); ok && elasticErr.Status/100 == 4 && elasticErr.Status != http.StatusTooManyRequests {
+			return NewRejectedError(err)
+		}
+		return err
+	}
+	if failed := resp.Failed(); len(failed) > 0 {
+		reason := "unknown error"
+		if failed[0].Error != nil {
+			reason = failed[0].Error.Reason
+		}
+		return NewPartlyRejectedError(fmt.Errorf("%d of %d documents failed to be indexed, first error: %s", len(failed), len(logEntries), reason), len(failed))
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+const FileExporterName = "file"
+
+// FileExporter appends log entries as JSON lines of LogRecords to a local
+// file. When the file would grow beyond maxSize bytes it is rotated to
+// <path>.1, shifting older files up to <path>.<maxBackups>. If rotating
+// fails, entries keep being appended to the current file.
+type FileExporter struct {
+	*BatchExporter
+	path       string
+	maxSize    int64
+	maxBackups int
+	// file is nil if it couldn't be reopened after a failed rotation
+	file io.WriteCloser
+	size int64
+
+	// unwritten is the end of the last batch, which was only partly
+	// written. The BatchExporter keeps a failed batch at the front of its
+	// queue, so the next write resumes with it.
+	unwritten        []byte
+	unwrittenEntries int
+}
+
+func NewFileExporter(
+	path string,
+	maxSize int64,
+	maxBackups int,
+	batchSize int,
+	queueLen int,
+	exportInterval time.Duration,
+) (*FileExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("Failed to create log directory: %v", err)
+	}
+	e := &FileExporter{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := e.open(); err != nil {
+		return nil, err
+	}
+	e.BatchExporter = NewBatchExporter(FileExporterName, e.write, batchSize, queueLen, exportInterval)
+	return e, nil
+}
+
+// write is only called by the BatchExporter's export, so it needs no lock
+func (e *FileExporter) write(logEntries []*protos.LogEntry) error {
+	if e.file == nil {
+		if err := e.open(); err != nil {
+			return err
+		}
+	}
+	if e.unwrittenEntries > 0 {
+		written := e.unwrittenEntries
+		if err := e.writeBatch(e.unwritten, written); err != nil {
+			return err
+		}
+		logEntries = logEntries[written:]
+		if len(logEntries) == 0 {
+			return nil
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range ConvertToLogRecords(logEntries) {
+		if err := encoder.Encode(record); err != nil {
+			return NewRejectedError(err)
+		}
+	}
+	if e.size > 0 && e.size+int64(buf.Len()) > e.maxSize {
+		if err := e.rotate(); err != nil {
+			if e.file == nil {
+				return err
+			}
+			glog.Errorf("Failed to rotate %s, appending to it: %v", e.path, err)
+		}
+	}
+	return e.writeBatch(buf.Bytes(), len(logEntries))
+}
+
+// writeBatch appends an encoded batch of entries to the log file. If only
+// part of the batch is written, the rest is kept for the next write.
+func (e *FileExporter) writeBatch(data []byte, entries int) error {
+	n, err := e.file.Write(data)
+	e.size += int64(n)
+	if err != nil {
+		e.unwritten, e.unwrittenEntries = data[n:], entries
+		return err
+	}
+	e.unwritten, e.unwrittenEntries = nil, 0
+	return nil
+}
+
+func (e *FileExporter) open() error {
+	file, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("Failed to open log file: %v", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("Failed to stat log file: %v", err)
+	}
+	e.file = file
+	e.size = info.Size()
+	return nil
+}
+
+// rotate closes the log file, moves it out of the way and opens a new one.
+// If the file can't be moved, it is reopened. e.file is nil if no file
+// could be opened.
+func (e *FileExporter) rotate() error {
+	err := e.file.Close()
+	e.file = nil
+	if err != nil {
+		return e.reopen(fmt.Errorf("Failed to close log file: %v", err))
+	}
+	if err := e.moveFiles(); err != nil {
+		return e.reopen(err)
+	}
+	return e.open()
+}
+
+// reopen reopens the log file after a failed rotation and returns the
+// rotation's error
+func (e *FileExporter) reopen(rotateErr error) error {
+	if err := e.open(); err != nil {
+		return fmt.Errorf("%v; %v", rotateErr, err)
+	}
+	return rotateErr
+}
+
+func (e *FileExporter) moveFiles() error {
+	if e.maxBackups <= 0 {
+		if err := os.Remove(e.path); err != nil {
+			return fmt.Errorf("Failed to rotate log file: %v", err)
+		}
+		return nil
+	}
+	for i := e.maxBackups - 1; i > 0; i-- {
+		from := fmt.Sprintf("%s.%d", e.path, i)
+		if _, err := os.Stat(from); err == nil {
+			if err := os.Rename(from, fmt.Sprintf("%s.%d", e.path, i+1)); err != nil {
+				return fmt.Errorf("Failed to rotate log file: %v", err)
+			}
+		}
+	}
+	if err := os.Rename(e.path, e.path+".1"); err != nil {
+		return fmt.Errorf("Failed to rotate log file: %v", err)
+	}
+	return nil
+}
+
+// Close closes the log file
+func (e *FileExporter) Close() error {
+	e.exportMutex.Lock()
+	defer e.exportMutex.Unlock()
+	if e.file == nil {
+		return nil
+	}
+	return e.file.Close()
+}
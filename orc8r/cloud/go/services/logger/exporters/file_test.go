@@ -0,0 +1,72 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// shortWriter writes at most limit bytes, or any number of bytes if limit
+// is negative
+type shortWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if w.limit < 0 || len(p) <= w.limit {
+		w.limit -= len(p)
+		return w.Buffer.Write(p)
+	}
+	n, _ := w.Buffer.Write(p[:w.limit])
+	w.limit = 0
+	return n, io.ErrShortWrite
+}
+
+func (w *shortWriter) Close() error {
+	return nil
+}
+
+func TestFileExporter_PartialWrite(t *testing.T) {
+	out := &shortWriter{limit: 10}
+	e := &FileExporter{maxSize: 1 << 20, file: out}
+	e.BatchExporter = NewBatchExporter(FileExporterName, e.write, 2, 100, time.Hour)
+	entries := []*protos.LogEntry{
+		{Category: "test", Time: 1, NormalMap: map[string]string{"n": "1"}},
+		{Category: "test", Time: 2, NormalMap: map[string]string{"n": "2"}},
+		{Category: "test", Time: 3, NormalMap: map[string]string{"n": "3"}},
+	}
+
+	assert.NoError(t, e.Submit(entries[:2]))
+	assert.Error(t, e.Export())
+	assert.Equal(t, 10, out.Len())
+	assert.Equal(t, 2, e.QueueLen())
+
+	// the retried batch resumes after the bytes already written
+	out.limit = -1
+	assert.NoError(t, e.Submit(entries[2:]))
+	assert.NoError(t, e.Export())
+	assert.Equal(t, 0, e.QueueLen())
+
+	var expected bytes.Buffer
+	encoder := json.NewEncoder(&expected)
+	for _, record := range ConvertToLogRecords(entries) {
+		assert.NoError(t, encoder.Encode(record))
+	}
+	assert.Equal(t, expected.String(), out.String())
+	assert.Equal(t, int64(expected.Len()), e.size)
+}
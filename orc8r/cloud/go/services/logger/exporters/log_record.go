@@ -0,0 +1,60 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/glog"
+)
+
+// LogRecord is the JSON document written for a log entry by the
+// Elasticsearch, file and webhook exporters. Its timestamp and network_id
+// fields match the ones the log query API searches.
+type LogRecord struct {
+	Timestamp  string            `json:"@timestamp"`
+	Category   string            `json:"category"`
+	HardwareID string            `json:"hw_id,omitempty"`
+	NetworkID  string            `json:"network_id,omitempty"`
+	GatewayID  string            `json:"gateway_id,omitempty"`
+	Normal     map[string]string `json:"normal,omitempty"`
+	Int        map[string]int64  `json:"int,omitempty"`
+	TagSet     []string          `json:"tagset,omitempty"`
+	NormVec    []string          `json:"normvector,omitempty"`
+}
+
+// ConvertToLogRecords converts log entries to LogRecords, adding the
+// networkId and gatewayId of entries logged from a gateway
+func ConvertToLogRecords(entries []*protos.LogEntry) []*LogRecord {
+	records := make([]*LogRecord, 0, len(entries))
+	for _, entry := range entries {
+		record := &LogRecord{
+			Timestamp:  time.Unix(entry.Time, 0).UTC().Format(time.RFC3339),
+			Category:   entry.Category,
+			HardwareID: entry.HwId,
+			Normal:     entry.NormalMap,
+			Int:        entry.IntMap,
+			TagSet:     entry.TagSet,
+			NormVec:    entry.Normvector,
+		}
+		if len(entry.HwId) != 0 {
+			networkID, gatewayID, err := configurator.GetNetworkAndEntityIDForPhysicalID(entry.HwId)
+			if err != nil {
+				glog.Errorf("Error retrieving nwId and gwId for hwId %s: %v", entry.HwId, err)
+			}
+			record.NetworkID = networkID
+			record.GatewayID = gatewayID
+		}
+		records = append(records, record)
+	}
+	return records
+}
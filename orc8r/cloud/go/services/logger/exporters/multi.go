@@ -0,0 +1,39 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+)
+
+// MultiExporter submits log entries to several exporters
+type MultiExporter struct {
+	exporters []Exporter
+}
+
+func NewMultiExporter(exporters ...Exporter) *MultiExporter {
+	return &MultiExporter{exporters: exporters}
+}
+
+// Submit submits logEntries to every exporter, even if some fail
+func (e *MultiExporter) Submit(logEntries []*protos.LogEntry) error {
+	var errs []string
+	for _, exporter := range e.exporters {
+		if err := exporter.Submit(logEntries); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -18,10 +18,13 @@ import (
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/metrics"
 
 	"github.com/golang/glog"
 )
 
+const ScribeExporterName = "scribe"
+
 type HttpClient interface {
 	PostForm(url string, data url.Values) (resp *http.Response, err error)
 }
@@ -77,6 +80,7 @@ func (e *ScribeExporter) Export(client HttpClient) error {
 			return fmt.Errorf("Failed to export to scribe: %v\n", err)
 		}
 		// write to ods successful, clear written logs from queue
+		metrics.ReportExportedEntries(ScribeExporterName, len(logs))
 		e.queueMutex.Lock()
 		e.queue = e.queue[len(logs):]
 		e.queueMutex.Unlock()
@@ -109,9 +113,11 @@ func (e *ScribeExporter) Submit(logEntries []*protos.LogEntry) error {
 	defer e.queueMutex.Unlock()
 	if (len(e.queue) + len(logEntries)) > e.queueLen {
 		// queue is full, clear queue and log that queue was full
+		metrics.ReportDroppedEntries(ScribeExporterName, metrics.DropReasonQueueFull, len(e.queue))
 		e.queue = []*ScribeLogEntry{}
 		glog.Warningf("Queue is full, clearing...")
 		if len(logEntries) > e.queueLen {
+			metrics.ReportDroppedEntries(ScribeExporterName, metrics.DropReasonQueueFull, len(logEntries))
 			return fmt.Errorf("dropping %v logEntries as it exceeds max queue length", len(logEntries))
 		}
 	}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedRecord = &exporters.LogRecord{
+	Timestamp: "2017-07-14T02:40:00Z",
+	Category:  "test",
+	Normal:    map[string]string{"status": "ACTIVE"},
+}
+
+func TestFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logger_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "logger.jsonl")
+
+	// a batch of 2 entries is under 200 bytes, so the file is rotated after 2
+	// batches
+	exporter, err := exporters.NewFileExporter(path, 400, 2, 2, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.NoError(t, exporter.Export())
+	records := readRecords(t, path)
+	assert.Len(t, records, 2)
+	assert.Equal(t, expectedRecord, records[0])
+	assert.Equal(t, "2017-07-14T02:40:01Z", records[1].Timestamp)
+
+	assert.NoError(t, exporter.Submit(makeLogEntries(8)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, readRecords(t, path), 2)
+	assert.Len(t, readRecords(t, path+".1"), 4)
+	assert.Len(t, readRecords(t, path+".2"), 4)
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, exporter.Close())
+
+	// a reopened file keeps being appended to
+	exporter, err = exporters.NewFileExporter(path, 400, 2, 2, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, readRecords(t, path), 4)
+	assert.Equal(t, "2017-07-14T02:40:00Z", readRecords(t, path)[2].Timestamp)
+	assert.Len(t, readRecords(t, path+".1"), 4)
+	assert.NoError(t, exporter.Close())
+}
+
+func TestFileExporter_FailedRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logger_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logger.jsonl")
+
+	// the file can't be rotated onto a directory, so it keeps being appended
+	// to
+	assert.NoError(t, os.Mkdir(path+".1", 0755))
+	exporter, err := exporters.NewFileExporter(path, 400, 1, 2, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeLogEntries(6)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, readRecords(t, path), 6)
+
+	// and is rotated once the directory is gone
+	assert.NoError(t, os.Remove(path+".1"))
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, readRecords(t, path), 2)
+	assert.Len(t, readRecords(t, path+".1"), 6)
+	assert.NoError(t, exporter.Close())
+}
+
+func TestElasticsearchExporter(t *testing.T) {
+	var requests []string
+	status := http.StatusOK
+	response := `{"took":1,"errors":false,"items":[{"index":{"status":201}},{"index":{"status":201}}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+"\n"+string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	exporter, err := exporters.NewElasticsearchExporter(server.URL, "magma-logs", 2, 100, time.Hour)
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, requests, 1)
+	lines := strings.Split(strings.TrimSpace(requests[0]), "\n")
+	assert.Equal(t, []string{
+		"POST /_bulk",
+		`{"index":{"_index":"magma-logs-2017.07.14"}}`,
+		`{"@timestamp":"2017-07-14T02:40:00Z","category":"test","normal":{"status":"ACTIVE"}}`,
+		`{"index":{"_index":"magma-logs-2017.07.14"}}`,
+		`{"@timestamp":"2017-07-14T02:40:01Z","category":"test","normal":{"status":"ACTIVE"}}`,
+	}, lines)
+
+	// documents failing to be indexed are dropped
+	response = `{"took":1,"errors":true,"items":[{"index":{"status":201}},{"index":{"status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}}]}`
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, 0, exporter.QueueLen())
+
+	// unavailable clusters are retried at the next export
+	status = http.StatusServiceUnavailable
+	response = `{"error":{"type":"cluster_block_exception","reason":"unavailable"},"status":503}`
+	assert.NoError(t, exporter.Submit(makeLogEntries(2)))
+	assert.Error(t, exporter.Export())
+	assert.Equal(t, 2, exporter.QueueLen())
+}
+
+func TestWebhookExporter(t *testing.T) {
+	var records []*exporters.LogRecord
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var batch []*exporters.LogRecord
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		if status == http.StatusOK {
+			records = append(records, batch...)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	exporter := exporters.NewWebhookExporter(server.URL, "token", 2, 100, time.Hour)
+	assert.NoError(t, exporter.Submit(makeLogEntries(3)))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, records, 3)
+	assert.Equal(t, expectedRecord, records[0])
+
+	status = http.StatusBadGateway
+	assert.NoError(t, exporter.Submit(makeLogEntries(1)))
+	assert.EqualError(t, exporter.Export(), "Failed to export to webhook: Webhook status code 502: ")
+	assert.Equal(t, 1, exporter.QueueLen())
+
+	// rejected entries are dropped
+	exporter = exporters.NewWebhookExporter(server.URL, "wrong token", 2, 100, time.Hour)
+	assert.NoError(t, exporter.Submit(makeLogEntries(1)))
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, 0, exporter.QueueLen())
+}
+
+func readRecords(t *testing.T, path string) []*exporters.LogRecord {
+	file, err := os.Open(path)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer file.Close()
+	var records []*exporters.LogRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := &exporters.LogRecord{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+)
+
+const (
+	WebhookExporterName = "webhook"
+
+	webhookTimeout = time.Second * 30
+)
+
+// WebhookExporter posts each batch of log entries as a JSON array of
+// LogRecords to a URL, with an optional bearer token
+type WebhookExporter struct {
+	*BatchExporter
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewWebhookExporter(
+	url string,
+	token string,
+	batchSize int,
+	queueLen int,
+	exportInterval time.Duration,
+) *WebhookExporter {
+	e := &WebhookExporter{url: url, token: token, client: &http.Client{Timeout: webhookTimeout}}
+	e.BatchExporter = NewBatchExporter(WebhookExporterName, e.write, batchSize, queueLen, exportInterval)
+	return e
+}
+
+func (e *WebhookExporter) write(logEntries []*protos.LogEntry) error {
+	body, err := json.Marshal(ConvertToLogRecords(logEntries))
+	if err != nil {
+		return NewRejectedError(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return NewRejectedError(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.token != "" {
+		req.Header.Set("Authorization", "Bearer "+e.token)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	errMsg, _ := ioutil.ReadAll(resp.Body)
+	err = fmt.Errorf("Webhook status code %d: %s", resp.StatusCode, errMsg)
+	if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusTooManyRequests {
+		return NewRejectedError(err)
+	}
+	return err
+}
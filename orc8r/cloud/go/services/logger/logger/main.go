@@ -10,11 +10,13 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"time"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/logger"
 	"magma/orc8r/cloud/go/services/logger/exporters"
 	"magma/orc8r/cloud/go/services/logger/nghttpxlogger"
@@ -27,8 +29,20 @@ const (
 	SCRIBE_EXPORTER_EXPORT_INTERVAL = time.Second * 60
 	SCRIBE_EXPORTER_QUEUE_LENGTH    = 100000
 	NGHTTPX_LOG_FILE_PATH           = "/var/log/nghttpx.log"
+
+	DEFAULT_EXPORTER_BATCH_SIZE      = 500
+	DEFAULT_EXPORTER_QUEUE_LENGTH    = 100000
+	DEFAULT_EXPORTER_EXPORT_INTERVAL = time.Second * 10
+	DEFAULT_FILE_MAX_SIZE_MB         = 100
+	DEFAULT_FILE_MAX_BACKUPS         = 5
 )
 
+// startableExporter is an exporter which exports asynchronously once started
+type startableExporter interface {
+	exporters.Exporter
+	Start()
+}
+
 var (
 	tailNghttpx = flag.Bool("tailNghttpx", false, "Tail Nghttpx Logs and export")
 )
@@ -54,20 +68,28 @@ func main() {
 		nghttpxLogger.Run(NGHTTPX_LOG_FILE_PATH)
 	}
 
-	scribeExportURL := srv.Config.GetRequiredStringParam("scribe_export_url")
-	scribeAppID := srv.Config.GetRequiredStringParam("scribe_app_id")
-	scribeAppSecret := srv.Config.GetRequiredStringParam("scribe_app_secret")
-
-	// Initialize exporters
-	scribeExporter := exporters.NewScribeExporter(
-		scribeExportURL,
-		scribeAppID,
-		scribeAppSecret,
-		SCRIBE_EXPORTER_QUEUE_LENGTH,
-		SCRIBE_EXPORTER_EXPORT_INTERVAL,
-	)
+	// Initialize exporters. Entries logged to the SCRIBE destination go to
+	// every configured exporter.
+	exporterNames, err := srv.Config.GetStringArrayParam("exporters")
+	if err != nil || len(exporterNames) == 0 {
+		exporterNames = []string{exporters.ScribeExporterName}
+	}
+	var startableExporters []startableExporter
+	var selectedExporters []exporters.Exporter
+	for _, name := range exporterNames {
+		exporter, err := newExporter(name, srv.Config)
+		if err != nil {
+			glog.Fatalf("Error creating %s exporter: %v", name, err)
+		}
+		startableExporters = append(startableExporters, exporter)
+		selectedExporters = append(selectedExporters, exporter)
+	}
 	logExporters := make(map[protos.LoggerDestination]exporters.Exporter)
-	logExporters[protos.LoggerDestination_SCRIBE] = scribeExporter
+	if len(selectedExporters) == 1 {
+		logExporters[protos.LoggerDestination_SCRIBE] = selectedExporters[0]
+	} else {
+		logExporters[protos.LoggerDestination_SCRIBE] = exporters.NewMultiExporter(selectedExporters...)
+	}
 
 	// Add servicers to the service
 	loggingServ, err := servicers.NewLoggingService(logExporters)
@@ -75,7 +97,9 @@ func main() {
 		glog.Fatalf("LoggingService Initialization Error: %s", err)
 	}
 	// start exporting asynchronously
-	scribeExporter.Start()
+	for _, exporter := range startableExporters {
+		exporter.Start()
+	}
 
 	protos.RegisterLoggingServiceServer(srv.GrpcServer, loggingServ)
 	srv.GrpcServer.RegisterService(protos.GetLegacyLoggerDesc(), loggingServ)
@@ -85,3 +109,57 @@ func main() {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+func newExporter(name string, cfg *config.ConfigMap) (startableExporter, error) {
+	batchSize := getIntParam(cfg, "exporter_batch_size", DEFAULT_EXPORTER_BATCH_SIZE)
+	queueLen := getIntParam(cfg, "exporter_queue_length", DEFAULT_EXPORTER_QUEUE_LENGTH)
+	exportInterval := DEFAULT_EXPORTER_EXPORT_INTERVAL
+	if secs, err := cfg.GetIntParam("exporter_export_interval_secs"); err == nil {
+		exportInterval = time.Duration(secs) * time.Second
+	}
+
+	switch name {
+	case exporters.ScribeExporterName:
+		return exporters.NewScribeExporter(
+			cfg.GetRequiredStringParam("scribe_export_url"),
+			cfg.GetRequiredStringParam("scribe_app_id"),
+			cfg.GetRequiredStringParam("scribe_app_secret"),
+			SCRIBE_EXPORTER_QUEUE_LENGTH,
+			SCRIBE_EXPORTER_EXPORT_INTERVAL,
+		), nil
+	case exporters.ElasticsearchExporterName:
+		return exporters.NewElasticsearchExporter(
+			cfg.GetRequiredStringParam("elasticsearch_url"),
+			cfg.GetRequiredStringParam("elasticsearch_index_prefix"),
+			batchSize,
+			queueLen,
+			exportInterval,
+		)
+	case exporters.FileExporterName:
+		return exporters.NewFileExporter(
+			cfg.GetRequiredStringParam("file_path"),
+			int64(getIntParam(cfg, "file_max_size_mb", DEFAULT_FILE_MAX_SIZE_MB))*1024*1024,
+			getIntParam(cfg, "file_max_backups", DEFAULT_FILE_MAX_BACKUPS),
+			batchSize,
+			queueLen,
+			exportInterval,
+		)
+	case exporters.WebhookExporterName:
+		token, _ := cfg.GetStringParam("webhook_token")
+		return exporters.NewWebhookExporter(
+			cfg.GetRequiredStringParam("webhook_url"),
+			token,
+			batchSize,
+			queueLen,
+			exportInterval,
+		), nil
+	}
+	return nil, fmt.Errorf("unknown exporter %s", name)
+}
+
+func getIntParam(cfg *config.ConfigMap, key string, defaultValue int) int {
+	if value, err := cfg.GetIntParam(key); err == nil {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	ExporterLabelName = "exporter"
+	ReasonLabelName   = "reason"
+
+	// DropReasonQueueFull is the reason of entries dropped because the
+	// exporter's queue was full
+	DropReasonQueueFull = "queue_full"
+	// DropReasonRejected is the reason of entries dropped because the sink
+	// rejected them
+	DropReasonRejected = "rejected"
+)
+
+var (
+	exportedEntries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "logger_exported_entries",
+			Help: "Number of log entries written by each exporter",
+		},
+		[]string{ExporterLabelName},
+	)
+	droppedEntries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "logger_dropped_entries",
+			Help: "Number of log entries dropped by each exporter",
+		},
+		[]string{ExporterLabelName, ReasonLabelName},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		exportedEntries,
+		droppedEntries,
+	)
+}
+
+// ReportExportedEntries counts entries written by an exporter
+func ReportExportedEntries(exporter string, count int) {
+	exportedEntries.WithLabelValues(exporter).Add(float64(count))
+}
+
+// ReportDroppedEntries counts entries dropped by an exporter
+func ReportDroppedEntries(exporter string, reason string, count int) {
+	droppedEntries.WithLabelValues(exporter, reason).Add(float64(count))
+}
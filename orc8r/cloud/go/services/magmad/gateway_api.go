@@ -88,3 +88,57 @@ func TailGatewayLogs(networkId string, gatewayId string, service string) (protos
 
 	return stream, nil
 }
+
+// OpenGatewayShell starts a remote shell session on the gateway and returns
+// the stream of its output. The returned function cancels the stream, and
+// must be called once the session is over.
+func OpenGatewayShell(networkId string, gatewayId string, request *protos.ShellRequest) (protos.Magmad_OpenShellClient, context.CancelFunc, error) {
+	client, ctx, err := getGWMagmadClient(networkId, gatewayId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := client.OpenShell(ctx, request)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return stream, cancel, nil
+}
+
+func SendGatewayShellInput(networkId string, gatewayId string, input *protos.ShellInput) error {
+	client, ctx, err := getGWMagmadClient(networkId, gatewayId)
+	if err != nil {
+		return err
+	}
+	_, err = client.SendShellInput(ctx, input)
+	return err
+}
+
+func UploadGatewayFile(networkId string, gatewayId string, request *protos.UploadFileRequest) error {
+	client, ctx, err := getGWMagmadClient(networkId, gatewayId)
+	if err != nil {
+		return err
+	}
+	_, err = client.UploadFile(ctx, request)
+	return err
+}
+
+// DownloadGatewayFile returns the stream of the content of a file on the
+// gateway. The returned function cancels the stream, and must be called once
+// the download is over.
+func DownloadGatewayFile(networkId string, gatewayId string, path string) (protos.Magmad_DownloadFileClient, context.CancelFunc, error) {
+	client, ctx, err := getGWMagmadClient(networkId, gatewayId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := client.DownloadFile(ctx, &protos.DownloadFileRequest{Path: path})
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	return stream, cancel, nil
+}
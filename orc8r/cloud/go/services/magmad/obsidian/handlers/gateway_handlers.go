@@ -121,7 +121,7 @@ func createGateway(c echo.Context) error {
 		Key:        gatewayID,
 		PhysicalID: record.HardwareID,
 	}
-	_, err = configurator.CreateEntity(networkID, gwEntity)
+	_, err = configurator.CreateEntity(c.Request().Context(), networkID, gwEntity)
 	if err != nil {
 		derr := device.DeleteDevice(networkID, orc8r.AccessGatewayRecordType, record.HardwareID)
 		if derr != nil {
@@ -207,7 +207,7 @@ func updateGatewayNameHandler(c echo.Context) error {
 		Type:    orc8r.MagmadGatewayType,
 		NewName: swag.String(string(payload)),
 	}
-	_, err := configurator.UpdateEntities(networkID, []configurator.EntityUpdateCriteria{updateRequest})
+	_, err := configurator.UpdateEntities(c.Request().Context(), networkID, []configurator.EntityUpdateCriteria{updateRequest})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -249,7 +249,7 @@ func deleteGateway(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	err = configurator.DeleteEntity(networkID, orc8r.MagmadGatewayType, gatewayID)
+	err = configurator.DeleteEntity(c.Request().Context(), networkID, orc8r.MagmadGatewayType, gatewayID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
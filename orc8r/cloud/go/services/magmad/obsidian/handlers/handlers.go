@@ -40,6 +40,9 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: GatewayPing, Methods: obsidian.POST, HandlerFunc: gatewayPing},
 		{Path: GatewayGenericCommand, Methods: obsidian.POST, HandlerFunc: gatewayGenericCommand},
 		{Path: TailGatewayLogs, Methods: obsidian.POST, HandlerFunc: tailGatewayLogs},
+		{Path: GatewayShell, Methods: obsidian.GET, HandlerFunc: openGatewayShell},
+		{Path: GatewayFile, Methods: obsidian.GET, HandlerFunc: downloadGatewayFile},
+		{Path: GatewayFile, Methods: obsidian.POST, HandlerFunc: uploadGatewayFile},
 
 		{Path: ConfigureAG, Methods: obsidian.GET, HandlerFunc: getGatewayConfig},
 		cfgObsidian.GetCreateGatewayConfigHandler(ConfigureAG, orc8r.MagmadGatewayType, &models.MagmadGatewayConfigs{}),
@@ -52,5 +55,8 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: GatewayPingV1, Methods: obsidian.POST, HandlerFunc: gatewayPing},
 		{Path: GatewayGenericCommandV1, Methods: obsidian.POST, HandlerFunc: gatewayGenericCommand},
 		{Path: TailGatewayLogsV1, Methods: obsidian.POST, HandlerFunc: tailGatewayLogs},
+		{Path: GatewayShellV1, Methods: obsidian.GET, HandlerFunc: openGatewayShell},
+		{Path: GatewayFileV1, Methods: obsidian.GET, HandlerFunc: downloadGatewayFile},
+		{Path: GatewayFileV1, Methods: obsidian.POST, HandlerFunc: uploadGatewayFile},
 	}
 }
@@ -9,6 +9,7 @@
 package handlers_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -234,7 +235,7 @@ func TestMagmad(t *testing.T) {
 	marshaledCfg, err := expCfg.MarshalBinary()
 	assert.NoError(t, err)
 	expectedCfgStr := string(marshaledCfg)
-	_, err = configurator.CreateEntity(networkId, configurator.NetworkEntity{
+	_, err = configurator.CreateEntity(context.Background(), networkId, configurator.NetworkEntity{
 		Type: orc8r.UpgradeTierEntityType,
 		Key:  "default",
 	})
@@ -78,7 +78,7 @@ func registerNetwork(c echo.Context) error {
 		},
 	}
 
-	err = configurator.CreateNetwork(network)
+	err = configurator.CreateNetwork(c.Request().Context(), network)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
@@ -129,7 +129,7 @@ func updateNetwork(c echo.Context) error {
 			orc8r.NetworkFeaturesConfig: &models.NetworkFeatures{Features: record.Features},
 		},
 	}
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria})
+	err := configurator.UpdateNetworks(c.Request().Context(), []configurator.NetworkUpdateCriteria{updateCriteria})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
@@ -142,7 +142,7 @@ func deleteNetwork(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteNetwork(networkID)
+	err := configurator.DeleteNetwork(c.Request().Context(), networkID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
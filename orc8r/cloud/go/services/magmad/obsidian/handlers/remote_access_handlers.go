@@ -0,0 +1,393 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/magmad"
+
+	"github.com/labstack/echo"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	GatewayShell   = CommandRoot + "/shell"
+	GatewayFile    = CommandRoot + "/file"
+	GatewayShellV1 = CommandRootV1 + "/shell"
+	GatewayFileV1  = CommandRootV1 + "/file"
+
+	ShellCommandQueryParam = "command"
+	ShellRowsQueryParam    = "rows"
+	ShellColsQueryParam    = "cols"
+	FilePathQueryParam     = "path"
+	FileModeQueryParam     = "mode"
+
+	// MaxUploadSize is the maximum size of an uploaded file. Files are sent
+	// to the gateway in a single SyncRPC request, so they must stay well
+	// below the maximum size of a gRPC message.
+	MaxUploadSize = 2 << 20
+
+	defaultFileMode = 0644
+)
+
+// ShellMessage is a message of a remote shell's websocket. Clients send input
+// to the shell and resize its terminal, and receive its output until it
+// exits. A message with an error is sent if the session fails.
+type ShellMessage struct {
+	Data []byte `json:"data,omitempty"`
+	// Resizes the shell's terminal if non-zero
+	Rows uint32 `json:"rows,omitempty"`
+	Cols uint32 `json:"cols,omitempty"`
+
+	Exited   bool   `json:"exited,omitempty"`
+	ExitCode int32  `json:"exit_code,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// openGatewayShell starts a shell on the gateway and connects it to the
+// request's websocket. The whole session is recorded in the audit log.
+func openGatewayShell(c echo.Context) error {
+	networkId, gatewayId, store, herr := getRemoteAccessParams(c)
+	if herr != nil {
+		return herr
+	}
+	if !c.IsWebSocket() {
+		return obsidian.HttpError(errors.New("remote shells require a websocket connection"), http.StatusBadRequest)
+	}
+	// Check the origin before starting a shell, the websocket handshake
+	// checks it again
+	if err := checkShellOrigin(c.Request(), obsidian.RemoteAccessAllowedOrigins); err != nil {
+		return obsidian.HttpError(err, http.StatusForbidden)
+	}
+	request := &protos.ShellRequest{Command: c.QueryParam(ShellCommandQueryParam)}
+	var err error
+	request.Rows, err = getUint32QueryParam(c, ShellRowsQueryParam)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	request.Cols, err = getUint32QueryParam(c, ShellColsQueryParam)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+
+	recorder, err := audit.StartSession(store, audit.Session{
+		Type:      audit.ShellSession,
+		Operator:  c.Request().Header.Get(access.CLIENT_CERT_CN_KEY),
+		NetworkID: networkId,
+		GatewayID: gatewayId,
+		Target:    request.Command,
+	})
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	request.SessionId = recorder.ID()
+	stream, cancel, err := magmad.OpenGatewayShell(networkId, gatewayId, request)
+	if err != nil {
+		recorder.End(err)
+		return remoteAccessError(err)
+	}
+	defer cancel()
+
+	sendInput := func(input *protos.ShellInput) error {
+		return magmad.SendGatewayShellInput(networkId, gatewayId, input)
+	}
+	handshakeDone := false
+	websocket.Server{
+		Handshake: func(config *websocket.Config, req *http.Request) error {
+			handshakeDone = true
+			return checkShellOrigin(req, obsidian.RemoteAccessAllowedOrigins)
+		},
+		Handler: func(ws *websocket.Conn) {
+			err := runShellSession(ws, stream, cancel, recorder, sendInput)
+			recorder.End(err)
+		},
+	}.ServeHTTP(c.Response(), c.Request())
+	if !handshakeDone {
+		recorder.End(errors.New("websocket handshake failed"))
+	}
+	return nil
+}
+
+// checkShellOrigin rejects websockets opened by web pages whose origin isn't
+// explicitly allowed, so that other sites can't open shells with the
+// credentials of an operator's browser. Clients which aren't browsers don't
+// send an Origin header, and are authenticated by their client certificate.
+func checkShellOrigin(req *http.Request, allowedOrigins []string) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	for _, allowedOrigin := range allowedOrigins {
+		if strings.EqualFold(origin, strings.TrimSuffix(allowedOrigin, "/")) {
+			return nil
+		}
+	}
+	return fmt.Errorf("origin %s is not allowed to open remote shells", origin)
+}
+
+// runShellSession forwards the websocket's input to the shell with sendInput
+// and the shell's output to the websocket until the shell exits or the
+// client disconnects
+func runShellSession(
+	ws *websocket.Conn,
+	stream protos.Magmad_OpenShellClient,
+	cancel func(),
+	recorder *audit.SessionRecorder,
+	sendInput func(*protos.ShellInput) error,
+) error {
+	inputDone := make(chan error, 1)
+	go func() {
+		err := forwardShellInput(ws, recorder, sendInput)
+		// The client is gone, so terminate the shell and stop waiting for its
+		// output. The shell may have exited already.
+		_ = sendInput(&protos.ShellInput{SessionId: recorder.ID(), Close: true})
+		inputDone <- err
+		cancel()
+	}()
+
+	for {
+		output, err := stream.Recv()
+		if err == io.EOF {
+			err = errors.New("shell session ended without exit status")
+		}
+		if err != nil {
+			select {
+			case inputErr := <-inputDone:
+				return inputErr
+			default:
+			}
+			_ = websocket.JSON.Send(ws, ShellMessage{Error: err.Error()})
+			return err
+		}
+
+		if len(output.Data) > 0 {
+			recorder.RecordOutput(output.Data)
+			err = websocket.JSON.Send(ws, ShellMessage{Data: output.Data})
+			if err != nil {
+				return err
+			}
+		}
+		if output.Exited {
+			recorder.RecordExit(output.ExitCode)
+			return websocket.JSON.Send(ws, ShellMessage{Exited: true, ExitCode: output.ExitCode})
+		}
+	}
+}
+
+func forwardShellInput(ws *websocket.Conn, recorder *audit.SessionRecorder, sendInput func(*protos.ShellInput) error) error {
+	for {
+		var msg ShellMessage
+		err := websocket.JSON.Receive(ws, &msg)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(msg.Data) == 0 && msg.Rows == 0 && msg.Cols == 0 {
+			continue
+		}
+
+		recorder.RecordInput(msg.Data)
+		input := &protos.ShellInput{SessionId: recorder.ID(), Data: msg.Data, Rows: msg.Rows, Cols: msg.Cols}
+		err = sendInput(input)
+		if err != nil {
+			return fmt.Errorf("error sending input to gateway: %v", err)
+		}
+	}
+}
+
+// uploadGatewayFile writes the request body to a file at an allowed path on
+// the gateway
+func uploadGatewayFile(c echo.Context) error {
+	networkId, gatewayId, store, herr := getRemoteAccessParams(c)
+	if herr != nil {
+		return herr
+	}
+	path, herr := getAllowedFilePath(c)
+	if herr != nil {
+		return herr
+	}
+	mode := uint64(defaultFileMode)
+	if modeParam := c.QueryParam(FileModeQueryParam); modeParam != "" {
+		var err error
+		mode, err = strconv.ParseUint(modeParam, 8, 32)
+		if err != nil || mode > 0777 {
+			return obsidian.HttpError(fmt.Errorf("invalid %s %q: must be octal permission bits", FileModeQueryParam, modeParam), http.StatusBadRequest)
+		}
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(c.Request().Body, MaxUploadSize+1))
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	if len(content) > MaxUploadSize {
+		return obsidian.HttpError(fmt.Errorf("file exceeds the maximum size of %d bytes", MaxUploadSize), http.StatusRequestEntityTooLarge)
+	}
+
+	recorder, err := audit.StartSession(store, audit.Session{
+		Type:      audit.UploadSession,
+		Operator:  c.Request().Header.Get(access.CLIENT_CERT_CN_KEY),
+		NetworkID: networkId,
+		GatewayID: gatewayId,
+		Target:    path,
+	})
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	recorder.RecordInput(content)
+	err = magmad.UploadGatewayFile(networkId, gatewayId, &protos.UploadFileRequest{Path: path, Content: content, Mode: uint32(mode)})
+	recorder.End(err)
+	if err != nil {
+		return remoteAccessError(err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// downloadGatewayFile streams the content of a file at an allowed path on the
+// gateway
+func downloadGatewayFile(c echo.Context) error {
+	networkId, gatewayId, store, herr := getRemoteAccessParams(c)
+	if herr != nil {
+		return herr
+	}
+	path, herr := getAllowedFilePath(c)
+	if herr != nil {
+		return herr
+	}
+
+	recorder, err := audit.StartSession(store, audit.Session{
+		Type:      audit.DownloadSession,
+		Operator:  c.Request().Header.Get(access.CLIENT_CERT_CN_KEY),
+		NetworkID: networkId,
+		GatewayID: gatewayId,
+		Target:    path,
+	})
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	stream, cancel, err := magmad.DownloadGatewayFile(networkId, gatewayId, path)
+	if err != nil {
+		recorder.End(err)
+		return remoteAccessError(err)
+	}
+	defer cancel()
+
+	// Wait for the first chunk before writing the response header, so that
+	// errors such as a missing file get the right status
+	chunk, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		recorder.End(err)
+		return remoteAccessError(err)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filepath.Base(path)))
+	c.Response().WriteHeader(http.StatusOK)
+	for err == nil {
+		recorder.RecordOutput(chunk.Content)
+		if _, err = c.Response().Write(chunk.Content); err != nil {
+			break
+		}
+		c.Response().Flush()
+		chunk, err = stream.Recv()
+	}
+	if err == io.EOF {
+		err = nil
+	}
+	recorder.End(err)
+	return nil
+}
+
+// getRemoteAccessParams returns the network and gateway of a remote access
+// request, and the audit store to record the session in. Remote access is
+// unavailable if the audit log is disabled.
+func getRemoteAccessParams(c echo.Context) (string, string, audit.Store, *echo.HTTPError) {
+	networkId, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return "", "", nil, nerr
+	}
+	gatewayId, gerr := getLegacyOrV1GatewayID(c)
+	if gerr != nil {
+		return "", "", nil, gerr
+	}
+	store := audit.GetStore(c)
+	if store == nil {
+		return "", "", nil, obsidian.HttpError(errors.New("remote access requires the audit log to be enabled"), http.StatusServiceUnavailable)
+	}
+	return networkId, gatewayId, store, nil
+}
+
+func getAllowedFilePath(c echo.Context) (string, *echo.HTTPError) {
+	path := c.QueryParam(FilePathQueryParam)
+	if path == "" {
+		return "", obsidian.HttpError(fmt.Errorf("%s is required", FilePathQueryParam), http.StatusBadRequest)
+	}
+	if !isAllowedPath(path, obsidian.RemoteAccessAllowedPaths) {
+		return "", obsidian.HttpError(fmt.Errorf("path %s is not allowed", path), http.StatusForbidden)
+	}
+	return path, nil
+}
+
+// isAllowedPath returns true if path is a clean absolute path inside one of
+// the allowed directories
+func isAllowedPath(path string, allowedPaths []string) bool {
+	if !filepath.IsAbs(path) || filepath.Clean(path) != path {
+		return false
+	}
+	for _, allowedPath := range allowedPaths {
+		dir := strings.TrimSuffix(filepath.Clean(allowedPath), "/") + "/"
+		if strings.HasPrefix(path, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+func getUint32QueryParam(c echo.Context, name string) (uint32, error) {
+	param := c.QueryParam(name)
+	if param == "" {
+		return 0, nil
+	}
+	val, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %v", name, param, err)
+	}
+	return uint32(val), nil
+}
+
+// remoteAccessError converts an error from the gateway to an HTTP error
+func remoteAccessError(err error) error {
+	if datastore.IsErrNotFound(err) {
+		return obsidian.HttpError(err, http.StatusNotFound)
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return obsidian.HttpError(err, http.StatusNotFound)
+	case codes.PermissionDenied, codes.FailedPrecondition:
+		return obsidian.HttpError(err, http.StatusForbidden)
+	case codes.InvalidArgument:
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	default:
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/sqorc"
+
+	"github.com/labstack/echo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsAllowedPath(t *testing.T) {
+	allowedPaths := []string{"/var/log", "/tmp/magma/"}
+	assert.True(t, isAllowedPath("/var/log/syslog", allowedPaths))
+	assert.True(t, isAllowedPath("/var/log/magma/mme.log", allowedPaths))
+	assert.True(t, isAllowedPath("/tmp/magma/upload", allowedPaths))
+
+	assert.False(t, isAllowedPath("/var/log", allowedPaths))
+	assert.False(t, isAllowedPath("/var/logs/syslog", allowedPaths))
+	assert.False(t, isAllowedPath("/var/log/../../etc/passwd", allowedPaths))
+	assert.False(t, isAllowedPath("/var/log//syslog", allowedPaths))
+	assert.False(t, isAllowedPath("var/log/syslog", allowedPaths))
+	assert.False(t, isAllowedPath("/etc/passwd", allowedPaths))
+	assert.False(t, isAllowedPath("/var/log/syslog", nil))
+}
+
+func TestRemoteAccessRequestValidation(t *testing.T) {
+	obsidian.RemoteAccessAllowedPaths = []string{"/tmp/magma"}
+	defer func() { obsidian.RemoteAccessAllowedPaths = nil }()
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+
+	// Remote access is unavailable without the audit log
+	err = uploadGatewayFile(newRemoteAccessContext(http.MethodPost, "/?path=/tmp/magma/foo", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, err.(*echo.HTTPError).Code)
+
+	err = uploadGatewayFile(newRemoteAccessContext(http.MethodPost, "/?path=/etc/passwd", store))
+	assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	err = downloadGatewayFile(newRemoteAccessContext(http.MethodGet, "/?path=/tmp/magma/../../etc/passwd", store))
+	assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	err = downloadGatewayFile(newRemoteAccessContext(http.MethodGet, "/", store))
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	err = uploadGatewayFile(newRemoteAccessContext(http.MethodPost, "/?path=/tmp/magma/foo&mode=999", store))
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+
+	err = openGatewayShell(newRemoteAccessContext(http.MethodGet, "/", store))
+	assert.EqualError(t, err, "code=400, message=remote shells require a websocket connection")
+
+	// Nothing reached the gateway, so no sessions were recorded
+	sessions, err := store.QuerySessions(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestCheckShellOrigin(t *testing.T) {
+	allowedOrigins := []string{"https://nms.example.com/"}
+	newRequest := func(origin string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		return req
+	}
+	assert.NoError(t, checkShellOrigin(newRequest(""), nil))
+	assert.NoError(t, checkShellOrigin(newRequest("https://nms.example.com"), allowedOrigins))
+	assert.NoError(t, checkShellOrigin(newRequest("https://NMS.example.com"), allowedOrigins))
+	assert.EqualError(t, checkShellOrigin(newRequest("https://evil.example.com"), allowedOrigins), "origin https://evil.example.com is not allowed to open remote shells")
+	assert.EqualError(t, checkShellOrigin(newRequest("http://nms.example.com"), allowedOrigins), "origin http://nms.example.com is not allowed to open remote shells")
+	assert.Error(t, checkShellOrigin(newRequest("https://nms.example.com"), nil))
+
+	// Disallowed origins are rejected before a shell is started
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+	c := newRemoteAccessContext(http.MethodGet, "/", store)
+	c.Request().Header.Set("Connection", "Upgrade")
+	c.Request().Header.Set(echo.HeaderUpgrade, "websocket")
+	c.Request().Header.Set("Origin", "https://evil.example.com")
+	err = openGatewayShell(c)
+	assert.Equal(t, http.StatusForbidden, err.(*echo.HTTPError).Code)
+	sessions, err := store.QuerySessions(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestRunShellSession(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store := audit.NewSQLStore(db, sqorc.GetSqlBuilder())
+	assert.NoError(t, store.Initialize())
+
+	// The shell echoes its input, and exits on "exit"
+	runSession := func(t *testing.T, client func(ws *websocket.Conn)) (audit.Session, []*protos.ShellInput) {
+		recorder, err := audit.StartSession(store, audit.Session{Type: audit.ShellSession, NetworkID: "n1", GatewayID: "g1"})
+		assert.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &fakeShellStream{ctx: ctx, outputs: make(chan *protos.ShellOutput, 10)}
+		var inputs []*protos.ShellInput
+		var inputsLock sync.Mutex
+		sendInput := func(input *protos.ShellInput) error {
+			inputsLock.Lock()
+			defer inputsLock.Unlock()
+			assert.Equal(t, recorder.ID(), input.SessionId)
+			inputs = append(inputs, input)
+			if string(input.Data) == "exit" {
+				stream.outputs <- &protos.ShellOutput{Exited: true, ExitCode: 3}
+			} else if len(input.Data) > 0 {
+				stream.outputs <- &protos.ShellOutput{Data: input.Data}
+			}
+			return nil
+		}
+
+		done := make(chan struct{})
+		srv := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+			recorder.End(runShellSession(ws, stream, cancel, recorder, sendInput))
+			close(done)
+		}))
+		defer srv.Close()
+		ws, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), "", "http://localhost/")
+		assert.NoError(t, err)
+		client(ws)
+
+		// Wait for the session to end and the shell to be closed
+		<-done
+		<-ctx.Done()
+		session, err := store.GetSession(recorder.ID())
+		assert.NoError(t, err)
+		inputsLock.Lock()
+		defer inputsLock.Unlock()
+		return session, inputs
+	}
+
+	session, inputs := runSession(t, func(ws *websocket.Conn) {
+		assert.NoError(t, websocket.JSON.Send(ws, ShellMessage{Rows: 24, Cols: 80}))
+		assert.NoError(t, websocket.JSON.Send(ws, ShellMessage{Data: []byte("ls")}))
+		var msg ShellMessage
+		assert.NoError(t, websocket.JSON.Receive(ws, &msg))
+		assert.Equal(t, ShellMessage{Data: []byte("ls")}, msg)
+		assert.NoError(t, websocket.JSON.Send(ws, ShellMessage{Data: []byte("exit")}))
+		var exitMsg ShellMessage
+		assert.NoError(t, websocket.JSON.Receive(ws, &exitMsg))
+		assert.Equal(t, ShellMessage{Exited: true, ExitCode: 3}, exitMsg)
+		assert.NoError(t, ws.Close())
+	})
+	assert.Equal(t, []byte("lsexit"), session.Input)
+	assert.Equal(t, []byte("ls"), session.Output)
+	assert.Equal(t, int32(3), *session.ExitCode)
+	assert.Empty(t, session.Error)
+	assert.NotNil(t, session.EndTime)
+	assert.Equal(t, &protos.ShellInput{SessionId: session.ID, Rows: 24, Cols: 80}, inputs[0])
+	// The shell is closed once the client disconnects
+	assert.True(t, inputs[len(inputs)-1].Close)
+
+	// The client disconnects before the shell exits
+	session, inputs = runSession(t, func(ws *websocket.Conn) {
+		assert.NoError(t, websocket.JSON.Send(ws, ShellMessage{Data: []byte("top")}))
+		var msg ShellMessage
+		assert.NoError(t, websocket.JSON.Receive(ws, &msg))
+		assert.NoError(t, ws.Close())
+	})
+	assert.Equal(t, []byte("top"), session.Output)
+	assert.Nil(t, session.ExitCode)
+	assert.Empty(t, session.Error)
+	assert.NotNil(t, session.EndTime)
+	assert.Equal(t, &protos.ShellInput{SessionId: session.ID, Close: true}, inputs[len(inputs)-1])
+}
+
+// fakeShellStream returns the outputs of a shell until its context is
+// cancelled
+type fakeShellStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	outputs chan *protos.ShellOutput
+}
+
+func (s *fakeShellStream) Recv() (*protos.ShellOutput, error) {
+	select {
+	case output, ok := <-s.outputs:
+		if !ok {
+			return nil, io.EOF
+		}
+		return output, nil
+	case <-s.ctx.Done():
+		return nil, status.Error(codes.Canceled, s.ctx.Err().Error())
+	}
+}
+
+func newRemoteAccessContext(method string, target string, store audit.Store) echo.Context {
+	req := httptest.NewRequest(method, target, strings.NewReader("content"))
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.SetParamNames("network_id", "gateway_id")
+	c.SetParamValues("n1", "g1")
+	if store != nil {
+		// Set the store the same way the audit middleware does
+		_ = audit.Middleware(store, audit.DefaultMaxBodySize)(func(echo.Context) error { return nil })(c)
+	}
+	return c
+}
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package view_factory_test
 
 import (
+	"context"
 	"testing"
 
 	"magma/orc8r/cloud/go/orc8r"
@@ -76,7 +77,7 @@ func TestFullGatewayViewFactoryImpl_GetGatewayViewsForNetwork(t *testing.T) {
 		Config: cfg1,
 	}
 
-	_, err = configurator.CreateEntities(networkID, []configurator.NetworkEntity{gw1config1, gw1config2, gw2config1})
+	_, err = configurator.CreateEntities(context.Background(), networkID, []configurator.NetworkEntity{gw1config1, gw1config2, gw2config1})
 	assert.NoError(t, err)
 
 	// add config associations to gateways
@@ -95,7 +96,7 @@ func TestFullGatewayViewFactoryImpl_GetGatewayViewsForNetwork(t *testing.T) {
 			{Type: gw2config1.Type, Key: gatewayID2}},
 	}
 
-	_, err = configurator.UpdateEntities(networkID, []configurator.EntityUpdateCriteria{updateGW1, updateGW2})
+	_, err = configurator.UpdateEntities(context.Background(), networkID, []configurator.EntityUpdateCriteria{updateGW1, updateGW2})
 	assert.NoError(t, err)
 
 	// put status into gw1
@@ -140,7 +141,7 @@ func TestFullGatewayViewFactoryImpl_GetGatewayViewsForNetwork(t *testing.T) {
 	assert.Equal(t, expected, actual)
 
 	// add an unrelated entity to gw1 and make sure only the config entities are loaded
-	nonConfigEntity, err := configurator.CreateEntity(networkID, configurator.NetworkEntity{
+	nonConfigEntity, err := configurator.CreateEntity(context.Background(), networkID, configurator.NetworkEntity{
 		Key:    "random_entity",
 		Type:   storagetu.NewConfig1Manager().GetType(),
 		Config: cfg1,
@@ -150,7 +151,7 @@ func TestFullGatewayViewFactoryImpl_GetGatewayViewsForNetwork(t *testing.T) {
 	// add association from gw1 -> nonConfigEntity
 	updateGW1.AssociationsToAdd = []storage.TypeAndKey{{Type: nonConfigEntity.Type, Key: nonConfigEntity.Key}}
 	updateGW1.AssociationsToSet = nil
-	_, err = configurator.UpdateEntities(networkID, []configurator.EntityUpdateCriteria{updateGW1})
+	_, err = configurator.UpdateEntities(context.Background(), networkID, []configurator.EntityUpdateCriteria{updateGW1})
 	assert.NoError(t, err)
 
 	actual, err = fact.GetGatewayViewsForNetwork(networkID)
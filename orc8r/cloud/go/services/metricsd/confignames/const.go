@@ -14,6 +14,13 @@ const (
 	PrometheusPushAddresses = "prometheusPushAddresses"
 	PrometheusQueryAddress  = "prometheusQueryAddress"
 
+	PrometheusRemoteWriteURL   = "prometheusRemoteWriteURL"
+	OTLPMetricsURL             = "otlpMetricsURL"
+	ExporterBatchSize          = "exporterBatchSize"
+	ExporterFlushIntervalSecs  = "exporterFlushIntervalSecs"
+	ExporterMaxBufferedMetrics = "exporterMaxBufferedMetrics"
+	ExporterMaxRetries         = "exporterMaxRetries"
+
 	PrometheusConfigServiceURL   = "prometheusConfigServiceURL"
 	AlertmanagerConfigServiceURL = "alertmanagerConfigServiceURL"
 	AlertmanagerApiURL           = "alertmanagerApiURL"
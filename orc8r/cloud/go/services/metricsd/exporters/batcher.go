@@ -0,0 +1,267 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	DefaultBatchSize      = 1000
+	DefaultFlushInterval  = time.Second * 15
+	DefaultMaxBuffered    = 100000
+	DefaultMaxRetries     = 5
+	DefaultInitialBackoff = time.Second
+	DefaultMaxBackoff     = time.Second * 30
+)
+
+// BatchConfig configures how an exporter writing to a remote endpoint
+// batches, retries and buffers metrics. Sizes count individual metrics, not
+// families.
+type BatchConfig struct {
+	// BatchSize is the maximum number of metrics in one write
+	BatchSize int
+	// FlushInterval is how often buffered metrics are written when fewer than
+	// BatchSize are buffered
+	FlushInterval time.Duration
+	// MaxBuffered bounds the in-memory buffer. When it is full the oldest
+	// metrics are dropped.
+	MaxBuffered int
+	// MaxRetries is the number of times a failed write is retried before its
+	// metrics are returned to the buffer
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry, doubled on each
+	// following retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultBatchConfig returns a BatchConfig with the default values
+func DefaultBatchConfig() BatchConfig {
+	return BatchConfig{
+		BatchSize:      DefaultBatchSize,
+		FlushInterval:  DefaultFlushInterval,
+		MaxBuffered:    DefaultMaxBuffered,
+		MaxRetries:     DefaultMaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+	}
+}
+
+// BatchWriter writes a batch of metrics to a remote endpoint. Errors wrapped
+// with NewPermanentError are not retried.
+type BatchWriter func(batch []MetricAndContext) error
+
+type permanentError struct {
+	error
+}
+
+// NewPermanentError wraps an error that retrying the write will not fix, for
+// instance a rejected request
+func NewPermanentError(err error) error {
+	return permanentError{err}
+}
+
+// IsPermanentError returns true if err was created with NewPermanentError
+func IsPermanentError(err error) bool {
+	_, ok := err.(permanentError)
+	return ok
+}
+
+// Batcher buffers submitted metrics and writes them in batches with a
+// BatchWriter, retrying failed writes with exponential backoff
+type Batcher struct {
+	name   string
+	config BatchConfig
+	write  BatchWriter
+
+	sync.Mutex
+	// buffer holds one metric per entry so batches can be cut at any metric
+	buffer  []MetricAndContext
+	dropped int
+	full    chan struct{}
+	// flushLock serializes flushes
+	flushLock sync.Mutex
+}
+
+// NewBatcher creates a Batcher writing with write. Unset config values take
+// their default. name identifies the exporter in logs.
+func NewBatcher(name string, config BatchConfig, write BatchWriter) *Batcher {
+	defaults := DefaultBatchConfig()
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaults.FlushInterval
+	}
+	if config.MaxBuffered <= 0 {
+		config.MaxBuffered = defaults.MaxBuffered
+	}
+	if config.MaxBuffered < config.BatchSize {
+		config.MaxBuffered = config.BatchSize
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	return &Batcher{
+		name:   name,
+		config: config,
+		write:  write,
+		full:   make(chan struct{}, 1),
+	}
+}
+
+// Add buffers metrics to be written with the next batch. Metrics without a
+// timestamp are stamped with the current time. This method is thread-safe.
+func (b *Batcher) Add(metrics []MetricAndContext) {
+	nowMs := time.Now().Unix() * 1000
+	b.Lock()
+	defer b.Unlock()
+	for _, metricAndContext := range metrics {
+		family := metricAndContext.Family
+		for _, metric := range family.Metric {
+			if metric.TimestampMs == nil || *metric.TimestampMs == 0 {
+				timestampMs := nowMs
+				metric.TimestampMs = &timestampMs
+			}
+			b.buffer = append(b.buffer, MetricAndContext{
+				Family: &dto.MetricFamily{
+					Name:   family.Name,
+					Help:   family.Help,
+					Type:   family.Type,
+					Metric: []*dto.Metric{metric},
+				},
+				Context: metricAndContext.Context,
+			})
+		}
+	}
+	b.dropOldestUnsafe()
+	if len(b.buffer) >= b.config.BatchSize {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Len returns the number of buffered metrics
+func (b *Batcher) Len() int {
+	b.Lock()
+	defer b.Unlock()
+	return len(b.buffer)
+}
+
+// Start writes buffered metrics every flush interval, or as soon as a full
+// batch is buffered
+func (b *Batcher) Start() {
+	go b.flushEvery()
+}
+
+func (b *Batcher) flushEvery() {
+	ticker := time.NewTicker(b.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-b.full:
+		}
+		if err := b.Flush(); err != nil {
+			glog.Errorf("error in writing metrics to %s: %v", b.name, err)
+		}
+	}
+}
+
+// Flush writes all buffered metrics in batches. A batch whose write still
+// fails after all retries is returned to the buffer and flushing stops.
+func (b *Batcher) Flush() error {
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+	for {
+		batch := b.takeBatch()
+		if len(batch) == 0 {
+			return nil
+		}
+		err := b.writeWithRetries(batch)
+		if err == nil {
+			continue
+		}
+		if IsPermanentError(err) {
+			glog.Errorf("Dropping %d metrics rejected by %s: %v", len(batch), b.name, err)
+			continue
+		}
+		b.requeue(batch)
+		return err
+	}
+}
+
+func (b *Batcher) takeBatch() []MetricAndContext {
+	b.Lock()
+	defer b.Unlock()
+	size := b.config.BatchSize
+	if size > len(b.buffer) {
+		size = len(b.buffer)
+	}
+	batch := b.buffer[:size:size]
+	b.buffer = b.buffer[size:]
+	b.logDroppedUnsafe()
+	return batch
+}
+
+// requeue puts a failed batch back at the front of the buffer, which may then
+// drop its oldest metrics
+func (b *Batcher) requeue(batch []MetricAndContext) {
+	b.Lock()
+	defer b.Unlock()
+	b.buffer = append(batch, b.buffer...)
+	b.dropOldestUnsafe()
+}
+
+func (b *Batcher) dropOldestUnsafe() {
+	if overflow := len(b.buffer) - b.config.MaxBuffered; overflow > 0 {
+		b.buffer = b.buffer[overflow:]
+		b.dropped += overflow
+	}
+}
+
+func (b *Batcher) logDroppedUnsafe() {
+	if b.dropped > 0 {
+		glog.Errorf("Dropped %d metrics for %s because the buffer of %d metrics was full", b.dropped, b.name, b.config.MaxBuffered)
+		b.dropped = 0
+	}
+}
+
+func (b *Batcher) writeWithRetries(batch []MetricAndContext) error {
+	backoff := b.config.InitialBackoff
+	var err error
+	for attempt := 0; attempt <= b.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > b.config.MaxBackoff {
+				backoff = b.config.MaxBackoff
+			}
+		}
+		err = b.write(batch)
+		if err == nil || IsPermanentError(err) {
+			return err
+		}
+	}
+	return fmt.Errorf("giving up after %d retries: %v", b.config.MaxRetries, err)
+}
@@ -0,0 +1,174 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testWriter struct {
+	sync.Mutex
+	batches [][]MetricAndContext
+	errs    []error
+}
+
+func (w *testWriter) write(batch []MetricAndContext) error {
+	w.Lock()
+	defer w.Unlock()
+	var err error
+	if len(w.errs) > 0 {
+		err, w.errs = w.errs[0], w.errs[1:]
+	}
+	if err == nil {
+		w.batches = append(w.batches, batch)
+	}
+	return err
+}
+
+func (w *testWriter) batchSizes() []int {
+	w.Lock()
+	defer w.Unlock()
+	sizes := []int{}
+	for _, batch := range w.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func TestBatcher_Flush(t *testing.T) {
+	writer := &testWriter{}
+	batcher := NewBatcher("test", testBatchConfig(), writer.write)
+	batcher.Add(makeTestMetrics("a", 3))
+	batcher.Add(makeTestMetrics("b", 4))
+	assert.Equal(t, 7, batcher.Len())
+
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, []int{5, 2}, writer.batchSizes())
+	assert.Equal(t, 0, batcher.Len())
+	// each buffered metric keeps its family and context, and is timestamped
+	for _, metricAndContext := range writer.batches[0] {
+		assert.Equal(t, dto.MetricType_GAUGE, metricAndContext.Family.GetType())
+		assert.Len(t, metricAndContext.Family.Metric, 1)
+		assert.NotZero(t, metricAndContext.Family.Metric[0].GetTimestampMs())
+	}
+	assert.Equal(t, "a", writer.batches[0][0].Context.MetricName)
+	assert.Equal(t, "b", writer.batches[0][4].Context.MetricName)
+
+	// nothing to write
+	assert.NoError(t, batcher.Flush())
+	assert.Len(t, writer.batches, 2)
+}
+
+func TestBatcher_Retry(t *testing.T) {
+	writer := &testWriter{errs: []error{errors.New("unavailable"), errors.New("unavailable")}}
+	batcher := NewBatcher("test", testBatchConfig(), writer.write)
+	batcher.Add(makeTestMetrics("a", 3))
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, []int{3}, writer.batchSizes())
+
+	// metrics are returned to the buffer once retries are exhausted
+	writer.errs = []error{errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable")}
+	batcher.Add(makeTestMetrics("b", 3))
+	assert.EqualError(t, batcher.Flush(), "giving up after 3 retries: unavailable")
+	assert.Equal(t, 3, batcher.Len())
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, []int{3, 3}, writer.batchSizes())
+	assert.Equal(t, "b", writer.batches[1][0].Context.MetricName)
+
+	// permanent errors drop the batch without retrying
+	writer.errs = []error{NewPermanentError(errors.New("bad request"))}
+	batcher.Add(makeTestMetrics("c", 7))
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, []int{3, 3, 2}, writer.batchSizes())
+	assert.Equal(t, 0, batcher.Len())
+}
+
+func TestBatcher_BoundedBuffer(t *testing.T) {
+	writer := &testWriter{}
+	batcher := NewBatcher("test", testBatchConfig(), writer.write)
+	batcher.Add(makeTestMetrics("a", 8))
+	batcher.Add(makeTestMetrics("b", 4))
+	assert.Equal(t, 10, batcher.Len())
+
+	// the oldest metrics are dropped
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, []int{5, 5}, writer.batchSizes())
+	assert.Equal(t, "a", writer.batches[0][0].Context.MetricName)
+	assert.Equal(t, "b", writer.batches[1][4].Context.MetricName)
+
+	// a requeued batch is dropped before newer metrics
+	writer.errs = []error{errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable"), errors.New("unavailable")}
+	batcher.Add(makeTestMetrics("c", 8))
+	assert.Error(t, batcher.Flush())
+	batcher.Add(makeTestMetrics("d", 4))
+	assert.Equal(t, 10, batcher.Len())
+	assert.NoError(t, batcher.Flush())
+	assert.Equal(t, "c", writer.batches[2][0].Context.MetricName)
+	assert.Equal(t, "d", writer.batches[3][1].Context.MetricName)
+}
+
+func TestBatcher_Start(t *testing.T) {
+	writer := &testWriter{}
+	config := testBatchConfig()
+	config.FlushInterval = time.Hour
+	batcher := NewBatcher("test", config, writer.write)
+	batcher.Start()
+
+	// buffered metrics are written once a full batch is buffered, before the
+	// flush interval
+	batcher.Add(makeTestMetrics("a", 4))
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []int{}, writer.batchSizes())
+	batcher.Add(makeTestMetrics("b", 2))
+	assert.Eventually(t, func() bool { return batcher.Len() == 0 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []int{5, 1}, writer.batchSizes())
+}
+
+func TestNewBatcher_Defaults(t *testing.T) {
+	batcher := NewBatcher("test", BatchConfig{BatchSize: 10, MaxBuffered: 5}, nil)
+	assert.Equal(t, BatchConfig{
+		BatchSize:      10,
+		FlushInterval:  DefaultFlushInterval,
+		MaxBuffered:    10,
+		MaxRetries:     0,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultInitialBackoff,
+	}, batcher.config)
+}
+
+func testBatchConfig() BatchConfig {
+	return BatchConfig{
+		BatchSize:      5,
+		FlushInterval:  time.Second,
+		MaxBuffered:    10,
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     2 * time.Millisecond,
+	}
+}
+
+func makeTestMetrics(name string, count int) []MetricAndContext {
+	family := tests.MakeTestMetricFamily(dto.MetricType_GAUGE, 0, nil)
+	for i := 0; i < count; i++ {
+		metric := tests.MakePromoGauge(float64(i))
+		family.Metric = append(family.Metric, &metric)
+	}
+	return []MetricAndContext{{
+		Family:  family,
+		Context: MetricsContext{MetricName: name, AdditionalContext: &CloudMetricContext{CloudHost: "host"}},
+	}}
+}
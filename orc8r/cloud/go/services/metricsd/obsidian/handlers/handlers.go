@@ -74,6 +74,15 @@ func GetObsidianHandlers(configMap *config.ConfigMap) []obsidian.Handler {
 
 		obsidian.Handler{Path: promH.AlertReceiverConfigURL + "/route", Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveAlertRouteHandler(alertmanagerConfigServiceURL)},
 		obsidian.Handler{Path: promH.AlertReceiverConfigURL + "/route", Methods: obsidian.POST, HandlerFunc: promH.GetUpdateAlertRouteHandler(alertmanagerConfigServiceURL)},
+
+		obsidian.Handler{Path: promH.AlertInhibitRuleURL, Methods: obsidian.POST, HandlerFunc: promH.GetConfigureInhibitRuleHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleURL, Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveInhibitRulesHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleURL, Methods: obsidian.DELETE, HandlerFunc: promH.GetDeleteInhibitRuleHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleUpdateURL, Methods: obsidian.PUT, HandlerFunc: promH.GetUpdateInhibitRuleHandler(alertmanagerConfigServiceURL)},
+
+		obsidian.Handler{Path: promH.AlertSilenceURL, Methods: obsidian.POST, HandlerFunc: promH.GetCreateSilenceHandler(alertmanagerURL)},
+		obsidian.Handler{Path: promH.AlertSilenceURL, Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveSilencesHandler(alertmanagerURL)},
+		obsidian.Handler{Path: promH.AlertSilenceURL, Methods: obsidian.DELETE, HandlerFunc: promH.GetExpireSilenceHandler(alertmanagerURL)},
 	)
 
 	// V1
@@ -93,6 +102,15 @@ func GetObsidianHandlers(configMap *config.ConfigMap) []obsidian.Handler {
 		obsidian.Handler{Path: promH.AlertReceiverConfigV1URL + "/route", Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveAlertRouteHandler(alertmanagerConfigServiceURL)},
 		obsidian.Handler{Path: promH.AlertReceiverConfigV1URL + "/route", Methods: obsidian.POST, HandlerFunc: promH.GetUpdateAlertRouteHandler(alertmanagerConfigServiceURL)},
 
+		obsidian.Handler{Path: promH.AlertInhibitRuleV1URL, Methods: obsidian.POST, HandlerFunc: promH.GetConfigureInhibitRuleHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleV1URL, Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveInhibitRulesHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleV1URL, Methods: obsidian.DELETE, HandlerFunc: promH.GetDeleteInhibitRuleHandler(alertmanagerConfigServiceURL)},
+		obsidian.Handler{Path: promH.AlertInhibitRuleUpdateV1URL, Methods: obsidian.PUT, HandlerFunc: promH.GetUpdateInhibitRuleHandler(alertmanagerConfigServiceURL)},
+
+		obsidian.Handler{Path: promH.AlertSilenceV1URL, Methods: obsidian.POST, HandlerFunc: promH.GetCreateSilenceHandler(alertmanagerURL)},
+		obsidian.Handler{Path: promH.AlertSilenceV1URL, Methods: obsidian.GET, HandlerFunc: promH.GetRetrieveSilencesHandler(alertmanagerURL)},
+		obsidian.Handler{Path: promH.AlertSilenceV1URL, Methods: obsidian.DELETE, HandlerFunc: promH.GetExpireSilenceHandler(alertmanagerURL)},
+
 		obsidian.Handler{Path: MetricsV1Root + "/push", Methods: obsidian.POST, HandlerFunc: pushHandler},
 	)
 
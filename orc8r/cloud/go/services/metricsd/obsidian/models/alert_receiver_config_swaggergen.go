@@ -22,10 +22,19 @@ type AlertReceiverConfig struct {
 	// email configs
 	EmailConfigs []*EmailReceiver `json:"email_configs"`
 
+	// msteams configs
+	MsteamsConfigs []*MsteamsReceiver `json:"msteams_configs"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// opsgenie configs
+	OpsgenieConfigs []*OpsgenieReceiver `json:"opsgenie_configs"`
+
+	// pagerduty configs
+	PagerdutyConfigs []*PagerdutyReceiver `json:"pagerduty_configs"`
+
 	// slack configs
 	SLACKConfigs []*SLACKReceiver `json:"slack_configs"`
 
@@ -41,10 +50,22 @@ func (m *AlertReceiverConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMsteamsConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateOpsgenieConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePagerdutyConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSLACKConfigs(formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +105,31 @@ func (m *AlertReceiverConfig) validateEmailConfigs(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *AlertReceiverConfig) validateMsteamsConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MsteamsConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.MsteamsConfigs); i++ {
+		if swag.IsZero(m.MsteamsConfigs[i]) { // not required
+			continue
+		}
+
+		if m.MsteamsConfigs[i] != nil {
+			if err := m.MsteamsConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("msteams_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AlertReceiverConfig) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -93,6 +139,56 @@ func (m *AlertReceiverConfig) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AlertReceiverConfig) validateOpsgenieConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OpsgenieConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OpsgenieConfigs); i++ {
+		if swag.IsZero(m.OpsgenieConfigs[i]) { // not required
+			continue
+		}
+
+		if m.OpsgenieConfigs[i] != nil {
+			if err := m.OpsgenieConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("opsgenie_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AlertReceiverConfig) validatePagerdutyConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PagerdutyConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PagerdutyConfigs); i++ {
+		if swag.IsZero(m.PagerdutyConfigs[i]) { // not required
+			continue
+		}
+
+		if m.PagerdutyConfigs[i] != nil {
+			if err := m.PagerdutyConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pagerduty_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AlertReceiverConfig) validateSLACKConfigs(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.SLACKConfigs) { // not required
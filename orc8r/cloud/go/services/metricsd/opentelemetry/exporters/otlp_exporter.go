@@ -0,0 +1,284 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/glog"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	otlpTimeout = time.Second * 30
+	scopeName   = "magma/orc8r/metricsd"
+
+	serviceNameAttribute = "service.name"
+	hostNameAttribute    = "host.name"
+	networkIDAttribute   = "magma.network_id"
+	gatewayIDAttribute   = "magma.gateway_id"
+	serviceName          = "magma"
+)
+
+// OTLPExporter writes metrics to an OpenTelemetry collector, or any other
+// OTLP/HTTP metrics receiver. Metrics keep their type: counters are exported
+// as cumulative monotonic sums, histograms and summaries natively, and
+// gauges and untyped metrics as gauges. Each metric's source (cloud host,
+// gateway or network) is exported as its resource.
+type OTLPExporter struct {
+	url     string
+	client  *http.Client
+	batcher *mxd_exp.Batcher
+}
+
+// NewOTLPExporter creates a new exporter to the OTLP/HTTP metrics endpoint
+// at url, e.g. http://otel-collector:4318/v1/metrics
+func NewOTLPExporter(url string, config mxd_exp.BatchConfig) mxd_exp.Exporter {
+	if !strings.HasPrefix(url, "http") {
+		url = fmt.Sprintf("http://%s", url)
+	}
+	exporter := &OTLPExporter{
+		url:    url,
+		client: &http.Client{Timeout: otlpTimeout},
+	}
+	exporter.batcher = mxd_exp.NewBatcher("OTLP endpoint "+url, config, exporter.write)
+	return exporter
+}
+
+// Submit buffers metrics to be written with the next batch
+func (e *OTLPExporter) Submit(metrics []mxd_exp.MetricAndContext) error {
+	e.batcher.Add(metrics)
+	return nil
+}
+
+// Start continuously writes batches of buffered metrics
+func (e *OTLPExporter) Start() {
+	e.batcher.Start()
+}
+
+func (e *OTLPExporter) write(batch []mxd_exp.MetricAndContext) error {
+	request := makeExportRequest(batch)
+	if len(request.ResourceMetrics) == 0 {
+		return nil
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return mxd_exp.NewPermanentError(fmt.Errorf("error marshaling export request: %v", err))
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	err = fmt.Errorf("error exporting to %s: %s %s", e.url, resp.Status, string(respBody))
+	// OTLP/HTTP only asks for these to be retried
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return err
+	}
+	return mxd_exp.NewPermanentError(err)
+}
+
+// makeExportRequest groups metrics by resource and converts each to a data
+// point of an OTLP metric of the same name and type
+func makeExportRequest(batch []mxd_exp.MetricAndContext) *exportMetricsServiceRequest {
+	request := &exportMetricsServiceRequest{}
+	scopesByResource := map[string]*scopeMetrics{}
+	metricsByKey := map[string]*otlpMetric{}
+	for _, metricAndContext := range batch {
+		family := metricAndContext.Family
+		name := metricAndContext.Context.MetricName
+		attributes := getResourceAttributes(metricAndContext.Context)
+		resourceKey := attributesKey(attributes)
+		scope, ok := scopesByResource[resourceKey]
+		if !ok {
+			scope = &scopeMetrics{Scope: instrumentationScope{Name: scopeName}}
+			scopesByResource[resourceKey] = scope
+			request.ResourceMetrics = append(request.ResourceMetrics, &resourceMetrics{
+				Resource:     resource{Attributes: attributes},
+				ScopeMetrics: []*scopeMetrics{scope},
+			})
+		}
+		metricKey := fmt.Sprintf("%s\x00%s\x00%s", resourceKey, name, family.GetType())
+		metric, ok := metricsByKey[metricKey]
+		if !ok {
+			metric = &otlpMetric{Name: name, Description: family.GetHelp()}
+			metricsByKey[metricKey] = metric
+			scope.Metrics = append(scope.Metrics, metric)
+		}
+		for _, m := range family.Metric {
+			if err := addDataPoint(metric, family.GetType(), m); err != nil {
+				glog.Errorf("Dropping metric %s: %v", name, err)
+			}
+		}
+	}
+	return request
+}
+
+func addDataPoint(metric *otlpMetric, metricType dto.MetricType, m *dto.Metric) error {
+	attributes := make([]keyValue, 0, len(m.Label))
+	for _, label := range m.Label {
+		attributes = append(attributes, stringKeyValue(label.GetName(), label.GetValue()))
+	}
+	timeUnixNano := strconv.FormatInt(m.GetTimestampMs()*int64(time.Millisecond), 10)
+
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		if err := checkFinite(m.GetCounter().GetValue()); err != nil {
+			return err
+		}
+		if metric.Sum == nil {
+			metric.Sum = &sum{AggregationTemporality: aggregationTemporalityCumulative, IsMonotonic: true}
+		}
+		metric.Sum.DataPoints = append(metric.Sum.DataPoints, numberDataPoint{
+			Attributes:   attributes,
+			TimeUnixNano: timeUnixNano,
+			AsDouble:     m.GetCounter().GetValue(),
+		})
+	case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+		value := m.GetGauge().GetValue()
+		if metricType == dto.MetricType_UNTYPED {
+			value = m.GetUntyped().GetValue()
+		}
+		if err := checkFinite(value); err != nil {
+			return err
+		}
+		if metric.Gauge == nil {
+			metric.Gauge = &gauge{}
+		}
+		metric.Gauge.DataPoints = append(metric.Gauge.DataPoints, numberDataPoint{
+			Attributes:   attributes,
+			TimeUnixNano: timeUnixNano,
+			AsDouble:     value,
+		})
+	case dto.MetricType_HISTOGRAM:
+		dataPoint, err := makeHistogramDataPoint(m.GetHistogram())
+		if err != nil {
+			return err
+		}
+		dataPoint.Attributes = attributes
+		dataPoint.TimeUnixNano = timeUnixNano
+		if metric.Histogram == nil {
+			metric.Histogram = &histogram{AggregationTemporality: aggregationTemporalityCumulative}
+		}
+		metric.Histogram.DataPoints = append(metric.Histogram.DataPoints, dataPoint)
+	case dto.MetricType_SUMMARY:
+		s := m.GetSummary()
+		if err := checkFinite(s.GetSampleSum()); err != nil {
+			return err
+		}
+		dataPoint := summaryDataPoint{
+			Attributes:     attributes,
+			TimeUnixNano:   timeUnixNano,
+			Count:          strconv.FormatUint(s.GetSampleCount(), 10),
+			Sum:            s.GetSampleSum(),
+			QuantileValues: []quantileValue{},
+		}
+		for _, quantile := range s.Quantile {
+			// Quantiles are NaN until a summary has observations
+			if checkFinite(quantile.GetValue()) != nil {
+				continue
+			}
+			dataPoint.QuantileValues = append(dataPoint.QuantileValues, quantileValue{Quantile: quantile.GetQuantile(), Value: quantile.GetValue()})
+		}
+		if metric.Summary == nil {
+			metric.Summary = &summary{}
+		}
+		metric.Summary.DataPoints = append(metric.Summary.DataPoints, dataPoint)
+	default:
+		return fmt.Errorf("unsupported metric type %s", metricType)
+	}
+	return nil
+}
+
+// makeHistogramDataPoint converts the cumulative buckets of a Prometheus
+// histogram to OTLP bucket counts, which count the observations of each
+// bucket alone and include an overflow bucket
+func makeHistogramDataPoint(h *dto.Histogram) (histogramDataPoint, error) {
+	if err := checkFinite(h.GetSampleSum()); err != nil {
+		return histogramDataPoint{}, err
+	}
+	dataPoint := histogramDataPoint{
+		Count:          strconv.FormatUint(h.GetSampleCount(), 10),
+		Sum:            h.GetSampleSum(),
+		BucketCounts:   []string{},
+		ExplicitBounds: []float64{},
+	}
+	var previous uint64
+	for _, bucket := range h.Bucket {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			break
+		}
+		count := bucket.GetCumulativeCount()
+		if count < previous {
+			return histogramDataPoint{}, fmt.Errorf("histogram bucket counts are not cumulative")
+		}
+		dataPoint.ExplicitBounds = append(dataPoint.ExplicitBounds, bucket.GetUpperBound())
+		dataPoint.BucketCounts = append(dataPoint.BucketCounts, strconv.FormatUint(count-previous, 10))
+		previous = count
+	}
+	if h.GetSampleCount() < previous {
+		return histogramDataPoint{}, fmt.Errorf("histogram sample count is less than its bucket counts")
+	}
+	dataPoint.BucketCounts = append(dataPoint.BucketCounts, strconv.FormatUint(h.GetSampleCount()-previous, 10))
+	return dataPoint, nil
+}
+
+func getResourceAttributes(context mxd_exp.MetricsContext) []keyValue {
+	attributes := []keyValue{stringKeyValue(serviceNameAttribute, serviceName)}
+	switch additionalCtx := context.AdditionalContext.(type) {
+	case *mxd_exp.CloudMetricContext:
+		attributes = append(attributes, stringKeyValue(hostNameAttribute, additionalCtx.CloudHost))
+	case *mxd_exp.GatewayMetricContext:
+		attributes = append(attributes,
+			stringKeyValue(networkIDAttribute, additionalCtx.NetworkID),
+			stringKeyValue(gatewayIDAttribute, additionalCtx.GatewayID),
+		)
+	case *mxd_exp.PushedMetricContext:
+		attributes = append(attributes, stringKeyValue(networkIDAttribute, additionalCtx.NetworkID))
+	}
+	return attributes
+}
+
+func attributesKey(attributes []keyValue) string {
+	builder := strings.Builder{}
+	for _, attribute := range attributes {
+		builder.WriteString(attribute.Key)
+		builder.WriteByte(0)
+		builder.WriteString(attribute.Value.StringValue)
+		builder.WriteByte(0)
+	}
+	return builder.String()
+}
+
+func stringKeyValue(key, value string) keyValue {
+	return keyValue{Key: key, Value: anyValue{StringValue: value}}
+}
+
+// checkFinite rejects values which can't be encoded in JSON
+func checkFinite(value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("value %v can't be exported", value)
+	}
+	return nil
+}
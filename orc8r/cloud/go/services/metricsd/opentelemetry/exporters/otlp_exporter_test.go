@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sampleTimestampMs    = int64(1500000000000)
+	sampleTimeUnixNano   = "1500000000000000000"
+	sampleLabels         = []*dto.LabelPair{{Name: tests.MakeStringPointer("testLabel"), Value: tests.MakeStringPointer("testValue")}}
+	sampleAttributes     = []keyValue{stringKeyValue("testLabel", "testValue")}
+	sampleGatewayContext = exporters.MetricsContext{
+		MetricName:        "gateway_metric",
+		AdditionalContext: &exporters.GatewayMetricContext{NetworkID: "network", GatewayID: "gateway"},
+	}
+	sampleCloudContext = exporters.MetricsContext{
+		MetricName:        "cloud_metric",
+		AdditionalContext: &exporters.CloudMetricContext{CloudHost: "host"},
+	}
+)
+
+func TestMakeExportRequest(t *testing.T) {
+	counter := tests.MakePromoCounter(3)
+	secondCounter := tests.MakePromoCounter(4)
+	promHistogram := tests.MakePromoHistogram([]float64{1, 5}, []float64{0.5, 3, 3, 10})
+	promSummary := tests.MakePromoSummary(map[float64]float64{0.5: 0.05, 0.9: 0.01}, []float64{2})
+	untyped := tests.MakePromoUntyped(math.NaN())
+	for _, metric := range []*dto.Metric{&counter, &secondCounter, &promHistogram, &promSummary, &untyped} {
+		metric.Label = sampleLabels
+		metric.TimestampMs = &sampleTimestampMs
+	}
+	batch := []exporters.MetricAndContext{
+		{Family: makeFamily(dto.MetricType_COUNTER, &counter), Context: sampleGatewayContext},
+		{Family: makeFamily(dto.MetricType_HISTOGRAM, &promHistogram), Context: sampleCloudContext},
+		{Family: makeFamily(dto.MetricType_COUNTER, &secondCounter), Context: sampleGatewayContext},
+		{Family: makeFamily(dto.MetricType_SUMMARY, &promSummary), Context: exporters.MetricsContext{MetricName: "summary", AdditionalContext: &exporters.CloudMetricContext{CloudHost: "host"}}},
+		{Family: makeFamily(dto.MetricType_UNTYPED, &untyped), Context: exporters.MetricsContext{MetricName: "untyped", AdditionalContext: &exporters.PushedMetricContext{NetworkID: "network"}}},
+	}
+
+	expected := &exportMetricsServiceRequest{
+		ResourceMetrics: []*resourceMetrics{
+			{
+				Resource: resource{Attributes: []keyValue{
+					stringKeyValue(serviceNameAttribute, serviceName),
+					stringKeyValue(networkIDAttribute, "network"),
+					stringKeyValue(gatewayIDAttribute, "gateway"),
+				}},
+				ScopeMetrics: []*scopeMetrics{{
+					Scope: instrumentationScope{Name: scopeName},
+					Metrics: []*otlpMetric{{
+						Name:        "gateway_metric",
+						Description: "help",
+						Sum: &sum{
+							AggregationTemporality: aggregationTemporalityCumulative,
+							IsMonotonic:            true,
+							DataPoints: []numberDataPoint{
+								{Attributes: sampleAttributes, TimeUnixNano: sampleTimeUnixNano, AsDouble: 3},
+								{Attributes: sampleAttributes, TimeUnixNano: sampleTimeUnixNano, AsDouble: 4},
+							},
+						},
+					}},
+				}},
+			},
+			{
+				Resource: resource{Attributes: []keyValue{
+					stringKeyValue(serviceNameAttribute, serviceName),
+					stringKeyValue(hostNameAttribute, "host"),
+				}},
+				ScopeMetrics: []*scopeMetrics{{
+					Scope: instrumentationScope{Name: scopeName},
+					Metrics: []*otlpMetric{
+						{
+							Name:        "cloud_metric",
+							Description: "help",
+							Histogram: &histogram{
+								AggregationTemporality: aggregationTemporalityCumulative,
+								DataPoints: []histogramDataPoint{{
+									Attributes:     sampleAttributes,
+									TimeUnixNano:   sampleTimeUnixNano,
+									Count:          "4",
+									Sum:            16.5,
+									BucketCounts:   []string{"1", "2", "1"},
+									ExplicitBounds: []float64{1, 5},
+								}},
+							},
+						},
+						{
+							Name:        "summary",
+							Description: "help",
+							Summary: &summary{
+								DataPoints: []summaryDataPoint{{
+									Attributes:     sampleAttributes,
+									TimeUnixNano:   sampleTimeUnixNano,
+									Count:          "1",
+									Sum:            2,
+									QuantileValues: []quantileValue{{Quantile: 0.5, Value: 2}, {Quantile: 0.9, Value: 2}},
+								}},
+							},
+						},
+					},
+				}},
+			},
+			{
+				Resource: resource{Attributes: []keyValue{
+					stringKeyValue(serviceNameAttribute, serviceName),
+					stringKeyValue(networkIDAttribute, "network"),
+				}},
+				ScopeMetrics: []*scopeMetrics{{
+					Scope: instrumentationScope{Name: scopeName},
+					// the NaN value is dropped
+					Metrics: []*otlpMetric{{Name: "untyped", Description: "help"}},
+				}},
+			},
+		},
+	}
+	assert.Equal(t, expected, makeExportRequest(batch))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var lock sync.Mutex
+	var requests []map[string]interface{}
+	var statuses []int
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if len(statuses) > 0 {
+			w.WriteHeader(statuses[0])
+			statuses = statuses[1:]
+			return
+		}
+		if r.URL.Path != "/v1/metrics" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		request := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, request)
+	}))
+	defer httpServer.Close()
+
+	exp := NewOTLPExporter(httpServer.URL+"/v1/metrics", exporters.BatchConfig{
+		BatchSize:      100,
+		FlushInterval:  time.Hour,
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+	}).(*OTLPExporter)
+
+	gauge := tests.MakePromoGauge(1.5)
+	gauge.TimestampMs = &sampleTimestampMs
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{{Family: makeFamily(dto.MetricType_GAUGE, &gauge), Context: sampleCloudContext}}))
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Len(t, requests, 1)
+	// 64 bit integers are encoded as strings
+	dataPoint := requests[0]["resourceMetrics"].([]interface{})[0].(map[string]interface{})["scopeMetrics"].([]interface{})[0].(map[string]interface{})["metrics"].([]interface{})[0].(map[string]interface{})["gauge"].(map[string]interface{})["dataPoints"].([]interface{})[0]
+	assert.Equal(t, map[string]interface{}{"timeUnixNano": sampleTimeUnixNano, "asDouble": 1.5}, dataPoint)
+
+	// unavailable collectors are retried
+	statuses = []int{http.StatusServiceUnavailable}
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{{Family: makeFamily(dto.MetricType_GAUGE, &gauge), Context: sampleCloudContext}}))
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Len(t, requests, 2)
+
+	// bad requests are dropped
+	statuses = []int{http.StatusBadRequest}
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{{Family: makeFamily(dto.MetricType_GAUGE, &gauge), Context: sampleCloudContext}}))
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Len(t, requests, 2)
+	assert.Equal(t, 0, exp.batcher.Len())
+}
+
+func makeFamily(metricType dto.MetricType, metrics ...*dto.Metric) *dto.MetricFamily {
+	return &dto.MetricFamily{
+		Name:   tests.MakeStringPointer("family"),
+		Help:   tests.MakeStringPointer("help"),
+		Type:   tests.MakeMetricTypePointer(metricType),
+		Metric: metrics,
+	}
+}
@@ -25,8 +25,13 @@ const (
 	ReceiverPath = rootPath + "/receiver"
 	RoutePath    = ReceiverPath + "/route"
 
+	InhibitRulePath = rootPath + "/inhibit_rule"
+
 	ReceiverNamePathParam  = "receiver"
 	ReceiverNameQueryParam = "receiver"
+
+	InhibitRuleIDPathParam  = "rule_id"
+	InhibitRuleIDQueryParam = "rule_id"
 )
 
 // GetReceiverPostHandler returns a handler function that creates a new
@@ -134,6 +139,82 @@ func GetUpdateRouteHandler(client receivers.AlertmanagerClient) func(c echo.Cont
 	}
 }
 
+// GetInhibitRulePostHandler returns a handler function that creates a new
+// inhibition rule, reloads alertmanager and responds with the new rule's ID
+func GetInhibitRulePostHandler(client receivers.AlertmanagerClient) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		rule, err := decodeInhibitRulePostRequest(c)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		ruleID, err := client.CreateInhibitRule(getFilePrefix(c), rule)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		err = client.ReloadAlertmanager()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ruleID)
+	}
+}
+
+// GetGetInhibitRulesHandler returns a handler function to retrieve inhibition
+// rules for a filePrefix
+func GetGetInhibitRulesHandler(client receivers.AlertmanagerClient) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		rules, err := client.GetInhibitRules(getFilePrefix(c))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, rules)
+	}
+}
+
+// GetUpdateInhibitRuleHandler returns a handler function to update an
+// inhibition rule. Since a rule's ID is derived from its contents, it responds
+// with the rule's new ID.
+func GetUpdateInhibitRuleHandler(client receivers.AlertmanagerClient) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		rule, err := decodeInhibitRulePostRequest(c)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		ruleID, err := client.UpdateInhibitRule(getFilePrefix(c), c.Param(InhibitRuleIDPathParam), rule)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		err = client.ReloadAlertmanager()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ruleID)
+	}
+}
+
+func GetDeleteInhibitRuleHandler(client receivers.AlertmanagerClient) func(c echo.Context) error {
+	return func(c echo.Context) error {
+		ruleID := c.QueryParam(InhibitRuleIDQueryParam)
+		if ruleID == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "missing rule ID")
+		}
+
+		err := client.DeleteInhibitRule(getFilePrefix(c), ruleID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		err = client.ReloadAlertmanager()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.NoContent(http.StatusOK)
+	}
+}
+
 func decodeReceiverPostRequest(c echo.Context) (receivers.Receiver, error) {
 	body, err := ioutil.ReadAll(c.Request().Body)
 	if err != nil {
@@ -170,6 +251,19 @@ func decodeRoutePostRequest(c echo.Context) (config.Route, error) {
 	return route, nil
 }
 
+func decodeInhibitRulePostRequest(c echo.Context) (config.InhibitRule, error) {
+	body, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return config.InhibitRule{}, fmt.Errorf("error reading request body: %v", err)
+	}
+	rule := config.InhibitRule{}
+	err = json.Unmarshal(body, &rule)
+	if err != nil {
+		return config.InhibitRule{}, fmt.Errorf("error unmarshalling inhibit rule: %v", err)
+	}
+	return rule, nil
+}
+
 func getFilePrefix(c echo.Context) string {
 	return c.Param("file_prefix")
 }
@@ -63,6 +63,12 @@ var (
 		GroupInterval:  &fiveSeconds,
 		RepeatInterval: &fiveSeconds,
 	}
+
+	sampleInhibitRule = config.InhibitRule{
+		SourceMatch: map[string]string{"severity": "critical"},
+		TargetMatch: map[string]string{"severity": "warning"},
+		Equal:       model.LabelNames{"gatewayID"},
+	}
 )
 
 func TestGetReceiverPostHandler(t *testing.T) {
@@ -259,6 +265,133 @@ func TestGetUpdateRouteHandler(t *testing.T) {
 	client.AssertExpectations(t)
 }
 
+func TestGetInhibitRulePostHandler(t *testing.T) {
+	// Successful Post
+	client := &mocks.AlertmanagerClient{}
+	client.On("CreateInhibitRule", testNID, sampleInhibitRule).Return("abc", nil)
+	client.On("ReloadAlertmanager").Return(nil)
+	c, rec := buildContext(sampleInhibitRule, http.MethodPost, "/", InhibitRulePath, testNID)
+
+	err := GetInhibitRulePostHandler(client)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `"abc"`, rec.Body.String())
+	client.AssertExpectations(t)
+
+	// Client Error
+	client = &mocks.AlertmanagerClient{}
+	client.On("CreateInhibitRule", testNID, sampleInhibitRule).Return("", errors.New("error"))
+	c, _ = buildContext(sampleInhibitRule, http.MethodPost, "/", InhibitRulePath, testNID)
+
+	err = GetInhibitRulePostHandler(client)(c)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=400, message=error`)
+	client.AssertExpectations(t)
+}
+
+func TestGetGetInhibitRulesHandler(t *testing.T) {
+	// Successful Get
+	client := &mocks.AlertmanagerClient{}
+	client.On("GetInhibitRules", testNID).Return([]receivers.InhibitRule{{ID: "abc", InhibitRule: sampleInhibitRule}}, nil)
+	c, rec := buildContext(nil, http.MethodGet, "/", InhibitRulePath, testNID)
+
+	err := GetGetInhibitRulesHandler(client)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	client.AssertExpectations(t)
+
+	var rules []receivers.InhibitRule
+	err = json.Unmarshal(rec.Body.Bytes(), &rules)
+	assert.NoError(t, err)
+	assert.Equal(t, []receivers.InhibitRule{{ID: "abc", InhibitRule: sampleInhibitRule}}, rules)
+
+	// Client Error
+	client = &mocks.AlertmanagerClient{}
+	client.On("GetInhibitRules", testNID).Return(nil, errors.New("error"))
+	c, _ = buildContext(nil, http.MethodGet, "/", InhibitRulePath, testNID)
+
+	err = GetGetInhibitRulesHandler(client)(c)
+	assert.Equal(t, http.StatusInternalServerError, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=500, message=error`)
+	client.AssertExpectations(t)
+}
+
+func TestGetUpdateInhibitRuleHandler(t *testing.T) {
+	// Successful Update
+	client := &mocks.AlertmanagerClient{}
+	client.On("UpdateInhibitRule", testNID, "abc", sampleInhibitRule).Return("def", nil)
+	client.On("ReloadAlertmanager").Return(nil)
+	c, rec := buildContext(sampleInhibitRule, http.MethodPut, "/", InhibitRulePath, testNID)
+	c.SetParamNames("file_prefix", InhibitRuleIDPathParam)
+	c.SetParamValues(testNID, "abc")
+
+	err := GetUpdateInhibitRuleHandler(client)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `"def"`, rec.Body.String())
+	client.AssertExpectations(t)
+
+	// Client Error
+	client = &mocks.AlertmanagerClient{}
+	client.On("UpdateInhibitRule", testNID, "abc", sampleInhibitRule).Return("", errors.New("error"))
+	c, _ = buildContext(sampleInhibitRule, http.MethodPut, "/", InhibitRulePath, testNID)
+	c.SetParamNames("file_prefix", InhibitRuleIDPathParam)
+	c.SetParamValues(testNID, "abc")
+
+	err = GetUpdateInhibitRuleHandler(client)(c)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=400, message=error`)
+	client.AssertExpectations(t)
+
+	// Alertmanager Error
+	client = &mocks.AlertmanagerClient{}
+	client.On("UpdateInhibitRule", testNID, "abc", sampleInhibitRule).Return("def", nil)
+	client.On("ReloadAlertmanager").Return(errors.New("error"))
+	c, _ = buildContext(sampleInhibitRule, http.MethodPut, "/", InhibitRulePath, testNID)
+	c.SetParamNames("file_prefix", InhibitRuleIDPathParam)
+	c.SetParamValues(testNID, "abc")
+
+	err = GetUpdateInhibitRuleHandler(client)(c)
+	assert.Equal(t, http.StatusInternalServerError, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=500, message=error`)
+	client.AssertExpectations(t)
+}
+
+func TestGetDeleteInhibitRuleHandler(t *testing.T) {
+	// Successful Delete
+	client := &mocks.AlertmanagerClient{}
+	client.On("DeleteInhibitRule", testNID, "abc").Return(nil)
+	client.On("ReloadAlertmanager").Return(nil)
+
+	q := make(url.Values)
+	q.Set(InhibitRuleIDQueryParam, "abc")
+	c, rec := buildContext(nil, http.MethodDelete, "/?"+q.Encode(), InhibitRulePath, testNID)
+
+	err := GetDeleteInhibitRuleHandler(client)(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	client.AssertExpectations(t)
+
+	// Client Error
+	client = &mocks.AlertmanagerClient{}
+	client.On("DeleteInhibitRule", testNID, "abc").Return(errors.New("error"))
+	c, _ = buildContext(nil, http.MethodDelete, "/?"+q.Encode(), InhibitRulePath, testNID)
+
+	err = GetDeleteInhibitRuleHandler(client)(c)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=400, message=error`)
+	client.AssertExpectations(t)
+
+	// Missing ID
+	client = &mocks.AlertmanagerClient{}
+	c, _ = buildContext(nil, http.MethodDelete, "/", InhibitRulePath, testNID)
+
+	err = GetDeleteInhibitRuleHandler(client)(c)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+	assert.EqualError(t, err, `code=400, message=missing rule ID`)
+	client.AssertExpectations(t)
+}
+
 func TestDecodeReceiverPostRequest(t *testing.T) {
 	// Successful Decode
 	c, _ := buildContext(sampleReceiver, http.MethodPost, "/", ReceiverPath, testNID)
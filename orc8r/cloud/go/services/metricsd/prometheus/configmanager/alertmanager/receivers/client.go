@@ -42,6 +42,23 @@ type AlertmanagerClient interface {
 	// GetRoute returns the routing tree for the given networkID
 	GetRoute(networkID string) (*config.Route, error)
 
+	// CreateInhibitRule adds an inhibition rule for the given network and
+	// returns its ID. The rule's source and target alerts are restricted to
+	// the network.
+	CreateInhibitRule(networkID string, rule config.InhibitRule) (string, error)
+
+	// GetInhibitRules returns the inhibition rules of the given network
+	// along with their IDs
+	GetInhibitRules(networkID string) ([]InhibitRule, error)
+
+	// UpdateInhibitRule replaces the network's inhibition rule with the given
+	// ID and returns the new rule's ID
+	UpdateInhibitRule(networkID string, ruleID string, rule config.InhibitRule) (string, error)
+
+	// DeleteInhibitRule removes the network's inhibition rule with the given
+	// ID
+	DeleteInhibitRule(networkID string, ruleID string) error
+
 	// ReloadAlertmanager triggers the alertmanager process to reload the
 	// configuration file(s)
 	ReloadAlertmanager() error
@@ -210,6 +227,118 @@ func (c *client) GetRoute(networkID string) (*config.Route, error) {
 	return nil, fmt.Errorf("Route for network %s does not exist", networkID)
 }
 
+// CreateInhibitRule appends a network-scoped inhibition rule to the config
+func (c *client) CreateInhibitRule(networkID string, rule config.InhibitRule) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+	conf, err := c.readConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	err = SecureInhibitRule(networkID, &rule)
+	if err != nil {
+		return "", err
+	}
+	ruleID, err := getInhibitRuleID(&rule)
+	if err != nil {
+		return "", err
+	}
+	err = conf.checkNewInhibitRule(networkID, ruleID)
+	if err != nil {
+		return "", err
+	}
+	conf.InhibitRules = append(conf.InhibitRules, &rule)
+	err = conf.Validate()
+	if err != nil {
+		return "", err
+	}
+	return ruleID, c.writeConfigFile(conf)
+}
+
+// GetInhibitRules returns the inhibition rules for the given networkID
+func (c *client) GetInhibitRules(networkID string) ([]InhibitRule, error) {
+	c.RLock()
+	defer c.RUnlock()
+	conf, err := c.readConfigFile()
+	if err != nil {
+		return []InhibitRule{}, err
+	}
+
+	rules := make([]InhibitRule, 0)
+	for _, rule := range conf.InhibitRules {
+		if !isNetworkInhibitRule(networkID, rule) {
+			continue
+		}
+		ruleID, err := getInhibitRuleID(rule)
+		if err != nil {
+			return []InhibitRule{}, err
+		}
+		unsecuredRule := *rule
+		UnsecureInhibitRule(&unsecuredRule)
+		rules = append(rules, InhibitRule{ID: ruleID, InhibitRule: unsecuredRule})
+	}
+	return rules, nil
+}
+
+// UpdateInhibitRule replaces an existing inhibition rule
+func (c *client) UpdateInhibitRule(networkID string, ruleID string, rule config.InhibitRule) (string, error) {
+	c.Lock()
+	defer c.Unlock()
+	conf, err := c.readConfigFile()
+	if err != nil {
+		return "", err
+	}
+
+	idx, err := conf.getNetworkInhibitRuleIdx(networkID, ruleID)
+	if err != nil {
+		return "", err
+	}
+	if idx < 0 {
+		return "", fmt.Errorf("Inhibit rule %s not found", ruleID)
+	}
+	err = SecureInhibitRule(networkID, &rule)
+	if err != nil {
+		return "", err
+	}
+	newRuleID, err := getInhibitRuleID(&rule)
+	if err != nil {
+		return "", err
+	}
+	if newRuleID != ruleID {
+		err = conf.checkNewInhibitRule(networkID, newRuleID)
+		if err != nil {
+			return "", err
+		}
+	}
+	conf.InhibitRules[idx] = &rule
+	err = conf.Validate()
+	if err != nil {
+		return "", fmt.Errorf("Error updating inhibit rule: %v", err)
+	}
+	return newRuleID, c.writeConfigFile(conf)
+}
+
+// DeleteInhibitRule removes an inhibition rule from the configuration
+func (c *client) DeleteInhibitRule(networkID string, ruleID string) error {
+	c.Lock()
+	defer c.Unlock()
+	conf, err := c.readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	idx, err := conf.getNetworkInhibitRuleIdx(networkID, ruleID)
+	if err != nil {
+		return err
+	}
+	if idx < 0 {
+		return fmt.Errorf("Inhibit rule %s does not exist", ruleID)
+	}
+	conf.InhibitRules = append(conf.InhibitRules[:idx], conf.InhibitRules[idx+1:]...)
+	return c.writeConfigFile(conf)
+}
+
 func (c *client) ReloadAlertmanager() error {
 	resp, err := http.Post(fmt.Sprintf("http://%s%s", c.alertmanagerURL, "/-/reload"), "text/plain", &bytes.Buffer{})
 	if err != nil {
@@ -9,11 +9,13 @@
 package receivers
 
 import (
+	"fmt"
 	"testing"
 
 	"magma/orc8r/cloud/go/services/metricsd/prometheus/configmanager/fsclient/mocks"
 
 	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -68,6 +70,22 @@ receivers:
     headers:
       name: value
       foo: bar
+inhibit_rules:
+- source_match:
+    networkID: test
+    severity: critical
+  target_match:
+    networkID: test
+    severity: warning
+  equal:
+  - gatewayID
+- source_match:
+    networkID: other
+    alertname: GatewayDown
+  target_match:
+    networkID: other
+  target_match_re:
+    alertname: .*
 templates: []`
 )
 
@@ -90,6 +108,18 @@ func TestClient_CreateReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
 
+	// Create PagerDuty receiver
+	client, fsClient = newTestClient()
+	err = client.CreateReceiver(testNID, samplePagerDutyReceiver)
+	assert.NoError(t, err)
+	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
+
+	// Create OpsGenie receiver
+	client, fsClient = newTestClient()
+	err = client.CreateReceiver(testNID, sampleOpsGenieReceiver)
+	assert.NoError(t, err)
+	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
+
 	// create duplicate receiver
 	err = client.CreateReceiver(testNID, Receiver{Name: "receiver"})
 	assert.EqualError(t, err, `notification config name "test_receiver" is not unique`)
@@ -168,6 +198,123 @@ func TestClient_GetRoute(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestClient_CreateInhibitRule(t *testing.T) {
+	client, fsClient := newTestClient()
+	rule := config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown"},
+		TargetMatch: map[string]string{"severity": "warning"},
+	}
+	ruleID, err := client.CreateInhibitRule(testNID, rule)
+	assert.NoError(t, err)
+	assert.Equal(t, getTestInhibitRuleID(t, testNID, rule), ruleID)
+	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
+
+	// rules with the same matchers as an existing rule are rejected
+	_, err = client.CreateInhibitRule(testNID, config.InhibitRule{
+		SourceMatch: map[string]string{"severity": "critical"},
+		TargetMatch: map[string]string{"severity": "warning"},
+		Equal:       model.LabelNames{"gatewayID"},
+	})
+	assert.Regexp(t, "^Inhibit rule [0-9a-f]{16} already exists$", err)
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 1)
+
+	// rules can't match alerts from other networks
+	_, err = client.CreateInhibitRule(testNID, config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown"},
+		TargetMatch: map[string]string{"networkID": otherNID, "severity": "warning"},
+	})
+	assert.EqualError(t, err, "inhibit rule cannot match alerts from network other")
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 1)
+
+	_, err = client.CreateInhibitRule(testNID, config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown", "bad label": "value"},
+		TargetMatch: map[string]string{"severity": "warning"},
+	})
+	assert.EqualError(t, err, `invalid label name "bad label"`)
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 1)
+}
+
+func TestClient_GetInhibitRules(t *testing.T) {
+	client, _ := newTestClient()
+	rules, err := client.GetInhibitRules(testNID)
+	assert.NoError(t, err)
+	expectedRule := config.InhibitRule{
+		SourceMatch: map[string]string{"severity": "critical"},
+		TargetMatch: map[string]string{"severity": "warning"},
+		Equal:       model.LabelNames{"gatewayID"},
+	}
+	assert.Equal(t, []InhibitRule{{
+		ID:          getTestInhibitRuleID(t, testNID, expectedRule),
+		InhibitRule: expectedRule,
+	}}, rules)
+
+	rules, err = client.GetInhibitRules(otherNID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rules))
+	assert.Equal(t, map[string]string{"alertname": "GatewayDown"}, rules[0].SourceMatch)
+	assert.Nil(t, rules[0].TargetMatch)
+	assert.Equal(t, getTestInhibitRuleID(t, otherNID, rules[0].InhibitRule), rules[0].ID)
+
+	rules, err = client.GetInhibitRules("bad_nid")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(rules))
+}
+
+func TestClient_UpdateInhibitRule(t *testing.T) {
+	client, fsClient := newTestClient()
+	rules, err := client.GetInhibitRules(testNID)
+	assert.NoError(t, err)
+	rule := config.InhibitRule{
+		SourceMatch: map[string]string{"severity": "critical"},
+		TargetMatch: map[string]string{"severity": "minor"},
+	}
+
+	newRuleID, err := client.UpdateInhibitRule(testNID, rules[0].ID, rule)
+	assert.NoError(t, err)
+	assert.Equal(t, getTestInhibitRuleID(t, testNID, rule), newRuleID)
+	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
+
+	// rules are only found in their own network
+	otherRules, err := client.GetInhibitRules(otherNID)
+	assert.NoError(t, err)
+	_, err = client.UpdateInhibitRule(testNID, otherRules[0].ID, rule)
+	assert.EqualError(t, err, fmt.Sprintf("Inhibit rule %s not found", otherRules[0].ID))
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 1)
+}
+
+func TestClient_DeleteInhibitRule(t *testing.T) {
+	client, fsClient := newTestClient()
+	rules, err := client.GetInhibitRules(otherNID)
+	assert.NoError(t, err)
+
+	err = client.DeleteInhibitRule(otherNID, rules[0].ID)
+	assert.NoError(t, err)
+	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
+
+	err = client.DeleteInhibitRule("bad_nid", rules[0].ID)
+	assert.EqualError(t, err, fmt.Sprintf("Inhibit rule %s does not exist", rules[0].ID))
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 1)
+}
+
+// getTestInhibitRuleID returns the ID a rule gets when it's stored for the
+// given network, without modifying the rule's matchers
+func getTestInhibitRuleID(t *testing.T, networkID string, rule config.InhibitRule) string {
+	rule.SourceMatch = copyMatch(rule.SourceMatch)
+	rule.TargetMatch = copyMatch(rule.TargetMatch)
+	assert.NoError(t, SecureInhibitRule(networkID, &rule))
+	ruleID, err := getInhibitRuleID(&rule)
+	assert.NoError(t, err)
+	return ruleID
+}
+
+func copyMatch(match map[string]string) map[string]string {
+	ret := make(map[string]string, len(match))
+	for k, v := range match {
+		ret[k] = v
+	}
+	return ret
+}
+
 func newTestClient() (AlertmanagerClient, *mocks.FSClient) {
 	fsClient := &mocks.FSClient{}
 	fsClient.On("ReadFile", mock.Anything).Return([]byte(testAlertmanagerFile), nil)
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package receivers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"magma/orc8r/cloud/go/metrics"
+
+	"github.com/prometheus/alertmanager/config"
+)
+
+// InhibitRule is an inhibition rule of a network along with its ID. The ID
+// is derived from the rule's contents, so it doesn't change when other rules
+// are added or removed, but updating the rule changes it.
+type InhibitRule struct {
+	ID string `json:"id"`
+	config.InhibitRule
+}
+
+// SecureInhibitRule scopes an inhibition rule to the given network by
+// requiring the networkID label on both the source and target alerts, so that
+// alerts in one network can never inhibit alerts in another.
+func SecureInhibitRule(networkID string, rule *config.InhibitRule) error {
+	if _, ok := rule.SourceMatchRE[metrics.NetworkLabelName]; ok {
+		return fmt.Errorf("inhibit rule cannot match %s label with a regex", metrics.NetworkLabelName)
+	}
+	if _, ok := rule.TargetMatchRE[metrics.NetworkLabelName]; ok {
+		return fmt.Errorf("inhibit rule cannot match %s label with a regex", metrics.NetworkLabelName)
+	}
+	if val, ok := rule.SourceMatch[metrics.NetworkLabelName]; ok && val != networkID {
+		return fmt.Errorf("inhibit rule cannot match alerts from network %s", val)
+	}
+	if val, ok := rule.TargetMatch[metrics.NetworkLabelName]; ok && val != networkID {
+		return fmt.Errorf("inhibit rule cannot match alerts from network %s", val)
+	}
+	if !hasOtherMatchers(rule.SourceMatch, rule.SourceMatchRE) || !hasOtherMatchers(rule.TargetMatch, rule.TargetMatchRE) {
+		return fmt.Errorf("inhibit rule must match source and target alerts on a label other than %s", metrics.NetworkLabelName)
+	}
+
+	if rule.SourceMatch == nil {
+		rule.SourceMatch = map[string]string{}
+	}
+	if rule.TargetMatch == nil {
+		rule.TargetMatch = map[string]string{}
+	}
+	rule.SourceMatch[metrics.NetworkLabelName] = networkID
+	rule.TargetMatch[metrics.NetworkLabelName] = networkID
+	return nil
+}
+
+// UnsecureInhibitRule removes the networkID matchers added by
+// SecureInhibitRule
+func UnsecureInhibitRule(rule *config.InhibitRule) {
+	delete(rule.SourceMatch, metrics.NetworkLabelName)
+	delete(rule.TargetMatch, metrics.NetworkLabelName)
+	if len(rule.SourceMatch) == 0 {
+		rule.SourceMatch = nil
+	}
+	if len(rule.TargetMatch) == 0 {
+		rule.TargetMatch = nil
+	}
+}
+
+func isNetworkInhibitRule(networkID string, rule *config.InhibitRule) bool {
+	return rule.SourceMatch[metrics.NetworkLabelName] == networkID &&
+		rule.TargetMatch[metrics.NetworkLabelName] == networkID
+}
+
+func hasOtherMatchers(match map[string]string, matchRE map[string]config.Regexp) bool {
+	for label := range match {
+		if label != metrics.NetworkLabelName {
+			return true
+		}
+	}
+	return len(matchRE) > 0
+}
+
+// getInhibitRuleID returns the ID of a rule secured by SecureInhibitRule.
+// Rules with the same matchers have the same ID.
+func getInhibitRuleID(rule *config.InhibitRule) (string, error) {
+	// Maps are marshaled with sorted keys, so equal rules marshal equally
+	encoded, err := json.Marshal(rule)
+	if err != nil {
+		return "", fmt.Errorf("error encoding inhibit rule: %v", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// getNetworkInhibitRuleIdx returns the index in c.InhibitRules of the
+// network's rule with the given ID, or -1 if there is none
+func (c *Config) getNetworkInhibitRuleIdx(networkID string, ruleID string) (int, error) {
+	for idx, rule := range c.InhibitRules {
+		if !isNetworkInhibitRule(networkID, rule) {
+			continue
+		}
+		id, err := getInhibitRuleID(rule)
+		if err != nil {
+			return -1, err
+		}
+		if id == ruleID {
+			return idx, nil
+		}
+	}
+	return -1, nil
+}
+
+// checkNewInhibitRule returns an error if the network already has a rule
+// with the given ID, since a rule with the same matchers would be redundant
+func (c *Config) checkNewInhibitRule(networkID string, ruleID string) error {
+	idx, err := c.getNetworkInhibitRuleIdx(networkID, ruleID)
+	if err != nil {
+		return err
+	}
+	if idx >= 0 {
+		return fmt.Errorf("Inhibit rule %s already exists", ruleID)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package receivers
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecureInhibitRule(t *testing.T) {
+	rule := config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown"},
+		TargetMatchRE: map[string]config.Regexp{
+			"alertname": {Regexp: regexp.MustCompile(".*")},
+		},
+	}
+	err := SecureInhibitRule(testNID, &rule)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"alertname": "GatewayDown", "networkID": testNID}, rule.SourceMatch)
+	assert.Equal(t, map[string]string{"networkID": testNID}, rule.TargetMatch)
+
+	UnsecureInhibitRule(&rule)
+	assert.Equal(t, map[string]string{"alertname": "GatewayDown"}, rule.SourceMatch)
+	assert.Nil(t, rule.TargetMatch)
+
+	// Matching on the network's own ID is allowed
+	rule = config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown", "networkID": testNID},
+		TargetMatch: map[string]string{"severity": "warning"},
+	}
+	assert.NoError(t, SecureInhibitRule(testNID, &rule))
+
+	rule = config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown"},
+		TargetMatchRE: map[string]config.Regexp{
+			"networkID": {Regexp: regexp.MustCompile(".*")},
+		},
+	}
+	err = SecureInhibitRule(testNID, &rule)
+	assert.EqualError(t, err, "inhibit rule cannot match networkID label with a regex")
+
+	rule = config.InhibitRule{
+		SourceMatch: map[string]string{"networkID": testNID},
+		TargetMatch: map[string]string{"severity": "warning"},
+	}
+	err = SecureInhibitRule(testNID, &rule)
+	assert.EqualError(t, err, "inhibit rule must match source and target alerts on a label other than networkID")
+}
+
+func TestGetInhibitRuleID(t *testing.T) {
+	rule := config.InhibitRule{
+		SourceMatch: map[string]string{"alertname": "GatewayDown", "networkID": testNID},
+		TargetMatch: map[string]string{"severity": "warning", "networkID": testNID},
+	}
+	ruleID, err := getInhibitRuleID(&rule)
+	assert.NoError(t, err)
+	assert.Regexp(t, "^[0-9a-f]{16}$", ruleID)
+
+	// Rules with the same matchers have the same ID
+	sameRule := config.InhibitRule{
+		SourceMatch: map[string]string{"networkID": testNID, "alertname": "GatewayDown"},
+		TargetMatch: map[string]string{"networkID": testNID, "severity": "warning"},
+	}
+	sameRuleID, err := getInhibitRuleID(&sameRule)
+	assert.NoError(t, err)
+	assert.Equal(t, ruleID, sameRuleID)
+
+	rule.Equal = model.LabelNames{"gatewayID"}
+	otherRuleID, err := getInhibitRuleID(&rule)
+	assert.NoError(t, err)
+	assert.NotEqual(t, ruleID, otherRuleID)
+}
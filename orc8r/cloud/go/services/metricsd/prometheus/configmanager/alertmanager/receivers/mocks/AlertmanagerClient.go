@@ -14,6 +14,27 @@ type AlertmanagerClient struct {
 	mock.Mock
 }
 
+// CreateInhibitRule provides a mock function with given fields: networkID, rule
+func (_m *AlertmanagerClient) CreateInhibitRule(networkID string, rule config.InhibitRule) (string, error) {
+	ret := _m.Called(networkID, rule)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, config.InhibitRule) string); ok {
+		r0 = rf(networkID, rule)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, config.InhibitRule) error); ok {
+		r1 = rf(networkID, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateReceiver provides a mock function with given fields: networkID, rec
 func (_m *AlertmanagerClient) CreateReceiver(networkID string, rec receivers.Receiver) error {
 	ret := _m.Called(networkID, rec)
@@ -28,6 +49,20 @@ func (_m *AlertmanagerClient) CreateReceiver(networkID string, rec receivers.Rec
 	return r0
 }
 
+// DeleteInhibitRule provides a mock function with given fields: networkID, ruleID
+func (_m *AlertmanagerClient) DeleteInhibitRule(networkID string, ruleID string) error {
+	ret := _m.Called(networkID, ruleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(networkID, ruleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteReceiver provides a mock function with given fields: networkID, receiverName
 func (_m *AlertmanagerClient) DeleteReceiver(networkID string, receiverName string) error {
 	ret := _m.Called(networkID, receiverName)
@@ -42,6 +77,29 @@ func (_m *AlertmanagerClient) DeleteReceiver(networkID string, receiverName stri
 	return r0
 }
 
+// GetInhibitRules provides a mock function with given fields: networkID
+func (_m *AlertmanagerClient) GetInhibitRules(networkID string) ([]receivers.InhibitRule, error) {
+	ret := _m.Called(networkID)
+
+	var r0 []receivers.InhibitRule
+	if rf, ok := ret.Get(0).(func(string) []receivers.InhibitRule); ok {
+		r0 = rf(networkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]receivers.InhibitRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(networkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReceivers provides a mock function with given fields: networkID
 func (_m *AlertmanagerClient) GetReceivers(networkID string) ([]receivers.Receiver, error) {
 	ret := _m.Called(networkID)
@@ -116,6 +174,27 @@ func (_m *AlertmanagerClient) ReloadAlertmanager() error {
 	return r0
 }
 
+// UpdateInhibitRule provides a mock function with given fields: networkID, ruleID, rule
+func (_m *AlertmanagerClient) UpdateInhibitRule(networkID string, ruleID string, rule config.InhibitRule) (string, error) {
+	ret := _m.Called(networkID, ruleID, rule)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, config.InhibitRule) string); ok {
+		r0 = rf(networkID, ruleID, rule)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, config.InhibitRule) error); ok {
+		r1 = rf(networkID, ruleID, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateReceiver provides a mock function with given fields: networkID, newRec
 func (_m *AlertmanagerClient) UpdateReceiver(networkID string, newRec *receivers.Receiver) error {
 	ret := _m.Called(networkID, newRec)
@@ -10,6 +10,7 @@ package receivers
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -20,6 +21,16 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// MSTeamsBridgeURL is the address of the prometheus-msteams bridge which
+// relays alerts of Microsoft Teams receivers to their Teams webhooks.
+// Alertmanager has no native Teams integration, so Teams receivers are
+// written to the config file as webhook configs pointing at the bridge.
+var MSTeamsBridgeURL = "http://prometheus-msteams:2000"
+
+// msteamsBridgePath is the bridge endpoint which posts to the Teams webhook
+// given in the rest of the path, so no per-network bridge config is needed
+const msteamsBridgePath = "/_dynamicwebhook/"
+
 // Config uses a custom receiver struct to avoid scrubbing of 'secrets' during
 // marshaling
 type Config struct {
@@ -67,6 +78,20 @@ func (c *Config) initializeNetworkBaseRoute(route *config.Route, networkID strin
 // Validate makes sure that the config is properly formed. Unmarshal the yaml
 // data into an alertmanager Config struct to ensure that it is properly formed
 func (c *Config) Validate() error {
+	for _, rec := range c.Receivers {
+		for _, ogc := range rec.OpsGenieConfigs {
+			// Don't fall back to a global API key shared between networks
+			if ogc.APIKey == "" {
+				return fmt.Errorf("missing API key in OpsGenie config")
+			}
+		}
+		for _, mtc := range rec.MSTeamsConfigs {
+			if err := mtc.validate(); err != nil {
+				return err
+			}
+		}
+	}
+
 	yamlData, err := yaml.Marshal(c)
 	if err != nil {
 		return err
@@ -86,6 +111,51 @@ type Receiver struct {
 	SlackConfigs   []*SlackConfig          `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
 	WebhookConfigs []*config.WebhookConfig `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
 	EmailConfigs   []*EmailConfig          `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+
+	PagerDutyConfigs []*PagerDutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	OpsGenieConfigs  []*OpsGenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+
+	// MSTeamsConfigs are written to the config file as webhook configs, see
+	// MSTeamsBridgeURL
+	MSTeamsConfigs []*MSTeamsConfig `yaml:"-" json:"msteams_configs,omitempty"`
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Receiver and
+// appends a webhook config to the bridge for each Teams config.
+func (r Receiver) MarshalYAML() (interface{}, error) {
+	type plain Receiver
+	ret := plain(r)
+	if len(r.MSTeamsConfigs) == 0 {
+		return ret, nil
+	}
+	ret.WebhookConfigs = append([]*config.WebhookConfig{}, r.WebhookConfigs...)
+	for _, mtc := range r.MSTeamsConfigs {
+		webhookConfig, err := mtc.toWebhookConfig()
+		if err != nil {
+			return nil, err
+		}
+		ret.WebhookConfigs = append(ret.WebhookConfigs, webhookConfig)
+	}
+	return ret, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Receiver and
+// turns webhook configs pointing at the bridge back into Teams configs.
+func (r *Receiver) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plain Receiver
+	if err := unmarshal((*plain)(r)); err != nil {
+		return err
+	}
+	var webhookConfigs []*config.WebhookConfig
+	for _, wc := range r.WebhookConfigs {
+		if mtc, ok := newMSTeamsConfig(wc); ok {
+			r.MSTeamsConfigs = append(r.MSTeamsConfigs, mtc)
+			continue
+		}
+		webhookConfigs = append(webhookConfigs, wc)
+	}
+	r.WebhookConfigs = webhookConfigs
+	return nil
 }
 
 // Secure replaces the receiver's name with a networkID prefix
@@ -164,6 +234,96 @@ func (e EmailConfig) MarshalYAML() (interface{}, error) {
 	return e, nil
 }
 
+// PagerDutyConfig uses string instead of Secret for the RoutingKey and
+// ServiceKey fields so that they are marshaled as is instead of being obscured
+// which is how alertmanager handles secrets
+type PagerDutyConfig struct {
+	config.NotifierConfig `yaml:",inline" json:",inline"`
+
+	RoutingKey  string                   `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	ServiceKey  string                   `yaml:"service_key,omitempty" json:"service_key,omitempty"`
+	URL         string                   `yaml:"url,omitempty" json:"url,omitempty"`
+	Client      string                   `yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL   string                   `yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Description string                   `yaml:"description,omitempty" json:"description,omitempty"`
+	Details     map[string]string        `yaml:"details,omitempty" json:"details,omitempty"`
+	Images      []*config.PagerdutyImage `yaml:"images,omitempty" json:"images,omitempty"`
+	Links       []*config.PagerdutyLink  `yaml:"links,omitempty" json:"links,omitempty"`
+	Severity    string                   `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Class       string                   `yaml:"class,omitempty" json:"class,omitempty"`
+	Component   string                   `yaml:"component,omitempty" json:"component,omitempty"`
+	Group       string                   `yaml:"group,omitempty" json:"group,omitempty"`
+}
+
+// OpsGenieConfig uses string instead of Secret for the APIKey field so that it
+// is marshaled as is instead of being obscured which is how alertmanager
+// handles secrets. The APIKey is required, since falling back to the global
+// key would share it between networks.
+type OpsGenieConfig struct {
+	config.NotifierConfig `yaml:",inline" json:",inline"`
+
+	APIKey      string            `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	APIURL      string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Message     string            `yaml:"message,omitempty" json:"message,omitempty"`
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Source      string            `yaml:"source,omitempty" json:"source,omitempty"`
+	Details     map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
+	Teams       string            `yaml:"teams,omitempty" json:"teams,omitempty"`
+	Tags        string            `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Note        string            `yaml:"note,omitempty" json:"note,omitempty"`
+	Priority    string            `yaml:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// MSTeamsConfig is a Microsoft Teams receiver. Alerts are posted to the
+// incoming webhook at WebhookURL through the prometheus-msteams bridge, which
+// formats them as message cards with its card template.
+type MSTeamsConfig struct {
+	SendResolved bool   `yaml:"send_resolved,omitempty" json:"send_resolved,omitempty"`
+	WebhookURL   string `yaml:"webhook_url" json:"webhook_url"`
+}
+
+func (m *MSTeamsConfig) validate() error {
+	webhookURL, err := url.Parse(m.WebhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL in Microsoft Teams config: %v", err)
+	}
+	if webhookURL.Scheme != "https" || webhookURL.Host == "" {
+		return fmt.Errorf("webhook URL in Microsoft Teams config must be an https URL, got '%s'", m.WebhookURL)
+	}
+	return nil
+}
+
+func (m *MSTeamsConfig) toWebhookConfig() (*config.WebhookConfig, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	bridgeURL, err := url.Parse(strings.TrimSuffix(MSTeamsBridgeURL, "/") + msteamsBridgePath + strings.TrimPrefix(m.WebhookURL, "https://"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Microsoft Teams bridge URL: %v", err)
+	}
+	return &config.WebhookConfig{
+		NotifierConfig: config.NotifierConfig{VSendResolved: m.SendResolved},
+		URL:            &config.URL{URL: bridgeURL},
+	}, nil
+}
+
+// newMSTeamsConfig returns the Teams config a webhook config was written for,
+// if it points at the bridge
+func newMSTeamsConfig(webhookConfig *config.WebhookConfig) (*MSTeamsConfig, bool) {
+	if webhookConfig == nil || webhookConfig.URL == nil || webhookConfig.URL.URL == nil {
+		return nil, false
+	}
+	prefix := strings.TrimSuffix(MSTeamsBridgeURL, "/") + msteamsBridgePath
+	webhookURL := webhookConfig.URL.String()
+	if !strings.HasPrefix(webhookURL, prefix) {
+		return nil, false
+	}
+	return &MSTeamsConfig{
+		SendResolved: webhookConfig.SendResolved(),
+		WebhookURL:   "https://" + strings.TrimPrefix(webhookURL, prefix),
+	}, true
+}
+
 // RouteJSONWrapper Provides a struct to marshal/unmarshal into a rulefmt.Rule
 // since rulefmt does not support json encoding
 type RouteJSONWrapper struct {
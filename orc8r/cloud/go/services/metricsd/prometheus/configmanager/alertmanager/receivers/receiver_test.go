@@ -63,10 +63,34 @@ var (
 			Smarthost: "http://mail-server.com",
 		}},
 	}
+	samplePagerDutyReceiver = Receiver{
+		Name: "pagerduty_receiver",
+		PagerDutyConfigs: []*PagerDutyConfig{{
+			RoutingKey: "0123456789abcdef",
+			Severity:   "critical",
+			Links:      []*config.PagerdutyLink{{HRef: "http://grafana.com", Text: "dashboard"}},
+		}},
+	}
+	sampleOpsGenieReceiver = Receiver{
+		Name: "opsgenie_receiver",
+		OpsGenieConfigs: []*OpsGenieConfig{{
+			APIKey:   "fedcba9876543210",
+			Priority: "P1",
+			Teams:    "noc",
+		}},
+	}
+	sampleMSTeamsReceiver = Receiver{
+		Name: "msteams_receiver",
+		MSTeamsConfigs: []*MSTeamsConfig{{
+			SendResolved: true,
+			WebhookURL:   "https://outlook.office.com/webhook/abc@def/IncomingWebhook/123/456",
+		}},
+	}
 	sampleConfig = Config{
 		Route: &sampleRoute,
 		Receivers: []*Receiver{
 			&sampleSlackReceiver, &sampleReceiver, &sampleWebhookReceiver, &sampleEmailReceiver,
+			&samplePagerDutyReceiver, &sampleOpsGenieReceiver, &sampleMSTeamsReceiver,
 		},
 	}
 )
@@ -126,6 +150,107 @@ func TestConfig_Validate(t *testing.T) {
 	}
 	err = invalidSlackAction.Validate()
 	assert.EqualError(t, err, `missing type in Slack action configuration`)
+
+	validPagingConfig := Config{
+		Route: &config.Route{
+			Receiver: "pagerduty_receiver",
+			Routes:   []*config.Route{{Receiver: "opsgenie_receiver"}},
+		},
+		Receivers: []*Receiver{&samplePagerDutyReceiver, &sampleOpsGenieReceiver},
+	}
+	err = validPagingConfig.Validate()
+	assert.NoError(t, err)
+
+	// Fail if PagerDuty has neither a routing nor a service key
+	invalidPagerDutyConfig := Config{
+		Route: &config.Route{
+			Receiver: "invalidPagerDuty",
+		},
+		Receivers: []*Receiver{{
+			Name:             "invalidPagerDuty",
+			PagerDutyConfigs: []*PagerDutyConfig{{Severity: "critical"}},
+		}},
+	}
+	err = invalidPagerDutyConfig.Validate()
+	assert.EqualError(t, err, `missing service or routing key in PagerDuty config`)
+
+	// Fail if OpsGenie would use the global API key
+	invalidOpsGenieConfig := Config{
+		Route: &config.Route{
+			Receiver: "invalidOpsGenie",
+		},
+		Receivers: []*Receiver{{
+			Name:            "invalidOpsGenie",
+			OpsGenieConfigs: []*OpsGenieConfig{{Priority: "P1"}},
+		}},
+		Global: &config.GlobalConfig{OpsGenieAPIKey: "global_key"},
+	}
+	err = invalidOpsGenieConfig.Validate()
+	assert.EqualError(t, err, `missing API key in OpsGenie config`)
+
+	invalidOpsGenieURL := Config{
+		Route: &config.Route{
+			Receiver: "invalidOpsGenieURL",
+		},
+		Receivers: []*Receiver{{
+			Name:            "invalidOpsGenieURL",
+			OpsGenieConfigs: []*OpsGenieConfig{{APIKey: "key", APIURL: "invalidURL"}},
+		}},
+	}
+	err = invalidOpsGenieURL.Validate()
+	assert.EqualError(t, err, `unsupported scheme "" for URL`)
+
+	validMSTeamsConfig := Config{
+		Route:     &config.Route{Receiver: "msteams_receiver"},
+		Receivers: []*Receiver{&sampleMSTeamsReceiver},
+	}
+	err = validMSTeamsConfig.Validate()
+	assert.NoError(t, err)
+
+	// Teams webhooks are always https
+	invalidMSTeamsConfig := Config{
+		Route: &config.Route{
+			Receiver: "invalidMSTeams",
+		},
+		Receivers: []*Receiver{{
+			Name:           "invalidMSTeams",
+			MSTeamsConfigs: []*MSTeamsConfig{{WebhookURL: "outlook.office.com/webhook/123"}},
+		}},
+	}
+	err = invalidMSTeamsConfig.Validate()
+	assert.EqualError(t, err, `webhook URL in Microsoft Teams config must be an https URL, got 'outlook.office.com/webhook/123'`)
+}
+
+// TestMarshalYamlMSTeamsConfigs checks that Teams receivers are written as
+// webhooks to the bridge and read back as Teams receivers
+func TestMarshalYamlMSTeamsConfigs(t *testing.T) {
+	receiver := sampleMSTeamsReceiver
+	receiver.WebhookConfigs = sampleWebhookReceiver.WebhookConfigs
+	ymlData, err := yaml.Marshal(Config{Receivers: []*Receiver{&receiver}})
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(ymlData), "url: http://prometheus-msteams:2000/_dynamicwebhook/outlook.office.com/webhook/abc@def/IncomingWebhook/123/456"))
+	assert.False(t, strings.Contains(string(ymlData), "msteams_configs"))
+	// The receiver itself isn't modified
+	assert.Equal(t, 1, len(receiver.WebhookConfigs))
+
+	var readConfig Config
+	err = yaml.Unmarshal(ymlData, &readConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(readConfig.Receivers))
+	assert.Equal(t, sampleMSTeamsReceiver.MSTeamsConfigs, readConfig.Receivers[0].MSTeamsConfigs)
+	assert.Equal(t, 1, len(readConfig.Receivers[0].WebhookConfigs))
+	assert.Equal(t, "http://test.com", readConfig.Receivers[0].WebhookConfigs[0].URL.String())
+}
+
+// TestMarshalYamlPagingConfigs checks that the paging secrets are written to
+// the yml file as is
+func TestMarshalYamlPagingConfigs(t *testing.T) {
+	ymlData, err := yaml.Marshal(Config{
+		Receivers: []*Receiver{&samplePagerDutyReceiver, &sampleOpsGenieReceiver},
+	})
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(string(ymlData), "routing_key: 0123456789abcdef"))
+	assert.True(t, strings.Contains(string(ymlData), "api_key: fedcba9876543210"))
 }
 
 func TestConfig_GetReceiver(t *testing.T) {
@@ -141,6 +266,15 @@ func TestConfig_GetReceiver(t *testing.T) {
 	rec = sampleConfig.GetReceiver("email_receiver")
 	assert.NotNil(t, rec)
 
+	rec = sampleConfig.GetReceiver("pagerduty_receiver")
+	assert.NotNil(t, rec)
+
+	rec = sampleConfig.GetReceiver("opsgenie_receiver")
+	assert.NotNil(t, rec)
+
+	rec = sampleConfig.GetReceiver("msteams_receiver")
+	assert.NotNil(t, rec)
+
 	rec = sampleConfig.GetReceiver("nonRoute")
 	assert.Nil(t, rec)
 }
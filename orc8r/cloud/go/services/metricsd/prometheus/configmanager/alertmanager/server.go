@@ -30,8 +30,11 @@ func main() {
 	port := flag.String("port", defaultPort, fmt.Sprintf("Port to listen for requests. Default is %s", defaultPort))
 	alertmanagerConfPath := flag.String("alertmanager-conf", defaultAlertmanagerConfigPath, fmt.Sprintf("Path to alertmanager configuration file. Default is %s", defaultAlertmanagerConfigPath))
 	alertmanagerURL := flag.String("alertmanagerURL", defaultAlertmanagerURL, fmt.Sprintf("URL of the alertmanager instance that is being used. Default is %s", defaultAlertmanagerURL))
+	msteamsBridgeURL := flag.String("msteams-bridge-url", receivers.MSTeamsBridgeURL, fmt.Sprintf("URL of the prometheus-msteams bridge that Microsoft Teams receivers are sent through. Default is %s", receivers.MSTeamsBridgeURL))
 	flag.Parse()
 
+	receivers.MSTeamsBridgeURL = *msteamsBridgeURL
+
 	e := echo.New()
 
 	e.GET("/", statusHandler)
@@ -45,6 +48,11 @@ func main() {
 	e.POST(RoutePath, GetUpdateRouteHandler(receiverClient))
 	e.GET(RoutePath, GetGetRouteHandler(receiverClient))
 
+	e.POST(InhibitRulePath, GetInhibitRulePostHandler(receiverClient))
+	e.GET(InhibitRulePath, GetGetInhibitRulesHandler(receiverClient))
+	e.DELETE(InhibitRulePath, GetDeleteInhibitRuleHandler(receiverClient))
+	e.PUT(InhibitRulePath+"/:"+InhibitRuleIDPathParam, GetUpdateInhibitRuleHandler(receiverClient))
+
 	glog.Infof("Alertmanager Config server listening on port: %s\n", *port)
 	e.Logger.Fatal(e.Start(fmt.Sprintf(":%s", *port)))
 }
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+)
+
+const (
+	remoteWriteVersion = "0.1.0"
+	remoteWriteTimeout = time.Second * 30
+	metricNameLabel    = "__name__"
+)
+
+// RemoteWriteExporter writes metrics to a Prometheus remote-write endpoint,
+// such as Prometheus, Thanos, Cortex or VictoriaMetrics
+type RemoteWriteExporter struct {
+	url     string
+	client  *http.Client
+	batcher *mxd_exp.Batcher
+}
+
+// NewRemoteWriteExporter creates a new exporter to the remote-write endpoint
+// at url
+func NewRemoteWriteExporter(url string, config mxd_exp.BatchConfig) mxd_exp.Exporter {
+	if !strings.HasPrefix(url, "http") {
+		url = fmt.Sprintf("http://%s", url)
+	}
+	exporter := &RemoteWriteExporter{
+		url:    url,
+		client: &http.Client{Timeout: remoteWriteTimeout},
+	}
+	exporter.batcher = mxd_exp.NewBatcher("remote-write endpoint "+url, config, exporter.write)
+	return exporter
+}
+
+// Submit buffers metrics to be written with the next batch
+func (e *RemoteWriteExporter) Submit(metrics []mxd_exp.MetricAndContext) error {
+	e.batcher.Add(metrics)
+	return nil
+}
+
+// Start continuously writes batches of buffered metrics
+func (e *RemoteWriteExporter) Start() {
+	e.batcher.Start()
+}
+
+func (e *RemoteWriteExporter) write(batch []mxd_exp.MetricAndContext) error {
+	request := makeWriteRequest(batch)
+	if len(request.Timeseries) == 0 {
+		return nil
+	}
+	data, err := request.Marshal()
+	if err != nil {
+		return mxd_exp.NewPermanentError(fmt.Errorf("error marshaling write request: %v", err))
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, e.url, bytes.NewReader(snappy.Encode(nil, data)))
+	if err != nil {
+		return mxd_exp.NewPermanentError(err)
+	}
+	httpReq.Header.Set("Content-Encoding", "snappy")
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	httpReq.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+
+	resp, err := e.client.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	err = fmt.Errorf("error writing to %s: %s %s", e.url, resp.Status, string(respBody))
+	// Client errors other than rate limiting won't succeed on retry
+	if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusTooManyRequests {
+		return mxd_exp.NewPermanentError(err)
+	}
+	return err
+}
+
+// makeWriteRequest converts metrics to gauge time series, merging samples of
+// the same series
+func makeWriteRequest(batch []mxd_exp.MetricAndContext) *prompb.WriteRequest {
+	seriesByKey := map[string]*prompb.TimeSeries{}
+	request := &prompb.WriteRequest{}
+	for _, metricAndContext := range batch {
+		family := metricAndContext.Family
+		familyCopy := &dto.MetricFamily{
+			Name:   sanitizePrometheusName(metricAndContext.Context.MetricName),
+			Type:   family.Type,
+			Metric: family.Metric,
+		}
+		for _, gaugeFamily := range convertFamilyToGauges(familyCopy) {
+			name := gaugeFamily.GetName()
+			for _, metric := range dropInvalidMetrics(gaugeFamily.Metric, name) {
+				labels := makeRemoteWriteLabels(name, metric.Label)
+				key := labelsKey(labels)
+				series, ok := seriesByKey[key]
+				if !ok {
+					series = &prompb.TimeSeries{Labels: labels}
+					seriesByKey[key] = series
+					request.Timeseries = append(request.Timeseries, series)
+				}
+				// Converted gauges don't keep the timestamp, so take it from
+				// the submitted metric
+				series.Samples = append(series.Samples, prompb.Sample{
+					Value:     metric.GetGauge().GetValue(),
+					Timestamp: family.Metric[0].GetTimestampMs(),
+				})
+			}
+		}
+	}
+	for _, series := range request.Timeseries {
+		samples := series.Samples
+		sort.SliceStable(samples, func(i, j int) bool { return samples[i].Timestamp < samples[j].Timestamp })
+	}
+	return request
+}
+
+// makeRemoteWriteLabels returns the labels of a series sorted by name, as
+// required by the remote-write protocol
+func makeRemoteWriteLabels(name string, labelPairs []*dto.LabelPair) []*prompb.Label {
+	labels := make([]*prompb.Label, 0, len(labelPairs)+1)
+	labels = append(labels, &prompb.Label{Name: metricNameLabel, Value: name})
+	for _, labelPair := range labelPairs {
+		labels = append(labels, &prompb.Label{Name: labelPair.GetName(), Value: labelPair.GetValue()})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Name < labels[j].Name })
+	return labels
+}
+
+func labelsKey(labels []*prompb.Label) string {
+	builder := strings.Builder{}
+	for _, label := range labels {
+		builder.WriteString(label.Name)
+		builder.WriteByte(0)
+		builder.WriteString(label.Value)
+		builder.WriteByte(0)
+	}
+	return builder.String()
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/metrics"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+)
+
+type remoteWriteServer struct {
+	sync.Mutex
+	requests []*prompb.WriteRequest
+	statuses []int
+}
+
+func (s *remoteWriteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if len(s.statuses) > 0 {
+		status := s.statuses[0]
+		s.statuses = s.statuses[1:]
+		w.WriteHeader(status)
+		return
+	}
+	if r.Header.Get("Content-Encoding") != "snappy" || r.Header.Get("X-Prometheus-Remote-Write-Version") != remoteWriteVersion {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	compressed, _ := ioutil.ReadAll(r.Body)
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	request := &prompb.WriteRequest{}
+	if err := request.Unmarshal(data); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	s.requests = append(s.requests, request)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestRemoteWriteExporter(t *testing.T) {
+	server := &remoteWriteServer{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	exp := NewRemoteWriteExporter(httpServer.URL, exporters.BatchConfig{
+		BatchSize:      100,
+		FlushInterval:  time.Hour,
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+	}).(*RemoteWriteExporter)
+
+	timestamp := int64(1500000000000)
+	gauge := tests.MakePromoGauge(3)
+	gauge.Label = sampleLabels
+	gauge.TimestampMs = &timestamp
+	laterGauge := tests.MakePromoGauge(4)
+	laterGauge.Label = sampleLabels
+	laterTimestamp := timestamp + 1000
+	laterGauge.TimestampMs = &laterTimestamp
+	histogram := tests.MakePromoHistogram([]float64{1, 5}, []float64{0.5, 3})
+	histogram.Label = sampleLabels
+	histogram.TimestampMs = &timestamp
+	invalidLabel := tests.MakePromoGauge(1)
+	invalidLabel.Label = []*dto.LabelPair{{Name: tests.MakeStringPointer("invalid-label"), Value: tests.MakeStringPointer("")}}
+	err := exp.Submit([]exporters.MetricAndContext{
+		{
+			Family:  &dto.MetricFamily{Name: tests.MakeStringPointer("gauge"), Type: tests.MakeMetricTypePointer(dto.MetricType_GAUGE), Metric: []*dto.Metric{&laterGauge, &gauge, &invalidLabel}},
+			Context: sampleGatewayContext,
+		},
+		{
+			Family:  &dto.MetricFamily{Name: tests.MakeStringPointer("histogram"), Type: tests.MakeMetricTypePointer(dto.MetricType_HISTOGRAM), Metric: []*dto.Metric{&histogram}},
+			Context: exporters.MetricsContext{MetricName: "latency-ms", AdditionalContext: &exporters.CloudMetricContext{CloudHost: "host"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, exp.batcher.Flush())
+
+	assert.Len(t, server.requests, 1)
+	series := server.requests[0].Timeseries
+	// the gauge's samples are merged into one series and sorted by time
+	assert.Equal(t, &prompb.TimeSeries{
+		Labels: []*prompb.Label{
+			{Name: metricNameLabel, Value: sampleMetricName},
+			{Name: metrics.NetworkLabelName, Value: sampleNetworkID},
+			{Name: "testLabel", Value: "testValue"},
+		},
+		Samples: []prompb.Sample{{Value: 3, Timestamp: timestamp}, {Value: 4, Timestamp: laterTimestamp}},
+	}, series[0])
+	// the histogram is converted to bucket, sum and count series with a
+	// sanitized name
+	assert.Len(t, series, 5)
+	names := []string{}
+	for _, s := range series[1:] {
+		names = append(names, s.Labels[0].Value)
+		assert.Equal(t, []prompb.Sample{s.Samples[0]}, s.Samples)
+		assert.Equal(t, timestamp, s.Samples[0].Timestamp)
+	}
+	assert.Equal(t, []string{"latency_ms_bucket", "latency_ms_bucket", "latency_ms_sum", "latency_ms_count"}, names)
+	assert.Equal(t, &prompb.Label{Name: "le", Value: "5"}, series[2].Labels[1])
+	assert.Equal(t, float64(2), series[2].Samples[0].Value)
+	assert.Equal(t, float64(2), series[4].Samples[0].Value)
+}
+
+func TestRemoteWriteExporter_Errors(t *testing.T) {
+	server := &remoteWriteServer{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	exp := NewRemoteWriteExporter(httpServer.URL, exporters.BatchConfig{
+		BatchSize:      100,
+		FlushInterval:  time.Hour,
+		MaxRetries:     2,
+		InitialBackoff: time.Millisecond,
+	}).(*RemoteWriteExporter)
+
+	// server errors and rate limiting are retried
+	server.statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{makeTestGaugeMetric()}))
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Len(t, server.requests, 1)
+
+	// metrics are kept after retries run out
+	server.statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{makeTestGaugeMetric()}))
+	assert.Error(t, exp.batcher.Flush())
+	assert.Equal(t, 1, exp.batcher.Len())
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Len(t, server.requests, 2)
+
+	// rejected metrics are dropped
+	server.statuses = []int{http.StatusBadRequest}
+	assert.NoError(t, exp.Submit([]exporters.MetricAndContext{makeTestGaugeMetric()}))
+	assert.NoError(t, exp.batcher.Flush())
+	assert.Equal(t, 0, exp.batcher.Len())
+	assert.Len(t, server.requests, 2)
+}
+
+func makeTestGaugeMetric() exporters.MetricAndContext {
+	return exporters.MetricAndContext{
+		Family:  tests.MakeTestMetricFamily(dto.MetricType_GAUGE, 1, sampleLabels),
+		Context: sampleGatewayContext,
+	}
+}
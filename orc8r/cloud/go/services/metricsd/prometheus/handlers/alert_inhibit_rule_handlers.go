@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/services/metricsd/prometheus/configmanager/alertmanager/receivers"
+
+	"github.com/labstack/echo"
+	"github.com/prometheus/alertmanager/config"
+)
+
+const (
+	alertInhibitRulePart = "alert_inhibit_rule"
+
+	InhibitRuleIDPathParam  = "rule_id"
+	InhibitRuleIDQueryParam = "rule_id"
+
+	AlertInhibitRuleURL       = PrometheusRoot + obsidian.UrlSep + alertInhibitRulePart
+	AlertInhibitRuleUpdateURL = AlertInhibitRuleURL + obsidian.UrlSep + ":" + InhibitRuleIDPathParam
+
+	AlertInhibitRuleV1URL       = PrometheusV1Root + obsidian.UrlSep + alertInhibitRulePart
+	AlertInhibitRuleUpdateV1URL = AlertInhibitRuleV1URL + obsidian.UrlSep + ":" + InhibitRuleIDPathParam
+)
+
+func GetConfigureInhibitRuleHandler(configManagerURL string) func(c echo.Context) error {
+	return getHandlerWithInhibitRuleFunc(configManagerURL, configureInhibitRule)
+}
+
+func GetRetrieveInhibitRulesHandler(configManagerURL string) func(c echo.Context) error {
+	return getHandlerWithInhibitRuleFunc(configManagerURL, retrieveInhibitRules)
+}
+
+func GetUpdateInhibitRuleHandler(configManagerURL string) func(c echo.Context) error {
+	return getHandlerWithInhibitRuleFunc(configManagerURL, updateInhibitRule)
+}
+
+func GetDeleteInhibitRuleHandler(configManagerURL string) func(c echo.Context) error {
+	return getHandlerWithInhibitRuleFunc(configManagerURL, deleteInhibitRule)
+}
+
+// getHandlerWithInhibitRuleFunc returns an echo HandlerFunc that checks the
+// networkID and runs the given handlerImplFunc that communicates with the
+// alertmanager config service for inhibition rules
+func getHandlerWithInhibitRuleFunc(configManagerURL string, handlerImplFunc func(echo.Context, string) error) func(echo.Context) error {
+	return func(c echo.Context) error {
+		networkID, nerr := obsidian.GetNetworkId(c)
+		if nerr != nil {
+			return nerr
+		}
+		url := makeNetworkInhibitRulePath(configManagerURL, networkID)
+		return handlerImplFunc(c, url)
+	}
+}
+
+func configureInhibitRule(c echo.Context, url string) error {
+	rule, err := buildInhibitRuleFromContext(c)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+
+	ruleID, sendErr := sendInhibitRule(rule, url, http.MethodPost)
+	if sendErr != nil {
+		return obsidian.HttpError(fmt.Errorf("%s", sendErr.Message), sendErr.Code)
+	}
+	return c.JSON(http.StatusCreated, ruleID)
+}
+
+func retrieveInhibitRules(c echo.Context, url string) error {
+	client := &http.Client{}
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body echo.HTTPError
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		return obsidian.HttpError(fmt.Errorf("error reading inhibit rules: %v", body.Message), resp.StatusCode)
+	}
+	var rules []receivers.InhibitRule
+	err = json.NewDecoder(resp.Body).Decode(&rules)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error decoding server response %v", err), http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, rules)
+}
+
+func updateInhibitRule(c echo.Context, url string) error {
+	rule, err := buildInhibitRuleFromContext(c)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	url += "/" + neturl.PathEscape(c.Param(InhibitRuleIDPathParam))
+
+	// The rule's ID changes along with its matchers, so the new one is
+	// returned to the caller
+	ruleID, sendErr := sendInhibitRule(rule, url, http.MethodPut)
+	if sendErr != nil {
+		return obsidian.HttpError(fmt.Errorf("%s", sendErr.Message), sendErr.Code)
+	}
+	return c.JSON(http.StatusOK, ruleID)
+}
+
+func deleteInhibitRule(c echo.Context, url string) error {
+	ruleID := c.QueryParam(InhibitRuleIDQueryParam)
+	if ruleID == "" {
+		return obsidian.HttpError(fmt.Errorf("missing rule ID"), http.StatusBadRequest)
+	}
+	url += fmt.Sprintf("?%s=%s", InhibitRuleIDQueryParam, neturl.QueryEscape(ruleID))
+
+	client := &http.Client{}
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body echo.HTTPError
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		return obsidian.HttpError(fmt.Errorf("error deleting inhibit rule: %v", body.Message), resp.StatusCode)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// sendInhibitRule sends a rule to the alertmanager config service and returns
+// the rule's ID from its response
+func sendInhibitRule(rule config.InhibitRule, url string, method string) (string, *echo.HTTPError) {
+	requestBody, err := json.Marshal(rule)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	client := &http.Client{}
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(requestBody))
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("error making %s request: %v", method, err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body echo.HTTPError
+		_ = json.NewDecoder(resp.Body).Decode(&body)
+		return "", echo.NewHTTPError(resp.StatusCode, fmt.Errorf("error writing inhibit rule: %v", body.Message))
+	}
+	var ruleID string
+	err = json.NewDecoder(resp.Body).Decode(&ruleID)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf("error decoding server response %v", err))
+	}
+	return ruleID, nil
+}
+
+func buildInhibitRuleFromContext(c echo.Context) (config.InhibitRule, error) {
+	rule := config.InhibitRule{}
+	err := json.NewDecoder(c.Request().Body).Decode(&rule)
+	if err != nil {
+		return config.InhibitRule{}, err
+	}
+	return rule, nil
+}
+
+func makeNetworkInhibitRulePath(configManagerURL, networkID string) string {
+	return configManagerURL + "/" + networkID + "/inhibit_rule"
+}
@@ -18,6 +18,7 @@ import (
 const (
 	webhookURL = "http://test.com"
 	slackURL   = "http://slack.com"
+	msteamsURL = "https://outlook.office.com/webhook/123"
 )
 
 var (
@@ -33,8 +34,27 @@ var (
       {
          "api_url": "%s"
       }
+      ],
+      "pagerduty_configs": [
+      {
+         "send_resolved": true,
+         "routing_key": "routing_key",
+         "links": [{"href": "%s", "text": "link"}]
+      }
+      ],
+      "opsgenie_configs": [
+      {
+         "api_key": "api_key",
+         "priority": "P1"
+      }
+      ],
+      "msteams_configs": [
+      {
+         "send_resolved": true,
+         "webhook_url": "%s"
+      }
       ]
-    }`, webhookURL, slackURL)
+    }`, webhookURL, slackURL, webhookURL, msteamsURL)
 
 	testWebhookURL, _ = url.Parse(webhookURL)
 	testWebhookConfig = config.WebhookConfig{
@@ -48,6 +68,21 @@ var (
 	testSlackConfig = receivers.SlackConfig{
 		APIURL: slackURL,
 	}
+	testPagerDutyConfig = receivers.PagerDutyConfig{
+		NotifierConfig: config.NotifierConfig{
+			VSendResolved: true,
+		},
+		RoutingKey: "routing_key",
+		Links:      []*config.PagerdutyLink{{HRef: webhookURL, Text: "link"}},
+	}
+	testOpsGenieConfig = receivers.OpsGenieConfig{
+		APIKey:   "api_key",
+		Priority: "P1",
+	}
+	testMSTeamsConfig = receivers.MSTeamsConfig{
+		SendResolved: true,
+		WebhookURL:   msteamsURL,
+	}
 )
 
 func TestBuildReceiverFromContext(t *testing.T) {
@@ -62,4 +97,10 @@ func TestBuildReceiverFromContext(t *testing.T) {
 	assert.Equal(t, 1, len(receiver.WebhookConfigs))
 	assert.Equal(t, testWebhookConfig, *receiver.WebhookConfigs[0])
 	assert.Equal(t, testSlackConfig, *receiver.SlackConfigs[0])
+	assert.Equal(t, 1, len(receiver.PagerDutyConfigs))
+	assert.Equal(t, testPagerDutyConfig, *receiver.PagerDutyConfigs[0])
+	assert.Equal(t, 1, len(receiver.OpsGenieConfigs))
+	assert.Equal(t, testOpsGenieConfig, *receiver.OpsGenieConfigs[0])
+	assert.Equal(t, 1, len(receiver.MSTeamsConfigs))
+	assert.Equal(t, testMSTeamsConfig, *receiver.MSTeamsConfigs[0])
 }
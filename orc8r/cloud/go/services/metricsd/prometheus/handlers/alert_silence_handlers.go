@@ -0,0 +1,242 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"strings"
+
+	"magma/orc8r/cloud/go/metrics"
+	"magma/orc8r/cloud/go/obsidian"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/prometheus/alertmanager/api/v2/models"
+)
+
+const (
+	alertSilencePart       = "alert_silence"
+	SilenceIDQueryParam    = "silence_id"
+	SilenceStateQueryParam = "state"
+
+	AlertSilenceURL   = PrometheusRoot + obsidian.UrlSep + alertSilencePart
+	AlertSilenceV1URL = PrometheusV1Root + obsidian.UrlSep + alertSilencePart
+)
+
+// silenceIDResponse is the body alertmanager returns when a silence is
+// created or updated
+type silenceIDResponse struct {
+	SilenceID string `json:"silenceID"`
+}
+
+// GetCreateSilenceHandler returns a handler which creates a silence scoped to
+// the network, or updates one of the network's silences if an ID is given.
+// alertmanagerURL is the URL of alertmanager's alerts API, as used for
+// viewing firing alerts.
+func GetCreateSilenceHandler(alertmanagerURL string) func(c echo.Context) error {
+	return getHandlerWithSilenceFunc(alertmanagerURL, createSilence)
+}
+
+// GetRetrieveSilencesHandler returns a handler which lists the network's
+// silences, optionally filtered by their state (active, pending, expired)
+func GetRetrieveSilencesHandler(alertmanagerURL string) func(c echo.Context) error {
+	return getHandlerWithSilenceFunc(alertmanagerURL, retrieveSilences)
+}
+
+// GetExpireSilenceHandler returns a handler which expires one of the
+// network's silences
+func GetExpireSilenceHandler(alertmanagerURL string) func(c echo.Context) error {
+	return getHandlerWithSilenceFunc(alertmanagerURL, expireSilence)
+}
+
+func getHandlerWithSilenceFunc(alertmanagerURL string, handlerImplFunc func(echo.Context, string, string) error) func(echo.Context) error {
+	return func(c echo.Context) error {
+		networkID, nerr := obsidian.GetNetworkId(c)
+		if nerr != nil {
+			return nerr
+		}
+		return handlerImplFunc(c, networkID, alertmanagerAPIRoot(alertmanagerURL))
+	}
+}
+
+func createSilence(c echo.Context, networkID, apiRoot string) error {
+	silence := models.PostableSilence{}
+	err := json.NewDecoder(c.Request().Body).Decode(&silence)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("misconfigured silence: %v", err), http.StatusBadRequest)
+	}
+	secureSilence(networkID, &silence.Silence)
+	err = silence.Validate(strfmt.Default)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("invalid silence: %v", err), http.StatusBadRequest)
+	}
+
+	// Alertmanager updates the silence with the given ID, so make sure it
+	// belongs to this network
+	if silence.ID != "" {
+		err = checkNetworkSilence(networkID, apiRoot, silence.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	requestBody, err := json.Marshal(silence)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	resp, err := http.Post(apiRoot+"/silences", "application/json", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error creating silence: %v", err), http.StatusInternalServerError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return obsidian.HttpError(fmt.Errorf("error creating silence: %s", readAlertmanagerError(resp)), resp.StatusCode)
+	}
+	var silenceID silenceIDResponse
+	err = json.NewDecoder(resp.Body).Decode(&silenceID)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error decoding alertmanager response: %v", err), http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusCreated, silenceID)
+}
+
+func retrieveSilences(c echo.Context, networkID, apiRoot string) error {
+	resp, err := http.Get(apiRoot + "/silences")
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error reading silences: %v", err), http.StatusInternalServerError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return obsidian.HttpError(fmt.Errorf("error reading silences: %s", readAlertmanagerError(resp)), resp.StatusCode)
+	}
+	var silences models.GettableSilences
+	err = json.NewDecoder(resp.Body).Decode(&silences)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error decoding alertmanager response: %v", err), http.StatusInternalServerError)
+	}
+
+	state := c.QueryParam(SilenceStateQueryParam)
+	networkSilences := make(models.GettableSilences, 0)
+	for _, silence := range silences {
+		if !isNetworkSilence(networkID, &silence.Silence) {
+			continue
+		}
+		if state != "" && (silence.Status == nil || swag.StringValue(silence.Status.State) != state) {
+			continue
+		}
+		networkSilences = append(networkSilences, silence)
+	}
+	return c.JSON(http.StatusOK, networkSilences)
+}
+
+func expireSilence(c echo.Context, networkID, apiRoot string) error {
+	silenceID := c.QueryParam(SilenceIDQueryParam)
+	if silenceID == "" {
+		return obsidian.HttpError(fmt.Errorf("silence ID not provided"), http.StatusBadRequest)
+	}
+	err := checkNetworkSilence(networkID, apiRoot, silenceID)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, apiRoot+"/silence/"+neturl.PathEscape(silenceID), nil)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("could not form request: %v", err), http.StatusInternalServerError)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error expiring silence: %v", err), http.StatusInternalServerError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return obsidian.HttpError(fmt.Errorf("error expiring silence: %s", readAlertmanagerError(resp)), resp.StatusCode)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// checkNetworkSilence returns a not found error if the silence with the
+// given ID doesn't exist or belongs to another network
+func checkNetworkSilence(networkID, apiRoot, silenceID string) error {
+	resp, err := http.Get(apiRoot + "/silence/" + neturl.PathEscape(silenceID))
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error reading silence: %v", err), http.StatusInternalServerError)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return obsidian.HttpError(fmt.Errorf("silence %s not found", silenceID), http.StatusNotFound)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return obsidian.HttpError(fmt.Errorf("error reading silence: %s", readAlertmanagerError(resp)), resp.StatusCode)
+	}
+	silence := &models.GettableSilence{}
+	err = json.NewDecoder(resp.Body).Decode(silence)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("error decoding alertmanager response: %v", err), http.StatusInternalServerError)
+	}
+	if !isNetworkSilence(networkID, &silence.Silence) {
+		return obsidian.HttpError(fmt.Errorf("silence %s not found", silenceID), http.StatusNotFound)
+	}
+	return nil
+}
+
+// secureSilence restricts a silence to the given network by replacing any
+// matchers on the networkID label with an exact match on networkID
+func secureSilence(networkID string, silence *models.Silence) {
+	matchers := models.Matchers{}
+	for _, matcher := range silence.Matchers {
+		if matcher != nil && swag.StringValue(matcher.Name) != metrics.NetworkLabelName {
+			matchers = append(matchers, matcher)
+		}
+	}
+	matchers = append(matchers, &models.Matcher{
+		Name:    swag.String(metrics.NetworkLabelName),
+		Value:   swag.String(networkID),
+		IsRegex: swag.Bool(false),
+	})
+	silence.Matchers = matchers
+}
+
+func isNetworkSilence(networkID string, silence *models.Silence) bool {
+	for _, matcher := range silence.Matchers {
+		if matcher != nil &&
+			swag.StringValue(matcher.Name) == metrics.NetworkLabelName &&
+			swag.StringValue(matcher.Value) == networkID &&
+			!swag.BoolValue(matcher.IsRegex) {
+			return true
+		}
+	}
+	return false
+}
+
+// alertmanagerAPIRoot returns the root of alertmanager's v2 API given the URL
+// of its alerts endpoint
+func alertmanagerAPIRoot(alertmanagerURL string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(alertmanagerURL, "/"), "/alerts")
+}
+
+// readAlertmanagerError returns the error message in an alertmanager
+// response, which is a JSON string for API errors
+func readAlertmanagerError(resp *http.Response) string {
+	var body bytes.Buffer
+	_, _ = body.ReadFrom(resp.Body)
+	var msg string
+	if err := json.Unmarshal(body.Bytes(), &msg); err == nil {
+		return msg
+	}
+	return strings.TrimSpace(body.String())
+}
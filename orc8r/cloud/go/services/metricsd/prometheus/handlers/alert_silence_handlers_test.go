@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAlertmanager serves the silence endpoints of alertmanager's v2 API
+type fakeAlertmanager struct {
+	silences map[string]models.GettableSilence
+	posted   []models.PostableSilence
+	expired  []string
+}
+
+func (f *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		silences := make([]models.GettableSilence, 0)
+		for _, silence := range f.silences {
+			silences = append(silences, silence)
+		}
+		_ = json.NewEncoder(w).Encode(silences)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		silence := models.PostableSilence{}
+		_ = json.NewDecoder(r.Body).Decode(&silence)
+		f.posted = append(f.posted, silence)
+		_ = json.NewEncoder(w).Encode(silenceIDResponse{SilenceID: "new"})
+	case strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+		silence, ok := f.silences[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			f.expired = append(f.expired, id)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(silence)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestSilenceHandlers(t *testing.T) {
+	alertmanager := &fakeAlertmanager{silences: map[string]models.GettableSilence{
+		"test1":  makeGettableSilence("test1", "active", makeMatcher("networkID", "test", false)),
+		"test2":  makeGettableSilence("test2", "expired", makeMatcher("networkID", "test", false)),
+		"other":  makeGettableSilence("other", "active", makeMatcher("networkID", "other", false)),
+		"regexp": makeGettableSilence("regexp", "active", makeMatcher("networkID", "test", true)),
+	}}
+	server := httptest.NewServer(alertmanager)
+	defer server.Close()
+	alertmanagerURL := server.URL + "/api/v2/alerts"
+
+	// only the network's silences are listed
+	c, rec := buildSilenceContext(http.MethodGet, "/", "")
+	assert.NoError(t, GetRetrieveSilencesHandler(alertmanagerURL)(c))
+	var silences []models.GettableSilence
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &silences))
+	assert.Len(t, silences, 2)
+
+	c, rec = buildSilenceContext(http.MethodGet, "/?state=active", "")
+	assert.NoError(t, GetRetrieveSilencesHandler(alertmanagerURL)(c))
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &silences))
+	assert.Len(t, silences, 1)
+	assert.Equal(t, "test1", *silences[0].ID)
+
+	// networkID matchers are replaced with the request's network
+	body := `{
+		"comment": "maintenance",
+		"createdBy": "admin",
+		"startsAt": "2019-10-01T10:00:00Z",
+		"endsAt": "2019-10-01T12:00:00Z",
+		"matchers": [
+			{"name": "gatewayID", "value": "gw1", "isRegex": false},
+			{"name": "networkID", "value": ".*", "isRegex": true}
+		]
+	}`
+	c, rec = buildSilenceContext(http.MethodPost, "/", body)
+	assert.NoError(t, GetCreateSilenceHandler(alertmanagerURL)(c))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"silenceID": "new"}`, rec.Body.String())
+	assert.Len(t, alertmanager.posted, 1)
+	assert.Equal(t, models.Matchers{
+		makeMatcher("gatewayID", "gw1", false),
+		makeMatcher("networkID", "test", false),
+	}, alertmanager.posted[0].Matchers)
+
+	// silences of other networks can't be updated or expired
+	c, _ = buildSilenceContext(http.MethodPost, "/", strings.Replace(body, `"comment"`, `"id": "other", "comment"`, 1))
+	err := GetCreateSilenceHandler(alertmanagerURL)(c)
+	assert.EqualError(t, err, "code=404, message=silence other not found")
+	assert.Len(t, alertmanager.posted, 1)
+
+	c, _ = buildSilenceContext(http.MethodDelete, "/?silence_id=other", "")
+	err = GetExpireSilenceHandler(alertmanagerURL)(c)
+	assert.EqualError(t, err, "code=404, message=silence other not found")
+
+	c, _ = buildSilenceContext(http.MethodDelete, "/?silence_id=regexp", "")
+	err = GetExpireSilenceHandler(alertmanagerURL)(c)
+	assert.EqualError(t, err, "code=404, message=silence regexp not found")
+
+	c, rec = buildSilenceContext(http.MethodDelete, "/?silence_id=test1", "")
+	assert.NoError(t, GetExpireSilenceHandler(alertmanagerURL)(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, []string{"test1"}, alertmanager.expired)
+
+	// missing required fields
+	c, _ = buildSilenceContext(http.MethodPost, "/", `{"comment": "maintenance"}`)
+	err = GetCreateSilenceHandler(alertmanagerURL)(c)
+	assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code)
+}
+
+func buildSilenceContext(method, target, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("test")
+	return c, rec
+}
+
+func makeGettableSilence(id, state string, matchers ...*models.Matcher) models.GettableSilence {
+	now := strfmt.DateTime(time.Unix(1570000000, 0).UTC())
+	return models.GettableSilence{
+		ID:        swag.String(id),
+		Status:    &models.SilenceStatus{State: swag.String(state)},
+		UpdatedAt: &now,
+		Silence: models.Silence{
+			Comment:   swag.String("comment"),
+			CreatedBy: swag.String("admin"),
+			StartsAt:  &now,
+			EndsAt:    &now,
+			Matchers:  matchers,
+		},
+	}
+}
+
+func makeMatcher(name, value string, isRegex bool) *models.Matcher {
+	return &models.Matcher{Name: swag.String(name), Value: swag.String(value), IsRegex: swag.Bool(isRegex)}
+}
@@ -19,6 +19,8 @@ import (
 	"sync"
 	"time"
 
+	"magma/orc8r/cloud/go/metrics"
+
 	"github.com/golang/glog"
 	"github.com/labstack/echo"
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,9 +29,12 @@ import (
 )
 
 const (
-	internalMetricCacheSize  = "cache_size"
-	internalMetricCacheLimit = "cache_limit"
-	scrapeWorkerPoolSize     = 100
+	internalMetricCacheSize         = "cache_size"
+	internalMetricCacheLimit        = "cache_limit"
+	internalMetricSpillSize         = "cache_spill_size"
+	internalMetricSpilledDatapoints = "cache_spilled_datapoints"
+	internalMetricDroppedDatapoints = "cache_dropped_datapoints"
+	scrapeWorkerPoolSize            = 100
 )
 
 // MetricCache serves as a replacement for the prometheus pushgateway. Accepts
@@ -38,8 +43,16 @@ const (
 type MetricCache struct {
 	metricFamiliesByName map[string]*familyAndMetrics
 	internalMetrics      map[string]prometheus.Gauge
+	internalCounters     map[string]prometheus.Counter
 	limit                int
 	stats                cacheStats
+
+	// networkLimit is the maximum number of datapoints of a single network
+	// in the cache, and networkDatapoints the current number per network
+	networkLimit      int
+	networkDatapoints map[string]int
+	// spill stores datapoints which don't fit in the cache. Nil if disabled.
+	spill *DiskSpill
 	sync.Mutex
 	scrapeTimeout int
 }
@@ -59,22 +72,47 @@ type cacheStats struct {
 }
 
 func NewMetricCache(limit int, scrapeTimeout int) *MetricCache {
+	return NewSpillingMetricCache(limit, 0, scrapeTimeout, nil)
+}
+
+// NewSpillingMetricCache returns a MetricCache which also limits the number of
+// datapoints each network can hold in the cache, so that one network can't
+// starve the others. Instead of rejecting a push which doesn't fit, the
+// datapoints of the networks which don't fit are written to spill, and
+// replayed on later scrapes. If spill is nil they are dropped.
+func NewSpillingMetricCache(limit, networkLimit, scrapeTimeout int, spill *DiskSpill) *MetricCache {
 	if limit > 0 {
 		glog.Infof("Prometheus-Cache created with a limit of %d\n", limit)
 	} else {
 		glog.Info("Prometheus-Cache created with no limit\n")
 	}
+	if networkLimit > 0 {
+		glog.Infof("Prometheus-Cache created with a network limit of %d\n", networkLimit)
+	}
 
-	cacheLimit := prometheus.NewGauge(prometheus.GaugeOpts{Name: internalMetricCacheLimit, Help: "Maximum number of datapoints in cache", ConstLabels: prometheus.Labels{"networkID": "internal"}})
-	cacheSize := prometheus.NewGauge(prometheus.GaugeOpts{Name: internalMetricCacheSize, Help: "Number of datapoints in cache", ConstLabels: prometheus.Labels{"networkID": "internal"}})
-	internalMetrics := map[string]prometheus.Gauge{internalMetricCacheLimit: cacheLimit, internalMetricCacheSize: cacheSize}
+	internalLabels := prometheus.Labels{metrics.NetworkLabelName: "internal"}
+	cacheLimit := prometheus.NewGauge(prometheus.GaugeOpts{Name: internalMetricCacheLimit, Help: "Maximum number of datapoints in cache", ConstLabels: internalLabels})
+	cacheSize := prometheus.NewGauge(prometheus.GaugeOpts{Name: internalMetricCacheSize, Help: "Number of datapoints in cache", ConstLabels: internalLabels})
+	spillSize := prometheus.NewGauge(prometheus.GaugeOpts{Name: internalMetricSpillSize, Help: "Number of datapoints spilled to disk", ConstLabels: internalLabels})
+	internalMetrics := map[string]prometheus.Gauge{internalMetricCacheLimit: cacheLimit, internalMetricCacheSize: cacheSize, internalMetricSpillSize: spillSize}
+
+	spilled := prometheus.NewCounter(prometheus.CounterOpts{Name: internalMetricSpilledDatapoints, Help: "Total number of datapoints spilled to disk", ConstLabels: internalLabels})
+	dropped := prometheus.NewCounter(prometheus.CounterOpts{Name: internalMetricDroppedDatapoints, Help: "Total number of datapoints dropped", ConstLabels: internalLabels})
+	internalCounters := map[string]prometheus.Counter{internalMetricSpilledDatapoints: spilled, internalMetricDroppedDatapoints: dropped}
 
 	cacheLimit.Set(float64(limit))
+	if spill != nil {
+		spillSize.Set(float64(spill.Datapoints()))
+	}
 
 	return &MetricCache{
 		metricFamiliesByName: make(map[string]*familyAndMetrics),
 		internalMetrics:      internalMetrics,
+		internalCounters:     internalCounters,
 		limit:                limit,
+		networkLimit:         networkLimit,
+		networkDatapoints:    make(map[string]int),
+		spill:                spill,
 		scrapeTimeout:        scrapeTimeout,
 	}
 }
@@ -89,26 +127,28 @@ func (c *MetricCache) Receive(ctx echo.Context) error {
 		return ctx.String(http.StatusBadRequest, fmt.Sprintf("error parsing metrics: %v", err))
 	}
 
-	newDatapoints := 0
-	for _, fam := range parsedFamilies {
-		newDatapoints += len(fam.Metric)
-	}
-
-	// Check if new datapoints will exceed the specified limit
-	if c.limit > 0 {
-		if c.stats.currentCountDatapoints+newDatapoints > c.limit {
-			errString := fmt.Sprintf("Not accepting push of size %d. Would overfill cache limit of %d. Current cache size: %d\n", newDatapoints, c.limit, c.stats.currentCountDatapoints)
-			glog.Error(errString)
-			return ctx.String(http.StatusNotAcceptable, errString)
+	if c.networkLimit > 0 || c.spill != nil {
+		c.cacheMetricsByNetwork(parsedFamilies)
+	} else {
+		newDatapoints := countDatapoints(parsedFamilies)
+
+		// Check if new datapoints will exceed the specified limit
+		if c.limit > 0 {
+			if c.stats.currentCountDatapoints+newDatapoints > c.limit {
+				errString := fmt.Sprintf("Not accepting push of size %d. Would overfill cache limit of %d. Current cache size: %d\n", newDatapoints, c.limit, c.stats.currentCountDatapoints)
+				glog.Error(errString)
+				c.internalCounters[internalMetricDroppedDatapoints].Add(float64(newDatapoints))
+				return ctx.String(http.StatusNotAcceptable, errString)
+			}
 		}
-	}
 
-	c.cacheMetrics(parsedFamilies)
+		c.cacheMetrics(parsedFamilies)
+		c.stats.currentCountDatapoints += newDatapoints
+	}
 
 	c.stats.lastReceiveTime = time.Now().Unix()
 	c.stats.lastReceiveSize = ctx.Request().ContentLength
 	c.stats.lastReceiveNumFamilies = len(parsedFamilies)
-	c.stats.currentCountDatapoints += newDatapoints
 	c.internalMetrics[internalMetricCacheSize].Set(float64(c.stats.currentCountDatapoints))
 
 	return ctx.NoContent(http.StatusOK)
@@ -117,11 +157,89 @@ func (c *MetricCache) Receive(ctx echo.Context) error {
 func (c *MetricCache) cacheMetrics(families map[string]*dto.MetricFamily) {
 	c.Lock()
 	defer c.Unlock()
+	c.addFamilies(c.metricFamiliesByName, families)
+}
+
+// cacheMetricsByNetwork caches the datapoints of each network in the push
+// which fit in both the cache and the network's share of it. The datapoints
+// of the other networks are spilled, or dropped if spilling is disabled or
+// the spill is full. Once a network has spilled datapoints, its new
+// datapoints are spilled as well until the spill is replayed, so that
+// datapoints are always scraped in the order they were received. Spilled
+// datapoints are written to disk after releasing the cache's lock.
+func (c *MetricCache) cacheMetricsByNetwork(families map[string]*dto.MetricFamily) {
+	familiesByNetwork := splitFamiliesByNetwork(families)
+	networks := make([]string, 0, len(familiesByNetwork))
+	for networkID := range familiesByNetwork {
+		networks = append(networks, networkID)
+	}
+	sort.Strings(networks)
+
+	spillSeqs := map[string]uint64{}
+	c.Lock()
+	for _, networkID := range networks {
+		networkFamilies := familiesByNetwork[networkID]
+		newDatapoints := countDatapoints(networkFamilies)
+		if c.hasRoom(networkID, newDatapoints) {
+			c.addFamilies(c.metricFamiliesByName, networkFamilies)
+			c.networkDatapoints[networkID] += newDatapoints
+			c.stats.currentCountDatapoints += newDatapoints
+			continue
+		}
+		if c.spill != nil {
+			spillSeqs[networkID] = c.spill.reserve(networkID)
+			continue
+		}
+		c.dropDatapoints(networkID, newDatapoints)
+	}
+	c.Unlock()
+
+	for _, networkID := range networks {
+		seq, ok := spillSeqs[networkID]
+		if !ok {
+			continue
+		}
+		newDatapoints := countDatapoints(familiesByNetwork[networkID])
+		err := c.spill.writeReserved(networkID, seq, familiesByNetwork[networkID])
+		if err != nil {
+			glog.Errorf("Failed to spill %d datapoints of network %s: %v", newDatapoints, networkID, err)
+			c.dropDatapoints(networkID, newDatapoints)
+			continue
+		}
+		c.internalCounters[internalMetricSpilledDatapoints].Add(float64(newDatapoints))
+	}
+	if len(spillSeqs) > 0 {
+		c.internalMetrics[internalMetricSpillSize].Set(float64(c.spill.Datapoints()))
+	}
+}
+
+func (c *MetricCache) dropDatapoints(networkID string, datapoints int) {
+	glog.Errorf("Dropping %d datapoints of network %s. Would overfill cache limit of %d or network limit of %d\n",
+		datapoints, networkID, c.limit, c.networkLimit)
+	c.internalCounters[internalMetricDroppedDatapoints].Add(float64(datapoints))
+}
+
+// hasRoom returns true if newDatapoints of the network can be added to the
+// cache. c must be locked.
+func (c *MetricCache) hasRoom(networkID string, newDatapoints int) bool {
+	if c.spill != nil && c.spill.Has(networkID) {
+		return false
+	}
+	if c.limit > 0 && c.stats.currentCountDatapoints+newDatapoints > c.limit {
+		return false
+	}
+	if c.networkLimit > 0 && c.networkDatapoints[networkID]+newDatapoints > c.networkLimit {
+		return false
+	}
+	return true
+}
+
+func (c *MetricCache) addFamilies(metricFamiliesByName map[string]*familyAndMetrics, families map[string]*dto.MetricFamily) {
 	for _, fam := range families {
-		if families, ok := c.metricFamiliesByName[fam.GetName()]; ok {
+		if families, ok := metricFamiliesByName[fam.GetName()]; ok {
 			families.addMetrics(fam.Metric)
 		} else {
-			c.metricFamiliesByName[fam.GetName()] = newFamilyAndMetrics(fam)
+			metricFamiliesByName[fam.GetName()] = newFamilyAndMetrics(fam)
 		}
 	}
 }
@@ -132,7 +250,9 @@ func (c *MetricCache) Scrape(ctx echo.Context) error {
 	c.Lock()
 	scrapeMetrics := c.metricFamiliesByName
 	c.clearMetrics()
+	replayed := c.takeSpill()
 	c.Unlock()
+	c.replaySpill(replayed)
 
 	expositionString := c.exposeMetrics(scrapeMetrics, scrapeWorkerPoolSize)
 	expositionString += c.exposeInternalMetrics()
@@ -140,14 +260,55 @@ func (c *MetricCache) Scrape(ctx echo.Context) error {
 	c.stats.lastScrapeTime = time.Now().Unix()
 	c.stats.lastScrapeSize = int64(len(expositionString))
 	c.stats.lastScrapeNumFamilies = len(scrapeMetrics)
-	c.stats.currentCountDatapoints = 0
-	c.internalMetrics[internalMetricCacheSize].Set(0)
 
 	return ctx.String(http.StatusOK, expositionString)
 }
 
 func (c *MetricCache) clearMetrics() {
 	c.metricFamiliesByName = make(map[string]*familyAndMetrics)
+	c.networkDatapoints = make(map[string]int)
+	c.stats.currentCountDatapoints = 0
+	c.internalMetrics[internalMetricCacheSize].Set(0)
+}
+
+// takeSpill takes the oldest spilled datapoints which fit in the emptied
+// cache, and counts them against the cache and network limits, so that new
+// pushes only get the remaining room. c must be locked.
+func (c *MetricCache) takeSpill() []spillSegment {
+	if c.spill == nil {
+		return nil
+	}
+	segments := c.spill.take(c.limit, c.networkLimit)
+	for _, segment := range segments {
+		c.networkDatapoints[segment.networkID] += segment.datapoints
+		c.stats.currentCountDatapoints += segment.datapoints
+	}
+	return segments
+}
+
+// replaySpill reads the segments taken by takeSpill from disk, without
+// holding the cache's lock, and adds them to the cache, so that the spill
+// drains over the following scrapes.
+func (c *MetricCache) replaySpill(segments []spillSegment) {
+	if c.spill == nil {
+		return
+	}
+	spilledFamilies, droppedSegments := readSegments(segments)
+
+	c.Lock()
+	for _, families := range spilledFamilies {
+		c.addFamilies(c.metricFamiliesByName, families)
+	}
+	// Datapoints which couldn't be read don't take up room in the cache
+	for _, segment := range droppedSegments {
+		c.networkDatapoints[segment.networkID] -= segment.datapoints
+		c.stats.currentCountDatapoints -= segment.datapoints
+		c.internalCounters[internalMetricDroppedDatapoints].Add(float64(segment.datapoints))
+	}
+	c.internalMetrics[internalMetricCacheSize].Set(float64(c.stats.currentCountDatapoints))
+	c.Unlock()
+
+	c.internalMetrics[internalMetricSpillSize].Set(float64(c.spill.Datapoints()))
 }
 
 func (c *MetricCache) exposeMetrics(metricFamiliesByName map[string]*familyAndMetrics, workers int) string {
@@ -212,6 +373,13 @@ func (c *MetricCache) exposeInternalMetrics() string {
 		}
 		strBuilder.WriteString(str)
 	}
+	for name, metric := range c.internalCounters {
+		str, err := writeInternalMetric(metric, name, dto.MetricType_COUNTER)
+		if err != nil {
+			continue
+		}
+		strBuilder.WriteString(str)
+	}
 	return strBuilder.String()
 }
 
@@ -222,7 +390,7 @@ func (c *MetricCache) Debug(ctx echo.Context) error {
 
 	c.updateCountStats()
 	hostname, _ := os.Hostname()
-	var limitValue, utilizationValue string
+	var limitValue, utilizationValue, spillValue string
 	if c.limit <= 0 {
 		limitValue = "None"
 		utilizationValue = "0"
@@ -230,10 +398,16 @@ func (c *MetricCache) Debug(ctx echo.Context) error {
 		limitValue = strconv.Itoa(c.limit)
 		utilizationValue = strconv.FormatFloat(float64(c.stats.currentCountDatapoints)*100/float64(c.limit), 'f', 2, 64)
 	}
+	if c.spill == nil {
+		spillValue = "Disabled"
+	} else {
+		spillValue = fmt.Sprintf("%d datapoints, %d bytes", c.spill.Datapoints(), c.spill.Bytes())
+	}
 
 	debugString := fmt.Sprintf(`Prometheus Cache running on %s
 Cache Limit:       %s
 Cache Utilization: %s%%
+Spill:             %s
 
 Last Scrape: %d
 	Scrape Size: %d
@@ -245,7 +419,7 @@ Last Receive: %d
 
 Current Count Families:   %d
 Current Count Series:     %d
-Current Count Datapoints: %d `, hostname, limitValue, utilizationValue,
+Current Count Datapoints: %d `, hostname, limitValue, utilizationValue, spillValue,
 		c.stats.lastScrapeTime, c.stats.lastScrapeSize, c.stats.lastScrapeNumFamilies,
 		c.stats.lastReceiveTime, c.stats.lastReceiveSize, c.stats.lastReceiveNumFamilies,
 		c.stats.currentCountFamilies, c.stats.currentCountSeries, c.stats.currentCountDatapoints)
@@ -344,6 +518,46 @@ func makeLabeledName(metric *dto.Metric, metricName string) string {
 	return labeledName.String()
 }
 
+// splitFamiliesByNetwork groups the datapoints of the families by the value of
+// their networkID label
+func splitFamiliesByNetwork(families map[string]*dto.MetricFamily) map[string]map[string]*dto.MetricFamily {
+	familiesByNetwork := make(map[string]map[string]*dto.MetricFamily)
+	for name, family := range families {
+		for _, metric := range family.Metric {
+			networkID := getNetworkID(metric)
+			networkFamilies, ok := familiesByNetwork[networkID]
+			if !ok {
+				networkFamilies = make(map[string]*dto.MetricFamily)
+				familiesByNetwork[networkID] = networkFamilies
+			}
+			networkFamily, ok := networkFamilies[name]
+			if !ok {
+				networkFamily = &dto.MetricFamily{Name: family.Name, Help: family.Help, Type: family.Type}
+				networkFamilies[name] = networkFamily
+			}
+			networkFamily.Metric = append(networkFamily.Metric, metric)
+		}
+	}
+	return familiesByNetwork
+}
+
+func getNetworkID(metric *dto.Metric) string {
+	for _, labelPair := range metric.GetLabel() {
+		if labelPair.GetName() == metrics.NetworkLabelName {
+			return labelPair.GetValue()
+		}
+	}
+	return ""
+}
+
+func countDatapoints(families map[string]*dto.MetricFamily) int {
+	datapoints := 0
+	for _, fam := range families {
+		datapoints += len(fam.Metric)
+	}
+	return datapoints
+}
+
 func familyToString(family *dto.MetricFamily) (string, error) {
 	var buf bytes.Buffer
 	_, err := expfmt.MetricFamilyToText(&buf, family)
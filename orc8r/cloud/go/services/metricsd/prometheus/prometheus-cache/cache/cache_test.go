@@ -73,6 +73,7 @@ func TestReceiveOverLimit(t *testing.T) {
 
 	assert.Equal(t, 0, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
 	assert.Equal(t, 1, int(getGaugeValue(cache.internalMetrics[internalMetricCacheLimit])))
+	assert.Equal(t, 14, int(getCounterValue(cache.internalCounters[internalMetricDroppedDatapoints])))
 }
 
 func TestReceiveBadMetrics(t *testing.T) {
@@ -107,15 +108,15 @@ func TestScrape(t *testing.T) {
 	var parser expfmt.TextParser
 	parsedFamilies, err := parser.TextToMetricFamilies(rec.Body)
 	assert.NoError(t, err)
-	assert.Equal(t, 5, len(parsedFamilies))
+	assert.Equal(t, 8, len(parsedFamilies))
 
 	// make sure all metrics are returned.
-	// there are 2 extra internal metrics
+	// there are 5 extra internal metrics
 	sum := 0
 	for _, family := range parsedFamilies {
 		sum += len(family.Metric)
 	}
-	assert.Equal(t, 16, sum)
+	assert.Equal(t, 19, sum)
 }
 
 func TestScrapeBadMetrics(t *testing.T) {
@@ -318,6 +319,12 @@ func getGaugeValue(gauge prometheus.Gauge) float64 {
 	return *dtoMetric.Gauge.Value
 }
 
+func getCounterValue(counter prometheus.Counter) float64 {
+	var dtoMetric dto.Metric
+	counter.Write(&dtoMetric)
+	return *dtoMetric.Counter.Value
+}
+
 func makeFamily(familyType dto.MetricType, familyName string, numMetrics int, labels []*dto.LabelPair, timestamp int64) *dto.MetricFamily {
 	metrics := make([]*dto.Metric, 0)
 	for i := 0; i < numMetrics; i++ {
@@ -0,0 +1,449 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	spillSegmentExtension = ".spill"
+	spillTmpExtension     = ".tmp"
+	networkDirPrefix      = "network-"
+	unlabeledNetworkDir   = "unlabeled"
+)
+
+// DiskSpill is a write-ahead store on local disk for datapoints which don't
+// fit in the MetricCache. Each network's datapoints are kept in their own
+// directory as a queue of segments, one per spilled push, so that spilled
+// datapoints survive restarts and are replayed in the order they were
+// received.
+type DiskSpill struct {
+	dir             string
+	maxBytes        int64
+	networkMaxBytes int64
+
+	segmentsByNetwork map[string][]spillSegment
+	totalBytes        int64
+	totalDatapoints   int
+	nextSeq           uint64
+	// pendingByNetwork counts the reserved segments of each network which
+	// are still being written, and pendingBytes their size
+	pendingByNetwork map[string]int
+	pendingBytes     map[string]int64
+	sync.Mutex
+}
+
+type spillSegment struct {
+	networkID  string
+	seq        uint64
+	path       string
+	datapoints int
+	bytes      int64
+}
+
+// NewDiskSpill returns a DiskSpill storing segments under dir, loading any
+// segments left by a previous run. maxBytes and networkMaxBytes limit the
+// size of all spilled segments and of a single network's segments
+// respectively. Limits <= 0 are unlimited.
+func NewDiskSpill(dir string, maxBytes, networkMaxBytes int64) (*DiskSpill, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("error creating spill directory %s: %v", dir, err)
+	}
+	s := &DiskSpill{
+		dir:               dir,
+		maxBytes:          maxBytes,
+		networkMaxBytes:   networkMaxBytes,
+		segmentsByNetwork: map[string][]spillSegment{},
+		nextSeq:           1,
+		pendingByNetwork:  map[string]int{},
+		pendingBytes:      map[string]int64{},
+	}
+	err = s.load()
+	if err != nil {
+		return nil, err
+	}
+	glog.Infof("Prometheus-Cache spilling to %s with %d datapoints already spilled\n", dir, s.totalDatapoints)
+	return s, nil
+}
+
+// Write appends the families of a network to its spill queue. It fails if the
+// spill, or the network's share of it, is full.
+func (s *DiskSpill) Write(networkID string, families map[string]*dto.MetricFamily) error {
+	return s.writeReserved(networkID, s.reserve(networkID), families)
+}
+
+// reserve reserves the next position in the network's spill queue, so that
+// the caller can write to it with writeReserved without holding its own
+// locks. The network counts as having spilled datapoints until the write
+// finishes, and every reservation must be followed by a writeReserved.
+func (s *DiskSpill) reserve(networkID string) uint64 {
+	s.Lock()
+	defer s.Unlock()
+	seq := s.nextSeq
+	s.nextSeq++
+	s.pendingByNetwork[networkID]++
+	return seq
+}
+
+// writeReserved writes the families of a network to the position seq of its
+// spill queue, which was returned by reserve. The file is written without
+// holding the spill's lock.
+func (s *DiskSpill) writeReserved(networkID string, seq uint64, families map[string]*dto.MetricFamily) error {
+	var buf bytes.Buffer
+	encoder := expfmt.NewEncoder(&buf, expfmt.FmtProtoDelim)
+	for _, name := range sortedFamilyNames(families) {
+		err := encoder.Encode(families[name])
+		if err != nil {
+			s.release(networkID, 0)
+			return fmt.Errorf("error encoding family %s: %v", name, err)
+		}
+	}
+	size := int64(buf.Len())
+	err := s.reserveBytes(networkID, size)
+	if err != nil {
+		s.release(networkID, 0)
+		return err
+	}
+
+	datapoints := countDatapoints(families)
+	networkDir := filepath.Join(s.dir, networkDirName(networkID))
+	path := filepath.Join(networkDir, fmt.Sprintf("%020d-%d%s", seq, datapoints, spillSegmentExtension))
+	err = os.MkdirAll(networkDir, 0755)
+	if err == nil {
+		err = writeFileAtomic(path, buf.Bytes())
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.releaseLocked(networkID, size)
+	if err != nil {
+		s.totalBytes -= size
+		return err
+	}
+	s.insertSegment(spillSegment{networkID: networkID, seq: seq, path: path, datapoints: datapoints, bytes: size})
+	s.totalDatapoints += datapoints
+	return nil
+}
+
+// reserveBytes accounts for size bytes of a pending write of the network, or
+// fails if they don't fit in the spill
+func (s *DiskSpill) reserveBytes(networkID string, size int64) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.maxBytes > 0 && s.totalBytes+size > s.maxBytes {
+		return fmt.Errorf("spill limit of %d bytes reached", s.maxBytes)
+	}
+	if s.networkMaxBytes > 0 && s.networkBytes(networkID)+s.pendingBytes[networkID]+size > s.networkMaxBytes {
+		return fmt.Errorf("spill limit of %d bytes reached for network %s", s.networkMaxBytes, networkID)
+	}
+	s.totalBytes += size
+	s.pendingBytes[networkID] += size
+	return nil
+}
+
+func (s *DiskSpill) release(networkID string, size int64) {
+	s.Lock()
+	defer s.Unlock()
+	s.releaseLocked(networkID, size)
+}
+
+func (s *DiskSpill) releaseLocked(networkID string, size int64) {
+	s.pendingByNetwork[networkID]--
+	s.pendingBytes[networkID] -= size
+	if s.pendingByNetwork[networkID] <= 0 {
+		delete(s.pendingByNetwork, networkID)
+		delete(s.pendingBytes, networkID)
+	}
+}
+
+// insertSegment adds a segment to its network's queue in sequence order,
+// since concurrent writes of a network may finish out of order
+func (s *DiskSpill) insertSegment(segment spillSegment) {
+	segments := s.segmentsByNetwork[segment.networkID]
+	i := sort.Search(len(segments), func(i int) bool { return segments[i].seq > segment.seq })
+	segments = append(segments, spillSegment{})
+	copy(segments[i+1:], segments[i:])
+	segments[i] = segment
+	s.segmentsByNetwork[segment.networkID] = segments
+}
+
+// Read removes the oldest segments from the spill and returns their families.
+// See take for how the segments are chosen. Segments which can't be decoded
+// are dropped, and their datapoints are counted in the returned number of
+// dropped datapoints.
+func (s *DiskSpill) Read(limit, networkLimit int) ([]map[string]*dto.MetricFamily, int) {
+	families, droppedSegments := readSegments(s.take(limit, networkLimit))
+	dropped := 0
+	for _, segment := range droppedSegments {
+		dropped += segment.datapoints
+	}
+	return families, dropped
+}
+
+// take removes the oldest segments from the spill, without reading them.
+// Networks take turns so that each one drains at the same rate, and taking
+// stops once the next segment would take the total over limit datapoints or
+// its network over networkLimit datapoints. The first segment of each network
+// is exempt from networkLimit and the first segment overall is exempt from
+// limit, so that the spill always drains. Limits <= 0 are unlimited.
+// Networks with pending writes are skipped, so that their segments are
+// always taken in order. The taken segments must be passed to readSegments.
+func (s *DiskSpill) take(limit, networkLimit int) []spillSegment {
+	s.Lock()
+	defer s.Unlock()
+
+	var ret []spillSegment
+	total := 0
+	readByNetwork := map[string]int{}
+	for {
+		progress := false
+		for _, networkID := range s.sortedNetworks() {
+			if s.pendingByNetwork[networkID] > 0 {
+				continue
+			}
+			segment := s.segmentsByNetwork[networkID][0]
+			if total > 0 && limit > 0 && total+segment.datapoints > limit {
+				continue
+			}
+			read := readByNetwork[networkID]
+			if read > 0 && networkLimit > 0 && read+segment.datapoints > networkLimit {
+				continue
+			}
+
+			ret = append(ret, segment)
+			s.popSegment(networkID)
+			total += segment.datapoints
+			readByNetwork[networkID] = read + segment.datapoints
+			progress = true
+		}
+		if !progress {
+			return ret
+		}
+	}
+}
+
+// readSegments reads and removes taken segments from disk, returning their
+// families and the segments which couldn't be decoded
+func readSegments(segments []spillSegment) ([]map[string]*dto.MetricFamily, []spillSegment) {
+	var ret []map[string]*dto.MetricFamily
+	var dropped []spillSegment
+	for _, segment := range segments {
+		families, err := readSegment(segment.path)
+		if err != nil {
+			glog.Errorf("Dropping %d spilled datapoints of network %s: %v", segment.datapoints, segment.networkID, err)
+			dropped = append(dropped, segment)
+		} else {
+			ret = append(ret, families)
+		}
+		err = os.Remove(segment.path)
+		if err != nil && !os.IsNotExist(err) {
+			glog.Errorf("Failed to remove spill segment %s: %v", segment.path, err)
+		}
+	}
+	return ret, dropped
+}
+
+// Has returns true if the network has spilled datapoints which haven't been
+// taken yet, or spilled datapoints which are still being written
+func (s *DiskSpill) Has(networkID string) bool {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.segmentsByNetwork[networkID]) > 0 || s.pendingByNetwork[networkID] > 0
+}
+
+// Datapoints returns the number of datapoints in the spill
+func (s *DiskSpill) Datapoints() int {
+	s.Lock()
+	defer s.Unlock()
+	return s.totalDatapoints
+}
+
+// Bytes returns the size of the spill on disk
+func (s *DiskSpill) Bytes() int64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.totalBytes
+}
+
+func (s *DiskSpill) networkBytes(networkID string) int64 {
+	var size int64
+	for _, segment := range s.segmentsByNetwork[networkID] {
+		size += segment.bytes
+	}
+	return size
+}
+
+func (s *DiskSpill) sortedNetworks() []string {
+	networks := make([]string, 0, len(s.segmentsByNetwork))
+	for networkID := range s.segmentsByNetwork {
+		networks = append(networks, networkID)
+	}
+	sort.Strings(networks)
+	return networks
+}
+
+// popSegment removes the oldest segment of the network from the queue. Its
+// file is removed by readSegments.
+func (s *DiskSpill) popSegment(networkID string) {
+	segments := s.segmentsByNetwork[networkID]
+	segment := segments[0]
+	if len(segments) == 1 {
+		delete(s.segmentsByNetwork, networkID)
+	} else {
+		s.segmentsByNetwork[networkID] = segments[1:]
+	}
+	s.totalBytes -= segment.bytes
+	s.totalDatapoints -= segment.datapoints
+}
+
+// load restores the segments written by a previous run, removing any which
+// were only partially written
+func (s *DiskSpill) load() error {
+	networkDirs, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("error reading spill directory %s: %v", s.dir, err)
+	}
+	for _, networkDir := range networkDirs {
+		if !networkDir.IsDir() {
+			continue
+		}
+		networkID, ok := parseNetworkDirName(networkDir.Name())
+		if !ok {
+			continue
+		}
+		dir := filepath.Join(s.dir, networkDir.Name())
+		files, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return fmt.Errorf("error reading spill directory %s: %v", dir, err)
+		}
+		// ReadDir sorts by name, and sequence numbers are zero-padded
+		for _, file := range files {
+			path := filepath.Join(dir, file.Name())
+			if strings.HasSuffix(file.Name(), spillTmpExtension) {
+				_ = os.Remove(path)
+				continue
+			}
+			seq, datapoints, ok := parseSegmentName(file.Name())
+			if !ok {
+				continue
+			}
+			s.segmentsByNetwork[networkID] = append(s.segmentsByNetwork[networkID], spillSegment{networkID: networkID, seq: seq, path: path, datapoints: datapoints, bytes: file.Size()})
+			s.totalBytes += file.Size()
+			s.totalDatapoints += datapoints
+			if seq >= s.nextSeq {
+				s.nextSeq = seq + 1
+			}
+		}
+	}
+	return nil
+}
+
+func readSegment(path string) (map[string]*dto.MetricFamily, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	families := map[string]*dto.MetricFamily{}
+	decoder := expfmt.NewDecoder(file, expfmt.FmtProtoDelim)
+	for {
+		family := &dto.MetricFamily{}
+		err := decoder.Decode(family)
+		if err == io.EOF {
+			return families, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error decoding spill segment %s: %v", path, err)
+		}
+		families[family.GetName()] = family
+	}
+}
+
+// writeFileAtomic writes data to a temporary file and renames it into place,
+// so that a crash never leaves a partial segment behind
+func writeFileAtomic(path string, data []byte) error {
+	tmpPath := path + spillTmpExtension
+	err := ioutil.WriteFile(tmpPath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing spill segment %s: %v", tmpPath, err)
+	}
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("error writing spill segment %s: %v", path, err)
+	}
+	return nil
+}
+
+func networkDirName(networkID string) string {
+	if networkID == "" {
+		return unlabeledNetworkDir
+	}
+	return networkDirPrefix + url.PathEscape(networkID)
+}
+
+func parseNetworkDirName(name string) (string, bool) {
+	if name == unlabeledNetworkDir {
+		return "", true
+	}
+	if !strings.HasPrefix(name, networkDirPrefix) {
+		return "", false
+	}
+	networkID, err := url.PathUnescape(strings.TrimPrefix(name, networkDirPrefix))
+	if err != nil {
+		return "", false
+	}
+	return networkID, true
+}
+
+// parseSegmentName parses segment names of the form <seq>-<datapoints>.spill
+func parseSegmentName(name string) (uint64, int, bool) {
+	if !strings.HasSuffix(name, spillSegmentExtension) {
+		return 0, 0, false
+	}
+	parts := strings.Split(strings.TrimSuffix(name, spillSegmentExtension), "-")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	datapoints, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return seq, datapoints, true
+}
+
+func sortedFamilyNames(families map[string]*dto.MetricFamily) []string {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package cache
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	networkAReceiveString = `
+# TYPE cpu_usage gauge
+cpu_usage{networkID="netA",host="A"} 1 1395066363000
+cpu_usage{networkID="netA",host="A"} 2 1395066363100
+cpu_usage{networkID="netA",host="B"} 3 1395066363000
+`
+	networkBReceiveString = `
+# TYPE disk_usage gauge
+disk_usage{networkID="netB",host="A"} 4 1395066363000
+`
+	networkCReceiveString = `
+# TYPE memory_usage gauge
+memory_usage{networkID="netC",host="A"} 5 1395066363000
+memory_usage{networkID="netC",host="A"} 6 1395066363100
+`
+)
+
+func TestReceiveNetworkLimit(t *testing.T) {
+	cache := NewSpillingMetricCache(0, 2, 10, nil)
+	resp, err := receiveString(cache, networkAReceiveString+"\n"+networkBReceiveString)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// netA is over its limit, but netB's datapoints are still accepted
+	assert.Equal(t, 1, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
+	assert.Equal(t, 3, int(getCounterValue(cache.internalCounters[internalMetricDroppedDatapoints])))
+	assert.Equal(t, map[string]int{"netB": 1}, cache.networkDatapoints)
+
+	// the network limit resets on scrape
+	families := scrape(t, cache)
+	assert.Equal(t, 1, countDatapoints(withoutInternalMetrics(families)))
+	assert.Empty(t, cache.networkDatapoints)
+}
+
+func TestSpill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus_cache_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	spill, err := NewDiskSpill(dir, 0, 0)
+	assert.NoError(t, err)
+	cache := NewSpillingMetricCache(3, 0, 10, spill)
+
+	_, err = receiveString(cache, networkAReceiveString)
+	assert.NoError(t, err)
+	// doesn't fit in the cache, so netB is spilled
+	_, err = receiveString(cache, networkBReceiveString)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
+	assert.Equal(t, 1, int(getGaugeValue(cache.internalMetrics[internalMetricSpillSize])))
+	assert.Equal(t, 1, int(getCounterValue(cache.internalCounters[internalMetricSpilledDatapoints])))
+
+	// the first scrape returns the cached datapoints, and replays the spill
+	// into the cache for the next one
+	families := withoutInternalMetrics(scrape(t, cache))
+	assert.Equal(t, 3, countDatapoints(families))
+	assert.Equal(t, 0, spill.Datapoints())
+	assert.Equal(t, 1, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
+	assert.Equal(t, map[string]int{"netB": 1}, cache.networkDatapoints)
+
+	// replayed datapoints count against the cache limit
+	_, err = receiveString(cache, networkCReceiveString)
+	assert.NoError(t, err)
+	_, err = receiveString(cache, networkCReceiveString)
+	assert.NoError(t, err)
+	_, err = receiveString(cache, networkAReceiveString)
+	assert.NoError(t, err)
+	_, err = receiveString(cache, networkBReceiveString)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
+	assert.Equal(t, 6, spill.Datapoints())
+
+	// networks take turns replaying, up to the cache limit
+	families = withoutInternalMetrics(scrape(t, cache))
+	assert.Equal(t, 3, countDatapoints(families))
+	assert.Equal(t, 3, spill.Datapoints())
+	assert.Equal(t, map[string]int{"netA": 3}, cache.networkDatapoints)
+
+	// spilled datapoints are kept across restarts
+	spill, err = NewDiskSpill(dir, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, spill.Datapoints())
+	cache = NewSpillingMetricCache(3, 0, 10, spill)
+	assert.Equal(t, 3, int(getGaugeValue(cache.internalMetrics[internalMetricSpillSize])))
+
+	// netC is spilled while it has spilled datapoints, even if it would fit
+	_, err = receiveString(cache, networkCReceiveString)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, int(getGaugeValue(cache.internalMetrics[internalMetricCacheSize])))
+	assert.Equal(t, 5, spill.Datapoints())
+
+	families = withoutInternalMetrics(scrape(t, cache))
+	assert.Equal(t, 0, countDatapoints(families))
+	families = withoutInternalMetrics(scrape(t, cache))
+	assert.Equal(t, 3, countDatapoints(families))
+	families = withoutInternalMetrics(scrape(t, cache))
+	assert.Equal(t, 2, countDatapoints(families))
+	assert.Equal(t, 0, spill.Datapoints())
+	assert.False(t, spill.Has("netC"))
+}
+
+// TestSpillPendingWrites checks that a network with a reserved segment counts
+// as spilled, and isn't replayed until the segment is written
+func TestSpillPendingWrites(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus_cache_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	parsedA := parseString(t, networkAReceiveString)
+	spill, err := NewDiskSpill(dir, 0, 0)
+	assert.NoError(t, err)
+
+	first := spill.reserve("netA")
+	assert.True(t, spill.Has("netA"))
+	assert.NoError(t, spill.Write("netA", parsedA))
+	read, _ := spill.Read(0, 0)
+	assert.Empty(t, read)
+
+	// the segments are read in the order they were reserved
+	assert.NoError(t, spill.writeReserved("netA", first, parseString(t, networkCReceiveString)))
+	segments := spill.take(0, 0)
+	assert.Len(t, segments, 2)
+	assert.Equal(t, first, segments[0].seq)
+	assert.Equal(t, 2, segments[0].datapoints)
+	assert.Equal(t, 3, segments[1].datapoints)
+	read, dropped := readSegments(segments)
+	assert.Len(t, read, 2)
+	assert.Empty(t, dropped)
+	assert.False(t, spill.Has("netA"))
+	assert.Equal(t, int64(0), spill.Bytes())
+}
+
+func TestSpillLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prometheus_cache_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	parsedA := parseString(t, networkAReceiveString)
+	parsedB := parseString(t, networkBReceiveString)
+	parsedC := parseString(t, networkCReceiveString)
+
+	spill, err := NewDiskSpill(dir, 0, 1)
+	assert.NoError(t, err)
+	assert.EqualError(t, spill.Write("netA", parsedA), "spill limit of 1 bytes reached for network netA")
+
+	spill, err = NewDiskSpill(dir, 1, 0)
+	assert.NoError(t, err)
+	assert.EqualError(t, spill.Write("netA", parsedA), "spill limit of 1 bytes reached")
+
+	// networks take turns when reading
+	spill, err = NewDiskSpill(dir, 0, 0)
+	assert.NoError(t, err)
+	assert.NoError(t, spill.Write("netA", parsedA))
+	assert.NoError(t, spill.Write("netA", parsedA))
+	assert.NoError(t, spill.Write("netB", parsedB))
+	assert.NoError(t, spill.Write("netC", parsedC))
+	read, dropped := spill.Read(6, 0)
+	assert.Equal(t, 0, dropped)
+	assert.Len(t, read, 3)
+	assert.Equal(t, 3, spill.Datapoints())
+	assert.True(t, spill.Has("netA"))
+
+	// the first segment is read even if it exceeds the limits
+	read, _ = spill.Read(1, 1)
+	assert.Len(t, read, 1)
+	assert.Equal(t, 3, countDatapoints(read[0]))
+	assert.Equal(t, 0, spill.Datapoints())
+}
+
+func scrape(t *testing.T, cache *MetricCache) map[string]*dto.MetricFamily {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	assert.NoError(t, cache.Scrape(c))
+	return parseString(t, rec.Body.String())
+}
+
+func parseString(t *testing.T, str string) map[string]*dto.MetricFamily {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(strings.NewReader(str))
+	assert.NoError(t, err)
+	return families
+}
+
+func withoutInternalMetrics(families map[string]*dto.MetricFamily) map[string]*dto.MetricFamily {
+	ret := map[string]*dto.MetricFamily{}
+	for name, family := range families {
+		if !strings.HasPrefix(name, "cache_") {
+			ret[name] = family
+		}
+	}
+	return ret
+}
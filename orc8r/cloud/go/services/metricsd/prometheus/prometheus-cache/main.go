@@ -14,22 +14,37 @@ import (
 
 	"magma/orc8r/cloud/go/services/metricsd/prometheus/prometheus-cache/cache"
 
+	"github.com/golang/glog"
 	"github.com/labstack/echo"
 )
 
 const (
 	defaultPort          = "9091"
 	defaultLimit         = -1
+	defaultNetworkLimit  = -1
 	defaultScrapeTimeout = 10 // seconds
+	defaultSpillLimit    = -1
 )
 
 func main() {
 	port := flag.String("port", defaultPort, fmt.Sprintf("Port to listen for requests. Default is %s", defaultPort))
-	totalMetricsLimit := flag.Int("limit", defaultLimit, fmt.Sprintf("Limit the total metrics in the cache at one time. Will reject a push if cache is full. Default is %d which is no limit.", defaultLimit))
+	totalMetricsLimit := flag.Int("limit", defaultLimit, fmt.Sprintf("Limit the total metrics in the cache at one time. Will reject a push if cache is full, or drop or spill the metrics which don't fit if networkLimit or spillDir are set. Default is %d which is no limit.", defaultLimit))
+	networkMetricsLimit := flag.Int("networkLimit", defaultNetworkLimit, fmt.Sprintf("Limit the metrics of a single network in the cache at one time. Will drop or spill the network's metrics if its share of the cache is full. Default is %d which is no limit.", defaultNetworkLimit))
 	scrapeTimeout := flag.Int("scrapeTimeout", defaultScrapeTimeout, fmt.Sprintf("Timeout for scrape calls. Default is %d", defaultScrapeTimeout))
+	spillDir := flag.String("spillDir", "", "Directory to spill metrics which don't fit in the cache to, instead of rejecting them. They are replayed on later scrapes. Default is no spilling.")
+	spillLimit := flag.Int64("spillLimit", defaultSpillLimit, fmt.Sprintf("Limit the total bytes of spilled metrics. Default is %d which is no limit.", defaultSpillLimit))
+	spillNetworkLimit := flag.Int64("spillNetworkLimit", defaultSpillLimit, fmt.Sprintf("Limit the bytes of spilled metrics of a single network. Default is %d which is no limit.", defaultSpillLimit))
 	flag.Parse()
 
-	metricCache := cache.NewMetricCache(*totalMetricsLimit, *scrapeTimeout)
+	var spill *cache.DiskSpill
+	if *spillDir != "" {
+		var err error
+		spill, err = cache.NewDiskSpill(*spillDir, *spillLimit, *spillNetworkLimit)
+		if err != nil {
+			glog.Fatalf("Failed to open spill directory: %v", err)
+		}
+	}
+	metricCache := cache.NewSpillingMetricCache(*totalMetricsLimit, *networkMetricsLimit, *scrapeTimeout, spill)
 	e := echo.New()
 
 	e.POST("/metrics", metricCache.Receive)
@@ -11,7 +11,7 @@ package state
 import (
 	"context"
 	"encoding/json"
-	"sync"
+	"io"
 
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/orc8r"
@@ -22,7 +22,6 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/thoas/go-funk"
-	"google.golang.org/grpc"
 )
 
 // State includes reported operational state and additional info about the reporter
@@ -52,27 +51,14 @@ type StateID struct {
 	DeviceID string
 }
 
-// Global clientconn that can be reused for this service
-var connSingleton = (*grpc.ClientConn)(nil)
-var connGuard = sync.Mutex{}
-
 func GetStateClient() (protos.StateServiceClient, error) {
-	if connSingleton == nil {
-		// Reading the conn optimistically to avoid unnecessary overhead
-		connGuard.Lock()
-		if connSingleton == nil {
-			conn, err := registry.GetConnection(ServiceName)
-			if err != nil {
-				initErr := errors.NewInitError(err, ServiceName)
-				glog.Error(initErr)
-				connGuard.Unlock()
-				return nil, initErr
-			}
-			connSingleton = conn
-		}
-		connGuard.Unlock()
+	conn, err := registry.GetConnection(ServiceName)
+	if err != nil {
+		initErr := errors.NewInitError(err, ServiceName)
+		glog.Error(initErr)
+		return nil, initErr
 	}
-	return protos.NewStateServiceClient(connSingleton), nil
+	return protos.NewStateServiceClient(conn), nil
 }
 
 // GetState returns the state specified by the networkID, typeVal, and hwID
@@ -151,6 +137,40 @@ func DeleteStates(networkID string, stateIDs []StateID) error {
 	return err
 }
 
+// WatchStates calls handleEvent for every change to the states of a network,
+// optionally filtered to a set of state types (all types if empty) and a set
+// of device IDs (all devices if empty). This function blocks until ctx is
+// cancelled, the stream ends, or handleEvent returns an error. Cancellation
+// of ctx is not reported as an error.
+func WatchStates(
+	ctx context.Context,
+	networkID string,
+	types []string,
+	deviceIDs []string,
+	handleEvent func(event *protos.StateChangeEvent) error,
+) error {
+	client, err := GetStateClient()
+	if err != nil {
+		return err
+	}
+	stream, err := client.WatchStates(ctx, &protos.WatchStatesRequest{NetworkID: networkID, Types: types, DeviceIDs: deviceIDs})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := handleEvent(event); err != nil {
+			return err
+		}
+	}
+}
+
 func GetGatewayStatus(networkID string, deviceID string) (*models.GatewayStatus, error) {
 	state, err := GetState(networkID, orc8r.GatewayStateType, deviceID)
 	if err != nil {
@@ -12,8 +12,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/orc8r"
 	models2 "magma/orc8r/cloud/go/pluginimpl/models"
@@ -25,8 +28,10 @@ import (
 	"magma/orc8r/cloud/go/services/device"
 	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
 	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/servicers"
 	stateTestInit "magma/orc8r/cloud/go/services/state/test_init"
 	"magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/glog"
 	"github.com/stretchr/testify/assert"
@@ -142,6 +147,105 @@ func TestStateService(t *testing.T) {
 	testGetStatesResponse(t, states, bundle0, bundle1)
 }
 
+func TestWatchStates(t *testing.T) {
+	defer func(interval time.Duration) { servicers.WatchPollInterval = interval }(servicers.WatchPollInterval)
+	servicers.WatchPollInterval = 10 * time.Millisecond
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+	_ = serde.RegisterSerdes(
+		state.NewStateSerde("test-serde", &Name{}),
+		serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models2.GatewayDevice{}))
+
+	networkID := "state_watch_test_network"
+	configuratorTestUtils.RegisterNetwork(t, networkID, "State Watch Test")
+	configuratorTestUtils.RegisterGateway(t, networkID, testAgHwId, &models2.GatewayDevice{HardwareID: testAgHwId})
+	ctx := test_utils.GetContextWithCertificate(t, testAgHwId)
+
+	events, stop := watchStates(t, networkID, []string{"test-serde"})
+	defer stop()
+
+	bundle := makeVersionedStateBundle("test-serde", "key0", Name{Name: "name0"}, 1)
+	_, err := reportStates(ctx, bundle)
+	assert.NoError(t, err)
+	expectStateChangeEvent(t, events, &protos.StateChangeEvent{Change: protos.StateChangeEvent_CREATED, NetworkID: networkID, Type: "test-serde", DeviceID: "key0", Version: 1})
+
+	bundle.state.Version = 2
+	_, err = reportStates(ctx, bundle)
+	assert.NoError(t, err)
+	expectStateChangeEvent(t, events, &protos.StateChangeEvent{Change: protos.StateChangeEvent_UPDATED, NetworkID: networkID, Type: "test-serde", DeviceID: "key0", Version: 2})
+
+	err = state.DeleteStates(networkID, []state.StateID{bundle.ID})
+	assert.NoError(t, err)
+	expectStateChangeEvent(t, events, &protos.StateChangeEvent{Change: protos.StateChangeEvent_DELETED, NetworkID: networkID, Type: "test-serde", DeviceID: "key0", Version: 2})
+}
+
+func TestWatchStates_PollFailure(t *testing.T) {
+	defer func(interval time.Duration) { servicers.WatchPollInterval = interval }(servicers.WatchPollInterval)
+	defer func(interval time.Duration) { servicers.WatchMaxRetryInterval = interval }(servicers.WatchMaxRetryInterval)
+	servicers.WatchPollInterval = 10 * time.Millisecond
+	servicers.WatchMaxRetryInterval = 20 * time.Millisecond
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	factory := &flakyBlobStorageFactory{BlobStorageFactory: blobstore.NewMemoryBlobStorageFactory()}
+	stateTestInit.StartTestServiceWithFactory(t, factory)
+	_ = serde.RegisterSerdes(
+		state.NewStateSerde("test-serde", &Name{}),
+		serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models2.GatewayDevice{}))
+
+	networkID := "state_watch_failure_test_network"
+	configuratorTestUtils.RegisterNetwork(t, networkID, "State Watch Failure Test")
+	configuratorTestUtils.RegisterGateway(t, networkID, testAgHwId, &models2.GatewayDevice{HardwareID: testAgHwId})
+	ctx := test_utils.GetContextWithCertificate(t, testAgHwId)
+
+	events, stop := watchStates(t, networkID, []string{"test-serde"})
+	defer stop()
+
+	// Polls fail while the storage is unavailable, the watch keeps retrying
+	atomic.StoreInt32(&factory.unavailable, 1)
+	for atomic.LoadInt32(&factory.failures) < 3 {
+		time.Sleep(time.Millisecond)
+	}
+	atomic.StoreInt32(&factory.unavailable, 0)
+
+	bundle := makeVersionedStateBundle("test-serde", "key0", Name{Name: "name0"}, 1)
+	_, err := reportStates(ctx, bundle)
+	assert.NoError(t, err)
+	expectStateChangeEvent(t, events, &protos.StateChangeEvent{Change: protos.StateChangeEvent_CREATED, NetworkID: networkID, Type: "test-serde", DeviceID: "key0", Version: 1})
+}
+
+// watchStates watches the states of a network through state.WatchStates
+// and returns the channel of received events, along with a function which
+// stops the watch and checks that it ended cleanly.
+func watchStates(t *testing.T, networkID string, types []string) (chan *protos.StateChangeEvent, func()) {
+	events := make(chan *protos.StateChangeEvent, 10)
+	watchCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- state.WatchStates(watchCtx, networkID, types, nil, func(event *protos.StateChangeEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+	// Let the watch take its initial snapshot
+	time.Sleep(50 * time.Millisecond)
+
+	stop := func() {
+		cancel()
+		assert.NoError(t, <-done)
+	}
+	return events, stop
+}
+
+func expectStateChangeEvent(t *testing.T, events chan *protos.StateChangeEvent, expected *protos.StateChangeEvent) {
+	select {
+	case actual := <-events:
+		assert.Equal(t, protos.TestMarshal(expected), protos.TestMarshal(actual))
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for state change event")
+	}
+}
+
 type NameAndAge struct {
 	// name
 	Name string `json:"name"`
@@ -248,3 +352,19 @@ func makeStates(bundles []stateBundle) []*protos.State {
 	}
 	return states
 }
+
+// flakyBlobStorageFactory fails to start transactions while unavailable is
+// set, and counts the failures
+type flakyBlobStorageFactory struct {
+	blobstore.BlobStorageFactory
+	unavailable int32
+	failures    int32
+}
+
+func (f *flakyBlobStorageFactory) StartTransaction(opts *storage.TxOptions) (blobstore.TransactionalBlobStorage, error) {
+	if atomic.LoadInt32(&f.unavailable) != 0 {
+		atomic.AddInt32(&f.failures, 1)
+		return nil, fmt.Errorf("database unavailable")
+	}
+	return f.BlobStorageFactory.StartTransaction(opts)
+}
@@ -10,6 +10,8 @@ package state
 
 import (
 	"encoding/json"
+	"time"
+
 	"magma/orc8r/cloud/go/serde"
 )
 
@@ -19,6 +21,55 @@ func NewStateSerde(stateType string, modelPtr serde.ValidateableBinaryConvertibl
 	return serde.NewBinarySerde(SerdeDomain, stateType, modelPtr)
 }
 
+// ExpiringSerde is a state Serde for a state type whose reported values
+// expire a fixed duration after they were reported. Expired states are not
+// returned by the state service and are eventually deleted.
+type ExpiringSerde interface {
+	serde.Serde
+
+	// GetTTL returns how long a reported state of this type stays valid
+	GetTTL() time.Duration
+}
+
+// NewStateSerdeWithTTL returns a state Serde for stateType whose reported
+// values expire ttl after they were reported.
+func NewStateSerdeWithTTL(stateType string, modelPtr serde.ValidateableBinaryConvertible, ttl time.Duration) serde.Serde {
+	return &expiringSerde{Serde: NewStateSerde(stateType, modelPtr), ttl: ttl}
+}
+
+type expiringSerde struct {
+	serde.Serde
+	ttl time.Duration
+}
+
+func (s *expiringSerde) GetTTL() time.Duration {
+	return s.ttl
+}
+
+// GetStateTTLs returns the TTLs of all registered state types which were
+// registered with NewStateSerdeWithTTL, keyed by state type.
+func GetStateTTLs() map[string]time.Duration {
+	ret := map[string]time.Duration{}
+	for _, s := range serde.GetSerdesForDomain(SerdeDomain) {
+		expiring, ok := s.(ExpiringSerde)
+		if !ok || expiring.GetTTL() <= 0 {
+			continue
+		}
+		ret[s.GetType()] = expiring.GetTTL()
+	}
+	return ret
+}
+
+// IsExpired returns true if a state reported at reportTimeMs has outlived
+// the ttl at time now. A non-positive ttl never expires.
+func IsExpired(reportTimeMs uint64, ttl time.Duration, now time.Time) bool {
+	if ttl <= 0 {
+		return false
+	}
+	expiry := time.Unix(0, int64(reportTimeMs)*int64(time.Millisecond)).Add(ttl)
+	return now.After(expiry)
+}
+
 // A generic map that holds key value pair both of type string. This is used on
 // the gateway side in checkin_cli.py to simply test the connection between the
 // cloud and the gateway.
@@ -35,3 +86,19 @@ func (m *StringToStringMap) UnmarshalBinary(data []byte) error {
 func (m *StringToStringMap) ValidateModel() error {
 	return nil
 }
+
+// ArbitraryJSON is a generic model for states whose schema is owned by the
+// gateway service reporting them, e.g. per-subscriber session state.
+type ArbitraryJSON map[string]interface{}
+
+func (m *ArbitraryJSON) MarshalBinary() (data []byte, err error) {
+	return json.Marshal(m)
+}
+
+func (m *ArbitraryJSON) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func (m *ArbitraryJSON) ValidateModel() error {
+	return nil
+}
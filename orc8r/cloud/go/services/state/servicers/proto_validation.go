@@ -40,6 +40,14 @@ func ValidateSyncStatesRequest(req *protos.SyncStatesRequest) error {
 	return nil
 }
 
+// ValidateWatchStatesRequest checks that all required fields exist
+func ValidateWatchStatesRequest(req *protos.WatchStatesRequest) error {
+	if len(req.GetNetworkID()) == 0 {
+		return errors.New("Network ID must be specified")
+	}
+	return nil
+}
+
 // PartitionStatesBySerializability checks that each state is deserializable.
 // If a state is not deserializable, we will send back the states type, key, and error.
 func PartitionStatesBySerializability(req *protos.ReportStatesRequest) ([]*protos.State, []*protos.IDAndError, error) {
@@ -0,0 +1,101 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/configurator"
+	stateService "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+)
+
+// StateReaper deletes reported states which have outlived the TTL registered
+// for their state type.
+type StateReaper struct {
+	factory blobstore.BlobStorageFactory
+}
+
+// NewStateReaper returns a reaper which deletes expired states from the
+// storage passed in
+func NewStateReaper(factory blobstore.BlobStorageFactory) *StateReaper {
+	return &StateReaper{factory: factory}
+}
+
+// PeriodicallyReapExpiredStates reaps expired states across all networks
+// every dur. This function blocks forever.
+func (r *StateReaper) PeriodicallyReapExpiredStates(dur time.Duration) {
+	for range time.Tick(dur) {
+		networkIDs, err := configurator.ListNetworkIDs()
+		if err != nil {
+			glog.Errorf("Failed to list networks for state reaping: %s", err)
+			continue
+		}
+		for _, networkID := range networkIDs {
+			if err := r.ReapExpiredStates(networkID); err != nil {
+				glog.Errorf("Failed to reap expired states for network %s: %s", networkID, err)
+			}
+		}
+	}
+}
+
+// ReapExpiredStates deletes all states in a network which have outlived the
+// TTL of their state type, in a single transaction.
+func (r *StateReaper) ReapExpiredStates(networkID string) error {
+	ttlsByType := stateService.GetStateTTLs()
+	if len(ttlsByType) == 0 {
+		return nil
+	}
+
+	store, err := r.factory.StartTransaction(nil)
+	if err != nil {
+		return err
+	}
+	var expiredIDs []storage.TypeAndKey
+	now := clock.Now()
+	for stateType := range ttlsByType {
+		keys, err := store.ListKeys(networkID, stateType)
+		if err != nil {
+			store.Rollback()
+			return fmt.Errorf("failed to list keys of type %s: %s", stateType, err)
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		ids := make([]storage.TypeAndKey, 0, len(keys))
+		for _, key := range keys {
+			ids = append(ids, storage.TypeAndKey{Type: stateType, Key: key})
+		}
+		blobs, err := store.GetMany(networkID, ids)
+		if err != nil {
+			store.Rollback()
+			return fmt.Errorf("failed to load states of type %s: %s", stateType, err)
+		}
+		for _, blob := range blobs {
+			if isBlobExpired(blob, ttlsByType, now) {
+				expiredIDs = append(expiredIDs, storage.TypeAndKey{Type: blob.Type, Key: blob.Key})
+			}
+		}
+	}
+	if len(expiredIDs) == 0 {
+		return store.Commit()
+	}
+
+	if err := store.Delete(networkID, expiredIDs); err != nil {
+		store.Rollback()
+		return fmt.Errorf("failed to delete expired states: %s", err)
+	}
+	glog.V(2).Infof("Reaped %d expired states in network %s", len(expiredIDs), networkID)
+	return store.Commit()
+}
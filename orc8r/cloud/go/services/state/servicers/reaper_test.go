@@ -0,0 +1,97 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/servicers"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+const (
+	expiringType = "expiring"
+	durableType  = "durable"
+)
+
+func TestStateReaper_ReapExpiredStates(t *testing.T) {
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err := serde.RegisterSerdes(
+		state.NewStateSerdeWithTTL(expiringType, &state.StringToStringMap{}, time.Hour),
+		state.NewStateSerde(durableType, &state.StringToStringMap{}),
+	)
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	err = store.CreateOrUpdate("n1", []blobstore.Blob{
+		makeBlob(t, expiringType, "fresh", now.Add(-time.Minute)),
+		makeBlob(t, expiringType, "stale", now.Add(-2*time.Hour)),
+		makeBlob(t, durableType, "stale", now.Add(-2*time.Hour)),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Expired states are not returned
+	srv, err := servicers.NewStateServicer(factory)
+	assert.NoError(t, err)
+	res, err := srv.GetStates(context.Background(), &protos.GetStatesRequest{
+		NetworkID: "n1",
+		Ids: []*protos.StateID{
+			{Type: expiringType, DeviceID: "fresh"},
+			{Type: expiringType, DeviceID: "stale"},
+			{Type: durableType, DeviceID: "stale"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.States, 2)
+
+	// Expired states are deleted
+	err = servicers.NewStateReaper(factory).ReapExpiredStates("n1")
+	assert.NoError(t, err)
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	blobs, err := store.GetMany("n1", []storage.TypeAndKey{
+		{Type: expiringType, Key: "fresh"},
+		{Type: expiringType, Key: "stale"},
+		{Type: durableType, Key: "stale"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	actual := blobstore.GetBlobsByTypeAndKey(blobs)
+	assert.Len(t, actual, 2)
+	assert.Contains(t, actual, storage.TypeAndKey{Type: expiringType, Key: "fresh"})
+	assert.Contains(t, actual, storage.TypeAndKey{Type: durableType, Key: "stale"})
+}
+
+func makeBlob(t *testing.T, typ string, key string, reportTime time.Time) blobstore.Blob {
+	value, err := json.Marshal(state.SerializedStateWithMeta{
+		ReporterID:              "hw1",
+		TimeMs:                  uint64(reportTime.UnixNano() / int64(time.Millisecond)),
+		SerializedReportedState: []byte(`{"foo":"bar"}`),
+	})
+	assert.NoError(t, err)
+	return blobstore.Blob{Type: typ, Key: key, Value: value}
+}
@@ -52,6 +52,7 @@ func (srv *stateServicer) GetStates(context context.Context, req *protos.GetStat
 		store.Rollback()
 		return nil, err
 	}
+	states = filterExpiredBlobs(states, stateService.GetStateTTLs(), clock.Now())
 	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, store.Commit()
 }
 
@@ -156,6 +157,7 @@ func (srv *stateServicer) SyncStates(
 		store.Rollback()
 		return response, err
 	}
+	blobs = filterExpiredBlobs(blobs, stateService.GetStateTTLs(), clock.Now())
 	// pre-sort the blobstore results for faster syncing
 	statesByDeviceID := map[string][]*protos.State{}
 	for _, blob := range blobs {
@@ -214,3 +216,31 @@ func addWrapperAndMakeBlobs(states []*protos.State, hwID string, timeMs uint64,
 	}
 	return blobs, nil
 }
+
+// filterExpiredBlobs returns the blobs which have not outlived the TTL of
+// their state type.
+func filterExpiredBlobs(blobs []blobstore.Blob, ttlsByType map[string]time.Duration, now time.Time) []blobstore.Blob {
+	if len(ttlsByType) == 0 {
+		return blobs
+	}
+	ret := make([]blobstore.Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		if !isBlobExpired(blob, ttlsByType, now) {
+			ret = append(ret, blob)
+		}
+	}
+	return ret
+}
+
+func isBlobExpired(blob blobstore.Blob, ttlsByType map[string]time.Duration, now time.Time) bool {
+	ttl, ok := ttlsByType[blob.Type]
+	if !ok {
+		return false
+	}
+	wrap := stateService.SerializedStateWithMeta{}
+	if err := json.Unmarshal(blob.Value, &wrap); err != nil {
+		// Leave states we can't interpret to the serde to complain about
+		return false
+	}
+	return stateService.IsExpired(wrap.TimeMs, ttl, now)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
+	stateService "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+)
+
+// WatchPollInterval is how often watched states are compared against their
+// last known versions.
+var WatchPollInterval = 5 * time.Second
+
+// WatchMaxRetryInterval caps the interval at which failed polls of watched
+// states are retried.
+var WatchMaxRetryInterval = time.Minute
+
+// WatchStates streams change events for the states of a network. Changes are
+// detected by periodically comparing the blobstore version of each watched
+// state against the version seen in the previous poll, so changes made
+// through any replica of the state service are observed. Polls which fail
+// are retried with exponential backoff, without closing the stream.
+func (srv *stateServicer) WatchStates(req *protos.WatchStatesRequest, stream protos.StateService_WatchStatesServer) error {
+	if err := ValidateWatchStatesRequest(req); err != nil {
+		return err
+	}
+
+	var known map[storage.TypeAndKey]uint64
+	pollInterval, maxRetryInterval := WatchPollInterval, WatchMaxRetryInterval
+	retryInterval := pollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-timer.C:
+		}
+
+		current, err := srv.getStateVersions(req)
+		if err != nil {
+			glog.Errorf("Failed to poll watched states of network %s, retrying in %s: %s", req.NetworkID, retryInterval, err)
+			timer.Reset(retryInterval)
+			retryInterval *= 2
+			if retryInterval > maxRetryInterval {
+				retryInterval = maxRetryInterval
+			}
+			continue
+		}
+		retryInterval = pollInterval
+		timer.Reset(pollInterval)
+
+		// The first poll only records the initial versions
+		if known != nil {
+			for _, event := range getStateChangeEvents(req.NetworkID, known, current) {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+		}
+		known = current
+	}
+}
+
+// getStateVersions returns the versions of all unexpired states matching
+// the watch request, keyed by state ID. Only the watched types are listed,
+// and if the request names device IDs, only those states are loaded.
+func (srv *stateServicer) getStateVersions(req *protos.WatchStatesRequest) (map[storage.TypeAndKey]uint64, error) {
+	types := req.Types
+	if len(types) == 0 {
+		for _, s := range serde.GetSerdesForDomain(stateService.SerdeDomain) {
+			types = append(types, s.GetType())
+		}
+	}
+
+	store, err := srv.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	var ids []storage.TypeAndKey
+	for _, typ := range types {
+		keys := req.DeviceIDs
+		if len(keys) == 0 {
+			keys, err = store.ListKeys(req.NetworkID, typ)
+			if err != nil {
+				store.Rollback()
+				return nil, err
+			}
+		}
+		for _, key := range keys {
+			ids = append(ids, storage.TypeAndKey{Type: typ, Key: key})
+		}
+	}
+	var blobs []blobstore.Blob
+	if len(ids) > 0 {
+		blobs, err = store.GetMany(req.NetworkID, ids)
+		if err != nil {
+			store.Rollback()
+			return nil, err
+		}
+	}
+	blobs = filterExpiredBlobs(blobs, stateService.GetStateTTLs(), clock.Now())
+
+	ret := make(map[storage.TypeAndKey]uint64, len(blobs))
+	for _, blob := range blobs {
+		ret[storage.TypeAndKey{Type: blob.Type, Key: blob.Key}] = blob.Version
+	}
+	return ret, store.Commit()
+}
+
+func getStateChangeEvents(networkID string, previous, current map[storage.TypeAndKey]uint64) []*protos.StateChangeEvent {
+	var events []*protos.StateChangeEvent
+	for id, version := range current {
+		prevVersion, existed := previous[id]
+		switch {
+		case !existed:
+			events = append(events, newStateChangeEvent(protos.StateChangeEvent_CREATED, networkID, id, version))
+		case prevVersion != version:
+			events = append(events, newStateChangeEvent(protos.StateChangeEvent_UPDATED, networkID, id, version))
+		}
+	}
+	for id, version := range previous {
+		if _, exists := current[id]; !exists {
+			events = append(events, newStateChangeEvent(protos.StateChangeEvent_DELETED, networkID, id, version))
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Type != events[j].Type {
+			return events[i].Type < events[j].Type
+		}
+		return events[i].DeviceID < events[j].DeviceID
+	})
+	return events
+}
+
+func newStateChangeEvent(change protos.StateChangeEvent_ChangeType, networkID string, id storage.TypeAndKey, version uint64) *protos.StateChangeEvent {
+	return &protos.StateChangeEvent{
+		Change:    change,
+		NetworkID: networkID,
+		Type:      id.Type,
+		DeviceID:  id.Key,
+		Version:   version,
+	}
+}
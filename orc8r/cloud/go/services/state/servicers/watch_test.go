@@ -0,0 +1,105 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/servicers"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestStateServicer_WatchStates(t *testing.T) {
+	defer func(interval time.Duration) { servicers.WatchPollInterval = interval }(servicers.WatchPollInterval)
+	defer func(interval time.Duration) { servicers.WatchMaxRetryInterval = interval }(servicers.WatchMaxRetryInterval)
+	servicers.WatchPollInterval = 10 * time.Millisecond
+	servicers.WatchMaxRetryInterval = 20 * time.Millisecond
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err := serde.RegisterSerdes(state.NewStateSerde(durableType, &state.StringToStringMap{}))
+	assert.NoError(t, err)
+
+	// The first polls fail, the stream stays open and retries
+	factory := &flakyBlobStorageFactory{BlobStorageFactory: blobstore.NewMemoryBlobStorageFactory(), failures: 3}
+	srv, err := servicers.NewStateServicer(factory)
+	assert.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockWatchStatesServer{ctx: ctx, events: make(chan *protos.StateChangeEvent, 10)}
+	done := make(chan error)
+	go func() {
+		done <- srv.WatchStates(&protos.WatchStatesRequest{NetworkID: "n1", Types: []string{durableType}, DeviceIDs: []string{"watched"}}, stream)
+	}()
+
+	// Wait for the initial versions to be recorded
+	for atomic.LoadInt32(&factory.failures) > -2 {
+		time.Sleep(time.Millisecond)
+	}
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	err = store.CreateOrUpdate("n1", []blobstore.Blob{
+		makeBlob(t, durableType, "watched", clock.Now()),
+		makeBlob(t, durableType, "unwatched", clock.Now()),
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Only the watched device is reported
+	select {
+	case event := <-stream.events:
+		assert.Equal(t, protos.StateChangeEvent_CREATED, event.Change)
+		assert.Equal(t, "watched", event.DeviceID)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for state change event")
+	}
+	time.Sleep(5 * servicers.WatchPollInterval)
+	assert.Empty(t, stream.events)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+// flakyBlobStorageFactory fails the first transactions it is asked to start
+type flakyBlobStorageFactory struct {
+	blobstore.BlobStorageFactory
+	failures int32
+}
+
+func (f *flakyBlobStorageFactory) StartTransaction(opts *storage.TxOptions) (blobstore.TransactionalBlobStorage, error) {
+	if atomic.AddInt32(&f.failures, -1) >= 0 {
+		return nil, errors.New("database unavailable")
+	}
+	return f.BlobStorageFactory.StartTransaction(opts)
+}
+
+type mockWatchStatesServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *protos.StateChangeEvent
+}
+
+func (m *mockWatchStatesServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockWatchStatesServer) Send(event *protos.StateChangeEvent) error {
+	m.events <- event
+	return nil
+}
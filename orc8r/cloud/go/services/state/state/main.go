@@ -27,6 +27,9 @@ import (
 // how often to report gateway status
 const gatewayStatusReportInterval = time.Second * 60
 
+// how often to delete states which have outlived their TTL
+const stateReapInterval = time.Minute * 10
+
 func main() {
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, state.ServiceName)
 	if err != nil {
@@ -48,6 +51,9 @@ func main() {
 	}
 	protos.RegisterStateServiceServer(srv.GrpcServer, server)
 
+	// periodically delete expired states
+	go servicers.NewStateReaper(store).PeriodicallyReapExpiredStates(stateReapInterval)
+
 	// periodically go through all existing gateways and log metrics
 	go metrics.PeriodicallyReportGatewayStatus(gatewayStatusReportInterval)
 
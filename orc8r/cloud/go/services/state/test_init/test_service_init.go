@@ -23,7 +23,12 @@ import (
 
 // StartTestService instantiates a service backed by an in-memory storage
 func StartTestService(t *testing.T) {
-	factory := blobstore.NewMemoryBlobStorageFactory()
+	StartTestServiceWithFactory(t, blobstore.NewMemoryBlobStorageFactory())
+}
+
+// StartTestServiceWithFactory instantiates a service backed by the given
+// storage
+func StartTestServiceWithFactory(t *testing.T, factory blobstore.BlobStorageFactory) {
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, state.ServiceName)
 	server, err := servicers.NewStateServicer(factory)
 	assert.NoError(t, err)
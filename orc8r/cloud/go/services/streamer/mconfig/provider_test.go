@@ -57,9 +57,9 @@ func TestMconfigStreamer_Configurator(t *testing.T) {
 	factory.ClearMconfigBuilders(t)
 	factory.RegisterMconfigBuilders(mockLegacyBuilder)
 
-	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	err := configurator.CreateNetwork(context.Background(), configurator.Network{ID: "n1"})
 	assert.NoError(t, err)
-	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "gw1", PhysicalID: "hw1"})
+	_, err = configurator.CreateEntity(context.Background(), "n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "gw1", PhysicalID: "hw1"})
 	assert.NoError(t, err)
 
 	conn, err := registry.GetConnection(streamer.ServiceName)
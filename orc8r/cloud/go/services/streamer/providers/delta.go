@@ -0,0 +1,291 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// DefaultSnapshotCacheMaxBytes is the default estimated memory budget of
+// the stream snapshots that a delta provider created by
+// NewDeltaStreamProvider keeps around to compute deltas against. Gateways in
+// the same network usually share a snapshot.
+const DefaultSnapshotCacheMaxBytes = 64 << 20
+
+// SnapshotCacheMaxBytes is the estimated memory budget of the snapshots
+// cached by each delta provider. Least recently used snapshots are evicted
+// first. A snapshot larger than the budget is never cached, so gateways
+// holding it receive full resyncs.
+var SnapshotCacheMaxBytes int64 = DefaultSnapshotCacheMaxBytes
+
+// snapshotEntryOverhead approximates the memory used by each key of a
+// snapshot besides the key itself: the value hash, the string header and
+// the map bucket overhead.
+const snapshotEntryOverhead = sha256.Size + 32
+
+// DeltaStreamProvider is a StreamProvider which can compute incremental
+// updates for a gateway that already holds a previous version of the stream.
+type DeltaStreamProvider interface {
+	StreamProvider
+
+	// GetUpdatesDelta returns the batch of updates which brings a gateway
+	// holding the stream contents identified by digest up to date. If the
+	// provider can't compute a delta against digest, the returned batch is a
+	// full resync. The returned batch always carries the digest of the
+	// stream contents after it is applied.
+	GetUpdatesDelta(gatewayId string, digest string, extraArgs *any.Any) (*protos.DataUpdateBatch, error)
+}
+
+// VersionedStreamProvider is a StreamProvider which versions each key of its
+// stream. Deltas of a versioned provider are computed from the versions of
+// the keys, so only the values of changed keys are loaded.
+type VersionedStreamProvider interface {
+	StreamProvider
+
+	// GetVersions returns the version of each key of the stream. The version
+	// of a key must change whenever its value does, and should be much
+	// cheaper to compute than the value.
+	GetVersions(gatewayId string, extraArgs *any.Any) (map[string]string, error)
+
+	// GetUpdatesForKeys returns the updates of the given keys. Keys which
+	// don't exist anymore are omitted.
+	GetUpdatesForKeys(gatewayId string, keys []string, extraArgs *any.Any) ([]*protos.DataUpdate, error)
+}
+
+// NewDeltaStreamProvider wraps a StreamProvider into a DeltaStreamProvider.
+// Deltas of a VersionedStreamProvider are computed by comparing the versions
+// of its keys against a cached snapshot of the versions the gateway last
+// received. Deltas of other providers are computed by diffing the full set
+// of updates returned by the wrapped provider against a cached snapshot of
+// the contents the gateway last received.
+func NewDeltaStreamProvider(provider StreamProvider) DeltaStreamProvider {
+	if versioned, ok := provider.(VersionedStreamProvider); ok {
+		return &versionedDeltaProvider{
+			VersionedStreamProvider: versioned,
+			snapshots:               newSnapshotCache(),
+		}
+	}
+	return &snapshotDeltaProvider{
+		StreamProvider: provider,
+		snapshots:      newSnapshotCache(),
+	}
+}
+
+type snapshotDeltaProvider struct {
+	StreamProvider
+	snapshots *snapshotCache
+}
+
+func (p *snapshotDeltaProvider) GetUpdatesDelta(gatewayId string, digest string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	updates, err := p.GetUpdates(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	current := newSnapshot(updates)
+	p.snapshots.put(current)
+
+	if digest == current.digest {
+		return &protos.DataUpdateBatch{Resync: false, Digest: current.digest}, nil
+	}
+	previous, ok := p.snapshots.get(digest)
+	if digest == "" || !ok {
+		return &protos.DataUpdateBatch{Updates: updates, Resync: true, Digest: current.digest}, nil
+	}
+	return &protos.DataUpdateBatch{Updates: current.diff(previous, updates), Resync: false, Digest: current.digest}, nil
+}
+
+type versionedDeltaProvider struct {
+	VersionedStreamProvider
+	snapshots *snapshotCache
+}
+
+// GetUpdatesDelta only loads the versions of the stream's keys, and the
+// values of the keys which changed since digest. The versions are loaded
+// before the values, so a value which changes in between is sent again with
+// the next delta.
+func (p *versionedDeltaProvider) GetUpdatesDelta(gatewayId string, digest string, extraArgs *any.Any) (*protos.DataUpdateBatch, error) {
+	versions, err := p.GetVersions(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	current := newVersionSnapshot(versions)
+	p.snapshots.put(current)
+
+	if digest == current.digest {
+		return &protos.DataUpdateBatch{Resync: false, Digest: current.digest}, nil
+	}
+	previous, ok := p.snapshots.get(digest)
+	if digest == "" || !ok {
+		updates, err := p.GetUpdates(gatewayId, extraArgs)
+		if err != nil {
+			return nil, err
+		}
+		return &protos.DataUpdateBatch{Updates: updates, Resync: true, Digest: current.digest}, nil
+	}
+
+	updates := []*protos.DataUpdate{}
+	if changed := current.changedKeys(previous); len(changed) > 0 {
+		updates, err = p.GetUpdatesForKeys(gatewayId, changed, extraArgs)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, key := range current.deletedKeys(previous) {
+		updates = append(updates, &protos.DataUpdate{Key: key, Deleted: true})
+	}
+	return &protos.DataUpdateBatch{Updates: updates, Resync: false, Digest: current.digest}, nil
+}
+
+// GetUpdatesDigest returns the digest of the stream contents represented by
+// a full set of updates. The digest does not depend on the order of updates.
+func GetUpdatesDigest(updates []*protos.DataUpdate) string {
+	return newSnapshot(updates).digest
+}
+
+// snapshot is a compact representation of the full contents of a stream,
+// mapping each key to a hash of its value, or of its version for versioned
+// streams.
+type snapshot struct {
+	digest      string
+	valueHashes map[string][sha256.Size]byte
+	// size is the estimated memory used by the snapshot in bytes
+	size int64
+}
+
+func newSnapshot(updates []*protos.DataUpdate) *snapshot {
+	valueHashes := make(map[string][sha256.Size]byte, len(updates))
+	for _, update := range updates {
+		valueHashes[update.Key] = sha256.Sum256(update.Value)
+	}
+	return newSnapshotFromHashes(valueHashes)
+}
+
+func newVersionSnapshot(versions map[string]string) *snapshot {
+	versionHashes := make(map[string][sha256.Size]byte, len(versions))
+	for key, version := range versions {
+		versionHashes[key] = sha256.Sum256([]byte(version))
+	}
+	return newSnapshotFromHashes(versionHashes)
+}
+
+func newSnapshotFromHashes(valueHashes map[string][sha256.Size]byte) *snapshot {
+	keys := make([]string, 0, len(valueHashes))
+	size := int64(0)
+	for key := range valueHashes {
+		keys = append(keys, key)
+		size += int64(len(key)) + snapshotEntryOverhead
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		valueHash := valueHashes[key]
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(valueHash[:])
+	}
+	return &snapshot{digest: hex.EncodeToString(hash.Sum(nil)), valueHashes: valueHashes, size: size}
+}
+
+// diff returns the updates which turn previous into s. updates must be the
+// full set of updates s was built from. Keys which are absent from s are
+// returned as deleted updates.
+func (s *snapshot) diff(previous *snapshot, updates []*protos.DataUpdate) []*protos.DataUpdate {
+	ret := []*protos.DataUpdate{}
+	for _, update := range updates {
+		if s.isChanged(previous, update.Key) {
+			ret = append(ret, update)
+		}
+	}
+	for _, key := range s.deletedKeys(previous) {
+		ret = append(ret, &protos.DataUpdate{Key: key, Deleted: true})
+	}
+	return ret
+}
+
+// changedKeys returns the sorted keys of s which are absent from previous or
+// whose hash differs.
+func (s *snapshot) changedKeys(previous *snapshot) []string {
+	changed := []string{}
+	for key := range s.valueHashes {
+		if s.isChanged(previous, key) {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// deletedKeys returns the sorted keys of previous which are absent from s.
+func (s *snapshot) deletedKeys(previous *snapshot) []string {
+	deleted := []string{}
+	for key := range previous.valueHashes {
+		if _, ok := s.valueHashes[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+	sort.Strings(deleted)
+	return deleted
+}
+
+func (s *snapshot) isChanged(previous *snapshot, key string) bool {
+	prevHash, ok := previous.valueHashes[key]
+	return !ok || prevHash != s.valueHashes[key]
+}
+
+// snapshotCache is an LRU cache of snapshots keyed by digest, bounded by
+// the estimated size of the snapshots it holds.
+type snapshotCache struct {
+	sync.Mutex
+	size     int64
+	order    *list.List
+	byDigest map[string]*list.Element
+}
+
+func newSnapshotCache() *snapshotCache {
+	return &snapshotCache{order: list.New(), byDigest: map[string]*list.Element{}}
+}
+
+func (c *snapshotCache) get(digest string) (*snapshot, bool) {
+	c.Lock()
+	defer c.Unlock()
+	elem, ok := c.byDigest[digest]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*snapshot), true
+}
+
+func (c *snapshotCache) put(s *snapshot) {
+	c.Lock()
+	defer c.Unlock()
+	if elem, ok := c.byDigest[s.digest]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+	if s.size > SnapshotCacheMaxBytes {
+		return
+	}
+	c.byDigest[s.digest] = c.order.PushFront(s)
+	c.size += s.size
+	for c.size > SnapshotCacheMaxBytes {
+		oldest := c.order.Remove(c.order.Back()).(*snapshot)
+		delete(c.byDigest, oldest.digest)
+		c.size -= oldest.size
+	}
+}
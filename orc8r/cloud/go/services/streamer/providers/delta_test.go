@@ -0,0 +1,66 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"fmt"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotCache_MaxBytes(t *testing.T) {
+	defer func(maxBytes int64) { SnapshotCacheMaxBytes = maxBytes }(SnapshotCacheMaxBytes)
+
+	// Each snapshot holds 10 keys of 4 bytes
+	newTestSnapshot := func(version int) *snapshot {
+		updates := make([]*protos.DataUpdate, 0, 10)
+		for i := 0; i < 10; i++ {
+			updates = append(updates, &protos.DataUpdate{Key: fmt.Sprintf("k%03d", i), Value: []byte(fmt.Sprintf("%d", version))})
+		}
+		return newSnapshot(updates)
+	}
+	snapshotSize := 10 * (4 + snapshotEntryOverhead)
+	s1, s2, s3 := newTestSnapshot(1), newTestSnapshot(2), newTestSnapshot(3)
+	assert.Equal(t, int64(snapshotSize), s1.size)
+
+	// Room for 2 snapshots, least recently used is evicted
+	SnapshotCacheMaxBytes = int64(2*snapshotSize + 1)
+	cache := newSnapshotCache()
+	cache.put(s1)
+	cache.put(s2)
+	_, ok := cache.get(s1.digest)
+	assert.True(t, ok)
+	cache.put(s3)
+	_, ok = cache.get(s2.digest)
+	assert.False(t, ok)
+	_, ok = cache.get(s1.digest)
+	assert.True(t, ok)
+	_, ok = cache.get(s3.digest)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2*snapshotSize), cache.size)
+
+	// Snapshots larger than the budget are not cached
+	SnapshotCacheMaxBytes = int64(snapshotSize - 1)
+	cache = newSnapshotCache()
+	cache.put(s1)
+	_, ok = cache.get(s1.digest)
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), cache.size)
+}
+
+func TestSnapshotDeltaProvider_Deleted(t *testing.T) {
+	previous := newSnapshot([]*protos.DataUpdate{{Key: "a", Value: []byte("1")}, {Key: "b"}})
+	updates := []*protos.DataUpdate{{Key: "b"}}
+	diff := newSnapshot(updates).diff(previous, updates)
+	// b has an empty value in both, only a is returned and marked as deleted
+	assert.Equal(t, []*protos.DataUpdate{{Key: "a", Deleted: true}}, diff)
+}
@@ -30,10 +30,14 @@ type StreamProvider interface {
 
 type providerRegistry struct {
 	sync.RWMutex
-	providersByStream map[string]StreamProvider
+	providersByStream      map[string]StreamProvider
+	deltaProvidersByStream map[string]DeltaStreamProvider
 }
 
-var registry = &providerRegistry{providersByStream: map[string]StreamProvider{}}
+var registry = &providerRegistry{
+	providersByStream:      map[string]StreamProvider{},
+	deltaProvidersByStream: map[string]DeltaStreamProvider{},
+}
 
 // RegisterStreamProviders registers a collection of providers with the
 // streamer service. This function will roll back changes if any registration
@@ -66,12 +70,18 @@ func registerUnsafe(provider StreamProvider) error {
 		return fmt.Errorf("Stream provider already registered for stream name %s", newName)
 	}
 	registry.providersByStream[newName] = provider
+	if deltaProvider, ok := provider.(DeltaStreamProvider); ok {
+		registry.deltaProvidersByStream[newName] = deltaProvider
+	} else {
+		registry.deltaProvidersByStream[newName] = NewDeltaStreamProvider(provider)
+	}
 	return nil
 }
 
 func unregisterUnsafe(provs []StreamProvider) {
 	for _, provider := range provs {
 		delete(registry.providersByStream, provider.GetStreamName())
+		delete(registry.deltaProvidersByStream, provider.GetStreamName())
 	}
 }
 
@@ -87,3 +97,18 @@ func GetStreamProvider(streamName string) (StreamProvider, error) {
 	}
 	return provider, nil
 }
+
+// GetDeltaStreamProvider returns the delta-aware stream provider for a stream
+// name. Providers which don't implement DeltaStreamProvider themselves are
+// wrapped with NewDeltaStreamProvider when they are registered. Returns an
+// error if no provider has been registered for the stream.
+func GetDeltaStreamProvider(streamName string) (DeltaStreamProvider, error) {
+	registry.RLock()
+	provider, ok := registry.deltaProvidersByStream[streamName]
+	registry.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("No provider found for stream %s", streamName)
+	}
+	return provider, nil
+}
@@ -12,24 +12,51 @@ to be notified on database changes with a stream of updates.
 
 The applications in the gateways connect to the StreamingServer and
 the updates would be pushed through that channel.
+
+Gateways which send a StreamDigest as the request's extra args receive
+incremental updates relative to the digest they hold, and can ask for the
+stream to be kept open. Open streams of providers which version their keys
+are re-evaluated every VersionPollInterval, since that only loads the
+versions of the keys. Open streams of other providers are re-evaluated every
+UpdatePollInterval. A new batch is pushed whenever the stream contents
+change.
 */
 package servicers
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/streamer/providers"
 
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// VersionPollInterval is the interval at which streams that are kept open
+// are re-evaluated if their provider implements
+// providers.VersionedStreamProvider.
+var VersionPollInterval = 10 * time.Second
+
+// UpdatePollInterval is the interval at which streams that are kept open are
+// re-evaluated if their provider doesn't version its keys, in which case
+// every evaluation recomputes the full stream.
+var UpdatePollInterval = 5 * time.Minute
+
 type StreamingServer struct{}
 
 func GetUpdatesUnverified(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
 ) error {
+	streamDigest := &protos.StreamDigest{}
+	if request.ExtraArgs != nil && ptypes.UnmarshalAny(request.ExtraArgs, streamDigest) == nil {
+		return getUpdatesDelta(request, streamDigest, stream)
+	}
+
 	streamProvider, err := providers.GetStreamProvider(request.GetStreamName())
 	if err != nil {
 		return status.Errorf(codes.Unavailable, "Stream %s does not exist", request.GetStreamName())
@@ -45,6 +72,63 @@ func GetUpdatesUnverified(
 	return nil
 }
 
+func getUpdatesDelta(
+	request *protos.StreamRequest,
+	streamDigest *protos.StreamDigest,
+	stream protos.Streamer_GetUpdatesServer,
+) error {
+	streamProvider, err := providers.GetDeltaStreamProvider(request.GetStreamName())
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Stream %s does not exist", request.GetStreamName())
+	}
+	gatewayID := request.GetGatewayId()
+	updateBatch, err := streamProvider.GetUpdatesDelta(gatewayID, streamDigest.Digest, streamDigest.ExtraArgs)
+	if err != nil {
+		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+	}
+	if err := stream.Send(updateBatch); err != nil {
+		return err
+	}
+	if !streamDigest.KeepOpen {
+		return nil
+	}
+
+	digest := updateBatch.Digest
+	ticker := time.NewTicker(getPollInterval(request.GetStreamName()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+		updateBatch, err = streamProvider.GetUpdatesDelta(gatewayID, digest, streamDigest.ExtraArgs)
+		if err != nil {
+			// Keep the stream open, the gateway still holds a valid snapshot
+			glog.Errorf("Error polling stream %s for gateway %s: %s", request.GetStreamName(), gatewayID, err)
+			continue
+		}
+		if updateBatch.Digest == digest {
+			continue
+		}
+		if err := stream.Send(updateBatch); err != nil {
+			return err
+		}
+		digest = updateBatch.Digest
+	}
+}
+
+func getPollInterval(streamName string) time.Duration {
+	streamProvider, err := providers.GetStreamProvider(streamName)
+	if err != nil {
+		return UpdatePollInterval
+	}
+	if _, ok := streamProvider.(providers.VersionedStreamProvider); ok {
+		return VersionPollInterval
+	}
+	return UpdatePollInterval
+}
+
 func (srv *StreamingServer) GetUpdates(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
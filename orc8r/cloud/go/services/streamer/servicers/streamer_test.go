@@ -10,14 +10,18 @@ package servicers_test
 
 import (
 	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/services/streamer/servicers"
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
@@ -25,9 +29,12 @@ import (
 )
 
 type mockStreamProvider struct {
-	name   string
-	retVal []*protos.DataUpdate
-	retErr error
+	sync.Mutex
+	name      string
+	retVal    []*protos.DataUpdate
+	retErr    error
+	calls     int
+	extraArgs *any.Any
 }
 
 func (m *mockStreamProvider) GetStreamName() string {
@@ -35,9 +42,73 @@ func (m *mockStreamProvider) GetStreamName() string {
 }
 
 func (m *mockStreamProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.calls++
+	m.extraArgs = extraArgs
 	return m.retVal, m.retErr
 }
 
+func (m *mockStreamProvider) getCalls() int {
+	m.Lock()
+	defer m.Unlock()
+	return m.calls
+}
+
+func (m *mockStreamProvider) getExtraArgs() *any.Any {
+	m.Lock()
+	defer m.Unlock()
+	return m.extraArgs
+}
+
+func (m *mockStreamProvider) setRetVal(retVal []*protos.DataUpdate) {
+	m.Lock()
+	defer m.Unlock()
+	m.retVal = retVal
+}
+
+type mockVersionedStreamProvider struct {
+	mockStreamProvider
+	versions      map[string]string
+	requestedKeys [][]string
+}
+
+func (m *mockVersionedStreamProvider) GetVersions(gatewayId string, extraArgs *any.Any) (map[string]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.versions, m.retErr
+}
+
+func (m *mockVersionedStreamProvider) GetUpdatesForKeys(gatewayId string, keys []string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.requestedKeys = append(m.requestedKeys, keys)
+	requested := map[string]bool{}
+	for _, key := range keys {
+		requested[key] = true
+	}
+	ret := []*protos.DataUpdate{}
+	for _, update := range m.retVal {
+		if requested[update.Key] {
+			ret = append(ret, update)
+		}
+	}
+	return ret, m.retErr
+}
+
+func (m *mockVersionedStreamProvider) setContents(versions map[string]string, retVal []*protos.DataUpdate) {
+	m.Lock()
+	defer m.Unlock()
+	m.versions = versions
+	m.retVal = retVal
+}
+
+func (m *mockVersionedStreamProvider) getRequestedKeys() [][]string {
+	m.Lock()
+	defer m.Unlock()
+	return m.requestedKeys
+}
+
 func TestStreamingServer_GetUpdates(t *testing.T) {
 	streamer_test_init.StartTestService(t)
 	conn, err := registry.GetConnection(streamer.ServiceName)
@@ -84,3 +155,201 @@ func TestStreamingServer_GetUpdates(t *testing.T) {
 	_, err = streamerClient.Recv()
 	assert.Error(t, err, "Stream stream_dne does not exist", codes.Unavailable)
 }
+
+func TestStreamingServer_GetUpdatesDelta(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	initial := []*protos.DataUpdate{
+		{Key: "a", Value: []byte("123")},
+		{Key: "b", Value: []byte("456")},
+	}
+	provider := &mockStreamProvider{name: "delta", retVal: initial}
+	assert.NoError(t, providers.RegisterStreamProvider(provider))
+
+	getBatch := func(digest *protos.StreamDigest) *protos.DataUpdateBatch {
+		return recvBatch(t, grpcClient, context.Background(), "hwId", "delta", digest)
+	}
+
+	// No digest: full resync
+	batch := getBatch(&protos.StreamDigest{})
+	assert.True(t, batch.Resync)
+	assert.Equal(t, providers.GetUpdatesDigest(initial), batch.Digest)
+	assert.Len(t, batch.Updates, 2)
+
+	// Same digest: no-op
+	batch = getBatch(&protos.StreamDigest{Digest: batch.Digest})
+	assert.False(t, batch.Resync)
+	assert.Equal(t, providers.GetUpdatesDigest(initial), batch.Digest)
+	assert.Empty(t, batch.Updates)
+
+	// Changed, added and deleted keys
+	updated := []*protos.DataUpdate{
+		{Key: "b", Value: []byte("789")},
+		{Key: "c", Value: []byte("000")},
+	}
+	provider.setRetVal(updated)
+	batch = getBatch(&protos.StreamDigest{Digest: providers.GetUpdatesDigest(initial)})
+	assert.False(t, batch.Resync)
+	assert.Equal(t, providers.GetUpdatesDigest(updated), batch.Digest)
+	assert.Equal(t, protos.TestMarshal(updated[0]), protos.TestMarshal(batch.Updates[0]))
+	assert.Equal(t, protos.TestMarshal(updated[1]), protos.TestMarshal(batch.Updates[1]))
+	assert.Equal(t, protos.TestMarshal(&protos.DataUpdate{Key: "a", Deleted: true}), protos.TestMarshal(batch.Updates[2]))
+
+	// Unknown digest: full resync
+	batch = getBatch(&protos.StreamDigest{Digest: "foobar"})
+	assert.True(t, batch.Resync)
+	assert.Len(t, batch.Updates, 2)
+
+	// The provider gets the extra args nested in the digest
+	providerArgs, err := ptypes.MarshalAny(&protos.GatewayConfigsDigest{Md5HexDigest: "md5"})
+	assert.NoError(t, err)
+	getBatch(&protos.StreamDigest{ExtraArgs: providerArgs})
+	assert.Equal(t, protos.TestMarshal(providerArgs), protos.TestMarshal(provider.getExtraArgs()))
+}
+
+func TestStreamingServer_GetUpdatesDeltaVersioned(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	provider := &mockVersionedStreamProvider{mockStreamProvider: mockStreamProvider{name: "versioned"}}
+	provider.setContents(map[string]string{"a": "1", "b": "1"}, []*protos.DataUpdate{
+		{Key: "a", Value: []byte("123")},
+		{Key: "b", Value: []byte("456")},
+	})
+	assert.NoError(t, providers.RegisterStreamProvider(provider))
+	getBatch := func(digest *protos.StreamDigest) *protos.DataUpdateBatch {
+		return recvBatch(t, grpcClient, context.Background(), "hwId", "versioned", digest)
+	}
+
+	// No digest: full resync
+	batch := getBatch(&protos.StreamDigest{})
+	assert.True(t, batch.Resync)
+	assert.Len(t, batch.Updates, 2)
+	assert.Equal(t, 1, provider.getCalls())
+
+	// Same versions: no-op without loading any value
+	batch = getBatch(&protos.StreamDigest{Digest: batch.Digest})
+	assert.False(t, batch.Resync)
+	assert.Empty(t, batch.Updates)
+	assert.Equal(t, 1, provider.getCalls())
+	assert.Empty(t, provider.getRequestedKeys())
+
+	// Only the values of changed and added keys are loaded
+	digest := batch.Digest
+	updated := []*protos.DataUpdate{
+		{Key: "b", Value: []byte("789")},
+		{Key: "c", Value: []byte("000")},
+	}
+	provider.setContents(map[string]string{"b": "2", "c": "1"}, updated)
+	batch = getBatch(&protos.StreamDigest{Digest: digest})
+	assert.False(t, batch.Resync)
+	assert.Equal(t, 1, provider.getCalls())
+	assert.Equal(t, [][]string{{"b", "c"}}, provider.getRequestedKeys())
+	assert.Len(t, batch.Updates, 3)
+	assert.Equal(t, protos.TestMarshal(updated[0]), protos.TestMarshal(batch.Updates[0]))
+	assert.Equal(t, protos.TestMarshal(updated[1]), protos.TestMarshal(batch.Updates[1]))
+	assert.Equal(t, protos.TestMarshal(&protos.DataUpdate{Key: "a", Deleted: true}), protos.TestMarshal(batch.Updates[2]))
+
+	// Unknown digest: full resync
+	batch = getBatch(&protos.StreamDigest{Digest: "foobar"})
+	assert.True(t, batch.Resync)
+	assert.Len(t, batch.Updates, 2)
+	assert.Equal(t, 2, provider.getCalls())
+}
+
+func TestStreamingServer_GetUpdatesKeepOpenVersioned(t *testing.T) {
+	defer func(interval time.Duration) { servicers.UpdatePollInterval = interval }(servicers.UpdatePollInterval)
+	defer func(interval time.Duration) { servicers.VersionPollInterval = interval }(servicers.VersionPollInterval)
+	servicers.UpdatePollInterval = time.Hour
+	servicers.VersionPollInterval = 10 * time.Millisecond
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	provider := &mockVersionedStreamProvider{mockStreamProvider: mockStreamProvider{name: "keep_open_versioned"}}
+	provider.setContents(map[string]string{"a": "1"}, []*protos.DataUpdate{{Key: "a", Value: []byte("123")}})
+	assert.NoError(t, providers.RegisterStreamProvider(provider))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	extraArgs, err := ptypes.MarshalAny(&protos.StreamDigest{KeepOpen: true})
+	assert.NoError(t, err)
+	streamerClient, err := grpcClient.GetUpdates(
+		ctx,
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "keep_open_versioned", ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+	batch, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, batch.Resync)
+
+	// A new version of a key pushes its new value
+	updated := []*protos.DataUpdate{{Key: "a", Value: []byte("456")}}
+	provider.setContents(map[string]string{"a": "2"}, updated)
+	batch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, batch.Resync)
+	assert.Equal(t, protos.TestMarshal(updated[0]), protos.TestMarshal(batch.Updates[0]))
+	assert.Equal(t, 1, provider.getCalls())
+}
+
+func TestStreamingServer_GetUpdatesKeepOpenPoll(t *testing.T) {
+	defer func(interval time.Duration) { servicers.UpdatePollInterval = interval }(servicers.UpdatePollInterval)
+	servicers.UpdatePollInterval = 10 * time.Millisecond
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	initial := []*protos.DataUpdate{{Key: "a", Value: []byte("123")}}
+	provider := &mockStreamProvider{name: "keep_open_poll", retVal: initial}
+	assert.NoError(t, providers.RegisterStreamProvider(provider))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	extraArgs, err := ptypes.MarshalAny(&protos.StreamDigest{KeepOpen: true})
+	assert.NoError(t, err)
+	streamerClient, err := grpcClient.GetUpdates(
+		ctx,
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "keep_open_poll", ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+	batch, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, batch.Resync)
+
+	// Streams of providers which don't version their keys are re-evaluated
+	// every UpdatePollInterval
+	updated := []*protos.DataUpdate{{Key: "a", Value: []byte("456")}}
+	provider.setRetVal(updated)
+	batch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, batch.Resync)
+	assert.Equal(t, providers.GetUpdatesDigest(updated), batch.Digest)
+}
+
+func recvBatch(
+	t *testing.T,
+	grpcClient protos.StreamerClient,
+	ctx context.Context,
+	gatewayID string,
+	streamName string,
+	digest *protos.StreamDigest,
+) *protos.DataUpdateBatch {
+	extraArgs, err := ptypes.MarshalAny(digest)
+	assert.NoError(t, err)
+	streamerClient, err := grpcClient.GetUpdates(
+		ctx,
+		&protos.StreamRequest{GatewayId: gatewayID, StreamName: streamName, ExtraArgs: extraArgs},
+	)
+	assert.NoError(t, err)
+	batch, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	return batch
+}
@@ -10,11 +10,13 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/streamer"
+	"magma/orc8r/cloud/go/services/streamer/providers"
 	"magma/orc8r/cloud/go/services/streamer/servicers"
 )
 
@@ -25,6 +27,18 @@ func main() {
 		log.Fatalf("Error creating service: %s", err)
 	}
 
+	if srv.Config != nil {
+		if pollInterval, err := srv.Config.GetIntParam("update_poll_interval_secs"); err == nil && pollInterval > 0 {
+			servicers.UpdatePollInterval = time.Duration(pollInterval) * time.Second
+		}
+		if pollInterval, err := srv.Config.GetIntParam("version_poll_interval_secs"); err == nil && pollInterval > 0 {
+			servicers.VersionPollInterval = time.Duration(pollInterval) * time.Second
+		}
+		if cacheSize, err := srv.Config.GetIntParam("snapshot_cache_max_mb"); err == nil && cacheSize > 0 {
+			providers.SnapshotCacheMaxBytes = int64(cacheSize) << 20
+		}
+	}
+
 	// Add servicers to the service
 	servicer := &servicers.StreamingServer{}
 	protos.RegisterStreamerServer(srv.GrpcServer, servicer)
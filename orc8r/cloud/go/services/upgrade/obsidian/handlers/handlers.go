@@ -77,7 +77,7 @@ func createReleaseChannel(c echo.Context) error {
 		Name:   string(channel.Name),
 		Config: channel,
 	}
-	_, err := configurator.CreateInternalEntity(entity)
+	_, err := configurator.CreateInternalEntity(c.Request().Context(), entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -107,7 +107,7 @@ func updateReleaseChannel(c echo.Context) error {
 		Type:      orc8r.UpgradeReleaseChannelEntityType,
 		NewConfig: channel,
 	}
-	_, err := configurator.UpdateInternalEntity(update)
+	_, err := configurator.UpdateInternalEntity(c.Request().Context(), update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -125,7 +125,7 @@ func deleteReleaseChannel(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
-	err = configurator.DeleteInternalEntity(orc8r.UpgradeReleaseChannelEntityType, channelID)
+	err = configurator.DeleteInternalEntity(c.Request().Context(), orc8r.UpgradeReleaseChannelEntityType, channelID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -185,7 +185,7 @@ func createrTier(c echo.Context) error {
 		Name:   string(tier.Name),
 		Config: tier,
 	}
-	_, err := configurator.CreateEntity(networkID, entity)
+	_, err := configurator.CreateEntity(c.Request().Context(), networkID, entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -230,7 +230,7 @@ func updateTier(c echo.Context) error {
 		NewName:   swag.String(string(tier.Name)),
 		NewConfig: tier,
 	}
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntity(c.Request().Context(), networkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -252,7 +252,7 @@ func deleteTier(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
 
-	err = configurator.DeleteEntity(networkID, orc8r.UpgradeTierEntityType, tierID)
+	err = configurator.DeleteEntity(c.Request().Context(), networkID, orc8r.UpgradeTierEntityType, tierID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -24,6 +24,7 @@ import (
 const (
 	postgresDialect = "psql"
 	mariaDialect    = "maria"
+	sqliteDialect   = "sqlite"
 )
 
 // GetSqlBuilder returns a squirrel Builder for the configured SQL dialect as
@@ -40,6 +41,8 @@ func GetSqlBuilder() StatementBuilder {
 		return NewPostgresStatementBuilder()
 	case mariaDialect:
 		return NewMariaDBStatementBuilder()
+	case sqliteDialect:
+		return NewSQLiteStatementBuilder()
 	default:
 		panic(fmt.Sprintf("unsupported sql dialect %s", dialect))
 	}
@@ -88,6 +91,13 @@ func NewMariaDBStatementBuilder() StatementBuilder {
 	return mariaDBStatementBuilder{StatementBuilderType: baseBuilder}
 }
 
+// NewSQLiteStatementBuilder returns an implementation of StatementBuilder for
+// SQLite dialect. Upserts require SQLite 3.24 or later.
+func NewSQLiteStatementBuilder() StatementBuilder {
+	baseBuilder := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)
+	return sqliteStatementBuilder{StatementBuilderType: baseBuilder}
+}
+
 type postgresStatementBuilder struct {
 	squirrel.StatementBuilderType
 }
@@ -135,6 +145,28 @@ func (msb mariaDBStatementBuilder) CreateIndex(name string) CreateIndexBuilder {
 		Name(name)
 }
 
+type sqliteStatementBuilder struct {
+	squirrel.StatementBuilderType
+}
+
+func (ssb sqliteStatementBuilder) Insert(into string) InsertBuilder {
+	baseInsertBuilder := ssb.StatementBuilderType.Insert(into)
+	return sqliteInsertBuilder{baseInsertBuilder}
+}
+
+func (ssb sqliteStatementBuilder) CreateTable(name string) CreateTableBuilder {
+	// see comment on the postgres builder about the EmptyBuilder
+	return CreateTableBuilder(builder.EmptyBuilder).
+		columnTypeNames(sqliteColumnTypeMap).
+		Name(name)
+}
+
+func (ssb sqliteStatementBuilder) CreateIndex(name string) CreateIndexBuilder {
+	// see comment on postgres builder CreateTable about EmptyBuilder
+	return CreateIndexBuilder(builder.EmptyBuilder).
+		Name(name)
+}
+
 // InsertBuilder is an interface which tracks squirrel's InsertBuilder
 // struct but returns InsertBuilder on all self-referencing returns and adds
 // an OnConflict method to support upserts.
@@ -306,6 +338,77 @@ func (mib mariaInsertBuilder) Select(sb squirrel.SelectBuilder) InsertBuilder {
 	return mariaInsertBuilder{newDelegate}
 }
 
+type sqliteInsertBuilder struct {
+	squirrel.InsertBuilder
+}
+
+// SQLite's upsert syntax matches PostgreSQL's
+func (sib sqliteInsertBuilder) OnConflict(setValues []UpsertValue, columns ...string) InsertBuilder {
+	if funk.IsEmpty(columns) {
+		panic("must provide at least one column in upsert clause builder")
+	}
+
+	suffixFormat := "ON CONFLICT %s DO %s"
+	colList := fmt.Sprintf("(%s)", strings.Join(columns, ", "))
+
+	if funk.IsEmpty(setValues) {
+		return sib.Suffix(fmt.Sprintf(suffixFormat, colList, "NOTHING"))
+	}
+
+	updateStr, updateArgs := setValuesToUpsertClause(setValues, true)
+	return sib.Suffix(fmt.Sprintf(suffixFormat, colList, updateStr), updateArgs...)
+}
+
+func (sib sqliteInsertBuilder) PlaceholderFormat(f squirrel.PlaceholderFormat) InsertBuilder {
+	newDelegate := sib.InsertBuilder.PlaceholderFormat(f)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) RunWith(runner squirrel.BaseRunner) InsertBuilder {
+	newDelegate := sib.InsertBuilder.RunWith(runner)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Prefix(sql string, args ...interface{}) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Prefix(sql, args...)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Options(options ...string) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Options(options...)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Into(from string) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Into(from)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Columns(columns ...string) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Columns(columns...)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Values(values ...interface{}) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Values(values...)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Suffix(sql string, args ...interface{}) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Suffix(sql, args...)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) SetMap(clauses map[string]interface{}) InsertBuilder {
+	newDelegate := sib.InsertBuilder.SetMap(clauses)
+	return sqliteInsertBuilder{newDelegate}
+}
+
+func (sib sqliteInsertBuilder) Select(sb squirrel.SelectBuilder) InsertBuilder {
+	newDelegate := sib.InsertBuilder.Select(sb)
+	return sqliteInsertBuilder{newDelegate}
+}
+
 func ClearStatementCacheLogOnError(cache *squirrel.StmtCache, callsite string) {
 	err := cache.Clear()
 	if err != nil {
@@ -324,8 +427,8 @@ func setValuesToUpsertClause(setValues []UpsertValue, writeSet bool) (string, []
 	}).([]string)
 	setClause := strings.Join(setParts, ", ")
 
-	// This is sloppy but we can make it nice if we ever have to support more
-	// than just psql and mysql
+	// This is sloppy but we can make it nice if we ever have to support a
+	// dialect which doesn't follow either psql or mysql syntax
 	var upsertClause string
 	if writeSet {
 		upsertClause = fmt.Sprintf("UPDATE SET %s", setClause)
@@ -97,6 +97,29 @@ func TestMysqlInsertBuilder_OnConflict(t *testing.T) {
 	runCases(t, cases, ib)
 }
 
+func TestSQLiteInsertBuilder_OnConflict(t *testing.T) {
+	ib := sqliteStatementBuilder{squirrel.StatementBuilder.PlaceholderFormat(squirrel.Question)}.Insert("table")
+
+	cases := []testCase{
+		{
+			setValues:    nil,
+			columns:      []string{"foo"},
+			expectedSql:  "INSERT INTO table (foo,bar) VALUES (?,?) ON CONFLICT (foo) DO NOTHING",
+			expectedArgs: []interface{}{},
+		},
+		{
+			setValues: []UpsertValue{
+				{Column: "foo", Value: 1},
+				{Column: "bar", Value: "baz"},
+			},
+			columns:      []string{"foo", "bar"},
+			expectedSql:  "INSERT INTO table (foo,bar) VALUES (?,?) ON CONFLICT (foo, bar) DO UPDATE SET foo = ?, bar = ?",
+			expectedArgs: []interface{}{1, "baz"},
+		},
+	}
+	runCases(t, cases, ib)
+}
+
 func runCases(t *testing.T, tcs []testCase, ib InsertBuilder) {
 	for _, tc := range tcs {
 		actualSql, actualArgs, err := ib.Columns("foo", "bar").Values("fooV", "barV").OnConflict(tc.setValues, tc.columns...).ToSql()
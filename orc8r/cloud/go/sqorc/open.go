@@ -10,22 +10,111 @@ package sqorc
 
 import (
 	"database/sql"
+	"fmt"
+	"net/url"
 	"strings"
 )
 
+// sqliteDefaultParams are the go-sqlite3 connection parameters Open sets on
+// sqlite3 sources unless the source already specifies them under any of
+// their names.
+// Foreign keys are enforced so ON DELETE CASCADE behaves as it does in the
+// other dialects, and the busy timeout lets concurrent writers to a database
+// file wait on each other instead of failing.
+var sqliteDefaultParams = []sqliteParam{
+	{names: []string{"_foreign_keys", "_fk"}, value: "1"},
+	{names: []string{"_busy_timeout", "_timeout"}, value: "5000"},
+}
+
+type sqliteParam struct {
+	// names holds the name Open sets first, followed by the aliases
+	// go-sqlite3 accepts for the parameter
+	names []string
+	value string
+}
+
 // Open is a wrapper for sql.Open which sets the max open connections to 1
 // for in memory sqlite3 dbs. In memory sqlite3 creates a new database
 // on each connection, so the number of open connections must be limited
 // to 1 for thread safety. Otherwise, there is a race condition between
 // threads using a cached connection to the original database or opening
 // a new connection to a new database.
+//
+// Shared cache sqlite3 sources are limited to a single open connection as
+// well, since connections sharing a cache fail with SQLITE_LOCKED instead of
+// waiting on the busy timeout. Connections to sqlite3 database files wait on
+// each other through the busy timeout.
 func Open(driver string, source string) (*sql.DB, error) {
+	if driver == "sqlite3" {
+		source = withSQLiteDefaultParams(source)
+	}
 	db, err := sql.Open(driver, source)
 	if err != nil {
 		return nil, err
 	}
-	if driver == "sqlite3" && strings.Contains(source, ":memory:") {
+	if driver == "sqlite3" && isSQLiteSingleConnSource(source) {
 		db.SetMaxOpenConns(1)
 	}
 	return db, nil
 }
+
+func withSQLiteDefaultParams(source string) string {
+	params, ok := parseSQLiteParams(source)
+	if !ok {
+		// Let the driver report the malformed source
+		return source
+	}
+
+	var toAdd []string
+	for _, param := range sqliteDefaultParams {
+		if !hasAnyParam(params, param.names) {
+			toAdd = append(toAdd, fmt.Sprintf("%s=%s", param.names[0], param.value))
+		}
+	}
+	if len(toAdd) == 0 {
+		return source
+	}
+	separator := "?"
+	if strings.ContainsRune(source, '?') {
+		separator = "&"
+	}
+	return source + separator + strings.Join(toAdd, "&")
+}
+
+// isSQLiteSingleConnSource returns true if source is an in memory or shared
+// cache sqlite3 database.
+func isSQLiteSingleConnSource(source string) bool {
+	path := source
+	if pos := strings.IndexRune(source, '?'); pos >= 0 {
+		path = source[:pos]
+	}
+	if strings.Contains(path, ":memory:") {
+		return true
+	}
+	params, ok := parseSQLiteParams(source)
+	if !ok {
+		return true
+	}
+	return params.Get("mode") == "memory" || params.Get("cache") == "shared"
+}
+
+func parseSQLiteParams(source string) (url.Values, bool) {
+	pos := strings.IndexRune(source, '?')
+	if pos < 0 {
+		return url.Values{}, true
+	}
+	params, err := url.ParseQuery(source[pos+1:])
+	if err != nil {
+		return nil, false
+	}
+	return params, true
+}
+
+func hasAnyParam(params url.Values, names []string) bool {
+	for _, name := range names {
+		if _, ok := params[name]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package sqorc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSQLiteDefaultParams(t *testing.T) {
+	assert.Equal(t, ":memory:?_foreign_keys=1&_busy_timeout=5000", withSQLiteDefaultParams(":memory:"))
+	assert.Equal(t, "file:/tmp/magma.db?cache=shared&_foreign_keys=1&_busy_timeout=5000", withSQLiteDefaultParams("file:/tmp/magma.db?cache=shared"))
+	assert.Equal(t, "/tmp/magma.db?_foreign_keys=0&_busy_timeout=1", withSQLiteDefaultParams("/tmp/magma.db?_foreign_keys=0&_busy_timeout=1"))
+	// Aliases are recognized
+	assert.Equal(t, "/tmp/magma.db?_fk=0&_busy_timeout=1", withSQLiteDefaultParams("/tmp/magma.db?_fk=0&_busy_timeout=1"))
+	assert.Equal(t, "/tmp/magma.db?_timeout=1&_foreign_keys=1", withSQLiteDefaultParams("/tmp/magma.db?_timeout=1"))
+	// Malformed sources are left to the driver
+	assert.Equal(t, "/tmp/magma.db?%zz", withSQLiteDefaultParams("/tmp/magma.db?%zz"))
+}
+
+func TestIsSQLiteSingleConnSource(t *testing.T) {
+	assert.True(t, isSQLiteSingleConnSource(":memory:"))
+	assert.True(t, isSQLiteSingleConnSource("file::memory:?cache=shared"))
+	assert.True(t, isSQLiteSingleConnSource("file:magma?mode=memory"))
+	assert.True(t, isSQLiteSingleConnSource("file:/tmp/magma.db?cache=shared"))
+	assert.False(t, isSQLiteSingleConnSource("/tmp/magma.db"))
+	assert.False(t, isSQLiteSingleConnSource("file:/tmp/magma.db?_busy_timeout=1"))
+}
+
+func TestOpen_SQLiteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqorc")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := Open("sqlite3", filepath.Join(dir, "magma.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.Equal(t, 0, db.Stats().MaxOpenConnections)
+
+	db, err = Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.Equal(t, 1, db.Stats().MaxOpenConnections)
+}
+
+func TestOpen_SQLite(t *testing.T) {
+	db, err := Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+	builder := NewSQLiteStatementBuilder()
+
+	_, err = builder.CreateTable("parent").
+		Column("id").Type(ColumnTypeText).PrimaryKey().EndColumn().
+		Column("value").Type(ColumnTypeBytes).EndColumn().
+		RunWith(db).
+		Exec()
+	assert.NoError(t, err)
+	_, err = builder.CreateTable("child").
+		Column("id").Type(ColumnTypeText).PrimaryKey().EndColumn().
+		Column("parent_id").Type(ColumnTypeText).NotNull().EndColumn().
+		ForeignKey("parent", map[string]string{"parent_id": "id"}, ColumnOnDeleteCascade).
+		RunWith(db).
+		Exec()
+	assert.NoError(t, err)
+
+	// Upserts
+	for _, value := range []string{"v1", "v2"} {
+		_, err = builder.Insert("parent").
+			Columns("id", "value").
+			Values("p1", []byte(value)).
+			OnConflict([]UpsertValue{{Column: "value", Value: []byte(value)}}, "id").
+			RunWith(db).
+			Exec()
+		assert.NoError(t, err)
+	}
+	var actual []byte
+	err = builder.Select("value").From("parent").Where("id = ?", "p1").RunWith(db).QueryRow().Scan(&actual)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("v2"), actual)
+
+	// Foreign keys are enforced
+	_, err = builder.Insert("child").Columns("id", "parent_id").Values("c1", "p2").RunWith(db).Exec()
+	assert.Error(t, err)
+	_, err = builder.Insert("child").Columns("id", "parent_id").Values("c1", "p1").RunWith(db).Exec()
+	assert.NoError(t, err)
+	_, err = builder.Delete("parent").Where("id = ?", "p1").RunWith(db).Exec()
+	assert.NoError(t, err)
+	var count int
+	err = builder.Select("COUNT(1)").From("child").RunWith(db).QueryRow().Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -27,9 +27,10 @@ func init() {
 }
 
 /*
-Because we are only supporting psql and mysql right now and the only difference
-between those dialects for table creation is the names of column types, we can
-use concrete types for the CREATE TABLE builder and column builder.
+Because we are only supporting psql, mysql and sqlite right now and the only
+difference between those dialects for table creation is the names of column
+types, we can use concrete types for the CREATE TABLE builder and column
+builder.
 
 The parameterized difference between the dialects is stored as a mapping of
 column type to name inside the data structure for each builder.
@@ -38,6 +39,8 @@ column type to name inside the data structure for each builder.
 var postgresColumnTypeMap = map[ColumnType]string{
 	ColumnTypeText: "TEXT",
 	ColumnTypeInt:  "INTEGER",
+	// BIGINT is a signed 64-bit integer
+	ColumnTypeBigInt: "BIGINT",
 	// BYTEA is effectively limited to 1GB
 	ColumnTypeBytes: "BYTEA",
 	ColumnTypeBool:  "BOOLEAN",
@@ -45,14 +48,26 @@ var postgresColumnTypeMap = map[ColumnType]string{
 
 var mariaColumnTypeMap = map[ColumnType]string{
 	// Mysql won't index TEXT columns, so choose VARCHAR(255) for text type
-	ColumnTypeText: "VARCHAR(255)",
-	ColumnTypeInt:  "INT",
+	ColumnTypeText:   "VARCHAR(255)",
+	ColumnTypeInt:    "INT",
+	ColumnTypeBigInt: "BIGINT",
 	// LONGBLOB stores up to 4GB and the cost is a flat extra 2 bytes of
 	// storage over BLOB, which is limited to 64KB
 	ColumnTypeBytes: "LONGBLOB",
 	ColumnTypeBool:  "BOOLEAN",
 }
 
+var sqliteColumnTypeMap = map[ColumnType]string{
+	ColumnTypeText: "TEXT",
+	ColumnTypeInt:  "INTEGER",
+	// SQLite INTEGER values are stored in up to 8 bytes
+	ColumnTypeBigInt: "INTEGER",
+	ColumnTypeBytes:  "BLOB",
+	// SQLite has no boolean storage class, BOOLEAN gets numeric affinity and
+	// values are stored as 0 and 1
+	ColumnTypeBool: "BOOLEAN",
+}
+
 // ColumnOnDeleteOption is an enum type to specify ON DELETE behavior for
 // foreign keys
 type ColumnOnDeleteOption uint8
@@ -71,6 +86,7 @@ const (
 	ColumnTypeInt
 	ColumnTypeBytes
 	ColumnTypeBool
+	ColumnTypeBigInt
 	// Fill in other types as needed
 )
 
@@ -10,7 +10,7 @@ of patent rights can be found in the PATENTS file in the same directory.
 import logging
 import threading
 import time
-from typing import Any, List
+from typing import Any, List, Optional
 
 import abc
 import grpc
@@ -19,7 +19,8 @@ from google.protobuf import any_pb2
 from magma.common import serialization_utils
 from magma.common.metrics import STREAMER_RESPONSES
 from magma.configuration.service_configs import get_service_config_value
-from orc8r.protos.streamer_pb2 import DataUpdate, StreamRequest
+from orc8r.protos.streamer_pb2 import DataUpdate, StreamDigest, \
+    StreamRequest
 from orc8r.protos.streamer_pb2_grpc import StreamerStub
 
 from .service_registry import ServiceRegistry
@@ -69,6 +70,40 @@ class StreamerClient(threading.Thread):
             """
             raise NotImplementedError()
 
+        def get_stream_digest(self, stream_name: str) -> Optional[str]:
+            """
+            Streams which support incremental updates return the digest of
+            the stream contents they hold, as last passed to
+            process_digest_update, or an empty string if they hold nothing.
+            The cloud then only sends the updates made since that digest.
+
+            Args:
+                stream_name: Name of the stream
+
+            Returns: The digest, or None if the stream only supports full
+                resyncs
+            """
+            return None
+
+        def process_digest_update(self, stream_name: str,
+                                  updates: List[DataUpdate], resync: bool,
+                                  digest: str):
+            """
+            Called instead of process_update for streams which return a
+            digest from get_stream_digest. This method will be called in the
+            event loop provided to the StreamerClient.
+
+            Args:
+                stream_name: Name of the stream
+                updates: Array of updates. If resync is false, updates with
+                    deleted set remove their key.
+                resync: if true, the application can clear the
+                    contents before applying the updates
+                digest: Digest of the stream contents once the updates are
+                    applied, to be returned by get_stream_digest
+            """
+            raise NotImplementedError()
+
     def __init__(self, stream_callbacks, loop):
         """
         Args:
@@ -125,17 +160,49 @@ class StreamerClient(threading.Thread):
 
     def process_stream_updates(self, client, stream_name, callback):
         extra_args = self._get_extra_args_any(callback, stream_name)
+        digest = callback.get_stream_digest(stream_name)
+        if digest is not None:
+            stream_digest = StreamDigest(digest=digest)
+            if extra_args is not None:
+                stream_digest.extra_args.CopyFrom(extra_args)
+            extra_args = any_pb2.Any()
+            extra_args.Pack(stream_digest)
         request = StreamRequest(gatewayId=snowflake.snowflake(),
                                 stream_name=stream_name,
                                 extra_args=extra_args)
         for update_batch in client.GetUpdates(
                 request, timeout=self._stream_timeout):
-            self._loop.call_soon_threadsafe(
-                callback.process_update,
-                stream_name,
-                update_batch.updates,
-                update_batch.resync,
-            )
+            if digest is None:
+                self._loop.call_soon_threadsafe(
+                    callback.process_update,
+                    stream_name,
+                    update_batch.updates,
+                    update_batch.resync,
+                )
+            else:
+                self._process_digest_update(callback, stream_name,
+                                            update_batch)
+
+    def _process_digest_update(self, callback, stream_name, update_batch):
+        """
+        Applies an incremental batch in the event loop and waits for it to
+        be applied, so the next request carries the resulting digest.
+        """
+        applied = threading.Event()
+
+        def apply():
+            try:
+                callback.process_digest_update(
+                    stream_name,
+                    update_batch.updates,
+                    update_batch.resync,
+                    update_batch.digest,
+                )
+            finally:
+                applied.set()
+
+        self._loop.call_soon_threadsafe(apply)
+        applied.wait()
 
     @staticmethod
     def _get_extra_args_any(callback, stream_name):
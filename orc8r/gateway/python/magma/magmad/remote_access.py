@@ -0,0 +1,204 @@
+"""
+Copyright (c) 2016-present, Facebook, Inc.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree. An additional grant
+of patent rights can be found in the PATENTS file in the same directory.
+"""
+import fcntl
+import logging
+import os
+import pty
+import select
+import struct
+import subprocess
+import termios
+import threading
+from typing import Callable, Dict, Iterator, List, Optional
+
+DEFAULT_SHELL = '/bin/bash'
+FILE_CHUNK_SIZE = 64 * 1024
+SHELL_READ_SIZE = 4096
+# How long to wait for shell output before checking if the client is gone
+SHELL_POLL_SECS = 1.0
+
+
+class RemoteAccessError(Exception):
+    """
+    Raised when a remote access request can't be served. not_found is set
+    if the requested file or session doesn't exist.
+    """
+
+    def __init__(self, msg: str, not_found: bool = False):
+        super().__init__(msg)
+        self.not_found = not_found
+
+
+def resolve_allowed_path(path: str, allowed_paths: List[str]) -> str:
+    """
+    Return the real path of path if it's inside one of the allowed
+    directories, following symlinks so that links can't escape them.
+    """
+    if not os.path.isabs(path):
+        raise RemoteAccessError('path {} is not absolute'.format(path))
+    real_path = os.path.realpath(path)
+    for allowed in allowed_paths:
+        real_allowed = os.path.realpath(allowed)
+        if real_path.startswith(real_allowed.rstrip('/') + '/'):
+            return real_path
+    raise RemoteAccessError('path {} is not allowed'.format(path))
+
+
+def write_file(path: str, content: bytes, mode: int):
+    """
+    Write content to path, replacing the file atomically
+    """
+    tmp_path = path + '.tmp'
+    with open(tmp_path, 'wb') as f:
+        f.write(content)
+    os.chmod(tmp_path, mode or 0o644)
+    os.rename(tmp_path, path)
+
+
+def read_file_chunks(path: str) -> Iterator[bytes]:
+    """
+    Yield the content of the file at path in chunks
+    """
+    if not os.path.isfile(path):
+        raise RemoteAccessError('file {} not found'.format(path),
+                                not_found=True)
+    with open(path, 'rb') as f:
+        while True:
+            chunk = f.read(FILE_CHUNK_SIZE)
+            if not chunk:
+                return
+            yield chunk
+
+
+class ShellSession:
+    """
+    A shell running in a pseudo terminal on the gateway
+    """
+
+    def __init__(self, shell: str, command: str, rows: int, cols: int):
+        args = [shell, '-c', command] if command else [shell, '-l']
+        self._master, slave = pty.openpty()
+        try:
+            self.resize(rows, cols)
+            self._proc = subprocess.Popen(
+                args,
+                stdin=slave,
+                stdout=slave,
+                stderr=slave,
+                start_new_session=True,
+                close_fds=True,
+            )
+        except Exception:
+            self._close_master()
+            raise
+        finally:
+            os.close(slave)
+
+    def resize(self, rows: int, cols: int):
+        """
+        Resize the shell's terminal, if a size is given
+        """
+        if rows and cols:
+            fcntl.ioctl(self._master, termios.TIOCSWINSZ,
+                        struct.pack('HHHH', rows, cols, 0, 0))
+
+    def write(self, data: bytes):
+        """
+        Write input to the shell
+        """
+        os.write(self._master, data)
+
+    def read(self, timeout: float) -> Optional[bytes]:
+        """
+        Read output of the shell, waiting up to timeout seconds. Returns an
+        empty string if there was no output and None once the shell's
+        terminal is closed.
+        """
+        ready, _, _ = select.select([self._master], [], [], timeout)
+        if not ready:
+            return b''
+        try:
+            data = os.read(self._master, SHELL_READ_SIZE)
+        except OSError:
+            # Linux raises EIO once the other end of the pty is closed
+            return None
+        return data or None
+
+    def wait(self) -> int:
+        """
+        Wait for the shell to exit and return its exit code
+        """
+        self._close_master()
+        return self._proc.wait()
+
+    def kill(self):
+        """
+        Kill the shell. The session still needs to be closed.
+        """
+        if self._proc.poll() is None:
+            try:
+                os.killpg(self._proc.pid, 9)
+            except ProcessLookupError:
+                pass
+
+    def close(self):
+        """
+        Kill the shell if it's still running, close its terminal and reap it
+        """
+        self.kill()
+        self.wait()
+
+    def _close_master(self):
+        if self._master is not None:
+            os.close(self._master)
+            self._master = None
+
+
+class ShellSessions:
+    """
+    The shell sessions open on the gateway, keyed by session ID
+    """
+
+    def __init__(self):
+        self._sessions = {}  # type: Dict[str, Optional[ShellSession]]
+        self._lock = threading.Lock()
+
+    def add(self, session_id: str,
+            create: Callable[[], ShellSession]) -> ShellSession:
+        """
+        Reserve the session ID, then create the session with create, so that
+        no shell is started for a duplicate ID
+        """
+        with self._lock:
+            if session_id in self._sessions:
+                raise RemoteAccessError(
+                    'session {} already exists'.format(session_id))
+            self._sessions[session_id] = None
+        try:
+            session = create()
+        except Exception:
+            with self._lock:
+                self._sessions.pop(session_id, None)
+            raise
+        with self._lock:
+            self._sessions[session_id] = session
+        return session
+
+    def get(self, session_id: str) -> ShellSession:
+        with self._lock:
+            session = self._sessions.get(session_id)
+        if session is None:
+            raise RemoteAccessError(
+                'session {} not found'.format(session_id), not_found=True)
+        return session
+
+    def remove(self, session_id: str):
+        with self._lock:
+            self._sessions.pop(session_id, None)
+        logging.info('Shell session %s ended', session_id)
@@ -29,6 +29,9 @@ from magma.magmad.generic_command.command_executor import \
     CommandExecutor
 from magma.magmad.service_manager import ServiceManager
 from magma.magmad.check.network_check import ping, traceroute
+from magma.magmad.remote_access import DEFAULT_SHELL, SHELL_POLL_SECS, \
+    RemoteAccessError, ShellSession, ShellSessions, read_file_chunks, \
+    resolve_allowed_path, write_file
 
 
 class MagmadRpcServicer(magmad_pb2_grpc.MagmadServicer):
@@ -63,6 +66,7 @@ class MagmadRpcServicer(magmad_pb2_grpc.MagmadServicer):
         self._magma_service = magma_service
         self._command_executor = command_executor
         self._loop = loop
+        self._shell_sessions = ShellSessions()
 
     def add_to_server(self, server):
         """
@@ -249,6 +253,113 @@ class MagmadRpcServicer(magmad_pb2_grpc.MagmadServicer):
             except queue.Empty:
                 pass
 
+    def OpenShell(self, request, context):
+        """
+        Run a shell on the gateway and stream its output. Input is sent to the
+        shell with SendShellInput.
+        """
+        config = self.__get_remote_access_config(context)
+        if config is None:
+            return
+        try:
+            session = self._shell_sessions.add(
+                request.session_id,
+                lambda: ShellSession(config.get('shell', DEFAULT_SHELL),
+                                     request.command, request.rows,
+                                     request.cols))
+        except (OSError, RemoteAccessError) as e:
+            self.__set_remote_access_err(context, e)
+            return
+
+        logging.info('Shell session %s started', request.session_id)
+        try:
+            while context.is_active():
+                data = session.read(SHELL_POLL_SECS)
+                if data is None:
+                    break
+                if data:
+                    yield magmad_pb2.ShellOutput(data=data)
+            else:
+                return
+            yield magmad_pb2.ShellOutput(exited=True,
+                                         exit_code=session.wait())
+        finally:
+            self._shell_sessions.remove(request.session_id)
+            session.close()
+
+    @return_void
+    def SendShellInput(self, request, context):
+        """
+        Send input to a shell session, resize its terminal or close it
+        """
+        if self.__get_remote_access_config(context) is None:
+            return
+        try:
+            session = self._shell_sessions.get(request.session_id)
+            session.resize(request.rows, request.cols)
+            if request.data:
+                session.write(request.data)
+            if request.close:
+                # OpenShell closes the session once the shell exits
+                session.kill()
+        except (OSError, RemoteAccessError) as e:
+            self.__set_remote_access_err(context, e)
+
+    @return_void
+    def UploadFile(self, request, context):
+        """
+        Write a file under one of the allowed paths
+        """
+        config = self.__get_remote_access_config(context)
+        if config is None:
+            return
+        try:
+            path = resolve_allowed_path(request.path,
+                                        config.get('allowed_paths', []))
+            write_file(path, request.content, request.mode)
+            logging.info('Uploaded %d bytes to %s',
+                         len(request.content), path)
+        except (OSError, RemoteAccessError) as e:
+            self.__set_remote_access_err(context, e)
+
+    def DownloadFile(self, request, context):
+        """
+        Stream the content of a file under one of the allowed paths
+        """
+        config = self.__get_remote_access_config(context)
+        if config is None:
+            return
+        try:
+            path = resolve_allowed_path(request.path,
+                                        config.get('allowed_paths', []))
+            for chunk in read_file_chunks(path):
+                if not context.is_active():
+                    return
+                yield magmad_pb2.FileChunk(content=chunk)
+        except (OSError, RemoteAccessError) as e:
+            self.__set_remote_access_err(context, e)
+
+    def __get_remote_access_config(self, context):
+        config = self._magma_service.config.get('remote_access_config', {})
+        if not config.get('enabled', False):
+            set_grpc_err(context,
+                         grpc.StatusCode.FAILED_PRECONDITION,
+                         'Remote access is disabled on this gateway')
+            return None
+        return config
+
+    @staticmethod
+    def __set_remote_access_err(context, e):
+        if isinstance(e, RemoteAccessError):
+            code = grpc.StatusCode.NOT_FOUND if e.not_found \
+                else grpc.StatusCode.PERMISSION_DENIED
+        elif isinstance(e, FileNotFoundError):
+            code = grpc.StatusCode.NOT_FOUND
+        else:
+            code = grpc.StatusCode.INTERNAL
+        logging.error('Remote access error: %s', e)
+        set_grpc_err(context, code, str(e))
+
     @staticmethod
     def __ping_specified_hosts(ping_param_protos):
         def create_ping_result_proto(ping_result):
@@ -0,0 +1,103 @@
+"""
+Copyright (c) 2016-present, Facebook, Inc.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree. An additional grant
+of patent rights can be found in the PATENTS file in the same directory.
+"""
+import os
+import tempfile
+from unittest import TestCase
+
+from magma.magmad.remote_access import RemoteAccessError, ShellSession, \
+    ShellSessions, read_file_chunks, resolve_allowed_path, write_file
+
+
+class RemoteAccessTest(TestCase):
+    """
+    Tests for remote shell and file transfer on the gateway
+    """
+    def setUp(self):
+        self._dir = tempfile.TemporaryDirectory()
+        self.allowed = os.path.join(self._dir.name, 'allowed')
+        os.mkdir(self.allowed)
+
+    def tearDown(self):
+        self._dir.cleanup()
+
+    def test_resolve_allowed_path(self):
+        path = os.path.join(self.allowed, 'file')
+        self.assertEqual(os.path.realpath(path),
+                         resolve_allowed_path(path, [self.allowed]))
+
+        for path in ['relative/file',
+                     self.allowed,
+                     os.path.join(self.allowed, '..', 'file'),
+                     os.path.join(self._dir.name, 'file')]:
+            with self.assertRaises(RemoteAccessError):
+                resolve_allowed_path(path, [self.allowed])
+
+        # symlinks can't escape the allowed directories
+        link = os.path.join(self.allowed, 'link')
+        os.symlink(self._dir.name, link)
+        with self.assertRaises(RemoteAccessError):
+            resolve_allowed_path(os.path.join(link, 'file'), [self.allowed])
+
+    def test_file_transfer(self):
+        path = os.path.join(self.allowed, 'file')
+        write_file(path, b'hello', 0o600)
+        self.assertEqual(0o600, os.stat(path).st_mode & 0o777)
+        self.assertEqual([b'hello'], list(read_file_chunks(path)))
+
+        with self.assertRaises(RemoteAccessError) as cm:
+            list(read_file_chunks(os.path.join(self.allowed, 'missing')))
+        self.assertTrue(cm.exception.not_found)
+
+    def test_shell(self):
+        session = ShellSession('/bin/sh', 'read line; echo got $line; '
+                                          'exit 3', 24, 80)
+        session.write(b'hi\n')
+        output = b''
+        while True:
+            data = session.read(5.0)
+            if data is None:
+                break
+            output += data
+        self.assertIn(b'got hi', output)
+        self.assertEqual(3, session.wait())
+
+    def test_shell_close(self):
+        session = ShellSession('/bin/sh', 'sleep 60', 24, 80)
+        pid = session._proc.pid
+        session.close()
+        # the shell is reaped and its terminal closed
+        self.assertIsNotNone(session._proc.returncode)
+        with self.assertRaises(ChildProcessError):
+            os.waitpid(pid, 0)
+        self.assertIsNone(session._master)
+        # closing again is harmless
+        session.close()
+
+    def test_shell_sessions(self):
+        sessions = ShellSessions()
+        session = sessions.add('id', lambda: ShellSession('/bin/sh', 'true',
+                                                          24, 80))
+        self.assertIs(session, sessions.get('id'))
+
+        # no shell is started for a duplicate ID
+        def create():
+            raise AssertionError('shell started for a duplicate session')
+        with self.assertRaises(RemoteAccessError):
+            sessions.add('id', create)
+
+        # the ID is released if the shell can't be started
+        with self.assertRaises(OSError):
+            sessions.add('other', lambda: ShellSession('/nonexistent', '',
+                                                       24, 80))
+        with self.assertRaises(RemoteAccessError) as cm:
+            sessions.get('other')
+        self.assertTrue(cm.exception.not_found)
+
+        sessions.remove('id')
+        session.close()